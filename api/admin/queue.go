@@ -0,0 +1,596 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package admin
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/go-vela/server/api/build"
+	"github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/queue"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/util"
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+// reconcileGrace is the time a pending build is given to be popped off the
+// queue and started by a worker before it is reported as missing from the queue.
+const reconcileGrace = time.Minute
+
+// swagger:operation GET /api/v1/admin/queue/items admin AdminListQueueItems
+//
+// Get the items waiting in the routes of the queue
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: query
+//   name: route
+//   description: Route to list items for, defaults to the routes of the registered workers
+//   required: false
+//   type: array
+//   items:
+//     type: string
+//   collectionFormat: multi
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully retrieved the items waiting in the queue
+//     schema:
+//       type: array
+//       items:
+//         "$ref": "#/definitions/QueueItems"
+//   '500':
+//     description: Unable to retrieve the items waiting in the queue
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// ListQueueItems represents the API handler to
+// capture the items waiting in the routes of the queue.
+func ListQueueItems(c *gin.Context) {
+	// capture middleware values
+	u := user.Retrieve(c)
+	ctx := c.Request.Context()
+
+	logrus.Infof("platform admin %s: reading items in queue", u.GetName())
+
+	routes, err := queueRoutes(c)
+	if err != nil {
+		retErr := fmt.Errorf("unable to capture queue routes: %w", err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	queued, err := listQueueItems(ctx, queue.FromContext(c), routes)
+	if err != nil {
+		util.HandleError(c, http.StatusInternalServerError, err)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, queued)
+}
+
+// swagger:operation DELETE /api/v1/admin/queue/items/{build} admin AdminRemoveQueueItem
+//
+// Remove the item for a build waiting in the queue
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: build
+//   description: ID of the build
+//   required: true
+//   type: integer
+// - in: query
+//   name: route
+//   description: Route to remove the item from, defaults to the routes of the registered workers
+//   required: false
+//   type: array
+//   items:
+//     type: string
+//   collectionFormat: multi
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully removed the item from the queue
+//     schema:
+//       type: string
+//   '400':
+//     description: Unable to remove the item from the queue
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '404':
+//     description: Unable to remove the item from the queue
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to remove the item from the queue
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// RemoveQueueItem represents the API handler to remove
+// the item for a build waiting in the queue.
+func RemoveQueueItem(c *gin.Context) {
+	// capture middleware values
+	u := user.Retrieve(c)
+	ctx := c.Request.Context()
+
+	id, err := strconv.ParseInt(util.PathParameter(c, "build"), 10, 64)
+	if err != nil {
+		retErr := fmt.Errorf("unable to convert build parameter %s: %w", util.PathParameter(c, "build"), err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	logrus.Infof("platform admin %s: removing item for build %d from queue", u.GetName(), id)
+
+	routes, err := queueRoutes(c)
+	if err != nil {
+		retErr := fmt.Errorf("unable to capture queue routes: %w", err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	for _, route := range routes {
+		removed, err := queue.FromContext(c).Remove(ctx, route, id)
+		if err != nil {
+			retErr := fmt.Errorf("unable to remove item for build %d from queue %s: %w", id, route, err)
+
+			util.HandleError(c, http.StatusInternalServerError, retErr)
+
+			return
+		}
+
+		if removed {
+			c.JSON(http.StatusOK, fmt.Sprintf("removed item for build %d from queue %s", id, route))
+
+			return
+		}
+	}
+
+	retErr := fmt.Errorf("unable to find item for build %d in queue %v", id, routes)
+
+	util.HandleError(c, http.StatusNotFound, retErr)
+}
+
+// swagger:operation PUT /api/v1/admin/queue/move admin AdminMoveQueueItems
+//
+// Move the items waiting in a route of the queue to another route
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: query
+//   name: from
+//   description: Route to move the items from
+//   required: true
+//   type: string
+// - in: query
+//   name: to
+//   description: Route to move the items to
+//   required: true
+//   type: string
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully moved the items in the queue
+//     schema:
+//       type: string
+//   '400':
+//     description: Unable to move the items in the queue
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to move the items in the queue
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// MoveQueueItems represents the API handler to move the
+// items waiting in a route of the queue to another route.
+func MoveQueueItems(c *gin.Context) {
+	// capture middleware values
+	u := user.Retrieve(c)
+	ctx := c.Request.Context()
+
+	from := c.Query("from")
+	to := c.Query("to")
+
+	if len(from) == 0 || len(to) == 0 || from == to {
+		retErr := fmt.Errorf("unable to move items from queue %q to queue %q: two different routes must be provided", from, to)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	logrus.Infof("platform admin %s: moving items from queue %s to queue %s", u.GetName(), from, to)
+
+	moved, err := queue.FromContext(c).Move(ctx, from, to)
+	if err != nil {
+		retErr := fmt.Errorf("unable to move items from queue %s to queue %s: %w", from, to, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, fmt.Sprintf("%d items moved from queue %s to queue %s", moved, from, to))
+}
+
+// swagger:operation POST /api/v1/admin/queue/requeue/{build} admin AdminRequeueBuild
+//
+// Publish a new item to the queue for a pending build whose item was lost
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: build
+//   description: ID of the build
+//   required: true
+//   type: integer
+// - in: query
+//   name: route
+//   description: Route to publish the item to, defaults to the route from the pipeline
+//   required: false
+//   type: string
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully requeued the build
+//     schema:
+//       type: string
+//   '400':
+//     description: Unable to requeue the build
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '404':
+//     description: Unable to requeue the build
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '409':
+//     description: Unable to requeue the build
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to requeue the build
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// RequeueBuild represents the API handler to publish a new item
+// to the queue for a pending build whose item is missing from the queue.
+func RequeueBuild(c *gin.Context) {
+	// capture middleware values
+	u := user.Retrieve(c)
+	ctx := c.Request.Context()
+	q := queue.FromContext(c)
+	db := database.FromContext(c)
+
+	id, err := strconv.ParseInt(util.PathParameter(c, "build"), 10, 64)
+	if err != nil {
+		retErr := fmt.Errorf("unable to convert build parameter %s: %w", util.PathParameter(c, "build"), err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	logrus.Infof("platform admin %s: requeueing build %d", u.GetName(), id)
+
+	b, err := db.GetBuild(ctx, id)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get build %d: %w", id, err)
+
+		util.HandleError(c, http.StatusNotFound, retErr)
+
+		return
+	}
+
+	if b.GetStatus() != constants.StatusPending {
+		retErr := fmt.Errorf("unable to requeue build %d: build is %s", id, b.GetStatus())
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	r, err := db.GetRepo(ctx, b.GetRepoID())
+	if err != nil {
+		retErr := fmt.Errorf("unable to get repo for build %d: %w", id, err)
+
+		util.HandleError(c, http.StatusNotFound, retErr)
+
+		return
+	}
+
+	// check the route the item is requeued to along with the
+	// routes of the registered workers for an existing item
+	routes, err := workerRoutes(c, c.Query("route"))
+	if err != nil {
+		retErr := fmt.Errorf("unable to capture queue routes: %w", err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	queued, err := listQueueItems(ctx, q, routes)
+	if err != nil {
+		util.HandleError(c, http.StatusInternalServerError, err)
+
+		return
+	}
+
+	// prevent the build from being run twice
+	for _, route := range queued {
+		for _, item := range route.Items {
+			if item.Build.GetID() == id {
+				retErr := fmt.Errorf("unable to requeue build %d: item is waiting in queue %s", id, route.Route)
+
+				util.HandleError(c, http.StatusConflict, retErr)
+
+				return
+			}
+		}
+	}
+
+	// the item may be popped off the queue but still held by a worker
+	held, err := queue.Held(ctx, q)
+	if err != nil {
+		util.HandleError(c, http.StatusInternalServerError, err)
+
+		return
+	}
+
+	if held[id] {
+		retErr := fmt.Errorf("unable to requeue build %d: item is held by a worker", id)
+
+		util.HandleError(c, http.StatusConflict, retErr)
+
+		return
+	}
+
+	route, err := build.Requeue(ctx, q, db, b, r, c.Query("route"))
+	if err != nil {
+		util.HandleError(c, http.StatusInternalServerError, err)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, fmt.Sprintf("requeued build %d for %s to queue %s", id, r.GetFullName(), route))
+}
+
+// swagger:operation GET /api/v1/admin/queue/reconcile admin AdminReconcileQueue
+//
+// Compare the pending builds in the database with the items waiting in the queue
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: query
+//   name: after
+//   description: Unix timestamp to limit the pending builds compared, defaults to 24 hours ago
+//   required: false
+//   type: integer
+// - in: query
+//   name: route
+//   description: Route to compare items for, defaults to the routes of the registered workers
+//   required: false
+//   type: array
+//   items:
+//     type: string
+//   collectionFormat: multi
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully compared the database with the queue
+//     schema:
+//       "$ref": "#/definitions/QueueReconciliation"
+//   '500':
+//     description: Unable to compare the database with the queue
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// ReconcileQueue represents the API handler to report the pending builds in
+// the database missing from the queue and the items waiting in the queue for
+// builds that are no longer pending in the database.
+func ReconcileQueue(c *gin.Context) {
+	// capture middleware values
+	u := user.Retrieve(c)
+	ctx := c.Request.Context()
+	db := database.FromContext(c)
+
+	logrus.Infof("platform admin %s: reconciling pending builds with queue", u.GetName())
+
+	// default timestamp to 24 hours ago if user did not provide it as query parameter
+	after := c.DefaultQuery("after", strconv.FormatInt(time.Now().UTC().Add(-24*time.Hour).Unix(), 10))
+
+	// send API call to capture pending builds
+	pending, err := db.ListPendingBuilds(ctx, after)
+	if err != nil {
+		retErr := fmt.Errorf("unable to capture pending builds: %w", err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	routes, err := queueRoutes(c)
+	if err != nil {
+		retErr := fmt.Errorf("unable to capture queue routes: %w", err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	queued, err := listQueueItems(ctx, queue.FromContext(c), routes)
+	if err != nil {
+		util.HandleError(c, http.StatusInternalServerError, err)
+
+		return
+	}
+
+	// builds popped off the queue and held by a worker are not missing
+	held, err := queue.Held(ctx, queue.FromContext(c))
+	if err != nil {
+		util.HandleError(c, http.StatusInternalServerError, err)
+
+		return
+	}
+
+	builds := make(map[int64]bool)
+	for _, b := range pending {
+		builds[b.GetID()] = true
+	}
+
+	reconciliation := &types.QueueReconciliation{
+		MissingFromQueue:    []*library.Build{},
+		MissingFromDatabase: []*types.QueueItems{},
+	}
+
+	items := make(map[int64]bool)
+
+	for _, route := range queued {
+		missing := &types.QueueItems{Route: route.Route}
+
+		for _, item := range route.Items {
+			items[item.Build.GetID()] = true
+
+			if builds[item.Build.GetID()] {
+				continue
+			}
+
+			// the build may be pending but created before the timeframe
+			b, err := db.GetBuild(ctx, item.Build.GetID())
+			if err == nil && b.GetStatus() == constants.StatusPending {
+				continue
+			}
+
+			missing.Items = append(missing.Items, item)
+		}
+
+		if len(missing.Items) > 0 {
+			reconciliation.MissingFromDatabase = append(reconciliation.MissingFromDatabase, missing)
+		}
+	}
+
+	grace := time.Now().UTC().Add(-reconcileGrace).Unix()
+
+	for _, b := range pending {
+		if items[b.GetID()] || held[b.GetID()] {
+			continue
+		}
+
+		// builds published recently may be popped off
+		// the queue without being started by a worker yet
+		published := b.GetEnqueued()
+		if published == 0 {
+			published = b.GetCreated()
+		}
+
+		if published > grace {
+			continue
+		}
+
+		reconciliation.MissingFromQueue = append(reconciliation.MissingFromQueue, b)
+	}
+
+	c.JSON(http.StatusOK, reconciliation)
+}
+
+// queueRoutes is a helper function to capture the routes of the queue
+// from the query parameters or, when not provided, the routes of the
+// registered workers including the default route.
+func queueRoutes(c *gin.Context) ([]string, error) {
+	routes := c.QueryArray("route")
+	if len(routes) > 0 {
+		return routes, nil
+	}
+
+	return workerRoutes(c, "")
+}
+
+// workerRoutes is a helper function to capture the routes of
+// the registered workers including the default route and the
+// additional route when provided.
+func workerRoutes(c *gin.Context, additional string) ([]string, error) {
+	// send API call to capture the registered workers
+	workers, err := database.FromContext(c).ListWorkers(c.Request.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	unique := map[string]bool{constants.DefaultRoute: true}
+
+	if len(additional) > 0 {
+		unique[additional] = true
+	}
+
+	for _, w := range workers {
+		for _, route := range w.GetRoutes() {
+			unique[route] = true
+		}
+	}
+
+	routes := []string{}
+
+	for route := range unique {
+		routes = append(routes, route)
+	}
+
+	sort.Strings(routes)
+
+	return routes, nil
+}
+
+// listQueueItems is a helper function to capture the items waiting in the
+// routes of the queue with the tokens for the user of each item masked.
+func listQueueItems(ctx context.Context, q queue.Service, routes []string) ([]*types.QueueItems, error) {
+	queued := []*types.QueueItems{}
+
+	for _, route := range routes {
+		items, err := q.List(ctx, route)
+		if err != nil {
+			return nil, fmt.Errorf("unable to list items in queue %s: %w", route, err)
+		}
+
+		for _, item := range items {
+			if item.User != nil {
+				item.User = item.User.Sanitize()
+			}
+		}
+
+		queued = append(queued, &types.QueueItems{Route: route, Items: items})
+	}
+
+	return queued, nil
+}
@@ -0,0 +1,149 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/queue"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+)
+
+func TestAdmin_RequeueBuild(t *testing.T) {
+	// setup database
+	db, err := database.NewTest()
+	if err != nil {
+		t.Errorf("unable to create test database engine: %v", err)
+	}
+
+	defer db.Close()
+
+	// setup queue
+	q, err := (&queue.Setup{
+		Routes:     []string{constants.DefaultRoute},
+		PrivateKey: "tCIevHOBq6DdN5SSBtteXUusjjd0fOqzk2eyi0DMq04NewmShNKQeUbbp3vkvIckb4pCxc+vxUo+mYf/vzOaSg==",
+		PublicKey:  "DXsJkoTSkHlG26d75LyHJG+KQsXPr8VKPpmH/78zmko=",
+		Lease:      time.Minute,
+	}).Memory()
+	if err != nil {
+		t.Errorf("unable to create test queue service: %v", err)
+	}
+
+	// setup types
+	u := new(library.User)
+	u.SetName("octocat")
+	u.SetToken("foo")
+	u.SetHash("bar")
+	u.SetActive(true)
+	u.SetAdmin(true)
+
+	u, err = db.CreateUser(context.TODO(), u)
+	if err != nil {
+		t.Errorf("unable to create test user: %v", err)
+	}
+
+	r := new(library.Repo)
+	r.SetUserID(u.GetID())
+	r.SetOrg("github")
+	r.SetName("octocat")
+	r.SetFullName("github/octocat")
+	r.SetHash("baz")
+	r.SetVisibility(constants.VisibilityPublic)
+
+	r, err = db.CreateRepo(context.TODO(), r)
+	if err != nil {
+		t.Errorf("unable to create test repo: %v", err)
+	}
+
+	builds := make(map[string]*library.Build)
+
+	for i, name := range []string{"leased", "waiting", "missing"} {
+		b := new(library.Build)
+		b.SetRepoID(r.GetID())
+		b.SetNumber(i + 1)
+		b.SetStatus(constants.StatusPending)
+		b.SetCreated(time.Now().UTC().Unix())
+
+		b, err = db.CreateBuild(context.TODO(), b)
+		if err != nil {
+			t.Errorf("unable to create test build: %v", err)
+		}
+
+		builds[name] = b
+	}
+
+	// push the items for the leased and waiting builds
+	for _, name := range []string{"leased", "waiting"} {
+		item, err := json.Marshal(queue.ToItem(builds[name], r, u, 0))
+		if err != nil {
+			t.Errorf("unable to marshal queue item: %v", err)
+		}
+
+		err = q.Push(context.TODO(), constants.DefaultRoute, item)
+		if err != nil {
+			t.Errorf("unable to push queue item: %v", err)
+		}
+	}
+
+	// pop the item for the leased build without acking it
+	_, err = q.Pop(context.TODO(), nil)
+	if err != nil {
+		t.Errorf("unable to pop queue item: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		name string
+		want int
+	}{
+		{
+			name: "leased",
+			want: http.StatusConflict,
+		},
+		{
+			name: "waiting",
+			want: http.StatusConflict,
+		},
+		{
+			name: "missing",
+			want: http.StatusOK,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+
+			resp := httptest.NewRecorder()
+			_, engine := gin.CreateTestContext(resp)
+
+			engine.Use(func(c *gin.Context) {
+				database.ToContext(c, db)
+				queue.WithGinContext(c, q)
+				user.ToContext(c, u)
+				c.Next()
+			})
+			engine.POST("/admin/queue/builds/:build/requeue", RequeueBuild)
+
+			path := fmt.Sprintf("/admin/queue/builds/%d/requeue?route=%s", builds[test.name].GetID(), constants.DefaultRoute)
+			req, _ := http.NewRequest(http.MethodPost, path, nil)
+
+			engine.ServeHTTP(resp, req)
+
+			if resp.Code != test.want {
+				t.Errorf("RequeueBuild for %s returned %v, want %v: %s", test.name, resp.Code, test.want, resp.Body.String())
+			}
+		})
+	}
+}
@@ -34,6 +34,10 @@ import (
 //   name: redirect_uri
 //   description: the url where the user will be sent after authorization
 //   type: string
+// - in: query
+//   name: provider
+//   description: the name of the scm provider to authenticate with (defaults to the default scm provider)
+//   type: string
 // responses:
 //   '200':
 //     description: Successfully authenticated
@@ -68,6 +72,25 @@ func GetAuthToken(c *gin.Context) {
 
 	// capture the OAuth code if present
 	code := c.Request.FormValue("code")
+
+	// capture the scm provider requested for the login
+	//
+	// named scm providers embed their name in the OAuth state
+	name := util.FormParameter(c, "provider")
+	if len(name) == 0 && len(code) > 0 {
+		name = scm.ProviderFromState(oAuthState)
+	}
+
+	// route the login to the requested scm provider
+	provider, err := scm.Select(c, name)
+	if err != nil {
+		retErr := fmt.Errorf("unable to login user: %w", err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
 	if len(code) == 0 {
 		// start the initial OAuth workflow
 		oAuthState, err = scm.FromContext(c).Login(ctx, c.Writer, c.Request)
@@ -96,8 +119,8 @@ func GetAuthToken(c *gin.Context) {
 		return
 	}
 
-	// send API call to capture the user logging in
-	u, err := database.FromContext(c).GetUserForName(ctx, newUser.GetName())
+	// send API call to capture the user logging in from the scm provider
+	u, err := database.FromContext(c).GetUserForProvider(ctx, provider, newUser.GetName())
 	// create a new user account
 	if len(u.GetName()) == 0 || err != nil {
 		// create the user account
@@ -121,9 +144,19 @@ func GetAuthToken(c *gin.Context) {
 		u.SetRefreshToken(rt)
 
 		// send API call to create the user in the database
-		_, err = database.FromContext(c).CreateUser(ctx, u)
+		u, err = database.FromContext(c).CreateUser(ctx, u)
+		if err != nil {
+			retErr := fmt.Errorf("unable to create user %s: %w", newUser.GetName(), err)
+
+			util.HandleError(c, http.StatusServiceUnavailable, retErr)
+
+			return
+		}
+
+		// send API call to store the scm provider for the user
+		err = database.FromContext(c).UpdateUserProvider(ctx, u, provider)
 		if err != nil {
-			retErr := fmt.Errorf("unable to create user %s: %w", u.GetName(), err)
+			retErr := fmt.Errorf("unable to set scm provider for user %s: %w", u.GetName(), err)
 
 			util.HandleError(c, http.StatusServiceUnavailable, retErr)
 
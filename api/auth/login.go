@@ -30,6 +30,10 @@ import (
 //   name: port
 //   description: the port number when type=cli
 //   type: integer
+// - in: query
+//   name: provider
+//   description: the name of the scm provider to authenticate with (defaults to the default scm provider)
+//   type: string
 // responses:
 //   '307':
 //     description: Redirected to /authenticate
@@ -43,6 +47,7 @@ func Login(c *gin.Context) {
 	// capture query params
 	t := util.FormParameter(c, "type")
 	p := util.FormParameter(c, "port")
+	provider := util.FormParameter(c, "provider")
 
 	// temp variable to hold redirect destination
 	r := ""
@@ -65,17 +70,26 @@ func Login(c *gin.Context) {
 		}
 	}
 
+	v := &url.Values{}
+
 	// if we a redirecting to non-default destination,
 	// prep and append the redirect
 	if len(r) > 0 {
-		v := &url.Values{}
 		v.Add("redirect_uri", r)
+	}
+
+	// pass through the scm provider to authenticate with
+	if len(provider) > 0 {
+		v.Add("provider", provider)
+	}
 
+	if len(*v) > 0 {
 		path = fmt.Sprintf("%s?%s", path, v.Encode())
 	}
 
 	// redirect to our authentication handler
 	// will be either <vela server>/authenticate (headless)
 	// or <vela server>/authenticate?redirect_uri=<redirect> (web or cli)
+	// with the scm provider appended if one was requested
 	c.Redirect(http.StatusTemporaryRedirect, path)
 }
@@ -29,6 +29,10 @@ import (
 //   required: true
 //   description: >
 //     scopes: repo, repo:status, user:email, read:user, and read:org
+// - in: query
+//   name: provider
+//   description: the name of the scm provider to authenticate with (defaults to the default scm provider)
+//   type: string
 // responses:
 //   '200':
 //     description: Successfully authenticated
@@ -50,6 +54,16 @@ func PostAuthToken(c *gin.Context) {
 	// capture middleware values
 	ctx := c.Request.Context()
 
+	// route the token to the requested scm provider
+	provider, err := scm.Select(c, util.FormParameter(c, "provider"))
+	if err != nil {
+		retErr := fmt.Errorf("unable to authenticate user: %w", err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
 	// attempt to get user from source
 	u, err := scm.FromContext(c).AuthenticateToken(ctx, c.Request)
 	if err != nil {
@@ -60,8 +74,8 @@ func PostAuthToken(c *gin.Context) {
 		return
 	}
 
-	// check if the user exists
-	u, err = database.FromContext(c).GetUserForName(ctx, u.GetName())
+	// check if the user exists for the scm provider of the token
+	u, err = database.FromContext(c).GetUserForProvider(ctx, provider, u.GetName())
 	if err != nil {
 		retErr := fmt.Errorf("user %s not found", u.GetName())
 
@@ -77,6 +91,7 @@ func PostAuthToken(c *gin.Context) {
 	// mint token options for access token
 	amto := &token.MintTokenOpts{
 		User:          u,
+		SCMProvider:   provider,
 		TokenType:     constants.UserAccessTokenType,
 		TokenDuration: tm.UserAccessTokenDuration,
 	}
@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/gin-gonic/gin"
+	serverconstants "github.com/go-vela/server/constants"
 	"github.com/go-vela/server/database"
 	"github.com/go-vela/server/internal/token"
 	"github.com/go-vela/types/constants"
@@ -27,46 +28,81 @@ func AutoCancel(c *gin.Context, b *library.Build, rB *library.Build, r *library.
 		return false, nil
 	}
 
-	// ensure criteria is met before auto canceling (push to same branch, or pull with same action from same head_ref)
+	// ensure criteria is met before auto canceling (push to same branch, pull with same action
+	// from same head_ref, or merge group with same head_ref)
 	if (strings.EqualFold(rB.GetEvent(), constants.EventPush) &&
 		strings.EqualFold(b.GetEvent(), constants.EventPush) &&
 		strings.EqualFold(b.GetBranch(), rB.GetBranch())) ||
 		(strings.EqualFold(rB.GetEvent(), constants.EventPull) &&
 			strings.EqualFold(b.GetEventAction(), rB.GetEventAction()) &&
+			strings.EqualFold(b.GetHeadRef(), rB.GetHeadRef())) ||
+		(strings.EqualFold(rB.GetEvent(), serverconstants.EventMergeGroup) &&
+			strings.EqualFold(b.GetEvent(), serverconstants.EventMergeGroup) &&
 			strings.EqualFold(b.GetHeadRef(), rB.GetHeadRef())) {
-		switch {
-		case strings.EqualFold(rB.GetStatus(), constants.StatusPending) && cancelOpts.Pending:
-			// pending build will be handled gracefully by worker once pulled off queue
-			rB.SetStatus(constants.StatusCanceled)
+		return cancelBuild(c, rB, r, cancelOpts, fmt.Sprintf("build was auto canceled in favor of build %d", b.GetNumber()))
+	}
 
-			_, err := database.FromContext(c).UpdateBuild(c, rB)
-			if err != nil {
-				return false, err
-			}
+	return true, nil
+}
 
-			// remove executable from table
-			_, err = database.FromContext(c).PopBuildExecutable(c, rB.GetID())
-			if err != nil {
-				return true, err
-			}
-		case strings.EqualFold(rB.GetStatus(), constants.StatusRunning) && cancelOpts.Running:
-			// call cancelRunning routine for builds already running on worker
-			err := cancelRunning(c, rB, r)
-			if err != nil {
-				return false, err
-			}
-		default:
-			return false, nil
-		}
+// CancelMergeGroup is a helper function that cancels a pending or running
+// build for a merge group that was destroyed in the merge queue.
+func CancelMergeGroup(c *gin.Context, rB *library.Build, r *library.Repo, headRef string) (bool, error) {
+	// only builds for the destroyed merge group are canceled
+	if !strings.EqualFold(rB.GetEvent(), serverconstants.EventMergeGroup) ||
+		!strings.EqualFold(rB.GetHeadRef(), headRef) {
+		return false, nil
+	}
+
+	return Cancel(c, rB, r, "build was canceled since the merge group was removed from the merge queue")
+}
 
-		// set error message that references current build
-		rB.SetError(fmt.Sprintf("build was auto canceled in favor of build %d", b.GetNumber()))
+// Cancel is a helper function that cancels a pending
+// or running build and records the reason on the build.
+func Cancel(c *gin.Context, rB *library.Build, r *library.Repo, reason string) (bool, error) {
+	cancelOpts := &pipeline.CancelOptions{
+		Pending: true,
+		Running: true,
+	}
+
+	return cancelBuild(c, rB, r, cancelOpts, reason)
+}
+
+// cancelBuild is a helper function that cancels a pending or running build
+// based off the cancel options and records the reason on the build.
+func cancelBuild(c *gin.Context, rB *library.Build, r *library.Repo, cancelOpts *pipeline.CancelOptions, reason string) (bool, error) {
+	switch {
+	case strings.EqualFold(rB.GetStatus(), constants.StatusPending) && cancelOpts.Pending:
+		// pending build will be handled gracefully by worker once pulled off queue
+		rB.SetStatus(constants.StatusCanceled)
 
 		_, err := database.FromContext(c).UpdateBuild(c, rB)
 		if err != nil {
-			// if this call fails, we still canceled the build, so return true
+			return false, err
+		}
+
+		// remove executable from table
+		_, err = database.FromContext(c).PopBuildExecutable(c, rB.GetID())
+		if err != nil {
 			return true, err
 		}
+	case strings.EqualFold(rB.GetStatus(), constants.StatusRunning) && cancelOpts.Running:
+		// call cancelRunning routine for builds already running on worker
+		err := cancelRunning(c, rB, r)
+		if err != nil {
+			return false, err
+		}
+	default:
+		return false, nil
+	}
+
+	// set error message that explains why the build was canceled
+	rB.SetError(reason)
+
+	_, err := database.FromContext(c).UpdateBuild(c, rB)
+	if err != nil {
+		// if this call fails, we still canceled the build, so return true
+		return true, err
 	}
 
 	return true, nil
@@ -14,6 +14,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/go-vela/server/database"
 	"github.com/go-vela/server/internal/token"
+	"github.com/go-vela/server/queue"
 	"github.com/go-vela/server/router/middleware/build"
 	"github.com/go-vela/server/router/middleware/executors"
 	"github.com/go-vela/server/router/middleware/org"
@@ -207,6 +208,12 @@ func CancelBuild(c *gin.Context) {
 		return
 	}
 
+	// release the build so it no longer counts against the owner when sharing workers
+	err = queue.FromContext(c).Done(ctx, b.GetID())
+	if err != nil {
+		logrus.Errorf("unable to release build %s from queue: %v", entry, err)
+	}
+
 	// remove build executable for clean up
 	_, err = database.FromContext(c).PopBuildExecutable(ctx, b.GetID())
 	if err != nil {
@@ -29,6 +29,12 @@ func CleanBuild(ctx context.Context, database database.Interface, b *library.Bui
 		logrus.Errorf("unable to kill build %d: %v", b.GetNumber(), err)
 	}
 
+	cleanResources(ctx, database, b, services, steps)
+}
+
+// cleanResources is a helper function to kill the
+// services and steps for a build that never ran.
+func cleanResources(ctx context.Context, database database.Interface, b *library.Build, services []*library.Service, steps []*library.Step) {
 	for _, s := range services {
 		// update fields in service object
 		s.SetStatus(constants.StatusKilled)
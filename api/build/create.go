@@ -223,6 +223,8 @@ func CreateBuild(c *gin.Context) {
 		config []byte
 		// variable to store executable pipeline
 		p *pipeline.Build
+		// variable to store the route required by the pipeline labels
+		route string
 		// variable to store pipeline configuration
 		pipeline *library.Pipeline
 		// variable to store the pipeline type for the repository
@@ -265,6 +267,11 @@ func CreateBuild(c *gin.Context) {
 		WithRepo(r).
 		WithUser(u).
 		Compile(config)
+	if err == nil {
+		// verify a registered worker has the labels required by the pipeline
+		route, err = LabelRoute(ctx, database.FromContext(c), config, r.GetPipelineType())
+	}
+
 	if err != nil {
 		retErr := fmt.Errorf("unable to compile pipeline configuration for %s/%d: %w", r.GetFullName(), input.GetNumber(), err)
 
@@ -357,6 +364,7 @@ func CreateBuild(c *gin.Context) {
 		input,
 		r,
 		u,
+		route,
 	)
 }
 
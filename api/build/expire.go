@@ -0,0 +1,284 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package build
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/queue"
+	"github.com/go-vela/server/scm"
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+	"github.com/sirupsen/logrus"
+)
+
+// expireGrace is the extra time a pending build without an item in
+// the scanned routes of the queue waits before it is expired, so a
+// build popped by a worker that has not started it yet is not expired.
+const expireGrace = time.Minute
+
+// ExpireQueued is a helper function that removes the items waiting in the
+// queue longer than the max time for their route and sets the builds to error.
+//
+// Pending builds without an item in the routes of the queue, like builds in
+// a route that was removed, are expired using the default max time unless a
+// worker still holds the build under a lease or counted in-flight.
+func ExpireQueued(ctx context.Context, q queue.Service, db database.Interface, providers *scm.Providers, maxTime queue.MaxTime) (int, error) {
+	routes, err := expireRoutes(ctx, db, maxTime)
+	if err != nil {
+		return 0, err
+	}
+
+	now := time.Now().UTC()
+
+	// track the builds with an item in the queue
+	queued := make(map[int64]bool)
+
+	expired := 0
+
+	for _, route := range routes {
+		items, err := q.List(ctx, route)
+		if err != nil {
+			return expired, fmt.Errorf("unable to list items in queue %s: %w", route, err)
+		}
+
+		limit := maxTime.For(route)
+
+		for _, item := range items {
+			queued[item.Build.GetID()] = true
+
+			// skip checking the database for items created within the max time
+			if limit == 0 || item.Build.GetCreated() > now.Add(-limit).Unix() {
+				continue
+			}
+
+			// send database call to capture the build for the enqueued time
+			b, err := db.GetBuild(ctx, item.Build.GetID())
+			if err != nil {
+				logrus.Errorf("unable to get build %d to expire: %v", item.Build.GetID(), err)
+
+				continue
+			}
+
+			if !expiredBuild(b, limit, now) {
+				continue
+			}
+
+			removed, err := q.Remove(ctx, route, b.GetID())
+			if err != nil {
+				logrus.Errorf("unable to remove build %d from queue %s: %v", b.GetID(), route, err)
+
+				continue
+			}
+
+			// the build was popped after it was listed
+			if !removed {
+				continue
+			}
+
+			logrus.Infof("expiring build %d waiting in queue %s for more than %s", b.GetID(), route, limit)
+
+			updated, err := expireBuild(ctx, db, providers, b, limit)
+			if err != nil {
+				logrus.Errorf("unable to expire build %d: %v", b.GetID(), err)
+			}
+
+			if updated {
+				expired++
+			}
+		}
+	}
+
+	limit := maxTime.For(queue.DefaultMaxTime)
+	if limit == 0 {
+		return expired, nil
+	}
+
+	// capture the builds popped off the queue that a worker still holds
+	held, err := queue.Held(ctx, q)
+	if err != nil {
+		return expired, err
+	}
+
+	// send database call to capture the pending builds created before the max time
+	pending, err := db.ListPendingBuilds(ctx, "0")
+	if err != nil {
+		return expired, fmt.Errorf("unable to capture pending builds: %w", err)
+	}
+
+	for _, b := range pending {
+		if queued[b.GetID()] || held[b.GetID()] || !expiredBuild(b, limit+expireGrace, now) {
+			continue
+		}
+
+		logrus.Infof("expiring build %d missing from the queue for more than %s", b.GetID(), limit)
+
+		updated, err := expireBuild(ctx, db, providers, b, limit)
+		if err != nil {
+			logrus.Errorf("unable to expire build %d: %v", b.GetID(), err)
+		}
+
+		if updated {
+			expired++
+		}
+	}
+
+	return expired, nil
+}
+
+// expireRoutes is a helper function to capture the routes of
+// the queue for the registered workers and the max times.
+func expireRoutes(ctx context.Context, db database.Interface, maxTime queue.MaxTime) ([]string, error) {
+	// send database call to capture the registered workers
+	workers, err := db.ListWorkers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to capture workers: %w", err)
+	}
+
+	unique := map[string]bool{constants.DefaultRoute: true}
+
+	for _, route := range maxTime.Routes() {
+		unique[route] = true
+	}
+
+	for _, w := range workers {
+		for _, route := range w.GetRoutes() {
+			unique[route] = true
+		}
+	}
+
+	routes := []string{}
+
+	for route := range unique {
+		routes = append(routes, route)
+	}
+
+	sort.Strings(routes)
+
+	return routes, nil
+}
+
+// expiredBuild is a helper function to check if a
+// build is still pending beyond the max time.
+func expiredBuild(b *library.Build, limit time.Duration, now time.Time) bool {
+	if !strings.EqualFold(b.GetStatus(), constants.StatusPending) {
+		return false
+	}
+
+	// builds published before they were enqueued use the created time
+	enqueued := b.GetEnqueued()
+	if enqueued == 0 {
+		enqueued = b.GetCreated()
+	}
+
+	return enqueued > 0 && enqueued <= now.Add(-limit).Unix()
+}
+
+// expireBuild is a helper function to set a build no worker picked
+// up to error and send the status of the build to the scm.
+//
+// The build is only updated while it is still pending, so a build
+// a worker started after it was captured is left running.
+func expireBuild(ctx context.Context, db database.Interface, providers *scm.Providers, b *library.Build, limit time.Duration) (bool, error) {
+	// update fields in build object
+	b.SetError(fmt.Sprintf("no worker picked this up within %d minutes", int64(limit/time.Minute)))
+	b.SetStatus(constants.StatusError)
+	b.SetFinished(time.Now().UTC().Unix())
+
+	// send database call to update the build if it is still pending
+	updated, err := db.UpdateBuildFromStatus(ctx, b, constants.StatusPending)
+	if err != nil {
+		return false, err
+	}
+
+	if !updated {
+		logrus.Infof("build %d is no longer pending, skipping expiring it", b.GetID())
+
+		return false, nil
+	}
+
+	services, steps, err := buildResources(ctx, db, b)
+	if err != nil {
+		logrus.Errorf("unable to get resources for build %d: %v", b.GetID(), err)
+	}
+
+	cleanResources(ctx, db, b, services, steps)
+
+	// send database call to capture the repo for the build
+	r, err := db.GetRepo(ctx, b.GetRepoID())
+	if err != nil {
+		return true, fmt.Errorf("unable to get repo for build %d: %w", b.GetID(), err)
+	}
+
+	// send database call to capture the owner of the repo
+	u, err := db.GetUser(ctx, r.GetUserID())
+	if err != nil {
+		return true, fmt.Errorf("unable to get owner for %s: %w", r.GetFullName(), err)
+	}
+
+	// send database call to capture the scm provider for the repo
+	provider, err := db.GetRepoProvider(ctx, r)
+	if err != nil {
+		return true, fmt.Errorf("unable to get scm provider for %s: %w", r.GetFullName(), err)
+	}
+
+	_, s, err := providers.Get(provider)
+	if err != nil {
+		return true, fmt.Errorf("unable to get scm provider for %s: %w", r.GetFullName(), err)
+	}
+
+	// send API call to set the status on the commit
+	err = s.Status(ctx, u, b, r.GetOrg(), r.GetName())
+	if err != nil {
+		return true, fmt.Errorf("unable to set commit status for %s: %w", r.GetFullName(), err)
+	}
+
+	return true, nil
+}
+
+// buildResources is a helper function to capture
+// the services and steps for a build.
+func buildResources(ctx context.Context, db database.Interface, b *library.Build) ([]*library.Service, []*library.Step, error) {
+	services := []*library.Service{}
+	steps := []*library.Step{}
+
+	// set the max per page
+	perPage := 100
+
+	for page := 1; ; page++ {
+		// send database call to capture a page of services for the build
+		part, _, err := db.ListServicesForBuild(ctx, b, map[string]interface{}{}, page, perPage)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		services = append(services, part...)
+
+		// assume no more pages exist if under 100 results are returned
+		if len(part) < perPage {
+			break
+		}
+	}
+
+	for page := 1; ; page++ {
+		// send database call to capture a page of steps for the build
+		part, _, err := db.ListStepsForBuild(b, map[string]interface{}{}, page, perPage)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		steps = append(steps, part...)
+
+		// assume no more pages exist if under 100 results are returned
+		if len(part) < perPage {
+			break
+		}
+	}
+
+	return services, steps, nil
+}
@@ -0,0 +1,469 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package build
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	serverconstants "github.com/go-vela/server/constants"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/queue"
+	"github.com/go-vela/server/scm"
+	"github.com/go-vela/server/scm/github"
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+)
+
+func Test_ExpireQueued(t *testing.T) {
+	// setup database
+	db, err := database.NewTest()
+	if err != nil {
+		t.Errorf("unable to create test database engine: %v", err)
+	}
+
+	defer db.Close()
+
+	// setup queue
+	q, err := (&queue.Setup{
+		Routes:     []string{constants.DefaultRoute, "large"},
+		PrivateKey: "tCIevHOBq6DdN5SSBtteXUusjjd0fOqzk2eyi0DMq04NewmShNKQeUbbp3vkvIckb4pCxc+vxUo+mYf/vzOaSg==",
+		PublicKey:  "DXsJkoTSkHlG26d75LyHJG+KQsXPr8VKPpmH/78zmko=",
+	}).Memory()
+	if err != nil {
+		t.Errorf("unable to create test queue service: %v", err)
+	}
+
+	// setup mock server
+	statuses := newTestStatuses(t)
+
+	providers := statuses.providers
+
+	// setup types
+	u := new(library.User)
+	u.SetName("octocat")
+	u.SetToken("foo")
+	u.SetHash("bar")
+	u.SetActive(true)
+
+	u, err = db.CreateUser(context.TODO(), u)
+	if err != nil {
+		t.Errorf("unable to create test user: %v", err)
+	}
+
+	r := new(library.Repo)
+	r.SetUserID(u.GetID())
+	r.SetOrg("github")
+	r.SetName("octocat")
+	r.SetFullName("github/octocat")
+	r.SetHash("baz")
+	r.SetVisibility(constants.VisibilityPublic)
+
+	r, err = db.CreateRepo(context.TODO(), r)
+	if err != nil {
+		t.Errorf("unable to create test repo: %v", err)
+	}
+
+	maxTime := queue.MaxTime{queue.DefaultMaxTime: time.Hour, "large": 3 * time.Hour}
+
+	// setup builds
+	builds := []struct {
+		name     string
+		number   int
+		enqueued time.Duration
+		route    string
+		want     string
+	}{
+		{
+			name:     "expired in queue",
+			number:   1,
+			enqueued: 2 * time.Hour,
+			route:    constants.DefaultRoute,
+			want:     constants.StatusError,
+		},
+		{
+			name:     "waiting in queue",
+			number:   2,
+			enqueued: time.Minute,
+			route:    constants.DefaultRoute,
+			want:     constants.StatusPending,
+		},
+		{
+			name:     "waiting in route with max time",
+			number:   3,
+			enqueued: 2 * time.Hour,
+			route:    "large",
+			want:     constants.StatusPending,
+		},
+		{
+			name:     "expired missing from queue",
+			number:   4,
+			enqueued: 2 * time.Hour,
+			want:     constants.StatusError,
+		},
+	}
+
+	created := []*library.Build{}
+
+	for _, build := range builds {
+		b := new(library.Build)
+		b.SetRepoID(r.GetID())
+		b.SetNumber(build.number)
+		b.SetCommit(build.name)
+		b.SetStatus(constants.StatusPending)
+		b.SetCreated(time.Now().UTC().Add(-build.enqueued).Unix())
+		b.SetEnqueued(time.Now().UTC().Add(-build.enqueued).Unix())
+
+		b, err := db.CreateBuild(context.TODO(), b)
+		if err != nil {
+			t.Errorf("unable to create test build: %v", err)
+		}
+
+		created = append(created, b)
+
+		if len(build.route) == 0 {
+			continue
+		}
+
+		item, err := json.Marshal(queue.ToItem(b, r, u, serverconstants.PriorityDefault))
+		if err != nil {
+			t.Errorf("unable to marshal queue item: %v", err)
+		}
+
+		err = q.Push(context.TODO(), build.route, item)
+		if err != nil {
+			t.Errorf("unable to push queue item: %v", err)
+		}
+	}
+
+	// run test
+	got, err := ExpireQueued(context.TODO(), q, db, providers, maxTime)
+	if err != nil {
+		t.Errorf("ExpireQueued returned err: %v", err)
+	}
+
+	if got != 2 {
+		t.Errorf("ExpireQueued is %d, want %d", got, 2)
+	}
+
+	for i, build := range builds {
+		b, err := db.GetBuild(context.TODO(), created[i].GetID())
+		if err != nil {
+			t.Errorf("unable to get test build: %v", err)
+		}
+
+		if b.GetStatus() != build.want {
+			t.Errorf("ExpireQueued set %s build to %s, want %s", build.name, b.GetStatus(), build.want)
+		}
+
+		if build.want == constants.StatusError && b.GetError() != "no worker picked this up within 60 minutes" {
+			t.Errorf("ExpireQueued set %s build error to %s", build.name, b.GetError())
+		}
+	}
+
+	items, err := q.List(context.TODO(), constants.DefaultRoute)
+	if err != nil {
+		t.Errorf("unable to list queue items: %v", err)
+	}
+
+	if len(items) != 1 || items[0].Build.GetID() != created[1].GetID() {
+		t.Errorf("ExpireQueued left %v in queue, want item for build %d", items, created[1].GetID())
+	}
+
+	if got := statuses.sent(); got != 2 {
+		t.Errorf("ExpireQueued sent %d statuses, want %d", got, 2)
+	}
+}
+
+func Test_expireBuild(t *testing.T) {
+	// setup database
+	db, err := database.NewTest()
+	if err != nil {
+		t.Errorf("unable to create test database engine: %v", err)
+	}
+
+	defer db.Close()
+
+	// setup mock server
+	statuses := newTestStatuses(t)
+
+	providers := statuses.providers
+
+	// setup types
+	u := new(library.User)
+	u.SetName("octocat")
+	u.SetToken("foo")
+	u.SetHash("bar")
+	u.SetActive(true)
+
+	u, err = db.CreateUser(context.TODO(), u)
+	if err != nil {
+		t.Errorf("unable to create test user: %v", err)
+	}
+
+	r := new(library.Repo)
+	r.SetUserID(u.GetID())
+	r.SetOrg("github")
+	r.SetName("octocat")
+	r.SetFullName("github/octocat")
+	r.SetHash("baz")
+	r.SetVisibility(constants.VisibilityPublic)
+
+	r, err = db.CreateRepo(context.TODO(), r)
+	if err != nil {
+		t.Errorf("unable to create test repo: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		name     string
+		number   int
+		status   string
+		updated  bool
+		want     string
+		statuses int
+	}{
+		{
+			name:     "still pending",
+			number:   1,
+			status:   constants.StatusPending,
+			updated:  true,
+			want:     constants.StatusError,
+			statuses: 1,
+		},
+		{
+			name:     "started by a worker",
+			number:   2,
+			status:   constants.StatusRunning,
+			updated:  false,
+			want:     constants.StatusRunning,
+			statuses: 0,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			b := new(library.Build)
+			b.SetRepoID(r.GetID())
+			b.SetNumber(test.number)
+			b.SetCommit(test.name)
+			b.SetStatus(constants.StatusPending)
+			b.SetCreated(time.Now().UTC().Add(-2 * time.Hour).Unix())
+			b.SetEnqueued(time.Now().UTC().Add(-2 * time.Hour).Unix())
+
+			b, err := db.CreateBuild(context.TODO(), b)
+			if err != nil {
+				t.Errorf("unable to create test build: %v", err)
+			}
+
+			// capture the pending build before a worker updates it
+			stale := *b
+
+			b.SetStatus(test.status)
+
+			_, err = db.UpdateBuild(context.TODO(), b)
+			if err != nil {
+				t.Errorf("unable to update test build: %v", err)
+			}
+
+			statuses.reset()
+
+			updated, err := expireBuild(context.TODO(), db, providers, &stale, time.Hour)
+			if err != nil {
+				t.Errorf("expireBuild returned err: %v", err)
+			}
+
+			if updated != test.updated {
+				t.Errorf("expireBuild is %v, want %v", updated, test.updated)
+			}
+
+			got, err := db.GetBuild(context.TODO(), b.GetID())
+			if err != nil {
+				t.Errorf("unable to get test build: %v", err)
+			}
+
+			if got.GetStatus() != test.want {
+				t.Errorf("expireBuild set build to %s, want %s", got.GetStatus(), test.want)
+			}
+
+			if got := statuses.sent(); got != test.statuses {
+				t.Errorf("expireBuild sent %d statuses, want %d", got, test.statuses)
+			}
+		})
+	}
+}
+
+func Test_ExpireQueued_Held(t *testing.T) {
+	// setup database
+	db, err := database.NewTest()
+	if err != nil {
+		t.Errorf("unable to create test database engine: %v", err)
+	}
+
+	defer db.Close()
+
+	// setup queue
+	q, err := (&queue.Setup{
+		Routes:     []string{constants.DefaultRoute},
+		PrivateKey: "tCIevHOBq6DdN5SSBtteXUusjjd0fOqzk2eyi0DMq04NewmShNKQeUbbp3vkvIckb4pCxc+vxUo+mYf/vzOaSg==",
+		PublicKey:  "DXsJkoTSkHlG26d75LyHJG+KQsXPr8VKPpmH/78zmko=",
+		Lease:      time.Minute,
+	}).Memory()
+	if err != nil {
+		t.Errorf("unable to create test queue service: %v", err)
+	}
+
+	// setup mock server
+	statuses := newTestStatuses(t)
+
+	// setup types
+	u := new(library.User)
+	u.SetName("octocat")
+	u.SetToken("foo")
+	u.SetHash("bar")
+	u.SetActive(true)
+
+	u, err = db.CreateUser(context.TODO(), u)
+	if err != nil {
+		t.Errorf("unable to create test user: %v", err)
+	}
+
+	r := new(library.Repo)
+	r.SetUserID(u.GetID())
+	r.SetOrg("github")
+	r.SetName("octocat")
+	r.SetFullName("github/octocat")
+	r.SetHash("baz")
+	r.SetVisibility(constants.VisibilityPublic)
+
+	r, err = db.CreateRepo(context.TODO(), r)
+	if err != nil {
+		t.Errorf("unable to create test repo: %v", err)
+	}
+
+	created := []*library.Build{}
+
+	for _, number := range []int{1, 2} {
+		b := new(library.Build)
+		b.SetRepoID(r.GetID())
+		b.SetNumber(number)
+		b.SetCommit(fmt.Sprintf("commit-%d", number))
+		b.SetStatus(constants.StatusPending)
+		b.SetCreated(time.Now().UTC().Add(-2 * time.Hour).Unix())
+		b.SetEnqueued(time.Now().UTC().Add(-2 * time.Hour).Unix())
+
+		b, err := db.CreateBuild(context.TODO(), b)
+		if err != nil {
+			t.Errorf("unable to create test build: %v", err)
+		}
+
+		created = append(created, b)
+	}
+
+	// the first build is popped by a worker under a lease
+	item, err := json.Marshal(queue.ToItem(created[0], r, u, serverconstants.PriorityDefault))
+	if err != nil {
+		t.Errorf("unable to marshal queue item: %v", err)
+	}
+
+	err = q.Push(context.TODO(), constants.DefaultRoute, item)
+	if err != nil {
+		t.Errorf("unable to push queue item: %v", err)
+	}
+
+	_, err = q.Pop(context.TODO(), nil)
+	if err != nil {
+		t.Errorf("unable to pop queue item: %v", err)
+	}
+
+	// run test
+	got, err := ExpireQueued(context.TODO(), q, db, statuses.providers, queue.MaxTime{queue.DefaultMaxTime: time.Hour})
+	if err != nil {
+		t.Errorf("ExpireQueued returned err: %v", err)
+	}
+
+	// only the second build is missing from the queue
+	if got != 1 {
+		t.Errorf("ExpireQueued is %d, want %d", got, 1)
+	}
+
+	for i, want := range []string{constants.StatusPending, constants.StatusError} {
+		b, err := db.GetBuild(context.TODO(), created[i].GetID())
+		if err != nil {
+			t.Errorf("unable to get test build: %v", err)
+		}
+
+		if b.GetStatus() != want {
+			t.Errorf("ExpireQueued set build %d to %s, want %s", b.GetNumber(), b.GetStatus(), want)
+		}
+	}
+
+	if got := statuses.sent(); got != 1 {
+		t.Errorf("ExpireQueued sent %d statuses, want %d", got, 1)
+	}
+}
+
+// testStatuses represents a mock GitHub server
+// recording the commit statuses sent to it.
+type testStatuses struct {
+	mutex     sync.Mutex
+	shas      []string
+	providers *scm.Providers
+}
+
+// newTestStatuses is a helper function to create a mock GitHub
+// server recording commit statuses and the scm providers for it.
+func newTestStatuses(t *testing.T) *testStatuses {
+	t.Helper()
+
+	gin.SetMode(gin.TestMode)
+
+	statuses := new(testStatuses)
+
+	_, engine := gin.CreateTestContext(httptest.NewRecorder())
+
+	engine.POST("/api/v3/repos/:org/:repo/statuses/:sha", func(c *gin.Context) {
+		statuses.mutex.Lock()
+		statuses.shas = append(statuses.shas, c.Param("sha"))
+		statuses.mutex.Unlock()
+
+		c.Header("Content-Type", "application/json")
+		c.String(http.StatusCreated, "{}")
+	})
+
+	s := httptest.NewServer(engine)
+	t.Cleanup(s.Close)
+
+	client, err := github.NewTest(s.URL)
+	if err != nil {
+		t.Errorf("unable to create test scm service: %v", err)
+	}
+
+	statuses.providers = scm.NewProviders(constants.DriverGithub, client)
+
+	return statuses
+}
+
+// sent returns the number of commit statuses sent.
+func (s *testStatuses) sent() int {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return len(s.shas)
+}
+
+// reset forgets the commit statuses sent.
+func (s *testStatuses) reset() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.shas = nil
+}
@@ -0,0 +1,103 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package build
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/buildkite/yaml"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/internal/labels"
+	"github.com/go-vela/types/constants"
+)
+
+// labelsConfig represents the fields of the pipeline
+// configuration used to determine the labels for a build.
+type labelsConfig struct {
+	Worker struct {
+		Labels map[string]string `yaml:"labels"`
+	} `yaml:"worker"`
+}
+
+// Labels is a helper function that captures the labels required from
+// the worker block of the pipeline configuration for a build.
+func Labels(config []byte, pipelineType string) (labels.Set, error) {
+	l := new(labelsConfig)
+
+	err := yaml.Unmarshal(config, l)
+	if err != nil && !templatedPipeline(pipelineType) {
+		return nil, fmt.Errorf("unable to parse worker labels: %w", err)
+	}
+
+	required := labels.Set(l.Worker.Labels)
+
+	err = required.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return required, nil
+}
+
+// templatedPipeline is a helper function that determines if the
+// configuration for a pipeline type may not be yaml, like a starlark
+// pipeline, so errors parsing the configuration are ignored.
+func templatedPipeline(pipelineType string) bool {
+	switch pipelineType {
+	case constants.PipelineTypeGo, constants.PipelineTypeStarlark:
+		return true
+	default:
+		return false
+	}
+}
+
+// LabelRoute is a helper function that determines the route to publish
+// a build to from the labels required by the pipeline configuration and
+// the labels registered by the workers.
+//
+// Inactive workers are matched as well so a build waits in the queue
+// while the workers that can run it are briefly offline.
+//
+// An empty route is returned when the pipeline does not require labels
+// and an error is returned when no registered worker can run the build.
+func LabelRoute(ctx context.Context, db database.Interface, config []byte, pipelineType string) (string, error) {
+	required, err := Labels(config, pipelineType)
+	if err != nil {
+		return "", err
+	}
+
+	// the pipeline is routed by the flavor and platform of the worker
+	if len(required) == 0 {
+		return "", nil
+	}
+
+	// send database call to capture the registered workers
+	workers, err := db.ListWorkers(ctx)
+	if err != nil {
+		return "", fmt.Errorf("unable to list workers to route labels %s: %w", required, err)
+	}
+
+	// send database call to capture the labels registered by the workers
+	workerLabels, err := db.ListWorkerLabels(ctx)
+	if err != nil {
+		return "", fmt.Errorf("unable to list worker labels to route labels %s: %w", required, err)
+	}
+
+	candidates := []*labels.Worker{}
+
+	for _, w := range workers {
+		// workers without routes pop builds from the default route
+		routes := w.GetRoutes()
+		if len(routes) == 0 {
+			routes = []string{constants.DefaultRoute}
+		}
+
+		candidates = append(candidates, &labels.Worker{
+			Routes: routes,
+			Labels: workerLabels[w.GetID()],
+		})
+	}
+
+	return labels.Route(required, candidates)
+}
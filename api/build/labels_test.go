@@ -0,0 +1,188 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package build
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/internal/labels"
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+)
+
+func Test_Labels(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name         string
+		config       []byte
+		pipelineType string
+		want         labels.Set
+		failure      bool
+	}{
+		{
+			name:         "labels",
+			config:       []byte("version: \"1\"\nworker:\n  labels:\n    arch: arm64\n    gpu: false\n    team: payments\n"),
+			pipelineType: constants.PipelineTypeYAML,
+			want:         labels.Set{"arch": "arm64", "gpu": "false", "team": "payments"},
+		},
+		{
+			name:         "flavor and platform",
+			config:       []byte("version: \"1\"\nworker:\n  flavor: large\n  platform: docker\n"),
+			pipelineType: constants.PipelineTypeYAML,
+			want:         labels.Set{},
+		},
+		{
+			name:         "starlark config",
+			config:       []byte("def main(ctx):\n  return {\n    'version': '1',\n  }\n"),
+			pipelineType: constants.PipelineTypeStarlark,
+			want:         labels.Set{},
+		},
+		{
+			name:         "go template config",
+			config:       []byte("version: \"1\"\nworker:\n  labels:\n  {{ range .labels }}\n    - {{ . }}\n  {{ end }}\n"),
+			pipelineType: constants.PipelineTypeGo,
+			want:         labels.Set{},
+		},
+		{
+			name:         "labels not a map",
+			config:       []byte("version: \"1\"\nworker:\n  labels:\n    - arch\n"),
+			pipelineType: constants.PipelineTypeYAML,
+			failure:      true,
+		},
+		{
+			name:         "invalid yaml",
+			config:       []byte("version: \"1\"\nworker:\n  labels: [arch\n"),
+			pipelineType: constants.PipelineTypeYAML,
+			failure:      true,
+		},
+		{
+			name:         "invalid labels",
+			config:       []byte("version: \"1\"\nworker:\n  labels:\n    arch: arm64,amd64\n"),
+			pipelineType: constants.PipelineTypeYAML,
+			failure:      true,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := Labels(test.config, test.pipelineType)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("Labels should have returned err")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("Labels returned err: %v", err)
+			}
+
+			if len(got) != len(test.want) || (len(got) > 0 && !reflect.DeepEqual(got, test.want)) {
+				t.Errorf("Labels is %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func Test_LabelRoute(t *testing.T) {
+	// setup database
+	db, err := database.NewTest()
+	if err != nil {
+		t.Errorf("unable to create test database engine: %v", err)
+	}
+
+	defer db.Close()
+
+	// setup types
+	workers := []struct {
+		hostname string
+		active   bool
+		routes   []string
+		labels   labels.Set
+	}{
+		{hostname: "worker_0", active: true, routes: nil, labels: labels.Set{"arch": "amd64"}},
+		{hostname: "worker_1", active: true, routes: []string{"arm"}, labels: labels.Set{"arch": "arm64", "team": "payments"}},
+		{hostname: "worker_2", active: false, routes: []string{"gpu"}, labels: labels.Set{"gpu": "true"}},
+	}
+
+	for _, worker := range workers {
+		w := new(library.Worker)
+		w.SetHostname(worker.hostname)
+		w.SetAddress("http://" + worker.hostname + ":8080")
+		w.SetActive(worker.active)
+		w.SetRoutes(worker.routes)
+
+		w, err = db.CreateWorker(context.TODO(), w)
+		if err != nil {
+			t.Errorf("unable to create test worker: %v", err)
+		}
+
+		err = db.UpdateWorkerLabels(context.TODO(), w, worker.labels)
+		if err != nil {
+			t.Errorf("unable to update test worker labels: %v", err)
+		}
+	}
+
+	// setup tests
+	tests := []struct {
+		name    string
+		config  []byte
+		want    string
+		failure bool
+	}{
+		{
+			name:   "labels",
+			config: []byte("version: \"1\"\nworker:\n  labels:\n    team: payments\n"),
+			want:   "arm",
+		},
+		{
+			name:   "worker without routes",
+			config: []byte("version: \"1\"\nworker:\n  labels:\n    arch: amd64\n"),
+			want:   "vela",
+		},
+		{
+			name:   "no labels",
+			config: []byte("version: \"1\"\nworker:\n  flavor: large\n"),
+			want:   "",
+		},
+		{
+			name:   "inactive worker with labels",
+			config: []byte("version: \"1\"\nworker:\n  labels:\n    gpu: true\n"),
+			want:   "gpu",
+		},
+		{
+			name:    "no worker with labels",
+			config:  []byte("version: \"1\"\nworker:\n  labels:\n    arch: s390x\n"),
+			failure: true,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := LabelRoute(context.TODO(), db, test.config, constants.PipelineTypeYAML)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("LabelRoute should have returned err")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("LabelRoute returned err: %v", err)
+			}
+
+			if got != test.want {
+				t.Errorf("LabelRoute is %s, want %s", got, test.want)
+			}
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/go-vela/server/api"
+	serverconstants "github.com/go-vela/server/constants"
 	"github.com/go-vela/server/database"
 	"github.com/go-vela/server/router/middleware/org"
 	"github.com/go-vela/server/router/middleware/repo"
@@ -48,6 +49,9 @@ import (
 //   - pull_request
 //   - push
 //   - schedule
+//   - merge_group
+//   - pull_request_review
+//   - release
 //   - tag
 // - in: query
 //   name: commit
@@ -159,7 +163,9 @@ func ListBuildsForRepo(c *gin.Context) {
 		// verify the event provided is a valid event type
 		if event != constants.EventComment && event != constants.EventDeploy &&
 			event != constants.EventPush && event != constants.EventPull &&
-			event != constants.EventTag && event != constants.EventSchedule {
+			event != constants.EventTag && event != constants.EventSchedule &&
+			event != serverconstants.EventMergeGroup && event != serverconstants.EventRelease &&
+			event != serverconstants.EventReview {
 			retErr := fmt.Errorf("unable to process event %s: invalid event type provided", event)
 
 			util.HandleError(c, http.StatusBadRequest, retErr)
@@ -0,0 +1,91 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package build
+
+import (
+	"context"
+	"strings"
+
+	"github.com/buildkite/yaml"
+	"github.com/go-vela/server/api/types"
+	serverconstants "github.com/go-vela/server/constants"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/util"
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+	"github.com/sirupsen/logrus"
+)
+
+// priorityConfig represents the fields of the pipeline
+// configuration used to determine the priority of a build.
+type priorityConfig struct {
+	Metadata struct {
+		Priority *int64 `yaml:"priority"`
+	} `yaml:"metadata"`
+}
+
+// Priority is a helper function that determines the priority of the queue item
+// for a build. The priority from the metadata of the pipeline configuration is
+// used first, then the priority configured for the repo and finally the priority
+// for the event of the build.
+func Priority(config []byte, s *types.RepoSettings, b *library.Build) int64 {
+	p := new(priorityConfig)
+
+	// the configuration may not be yaml, like a starlark
+	// pipeline, so the error is ignored to fall through
+	_ = yaml.Unmarshal(config, p)
+
+	switch {
+	case p.Metadata.Priority != nil:
+		return clampPriority(*p.Metadata.Priority)
+	case s != nil && s.Priority != nil:
+		return clampPriority(s.GetPriority())
+	case strings.EqualFold(b.GetEvent(), constants.EventDeploy):
+		return serverconstants.PriorityHigh
+	case strings.EqualFold(b.GetEvent(), constants.EventSchedule):
+		return serverconstants.PriorityLow
+	default:
+		return serverconstants.PriorityDefault
+	}
+}
+
+// pipelineConfig is a helper function that captures the pipeline
+// configuration and its pipeline type for a build from the database.
+func pipelineConfig(ctx context.Context, db database.Interface, b *library.Build, r *library.Repo) ([]byte, string) {
+	// send database call to capture the pipeline configuration for the build
+	pipeline, err := db.GetPipeline(ctx, b.GetPipelineID())
+	if err != nil {
+		logrus.Warnf("unable to get pipeline for build %d for %s: %v", b.GetNumber(), r.GetFullName(), err)
+
+		return nil, ""
+	}
+
+	return pipeline.GetData(), pipeline.GetType()
+}
+
+// queuePriority is a helper function that captures the repo
+// settings to determine the priority of the queue item for a
+// build with the pipeline configuration.
+func queuePriority(ctx context.Context, db database.Interface, config []byte, b *library.Build, r *library.Repo) int64 {
+	// send database call to capture the settings for the repo
+	settings, err := db.GetRepoSettings(ctx, r)
+	if err != nil {
+		logrus.Warnf("unable to get settings for %s to determine priority: %v", r.GetFullName(), err)
+	}
+
+	return Priority(config, settings, b)
+}
+
+// clampPriority is a helper function to clamp a
+// priority between the allowed queue priorities.
+func clampPriority(priority int64) int64 {
+	return int64(
+		util.MaxInt(
+			int(serverconstants.PriorityMin),
+			util.MinInt(
+				int(priority),
+				int(serverconstants.PriorityMax),
+			), // clamp max
+		), // clamp min
+	)
+}
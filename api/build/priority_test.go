@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package build
+
+import (
+	"testing"
+
+	"github.com/go-vela/server/api/types"
+	serverconstants "github.com/go-vela/server/constants"
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+)
+
+func Test_Priority(t *testing.T) {
+	// setup types
+	push := new(library.Build)
+	push.SetEvent(constants.EventPush)
+
+	deploy := new(library.Build)
+	deploy.SetEvent(constants.EventDeploy)
+
+	schedule := new(library.Build)
+	schedule.SetEvent(constants.EventSchedule)
+
+	settings := new(types.RepoSettings)
+	settings.SetPriority(7)
+
+	high := new(types.RepoSettings)
+	high.SetPriority(42)
+
+	// setup tests
+	tests := []struct {
+		name     string
+		config   []byte
+		settings *types.RepoSettings
+		build    *library.Build
+		want     int64
+	}{
+		{
+			name:     "pipeline metadata",
+			config:   []byte("version: \"1\"\nmetadata:\n  priority: 9\n"),
+			settings: settings,
+			build:    deploy,
+			want:     9,
+		},
+		{
+			name:   "pipeline metadata below min",
+			config: []byte("version: \"1\"\nmetadata:\n  priority: -3\n"),
+			build:  push,
+			want:   serverconstants.PriorityMin,
+		},
+		{
+			name:     "repo setting",
+			config:   []byte("version: \"1\"\nmetadata:\n  template: false\n"),
+			settings: settings,
+			build:    deploy,
+			want:     7,
+		},
+		{
+			name:     "repo setting above max",
+			settings: high,
+			build:    push,
+			want:     serverconstants.PriorityMax,
+		},
+		{
+			name:  "deployment event",
+			build: deploy,
+			want:  serverconstants.PriorityHigh,
+		},
+		{
+			name:  "schedule event",
+			build: schedule,
+			want:  serverconstants.PriorityLow,
+		},
+		{
+			name:     "push event",
+			settings: new(types.RepoSettings),
+			build:    push,
+			want:     serverconstants.PriorityDefault,
+		},
+		{
+			name:   "non yaml config",
+			config: []byte("def main(ctx):\n  return {}\n"),
+			build:  push,
+			want:   serverconstants.PriorityDefault,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := Priority(test.config, test.settings, test.build)
+
+			if got != test.want {
+				t.Errorf("Priority is %d, want %d", got, test.want)
+			}
+		})
+	}
+}
@@ -9,15 +9,18 @@ import (
 
 	"github.com/go-vela/server/database"
 	"github.com/go-vela/server/queue"
-	"github.com/go-vela/types"
 	"github.com/go-vela/types/library"
 	"github.com/go-vela/types/pipeline"
 	"github.com/sirupsen/logrus"
 )
 
 // PublishToQueue is a helper function that pushes the build executable to the database
-// and publishes a queue item (build, repo, user) to the queue.
-func PublishToQueue(ctx context.Context, queue queue.Service, db database.Interface, p *pipeline.Build, b *library.Build, r *library.Repo, u *library.User) {
+// and publishes a queue item (build, repo, user, priority) to the queue.
+//
+// The item is published to the provided route determined by LabelRoute when the
+// pipeline was compiled or, when the route is empty, to the route for the flavor
+// and platform of the worker block.
+func PublishToQueue(ctx context.Context, q queue.Service, db database.Interface, p *pipeline.Build, b *library.Build, r *library.Repo, u *library.User, route string) {
 	// marshal pipeline build into byte data to add to the build executable object
 	byteExecutable, err := json.Marshal(p)
 	if err != nil {
@@ -45,8 +48,14 @@ func PublishToQueue(ctx context.Context, queue queue.Service, db database.Interf
 		return
 	}
 
-	// convert build, repo, and user into queue item
-	item := types.ToItem(b, r, u)
+	// capture the pipeline configuration for the build
+	config, _ := pipelineConfig(ctx, db, b, r)
+
+	// determine the priority of the queue item
+	priority := queuePriority(ctx, db, config, b, r)
+
+	// convert build, repo, user and priority into queue item
+	item := queue.ToItem(b, r, u, priority)
 
 	logrus.Infof("Converting queue item to json for build %d for %s", b.GetNumber(), r.GetFullName())
 
@@ -63,24 +72,27 @@ func PublishToQueue(ctx context.Context, queue queue.Service, db database.Interf
 	logrus.Infof("Establishing route for build %d for %s", b.GetNumber(), r.GetFullName())
 
 	// determine the route on which to publish the queue item
-	route, err := queue.Route(&p.Worker)
-	if err != nil {
-		logrus.Errorf("unable to set route for build %d for %s: %v", b.GetNumber(), r.GetFullName(), err)
+	// when the pipeline does not require labels
+	if len(route) == 0 {
+		route, err = q.Route(&p.Worker)
+		if err != nil {
+			logrus.Errorf("unable to set route for build %d for %s: %v", b.GetNumber(), r.GetFullName(), err)
 
-		// error out the build
-		CleanBuild(ctx, db, b, nil, nil, err)
+			// error out the build
+			CleanBuild(ctx, db, b, nil, nil, err)
 
-		return
+			return
+		}
 	}
 
 	logrus.Infof("Publishing item for build %d for %s to queue %s", b.GetNumber(), r.GetFullName(), route)
 
 	// push item on to the queue
-	err = queue.Push(context.Background(), route, byteItem)
+	err = q.Push(context.Background(), route, byteItem)
 	if err != nil {
 		logrus.Errorf("Retrying; Failed to publish build %d for %s: %v", b.GetNumber(), r.GetFullName(), err)
 
-		err = queue.Push(context.Background(), route, byteItem)
+		err = q.Push(context.Background(), route, byteItem)
 		if err != nil {
 			logrus.Errorf("Failed to publish build %d for %s: %v", b.GetNumber(), r.GetFullName(), err)
 
@@ -0,0 +1,132 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package build
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/queue"
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+	"github.com/go-vela/types/pipeline"
+)
+
+func Test_PublishToQueue(t *testing.T) {
+	// setup database
+	db, err := database.NewTest()
+	if err != nil {
+		t.Errorf("unable to create test database engine: %v", err)
+	}
+
+	defer db.Close()
+
+	// setup queue
+	q, err := (&queue.Setup{
+		Routes:     []string{constants.DefaultRoute, "large", "arm"},
+		PrivateKey: "tCIevHOBq6DdN5SSBtteXUusjjd0fOqzk2eyi0DMq04NewmShNKQeUbbp3vkvIckb4pCxc+vxUo+mYf/vzOaSg==",
+		PublicKey:  "DXsJkoTSkHlG26d75LyHJG+KQsXPr8VKPpmH/78zmko=",
+	}).Memory()
+	if err != nil {
+		t.Errorf("unable to create test queue service: %v", err)
+	}
+
+	// setup types
+	u := new(library.User)
+	u.SetName("octocat")
+	u.SetToken("foo")
+	u.SetHash("bar")
+	u.SetActive(true)
+
+	u, err = db.CreateUser(context.TODO(), u)
+	if err != nil {
+		t.Errorf("unable to create test user: %v", err)
+	}
+
+	r := new(library.Repo)
+	r.SetUserID(u.GetID())
+	r.SetOrg("github")
+	r.SetName("octocat")
+	r.SetFullName("github/octocat")
+	r.SetHash("baz")
+	r.SetVisibility(constants.VisibilityPublic)
+
+	r, err = db.CreateRepo(context.TODO(), r)
+	if err != nil {
+		t.Errorf("unable to create test repo: %v", err)
+	}
+
+	// no registered worker has the labels so routing
+	// them again during publish would error the build
+	p := new(library.Pipeline)
+	p.SetRepoID(r.GetID())
+	p.SetCommit("48afb5bdc41ad69bf22588491333f7cf71135163")
+	p.SetRef("refs/heads/main")
+	p.SetType(constants.PipelineTypeYAML)
+	p.SetVersion("1")
+	p.SetData([]byte("version: \"1\"\nworker:\n  flavor: large\n  labels:\n    arch: arm64\n"))
+
+	p, err = db.CreatePipeline(context.TODO(), p)
+	if err != nil {
+		t.Errorf("unable to create test pipeline: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		name   string
+		number int
+		route  string
+		want   string
+	}{
+		{
+			name:   "route from labels",
+			number: 1,
+			route:  "arm",
+			want:   "arm",
+		},
+		{
+			name:   "route from worker",
+			number: 2,
+			want:   "large",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			b := new(library.Build)
+			b.SetRepoID(r.GetID())
+			b.SetPipelineID(p.GetID())
+			b.SetNumber(test.number)
+			b.SetStatus(constants.StatusPending)
+
+			b, err := db.CreateBuild(context.TODO(), b)
+			if err != nil {
+				t.Errorf("unable to create test build: %v", err)
+			}
+
+			_pipeline := &pipeline.Build{Worker: pipeline.Worker{Flavor: "large"}}
+
+			PublishToQueue(context.TODO(), q, db, _pipeline, b, r, u, test.route)
+
+			items, err := q.List(context.TODO(), test.want)
+			if err != nil {
+				t.Errorf("unable to list queue items: %v", err)
+			}
+
+			if len(items) != 1 || items[0].Build.GetID() != b.GetID() {
+				t.Errorf("PublishToQueue published %v, want item for build %d", items, b.GetID())
+			}
+
+			got, err := db.GetBuild(context.TODO(), b.GetID())
+			if err != nil {
+				t.Errorf("unable to get test build: %v", err)
+			}
+
+			if got.GetStatus() != constants.StatusPending {
+				t.Errorf("PublishToQueue set build status %s, want %s", got.GetStatus(), constants.StatusPending)
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package build
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/queue"
+	"github.com/go-vela/types/constants"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// ReleaseCompleted is a helper function that releases the builds counted
+// in-flight by the queue that are no longer pending or running, so builds
+// completed without the server being told, like builds whose worker died,
+// do not hold a slot for their owner when sharing workers.
+func ReleaseCompleted(ctx context.Context, q queue.Service, db database.Interface) (int, error) {
+	builds, err := q.Inflight(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("unable to list builds in-flight from queue: %w", err)
+	}
+
+	released := 0
+
+	for _, id := range builds {
+		// send database call to capture the build for the status
+		b, err := db.GetBuild(ctx, id)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			logrus.Errorf("unable to get build %d to release: %v", id, err)
+
+			continue
+		}
+
+		// the build is still pending or running
+		if err == nil && (strings.EqualFold(b.GetStatus(), constants.StatusPending) ||
+			strings.EqualFold(b.GetStatus(), constants.StatusRunning)) {
+			continue
+		}
+
+		err = q.Done(ctx, id)
+		if err != nil {
+			logrus.Errorf("unable to release build %d from queue: %v", id, err)
+
+			continue
+		}
+
+		released++
+	}
+
+	return released, nil
+}
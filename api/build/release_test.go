@@ -0,0 +1,165 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package build
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	serverconstants "github.com/go-vela/server/constants"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/queue"
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+)
+
+func Test_ReleaseCompleted(t *testing.T) {
+	// setup database
+	db, err := database.NewTest()
+	if err != nil {
+		t.Errorf("unable to create test database engine: %v", err)
+	}
+
+	defer db.Close()
+
+	// setup queue
+	q, err := (&queue.Setup{
+		Routes:     []string{constants.DefaultRoute},
+		PrivateKey: "tCIevHOBq6DdN5SSBtteXUusjjd0fOqzk2eyi0DMq04NewmShNKQeUbbp3vkvIckb4pCxc+vxUo+mYf/vzOaSg==",
+		PublicKey:  "DXsJkoTSkHlG26d75LyHJG+KQsXPr8VKPpmH/78zmko=",
+		Timeout:    time.Second,
+		Lease:      time.Minute,
+		FairShare:  "org",
+	}).Memory()
+	if err != nil {
+		t.Errorf("unable to create test queue service: %v", err)
+	}
+
+	// setup types
+	u := new(library.User)
+	u.SetName("octocat")
+	u.SetToken("foo")
+	u.SetHash("bar")
+	u.SetActive(true)
+
+	u, err = db.CreateUser(context.TODO(), u)
+	if err != nil {
+		t.Errorf("unable to create test user: %v", err)
+	}
+
+	r := new(library.Repo)
+	r.SetUserID(u.GetID())
+	r.SetOrg("github")
+	r.SetName("octocat")
+	r.SetFullName("github/octocat")
+	r.SetHash("baz")
+	r.SetVisibility(constants.VisibilityPublic)
+
+	r, err = db.CreateRepo(context.TODO(), r)
+	if err != nil {
+		t.Errorf("unable to create test repo: %v", err)
+	}
+
+	// setup builds
+	builds := []struct {
+		name    string
+		status  string
+		deleted bool
+		want    bool
+	}{
+		{
+			name:   "running",
+			status: constants.StatusRunning,
+		},
+		{
+			name:   "pending",
+			status: constants.StatusPending,
+		},
+		{
+			name:   "success",
+			status: constants.StatusSuccess,
+			want:   true,
+		},
+		{
+			name:   "killed",
+			status: constants.StatusKilled,
+			want:   true,
+		},
+		{
+			name:    "deleted",
+			status:  constants.StatusRunning,
+			deleted: true,
+			want:    true,
+		},
+	}
+
+	held := []int64{}
+
+	for i, build := range builds {
+		b := new(library.Build)
+		b.SetRepoID(r.GetID())
+		b.SetNumber(i + 1)
+		b.SetCommit(build.name)
+		b.SetStatus(build.status)
+
+		b, err := db.CreateBuild(context.TODO(), b)
+		if err != nil {
+			t.Errorf("unable to create test build: %v", err)
+		}
+
+		if !build.want {
+			held = append(held, b.GetID())
+		}
+
+		item, err := json.Marshal(queue.ToItem(b, r, u, serverconstants.PriorityDefault))
+		if err != nil {
+			t.Errorf("unable to marshal queue item: %v", err)
+		}
+
+		err = q.Push(context.TODO(), constants.DefaultRoute, item)
+		if err != nil {
+			t.Errorf("unable to push queue item: %v", err)
+		}
+
+		popped, err := q.Pop(context.TODO(), []string{constants.DefaultRoute})
+		if err != nil {
+			t.Errorf("unable to pop queue item: %v", err)
+		}
+
+		err = q.Ack(context.TODO(), popped)
+		if err != nil {
+			t.Errorf("unable to ack queue item: %v", err)
+		}
+
+		if !build.deleted {
+			continue
+		}
+
+		err = db.DeleteBuild(context.TODO(), b)
+		if err != nil {
+			t.Errorf("unable to delete test build: %v", err)
+		}
+	}
+
+	// run test
+	got, err := ReleaseCompleted(context.TODO(), q, db)
+	if err != nil {
+		t.Errorf("ReleaseCompleted returned err: %v", err)
+	}
+
+	if got != 3 {
+		t.Errorf("ReleaseCompleted is %d, want %d", got, 3)
+	}
+
+	inflight, err := q.Inflight(context.TODO())
+	if err != nil {
+		t.Errorf("unable to list builds in-flight: %v", err)
+	}
+
+	if !reflect.DeepEqual(inflight, held) {
+		t.Errorf("ReleaseCompleted left %v in-flight, want %v", inflight, held)
+	}
+}
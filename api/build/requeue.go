@@ -0,0 +1,98 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package build
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/buildkite/yaml"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/queue"
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+	"github.com/go-vela/types/pipeline"
+	"github.com/sirupsen/logrus"
+)
+
+// workerConfig represents the fields of the pipeline configuration
+// used to determine the route for a build without labels.
+type workerConfig struct {
+	Worker pipeline.Worker `yaml:"worker"`
+}
+
+// Requeue is a helper function that publishes a queue item (build, repo, user, priority)
+// to the queue for a pending build whose item is missing from the queue.
+//
+// The item is published to the provided route or, when the route is empty,
+// to the route determined from the pipeline configuration for the build.
+func Requeue(ctx context.Context, q queue.Service, db database.Interface, b *library.Build, r *library.Repo, route string) (string, error) {
+	// only pending builds are waiting to be popped off the queue
+	if !strings.EqualFold(b.GetStatus(), constants.StatusPending) {
+		return "", fmt.Errorf("unable to requeue %s build %d for %s", b.GetStatus(), b.GetNumber(), r.GetFullName())
+	}
+
+	// send database call to capture the owner of the repo
+	u, err := db.GetUser(ctx, r.GetUserID())
+	if err != nil {
+		return "", fmt.Errorf("unable to get owner for %s: %w", r.GetFullName(), err)
+	}
+
+	// capture the pipeline configuration for the build
+	config, pipelineType := pipelineConfig(ctx, db, b, r)
+
+	if len(route) == 0 {
+		route, err = configRoute(ctx, q, db, config, pipelineType)
+		if err != nil {
+			return "", fmt.Errorf("unable to set route for build %d for %s: %w", b.GetNumber(), r.GetFullName(), err)
+		}
+	}
+
+	// convert build, repo, user and priority into queue item
+	item := queue.ToItem(b, r, u, queuePriority(ctx, db, config, b, r))
+
+	byteItem, err := json.Marshal(item)
+	if err != nil {
+		return "", fmt.Errorf("unable to convert item to json for build %d for %s: %w", b.GetNumber(), r.GetFullName(), err)
+	}
+
+	logrus.Infof("Requeueing item for build %d for %s to queue %s", b.GetNumber(), r.GetFullName(), route)
+
+	// push item on to the queue
+	err = q.Push(ctx, route, byteItem)
+	if err != nil {
+		return "", fmt.Errorf("unable to requeue build %d for %s: %w", b.GetNumber(), r.GetFullName(), err)
+	}
+
+	// update fields in build object
+	b.SetEnqueued(time.Now().UTC().Unix())
+
+	// update the build in the db to reflect the time it was enqueued
+	_, err = db.UpdateBuild(ctx, b)
+	if err != nil {
+		logrus.Errorf("Failed to update build %d during requeue for %s: %v", b.GetNumber(), r.GetFullName(), err)
+	}
+
+	return route, nil
+}
+
+// configRoute is a helper function that determines the route for
+// a build from the labels required by the pipeline configuration
+// or the flavor and platform of the worker block.
+func configRoute(ctx context.Context, q queue.Service, db database.Interface, config []byte, pipelineType string) (string, error) {
+	route, err := LabelRoute(ctx, db, config, pipelineType)
+	if err != nil || len(route) > 0 {
+		return route, err
+	}
+
+	w := new(workerConfig)
+
+	// the configuration may not be yaml, like a starlark
+	// pipeline, so the error is ignored to fall through
+	_ = yaml.Unmarshal(config, w)
+
+	return q.Route(&w.Worker)
+}
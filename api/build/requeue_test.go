@@ -0,0 +1,149 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package build
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/queue"
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+)
+
+func Test_Requeue(t *testing.T) {
+	// setup database
+	db, err := database.NewTest()
+	if err != nil {
+		t.Errorf("unable to create test database engine: %v", err)
+	}
+
+	defer db.Close()
+
+	// setup queue
+	q, err := (&queue.Setup{
+		Routes:     []string{constants.DefaultRoute, "large"},
+		PrivateKey: "tCIevHOBq6DdN5SSBtteXUusjjd0fOqzk2eyi0DMq04NewmShNKQeUbbp3vkvIckb4pCxc+vxUo+mYf/vzOaSg==",
+		PublicKey:  "DXsJkoTSkHlG26d75LyHJG+KQsXPr8VKPpmH/78zmko=",
+	}).Memory()
+	if err != nil {
+		t.Errorf("unable to create test queue service: %v", err)
+	}
+
+	// setup types
+	u := new(library.User)
+	u.SetName("octocat")
+	u.SetToken("foo")
+	u.SetHash("bar")
+	u.SetActive(true)
+
+	u, err = db.CreateUser(context.TODO(), u)
+	if err != nil {
+		t.Errorf("unable to create test user: %v", err)
+	}
+
+	r := new(library.Repo)
+	r.SetUserID(u.GetID())
+	r.SetOrg("github")
+	r.SetName("octocat")
+	r.SetFullName("github/octocat")
+	r.SetHash("baz")
+	r.SetVisibility(constants.VisibilityPublic)
+
+	r, err = db.CreateRepo(context.TODO(), r)
+	if err != nil {
+		t.Errorf("unable to create test repo: %v", err)
+	}
+
+	p := new(library.Pipeline)
+	p.SetRepoID(r.GetID())
+	p.SetCommit("48afb5bdc41ad69bf22588491333f7cf71135163")
+	p.SetRef("refs/heads/main")
+	p.SetType(constants.PipelineTypeYAML)
+	p.SetVersion("1")
+	p.SetData([]byte("version: \"1\"\nworker:\n  flavor: large\n"))
+
+	p, err = db.CreatePipeline(context.TODO(), p)
+	if err != nil {
+		t.Errorf("unable to create test pipeline: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		name    string
+		number  int
+		status  string
+		route   string
+		want    string
+		failure bool
+	}{
+		{
+			name:   "route from pipeline",
+			number: 1,
+			status: constants.StatusPending,
+			want:   "large",
+		},
+		{
+			name:   "provided route",
+			number: 2,
+			status: constants.StatusPending,
+			route:  constants.DefaultRoute,
+			want:   constants.DefaultRoute,
+		},
+		{
+			name:    "running build",
+			number:  3,
+			status:  constants.StatusRunning,
+			failure: true,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			b := new(library.Build)
+			b.SetRepoID(r.GetID())
+			b.SetPipelineID(p.GetID())
+			b.SetNumber(test.number)
+			b.SetStatus(test.status)
+
+			b, err := db.CreateBuild(context.TODO(), b)
+			if err != nil {
+				t.Errorf("unable to create test build: %v", err)
+			}
+
+			got, err := Requeue(context.TODO(), q, db, b, r, test.route)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("Requeue should have returned err")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("Requeue returned err: %v", err)
+			}
+
+			if got != test.want {
+				t.Errorf("Requeue is %s, want %s", got, test.want)
+			}
+
+			items, err := q.List(context.TODO(), test.want)
+			if err != nil {
+				t.Errorf("unable to list queue items: %v", err)
+			}
+
+			if len(items) != 1 || items[0].Build.GetID() != b.GetID() {
+				t.Errorf("Requeue published %v, want item for build %d", items, b.GetID())
+			}
+
+			_, err = q.Remove(context.TODO(), test.want, b.GetID())
+			if err != nil {
+				t.Errorf("unable to remove queue item: %v", err)
+			}
+		})
+	}
+}
@@ -74,8 +74,6 @@ import (
 //       "$ref": "#/definitions/Error"
 
 // RestartBuild represents the API handler to restart an existing build in the configured backend.
-//
-//nolint:funlen // ignore statement count
 func RestartBuild(c *gin.Context) {
 	// capture middleware values
 	m := c.MustGet("metadata").(*types.Metadata)
@@ -84,7 +82,6 @@ func RestartBuild(c *gin.Context) {
 	o := org.Retrieve(c)
 	r := repo.Retrieve(c)
 	u := user.Retrieve(c)
-	ctx := c.Request.Context()
 
 	entry := fmt.Sprintf("%s/%d", r.GetFullName(), b.GetNumber())
 
@@ -100,6 +97,35 @@ func RestartBuild(c *gin.Context) {
 
 	logger.Infof("restarting build %s", entry)
 
+	// restart the build
+	//
+	// errors are handled and written to the response by Restart
+	_, _ = Restart(c, m, b, r, cl.Subject)
+}
+
+// Restart is a helper function to restart an existing build for a repo
+// and write the restarted build to the response. Any error returned has
+// already been written to the response. The restarted build is nil if
+// an error occurred or the build was skipped.
+//
+// This is shared by the RestartBuild handler and webhook events that
+// request a build be restarted, like re-running a GitHub check run.
+//
+//nolint:funlen // ignore statement count
+func Restart(c *gin.Context, m *types.Metadata, b *library.Build, r *library.Repo, sender string) (*library.Build, error) {
+	ctx := c.Request.Context()
+
+	entry := fmt.Sprintf("%s/%d", r.GetFullName(), b.GetNumber())
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logger := logrus.WithFields(logrus.Fields{
+		"build": b.GetNumber(),
+		"org":   r.GetOrg(),
+		"repo":  r.GetName(),
+	})
+
 	// send API call to capture the repo owner
 	u, err := database.FromContext(c).GetUser(ctx, r.GetUserID())
 	if err != nil {
@@ -107,7 +133,7 @@ func RestartBuild(c *gin.Context) {
 
 		util.HandleError(c, http.StatusBadRequest, retErr)
 
-		return
+		return nil, retErr
 	}
 
 	// create SQL filters for querying pending and running builds for repo
@@ -122,7 +148,7 @@ func RestartBuild(c *gin.Context) {
 
 		util.HandleError(c, http.StatusBadRequest, retErr)
 
-		return
+		return nil, retErr
 	}
 
 	// check if the number of pending and running builds exceeds the limit for the repo
@@ -131,7 +157,7 @@ func RestartBuild(c *gin.Context) {
 
 		util.HandleError(c, http.StatusBadRequest, retErr)
 
-		return
+		return nil, retErr
 	}
 
 	// update fields in build object
@@ -144,7 +170,7 @@ func RestartBuild(c *gin.Context) {
 	b.SetHost("")
 	b.SetRuntime("")
 	b.SetDistribution("")
-	b.SetSender(cl.Subject)
+	b.SetSender(sender)
 
 	// update the PR event action if action was never set
 	// for backwards compatibility with pre-0.14 releases.
@@ -181,7 +207,7 @@ func RestartBuild(c *gin.Context) {
 
 			util.HandleError(c, http.StatusInternalServerError, retErr)
 
-			return
+			return nil, retErr
 		}
 	}
 
@@ -194,7 +220,7 @@ func RestartBuild(c *gin.Context) {
 
 			util.HandleError(c, http.StatusInternalServerError, retErr)
 
-			return
+			return nil, retErr
 		}
 
 		// send API call to capture list of files changed for the pull request
@@ -204,7 +230,7 @@ func RestartBuild(c *gin.Context) {
 
 			util.HandleError(c, http.StatusInternalServerError, retErr)
 
-			return
+			return nil, retErr
 		}
 	}
 
@@ -214,6 +240,8 @@ func RestartBuild(c *gin.Context) {
 		config []byte
 		// variable to store executable pipeline
 		p *pipeline.Build
+		// variable to store the route required by the pipeline labels
+		route string
 		// variable to store pipeline configuration
 		pipeline *library.Pipeline
 		// variable to store the pipeline type for the repository
@@ -230,7 +258,7 @@ func RestartBuild(c *gin.Context) {
 
 			util.HandleError(c, http.StatusNotFound, retErr)
 
-			return
+			return nil, retErr
 		}
 	} else {
 		config = pipeline.GetData()
@@ -257,12 +285,17 @@ func RestartBuild(c *gin.Context) {
 		WithRepo(r).
 		WithUser(u).
 		Compile(config)
+	if err == nil {
+		// verify a registered worker has the labels required by the pipeline
+		route, err = LabelRoute(ctx, database.FromContext(c), config, r.GetPipelineType())
+	}
+
 	if err != nil {
 		retErr := fmt.Errorf("unable to compile pipeline configuration for %s: %w", entry, err)
 
 		util.HandleError(c, http.StatusInternalServerError, retErr)
 
-		return
+		return nil, retErr
 	}
 	// reset the pipeline type for the repo
 	//
@@ -286,7 +319,7 @@ func RestartBuild(c *gin.Context) {
 
 		c.JSON(http.StatusOK, skip)
 
-		return
+		return nil, nil
 	}
 
 	// check if the pipeline did not already exist in the database
@@ -305,7 +338,7 @@ func RestartBuild(c *gin.Context) {
 
 			util.HandleError(c, http.StatusBadRequest, retErr)
 
-			return
+			return nil, retErr
 		}
 	}
 
@@ -316,7 +349,7 @@ func RestartBuild(c *gin.Context) {
 	if err != nil {
 		util.HandleError(c, http.StatusInternalServerError, err)
 
-		return
+		return nil, err
 	}
 
 	// send API call to update repo for ensuring counter is incremented
@@ -325,7 +358,7 @@ func RestartBuild(c *gin.Context) {
 		retErr := fmt.Errorf("unable to restart build: failed to update repo %s: %w", r.GetFullName(), err)
 		util.HandleError(c, http.StatusBadRequest, retErr)
 
-		return
+		return nil, retErr
 	}
 
 	// send API call to capture the restarted build
@@ -348,5 +381,8 @@ func RestartBuild(c *gin.Context) {
 		b,
 		r,
 		u,
+		route,
 	)
+
+	return b, nil
 }
@@ -3,9 +3,21 @@
 package build
 
 import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/go-vela/server/scm"
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
 	"github.com/go-vela/types/pipeline"
+	"github.com/sirupsen/logrus"
 )
 
+// skipDirectives represents the directives in a commit
+// message that request the build for the commit be skipped.
+var skipDirectives = []string{"[skip ci]", "[ci skip]", "[vela skip]"}
+
 // SkipEmptyBuild checks if the build should be skipped due to it
 // not containing any steps besides init or clone.
 //
@@ -37,3 +49,41 @@ func SkipEmptyBuild(p *pipeline.Build) string {
 
 	return ""
 }
+
+// SkipDirective checks if the build should be skipped due to the
+// head commit message containing a directive like [skip ci].
+//
+// Only push and pull_request builds are skipped so builds that
+// are explicitly requested, like deployments, are never skipped.
+//
+// The message for pull_request builds is the title of the pull request,
+// so the message for the head commit is captured from the scm instead.
+func SkipDirective(ctx context.Context, s scm.Service, u *library.User, r *library.Repo, b *library.Build) string {
+	message := b.GetMessage()
+
+	switch {
+	case strings.EqualFold(b.GetEvent(), constants.EventPush):
+	case strings.EqualFold(b.GetEvent(), constants.EventPull):
+		// send API call to capture the message for the head commit
+		head, err := s.CommitMessage(ctx, u, r, b.GetCommit())
+		if err != nil {
+			logrus.Errorf("unable to get head commit message for %s/%s: %v", r.GetFullName(), b.GetCommit(), err)
+
+			return ""
+		}
+
+		message = head
+	default:
+		return ""
+	}
+
+	message = strings.ToLower(message)
+
+	for _, directive := range skipDirectives {
+		if strings.Contains(message, directive) {
+			return fmt.Sprintf("skipping build since %s found in the commit message", directive)
+		}
+	}
+
+	return ""
+}
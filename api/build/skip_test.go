@@ -3,8 +3,15 @@
 package build
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/scm/github"
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
 	"github.com/go-vela/types/pipeline"
 )
 
@@ -76,3 +83,71 @@ func Test_SkipEmptyBuild(t *testing.T) {
 		})
 	}
 }
+
+func Test_SkipDirective(t *testing.T) {
+	// setup mock server
+	gin.SetMode(gin.TestMode)
+
+	_, engine := gin.CreateTestContext(httptest.NewRecorder())
+
+	// the head commit of the pull request is looked up by its sha
+	engine.GET("/api/v3/repos/:org/:repo/git/commits/:sha", func(c *gin.Context) {
+		message := "update docs"
+		if c.Param("sha") == "skip" {
+			message = "update docs\n\n[vela skip]"
+		}
+
+		c.JSON(http.StatusOK, gin.H{"sha": c.Param("sha"), "message": message})
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	client, err := github.NewTest(s.URL)
+	if err != nil {
+		t.Errorf("unable to create test scm service: %v", err)
+	}
+
+	u := new(library.User)
+	u.SetName("octocat")
+	u.SetToken("foo")
+
+	r := new(library.Repo)
+	r.SetOrg("github")
+	r.SetName("octocat")
+	r.SetFullName("github/octocat")
+
+	type args struct {
+		event   string
+		message string
+		commit  string
+	}
+
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{"push with skip ci", args{event: constants.EventPush, message: "update docs [skip ci]"}, "skipping build since [skip ci] found in the commit message"},
+		{"push with ci skip", args{event: constants.EventPush, message: "[CI SKIP] update docs"}, "skipping build since [ci skip] found in the commit message"},
+		{"pull request with vela skip in head commit", args{event: constants.EventPull, message: "Update docs", commit: "skip"}, "skipping build since [vela skip] found in the commit message"},
+		{"pull request with skip ci in title", args{event: constants.EventPull, message: "Update docs [skip ci]", commit: "build"}, ""},
+		{"pull request with missing head commit", args{event: constants.EventPull, message: "Update docs"}, ""},
+		{"push without directive", args{event: constants.EventPush, message: "skip ci for docs"}, ""},
+		{"deployment with directive", args{event: constants.EventDeploy, message: "[skip ci]"}, ""},
+		{"tag with directive", args{event: constants.EventTag, message: "[skip ci]"}, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := new(library.Build)
+			b.SetEvent(tt.args.event)
+			b.SetMessage(tt.args.message)
+			b.SetCommit(tt.args.commit)
+
+			if got := SkipDirective(context.TODO(), client, u, r, b); got != tt.want {
+				t.Errorf("SkipDirective() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
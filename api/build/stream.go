@@ -0,0 +1,211 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package build
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/build"
+	"github.com/go-vela/server/router/middleware/org"
+	"github.com/go-vela/server/router/middleware/repo"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/stream"
+	"github.com/go-vela/server/util"
+	"github.com/go-vela/types/library"
+	"github.com/sirupsen/logrus"
+)
+
+// swagger:operation GET /api/v1/repos/{org}/{repo}/builds/{build}/stream builds StreamBuild
+//
+// Stream the status of a build, and its steps and services, as server-sent events
+//
+// ---
+// produces:
+// - text/event-stream
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// - in: path
+//   name: repo
+//   description: Name of the repo
+//   required: true
+//   type: string
+// - in: path
+//   name: build
+//   description: Build number
+//   required: true
+//   type: integer
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully streamed the current status followed by the
+//       build, step and service events until the build is complete
+//     schema:
+//       type: string
+//   '500':
+//     description: Unable to stream the build
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// StreamBuild represents the API handler to stream the status
+// of a build, and its steps and services, to the client as
+// server-sent events until the build is complete.
+func StreamBuild(c *gin.Context) {
+	// capture middleware values
+	b := build.Retrieve(c)
+	o := org.Retrieve(c)
+	r := repo.Retrieve(c)
+	u := user.Retrieve(c)
+
+	// unsubscribe from the stream once the handler returns
+	ctx, cancel := context.WithCancel(c.Request.Context())
+	defer cancel()
+
+	entry := fmt.Sprintf("%s/%d", r.GetFullName(), b.GetNumber())
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"build": b.GetNumber(),
+		"org":   o,
+		"repo":  r.GetName(),
+		"user":  u.GetName(),
+	}).Infof("streaming build %s", entry)
+
+	s := stream.FromGinContext(c)
+	if s == nil {
+		retErr := fmt.Errorf("unable to stream build %s: no stream configured", entry)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	// subscribe before capturing the build so no status transitions are missed
+	events, err := s.Subscribe(ctx, stream.BuildTopic(b.GetID()))
+	if err != nil {
+		retErr := fmt.Errorf("unable to subscribe to build %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	// send API call to capture the current build
+	b, err = database.FromContext(c).GetBuild(ctx, b.GetID())
+	if err != nil {
+		retErr := fmt.Errorf("unable to get build %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	steps, services, err := resources(c, b)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get steps and services for build %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	// send the current status of the build
+	stream.Open(c)
+	stream.Write(c, "", stream.EventBuild, b)
+
+	for _, service := range services {
+		stream.Write(c, "", stream.EventService, service)
+	}
+
+	for _, step := range steps {
+		stream.Write(c, "", stream.EventStep, step)
+	}
+
+	ticker := time.NewTicker(stream.Heartbeat)
+	defer ticker.Stop()
+
+	// send the status transitions until the build is complete
+	for !stream.Final(b.GetStatus()) {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			stream.Ping(c)
+		case data, ok := <-events:
+			if !ok {
+				return
+			}
+
+			e, err := stream.Decode(data)
+			if err != nil {
+				logrus.Errorf("unable to stream build %s: %v", entry, err)
+
+				continue
+			}
+
+			switch e.Type {
+			case stream.EventBuild:
+				b = e.Build
+
+				stream.Write(c, "", e.Type, e.Build)
+			case stream.EventService:
+				stream.Write(c, "", e.Type, e.Service)
+			case stream.EventStep:
+				stream.Write(c, "", e.Type, e.Step)
+			}
+		}
+	}
+
+	stream.Write(c, "", stream.EventEnd, b)
+}
+
+// resources is a helper function to capture
+// all the steps and services for a build.
+func resources(c *gin.Context, b *library.Build) ([]*library.Step, []*library.Service, error) {
+	steps := []*library.Step{}
+	services := []*library.Service{}
+	perPage := 100
+
+	for page := 1; ; page++ {
+		// send API call to capture the steps (per page) for the build
+		s, _, err := database.FromContext(c).ListStepsForBuild(b, map[string]interface{}{}, page, perPage)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		steps = append(steps, s...)
+
+		// assume no more pages exist if under 100 results are returned
+		if len(s) < perPage {
+			break
+		}
+	}
+
+	for page := 1; ; page++ {
+		// send API call to capture the services (per page) for the build
+		s, _, err := database.FromContext(c).ListServicesForBuild(c.Request.Context(), b, map[string]interface{}{}, page, perPage)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		services = append(services, s...)
+
+		// assume no more pages exist if under 100 results are returned
+		if len(s) < perPage {
+			break
+		}
+	}
+
+	return steps, services, nil
+}
@@ -0,0 +1,380 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package build
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/build"
+	"github.com/go-vela/server/router/middleware/org"
+	"github.com/go-vela/server/router/middleware/repo"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/stream"
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+)
+
+func TestBuild_StreamBuild(t *testing.T) {
+	// setup database
+	db, err := database.NewTest()
+	if err != nil {
+		t.Errorf("unable to create test database engine: %v", err)
+	}
+
+	defer db.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("octocat")
+	u.SetToken("foo")
+	u.SetHash("bar")
+	u.SetActive(true)
+
+	u, err = db.CreateUser(context.TODO(), u)
+	if err != nil {
+		t.Errorf("unable to create test user: %v", err)
+	}
+
+	r := new(library.Repo)
+	r.SetUserID(u.GetID())
+	r.SetOrg("github")
+	r.SetName("octocat")
+	r.SetFullName("github/octocat")
+	r.SetHash("baz")
+	r.SetVisibility(constants.VisibilityPublic)
+
+	r, err = db.CreateRepo(context.TODO(), r)
+	if err != nil {
+		t.Errorf("unable to create test repo: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		name       string
+		status     string
+		publish    []string
+		disconnect bool
+		want       []string
+	}{
+		{
+			name:   "complete build",
+			status: constants.StatusSuccess,
+			want:   []string{stream.EventBuild, stream.EventService, stream.EventStep, stream.EventEnd},
+		},
+		{
+			name:    "status transitions",
+			status:  constants.StatusRunning,
+			publish: []string{stream.EventService, stream.EventStep, stream.EventBuild},
+			want: []string{
+				stream.EventBuild, stream.EventService, stream.EventStep,
+				stream.EventService, stream.EventStep, stream.EventBuild, stream.EventEnd,
+			},
+		},
+		{
+			name:       "client disconnect",
+			status:     constants.StatusRunning,
+			disconnect: true,
+			want:       []string{stream.EventBuild, stream.EventService, stream.EventStep},
+		},
+	}
+
+	// run tests
+	for i, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			b := new(library.Build)
+			b.SetRepoID(r.GetID())
+			b.SetNumber(i + 1)
+			b.SetStatus(test.status)
+
+			b, err := db.CreateBuild(context.TODO(), b)
+			if err != nil {
+				t.Errorf("unable to create test build: %v", err)
+			}
+
+			svc := new(library.Service)
+			svc.SetRepoID(r.GetID())
+			svc.SetBuildID(b.GetID())
+			svc.SetNumber(1)
+			svc.SetName("postgres")
+			svc.SetImage("postgres:latest")
+			svc.SetStatus(test.status)
+
+			svc, err = db.CreateService(context.TODO(), svc)
+			if err != nil {
+				t.Errorf("unable to create test service: %v", err)
+			}
+
+			s := new(library.Step)
+			s.SetRepoID(r.GetID())
+			s.SetBuildID(b.GetID())
+			s.SetNumber(1)
+			s.SetName("test")
+			s.SetImage("alpine:latest")
+			s.SetStatus(test.status)
+
+			s, err = db.CreateStep(s)
+			if err != nil {
+				t.Errorf("unable to create test step: %v", err)
+			}
+
+			f := newFakeStream()
+
+			resp := httptest.NewRecorder()
+
+			engine := testStreamEngine(db, f, u, r, b)
+			engine.GET("/stream", StreamBuild)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			done := make(chan struct{})
+
+			go func() {
+				defer close(done)
+
+				engine.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/stream", nil).WithContext(ctx))
+			}()
+
+			topics := waitFor(t, f.subscribed)
+			if !reflect.DeepEqual(topics, []string{stream.BuildTopic(b.GetID())}) {
+				t.Errorf("StreamBuild subscribed to %v, want %v", topics, []string{stream.BuildTopic(b.GetID())})
+			}
+
+			for _, event := range test.publish {
+				switch event {
+				case stream.EventService:
+					svc.SetStatus(constants.StatusSuccess)
+
+					err = stream.PublishService(ctx, f, svc)
+				case stream.EventStep:
+					s.SetStatus(constants.StatusSuccess)
+
+					err = stream.PublishStep(ctx, f, s)
+				case stream.EventBuild:
+					b.SetStatus(constants.StatusSuccess)
+
+					err = stream.PublishBuild(ctx, f, b)
+				}
+
+				if err != nil {
+					t.Errorf("unable to publish %s event: %v", event, err)
+				}
+			}
+
+			if test.disconnect {
+				cancel()
+			}
+
+			waitFor(t, done)
+			waitFor(t, f.unsubscribed)
+
+			if resp.Code != http.StatusOK {
+				t.Errorf("StreamBuild returned %v, want %v", resp.Code, http.StatusOK)
+			}
+
+			if got := resp.Header().Get("Content-Type"); got != "text/event-stream" {
+				t.Errorf("StreamBuild Content-Type is %v, want %v", got, "text/event-stream")
+			}
+
+			got := streamEvents(resp.Body.String())
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("StreamBuild sent events %v, want %v", got, test.want)
+			}
+
+			if test.disconnect {
+				return
+			}
+
+			// the stream ends with the complete build
+			end := new(library.Build)
+
+			err = json.Unmarshal([]byte(streamData(resp.Body.String(), stream.EventEnd)), end)
+			if err != nil {
+				t.Errorf("unable to unmarshal %s event: %v", stream.EventEnd, err)
+			}
+
+			if end.GetID() != b.GetID() || end.GetStatus() != constants.StatusSuccess {
+				t.Errorf("StreamBuild ended with build %d %s, want build %d %s", end.GetID(), end.GetStatus(), b.GetID(), constants.StatusSuccess)
+			}
+		})
+	}
+}
+
+func TestBuild_StreamBuild_NoStream(t *testing.T) {
+	// setup types
+	b := new(library.Build)
+	b.SetID(1)
+	b.SetNumber(1)
+
+	r := new(library.Repo)
+	r.SetFullName("github/octocat")
+
+	resp := httptest.NewRecorder()
+
+	engine := testStreamEngine(nil, nil, new(library.User), r, b)
+	engine.GET("/stream", StreamBuild)
+
+	// run test
+	engine.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/stream", nil))
+
+	if resp.Code != http.StatusInternalServerError {
+		t.Errorf("StreamBuild returned %v, want %v", resp.Code, http.StatusInternalServerError)
+	}
+}
+
+// fakeStream represents a stream Service for testing the stream
+// handlers that records the topics subscribed to and signals
+// when the subscription is canceled.
+type fakeStream struct {
+	events       chan []byte
+	subscribed   chan []string
+	unsubscribed chan struct{}
+}
+
+// newFakeStream is a helper function to create a fake stream
+// that supports a single subscription.
+func newFakeStream() *fakeStream {
+	return &fakeStream{
+		events:       make(chan []byte),
+		subscribed:   make(chan []string, 1),
+		unsubscribed: make(chan struct{}),
+	}
+}
+
+// Driver outputs the configured stream driver.
+func (f *fakeStream) Driver() string {
+	return "fake"
+}
+
+// Publish sends the event to the subscriber.
+func (f *fakeStream) Publish(_ context.Context, topic string, data []byte) error {
+	select {
+	case f.events <- data:
+		return nil
+	case <-f.unsubscribed:
+		return fmt.Errorf("unable to publish to %s: no subscriber", topic)
+	case <-time.After(5 * time.Second):
+		return fmt.Errorf("unable to publish to %s: timed out", topic)
+	}
+}
+
+// Subscribe receives the events published until the context
+// is canceled, closing the channel returned.
+func (f *fakeStream) Subscribe(ctx context.Context, topics ...string) (<-chan []byte, error) {
+	subscriber := make(chan []byte)
+
+	go func() {
+		defer close(f.unsubscribed)
+		defer close(subscriber)
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case data := <-f.events:
+				select {
+				case subscriber <- data:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	f.subscribed <- topics
+
+	return subscriber, nil
+}
+
+// testStreamEngine is a helper function to create a gin
+// engine with the middleware values used by the stream handlers.
+func testStreamEngine(db database.Interface, s stream.Service, u *library.User, r *library.Repo, b *library.Build) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	_, engine := gin.CreateTestContext(httptest.NewRecorder())
+
+	engine.Use(func(c *gin.Context) {
+		if db != nil {
+			database.ToContext(c, db)
+		}
+
+		if s != nil {
+			stream.WithGinContext(c, s)
+		}
+
+		org.ToContext(c, r.GetOrg())
+		repo.ToContext(c, r)
+		build.ToContext(c, b)
+		user.ToContext(c, u)
+		c.Next()
+	})
+
+	return engine
+}
+
+// waitFor is a helper function to receive from the
+// channel or fail the test when it takes too long.
+func waitFor[T any](t *testing.T, ch <-chan T) T {
+	t.Helper()
+
+	select {
+	case v := <-ch:
+		return v
+	case <-time.After(5 * time.Second):
+		t.Fatalf("timed out waiting for the stream handler")
+	}
+
+	var v T
+
+	return v
+}
+
+// streamEvents is a helper function to capture the
+// types of the server-sent events sent to the client.
+func streamEvents(body string) []string {
+	events := []string{}
+
+	for _, message := range strings.Split(body, "\n\n") {
+		for _, line := range strings.Split(message, "\n") {
+			if event, ok := strings.CutPrefix(line, "event:"); ok {
+				events = append(events, event)
+			}
+		}
+	}
+
+	return events
+}
+
+// streamData is a helper function to capture the data of
+// the last server-sent event of the type sent to the client.
+func streamData(body, event string) string {
+	data := ""
+
+	for _, message := range strings.Split(body, "\n\n") {
+		lines := strings.Split(message, "\n")
+		if !slices.Contains(lines, "event:"+event) {
+			continue
+		}
+
+		data = ""
+
+		for _, line := range lines {
+			if value, ok := strings.CutPrefix(line, "data:"); ok {
+				data += value
+			}
+		}
+	}
+
+	return data
+}
@@ -8,11 +8,13 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/queue"
 	"github.com/go-vela/server/router/middleware/build"
 	"github.com/go-vela/server/router/middleware/claims"
 	"github.com/go-vela/server/router/middleware/org"
 	"github.com/go-vela/server/router/middleware/repo"
 	"github.com/go-vela/server/scm"
+	"github.com/go-vela/server/stream"
 	"github.com/go-vela/server/util"
 	"github.com/go-vela/types/constants"
 	"github.com/go-vela/types/library"
@@ -98,6 +100,9 @@ func UpdateBuild(c *gin.Context) {
 		return
 	}
 
+	// capture the status before the update
+	status := b.GetStatus()
+
 	// update build fields if provided
 	if len(input.GetStatus()) > 0 {
 		// update status if set
@@ -159,6 +164,14 @@ func UpdateBuild(c *gin.Context) {
 		return
 	}
 
+	// publish the status transition of the build to the stream
+	if b.GetStatus() != status {
+		err = stream.PublishBuild(ctx, stream.FromGinContext(c), b)
+		if err != nil {
+			logrus.Errorf("unable to publish build %s to stream: %v", entry, err)
+		}
+	}
+
 	c.JSON(http.StatusOK, b)
 
 	// check if the build is in a "final" state
@@ -167,16 +180,51 @@ func UpdateBuild(c *gin.Context) {
 		b.GetStatus() == constants.StatusCanceled ||
 		b.GetStatus() == constants.StatusKilled ||
 		b.GetStatus() == constants.StatusError {
+		// release the build so it no longer counts against the owner when sharing workers
+		err = queue.FromContext(c).Done(ctx, b.GetID())
+		if err != nil {
+			logrus.Errorf("unable to release build %s from queue: %v", entry, err)
+		}
+
 		// send API call to capture the repo owner
 		u, err := database.FromContext(c).GetUser(ctx, r.GetUserID())
 		if err != nil {
 			logrus.Errorf("unable to get owner for build %s: %v", entry, err)
 		}
 
-		// send API call to set the status on the commit
-		err = scm.FromContext(c).Status(ctx, u, b, r.GetOrg(), r.GetName())
+		// retrieve the steps for the build from the step table
+		steps := []*library.Step{}
+		page := 1
+		perPage := 100
+
+		for page > 0 {
+			// retrieve build steps (per page) from the database
+			stepsPart, _, err := database.FromContext(c).ListStepsForBuild(b, map[string]interface{}{}, page, perPage)
+			if err != nil {
+				logrus.Errorf("unable to retrieve steps for build %s: %v", entry, err)
+
+				// the status is still set without per-step detail
+				steps = nil
+
+				break
+			}
+
+			// add page of steps to list steps
+			steps = append(steps, stepsPart...)
+
+			// assume no more pages exist if under 100 results are returned
+			if len(stepsPart) < perPage {
+				page = 0
+			} else {
+				page++
+			}
+		}
+
+		// send API call to set the check run with per-step detail, or the
+		// status on the commit when the scm does not report check runs
+		err = scm.FromContext(c).CheckRun(ctx, u, b, steps, r.GetOrg(), r.GetName())
 		if err != nil {
-			logrus.Errorf("unable to set commit status for build %s: %v", entry, err)
+			logrus.Errorf("unable to set status for build %s: %v", entry, err)
 		}
 	}
 }
@@ -57,7 +57,6 @@ func CreateDeployment(c *gin.Context) {
 	o := org.Retrieve(c)
 	r := repo.Retrieve(c)
 	u := user.Retrieve(c)
-	ctx := c.Request.Context()
 
 	// update engine logger with API metadata
 	//
@@ -81,24 +80,10 @@ func CreateDeployment(c *gin.Context) {
 	}
 
 	// update fields in deployment object
-	input.SetRepoID(r.GetID())
 	input.SetUser(u.GetName())
 
-	if len(input.GetDescription()) == 0 {
-		input.SetDescription("Deployment request from Vela")
-	}
-
-	if len(input.GetTask()) == 0 {
-		input.SetTask("deploy:vela")
-	}
-
-	// if ref is not provided, use repo default branch
-	if len(input.GetRef()) == 0 {
-		input.SetRef(fmt.Sprintf("refs/heads/%s", r.GetBranch()))
-	}
-
 	// send API call to create the deployment
-	err = scm.FromContext(c).CreateDeployment(ctx, u, r, input)
+	err = Create(c, u, r, input)
 	if err != nil {
 		retErr := fmt.Errorf("unable to create new deployment for %s: %w", r.GetFullName(), err)
 
@@ -109,3 +94,29 @@ func CreateDeployment(c *gin.Context) {
 
 	c.JSON(http.StatusCreated, input)
 }
+
+// Create is a helper function that sets the default fields
+// for a deployment and creates the deployment in the SCM.
+//
+// This is shared by the CreateDeployment handler and webhook
+// events that request a deployment, like pull request commands.
+func Create(c *gin.Context, u *library.User, r *library.Repo, d *library.Deployment) error {
+	// update fields in deployment object
+	d.SetRepoID(r.GetID())
+
+	if len(d.GetDescription()) == 0 {
+		d.SetDescription("Deployment request from Vela")
+	}
+
+	if len(d.GetTask()) == 0 {
+		d.SetTask("deploy:vela")
+	}
+
+	// if ref is not provided, use repo default branch
+	if len(d.GetRef()) == 0 {
+		d.SetRef(fmt.Sprintf("refs/heads/%s", r.GetBranch()))
+	}
+
+	// send API call to create the deployment
+	return scm.FromContext(c).CreateDeployment(c.Request.Context(), u, r, d)
+}
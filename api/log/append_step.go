@@ -0,0 +1,153 @@
+// SPDX-License-Identifier: Apache-2.0
+
+//nolint:dupl // ignore similar code with service
+package log
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database"
+	dbLog "github.com/go-vela/server/database/log"
+	"github.com/go-vela/server/router/middleware/build"
+	"github.com/go-vela/server/router/middleware/org"
+	"github.com/go-vela/server/router/middleware/repo"
+	"github.com/go-vela/server/router/middleware/step"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/stream"
+	"github.com/go-vela/server/util"
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+	"github.com/sirupsen/logrus"
+)
+
+// swagger:operation POST /api/v1/repos/{org}/{repo}/builds/{build}/steps/{step}/logs/chunks steps AppendStepLog
+//
+// Append a chunk to the logs for a step
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// - in: path
+//   name: repo
+//   description: Name of the repo
+//   required: true
+//   type: string
+// - in: path
+//   name: build
+//   description: Build number
+//   required: true
+//   type: integer
+// - in: path
+//   name: step
+//   description: Step number
+//   required: true
+//   type: integer
+// - in: body
+//   name: body
+//   description: Payload containing the sequence and data of the chunk to append
+//   required: true
+//   schema:
+//     "$ref": "#/definitions/LogChunk"
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully appended the chunk to the logs for step
+//   '400':
+//     description: Unable to append the chunk to the logs for a step
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '409':
+//     description: Chunk was not the next in sequence for the logs for a step
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to append the chunk to the logs for a step
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// AppendStepLog represents the API handler to append
+// a chunk to the logs for a step in the configured backend.
+func AppendStepLog(c *gin.Context) {
+	// capture middleware values
+	b := build.Retrieve(c)
+	o := org.Retrieve(c)
+	r := repo.Retrieve(c)
+	s := step.Retrieve(c)
+	u := user.Retrieve(c)
+	ctx := c.Request.Context()
+
+	entry := fmt.Sprintf("%s/%d/%d", r.GetFullName(), b.GetNumber(), s.GetNumber())
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"build": b.GetNumber(),
+		"org":   o,
+		"repo":  r.GetName(),
+		"step":  s.GetNumber(),
+		"user":  u.GetName(),
+	}).Infof("appending to logs for step %s", entry)
+
+	// capture body from API request
+	input := new(types.LogChunk)
+
+	err := c.Bind(input)
+	if err != nil {
+		retErr := fmt.Errorf("unable to decode JSON for step %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// create the log reference for the step
+	l := new(library.Log)
+	l.SetRepoID(r.GetID())
+	l.SetBuildID(b.GetID())
+	l.SetStepID(s.GetID())
+
+	// send API call to append the chunk to the log
+	err = database.FromContext(c).AppendLogChunk(ctx, l, input)
+	if err != nil {
+		retErr := fmt.Errorf("unable to append to logs for step %s: %w", entry, err)
+
+		switch {
+		case errors.Is(err, dbLog.ErrEmptyLogChunk):
+			util.HandleError(c, http.StatusBadRequest, retErr)
+		case errors.Is(err, dbLog.ErrLogChunkSequence):
+			util.HandleError(c, http.StatusConflict, retErr)
+		default:
+			util.HandleError(c, http.StatusInternalServerError, retErr)
+		}
+
+		return
+	}
+
+	// publish the data written to the log to the stream
+	err = stream.PublishLog(ctx, stream.FromGinContext(c), l)
+	if err != nil {
+		logrus.Errorf("unable to publish logs for step %s to stream: %v", entry, err)
+	}
+
+	// compact the log when the chunk arrives after the step is complete
+	if s.GetStatus() != constants.StatusPending && s.GetStatus() != constants.StatusRunning {
+		err = database.FromContext(c).CompactLog(ctx, l)
+		if err != nil {
+			logrus.Errorf("unable to compact logs for step %s: %v", entry, err)
+		}
+	}
+
+	c.JSON(http.StatusOK, nil)
+}
@@ -0,0 +1,142 @@
+// SPDX-License-Identifier: Apache-2.0
+
+//nolint:dupl // ignore similar code with step
+package log
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/build"
+	"github.com/go-vela/server/router/middleware/org"
+	"github.com/go-vela/server/router/middleware/repo"
+	"github.com/go-vela/server/router/middleware/service"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/util"
+	"github.com/go-vela/types/library"
+	"github.com/sirupsen/logrus"
+)
+
+// swagger:operation GET /api/v1/repos/{org}/{repo}/builds/{build}/services/{service}/logs/range services GetServiceLogRange
+//
+// Retrieve a range of the logs for a service
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// - in: path
+//   name: repo
+//   description: Name of the repo
+//   required: true
+//   type: string
+// - in: path
+//   name: build
+//   description: Build number
+//   required: true
+//   type: integer
+// - in: path
+//   name: service
+//   description: Service number
+//   required: true
+//   type: integer
+// - in: query
+//   name: start
+//   description: The byte offset, or line number, the range starts at
+//   type: integer
+//   default: 0
+// - in: query
+//   name: end
+//   description: The byte offset, or line number, the range ends before (0 for the end of the logs)
+//   type: integer
+//   default: 0
+// - in: query
+//   name: unit
+//   description: The unit of the range
+//   type: string
+//   enum:
+//   - bytes
+//   - lines
+//   default: bytes
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully retrieved the range of the logs for service
+//     schema:
+//       "$ref": "#/definitions/LogChunk"
+//   '400':
+//     description: Unable to retrieve the range of the logs for a service
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to retrieve the range of the logs for a service
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// GetServiceLogRange represents the API handler to capture a
+// range of the logs for a service from the configured backend.
+func GetServiceLogRange(c *gin.Context) {
+	// capture middleware values
+	b := build.Retrieve(c)
+	o := org.Retrieve(c)
+	r := repo.Retrieve(c)
+	s := service.Retrieve(c)
+	u := user.Retrieve(c)
+	ctx := c.Request.Context()
+
+	entry := fmt.Sprintf("%s/%d/%d", r.GetFullName(), b.GetNumber(), s.GetNumber())
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"build":   b.GetNumber(),
+		"org":     o,
+		"repo":    r.GetName(),
+		"service": s.GetNumber(),
+		"user":    u.GetName(),
+	}).Infof("reading range of logs for service %s", entry)
+
+	start, end, lines, err := parseRange(c)
+	if err != nil {
+		retErr := fmt.Errorf("unable to read range of logs for service %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// create the log reference for the service
+	l := new(library.Log)
+	l.SetRepoID(r.GetID())
+	l.SetBuildID(b.GetID())
+	l.SetServiceID(s.GetID())
+
+	var chunk *types.LogChunk
+
+	// send API call to capture the range of the service logs
+	if lines {
+		chunk, err = database.FromContext(c).GetLogLines(ctx, l, start, end)
+	} else {
+		chunk, err = database.FromContext(c).GetLogBytes(ctx, l, start, end)
+	}
+
+	if err != nil {
+		retErr := fmt.Errorf("unable to get range of logs for service %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, chunk)
+}
@@ -0,0 +1,33 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// parseRange is a helper function to capture the range of the
+// log to read from the start, end and unit query parameters.
+func parseRange(c *gin.Context) (int64, int64, bool, error) {
+	start, err := strconv.ParseInt(c.DefaultQuery("start", "0"), 10, 64)
+	if err != nil || start < 0 {
+		return 0, 0, false, fmt.Errorf("invalid start query parameter provided: %s", c.Query("start"))
+	}
+
+	end, err := strconv.ParseInt(c.DefaultQuery("end", "0"), 10, 64)
+	if err != nil || end < 0 || (end > 0 && end < start) {
+		return 0, 0, false, fmt.Errorf("invalid end query parameter provided: %s", c.Query("end"))
+	}
+
+	switch c.DefaultQuery("unit", "bytes") {
+	case "bytes":
+		return start, end, false, nil
+	case "lines":
+		return start, end, true, nil
+	default:
+		return 0, 0, false, fmt.Errorf("invalid unit query parameter provided: %s", c.Query("unit"))
+	}
+}
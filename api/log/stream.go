@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/stream"
+	"github.com/go-vela/types/library"
+)
+
+// parseOffset is a helper function to capture the byte offset to
+// resume streaming the log from the offset query parameter, or the
+// Last-Event-ID header sent by clients reconnecting to the stream.
+func parseOffset(c *gin.Context) (int64, error) {
+	value := c.Query("offset")
+	if len(value) == 0 {
+		value = c.GetHeader("Last-Event-ID")
+	}
+
+	// stream the log from the start
+	if len(value) == 0 {
+		return 0, nil
+	}
+
+	offset, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || offset < 0 {
+		return 0, fmt.Errorf("invalid offset provided: %s", value)
+	}
+
+	return offset, nil
+}
+
+// streamLog is a helper function to send the data written
+// to the log since the offset to the client of the stream.
+//
+// The ID of the event is the offset the data ends at so
+// clients can resume the stream where they left off.
+func streamLog(c *gin.Context, l *library.Log, offset int64) (int64, error) {
+	// send API call to capture the data written since the offset
+	chunk, err := database.FromContext(c).GetLogBytes(c.Request.Context(), l, offset, 0)
+	if err != nil {
+		return offset, err
+	}
+
+	// short-circuit if no data was written
+	if len(chunk.GetData()) == 0 {
+		return offset, nil
+	}
+
+	offset = chunk.GetOffset() + int64(len(chunk.GetData()))
+
+	stream.Write(c, strconv.FormatInt(offset, 10), stream.EventLog, chunk)
+
+	return offset, nil
+}
@@ -0,0 +1,189 @@
+// SPDX-License-Identifier: Apache-2.0
+
+//nolint:dupl // ignore similar code with service
+package log
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/build"
+	"github.com/go-vela/server/router/middleware/org"
+	"github.com/go-vela/server/router/middleware/repo"
+	"github.com/go-vela/server/router/middleware/step"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/stream"
+	"github.com/go-vela/server/util"
+	"github.com/go-vela/types/library"
+	"github.com/sirupsen/logrus"
+)
+
+// swagger:operation GET /api/v1/repos/{org}/{repo}/builds/{build}/steps/{step}/logs/stream steps StreamStepLog
+//
+// Stream the logs for a step as server-sent events
+//
+// ---
+// produces:
+// - text/event-stream
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// - in: path
+//   name: repo
+//   description: Name of the repo
+//   required: true
+//   type: string
+// - in: path
+//   name: build
+//   description: Build number
+//   required: true
+//   type: integer
+// - in: path
+//   name: step
+//   description: Step number
+//   required: true
+//   type: integer
+// - in: query
+//   name: offset
+//   description: The byte offset to resume the stream from (defaults to the Last-Event-ID header)
+//   type: integer
+//   default: 0
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully streamed the logs for the step until the step is complete
+//     schema:
+//       type: string
+//   '400':
+//     description: Unable to stream the logs for a step
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to stream the logs for a step
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// StreamStepLog represents the API handler to stream the logs
+// for a step to the client as server-sent events until the
+// step is complete.
+func StreamStepLog(c *gin.Context) {
+	// capture middleware values
+	b := build.Retrieve(c)
+	o := org.Retrieve(c)
+	r := repo.Retrieve(c)
+	s := step.Retrieve(c)
+	u := user.Retrieve(c)
+
+	// unsubscribe from the stream once the handler returns
+	ctx, cancel := context.WithCancel(c.Request.Context())
+	defer cancel()
+
+	entry := fmt.Sprintf("%s/%d/%d", r.GetFullName(), b.GetNumber(), s.GetNumber())
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"build": b.GetNumber(),
+		"org":   o,
+		"repo":  r.GetName(),
+		"step":  s.GetNumber(),
+		"user":  u.GetName(),
+	}).Infof("streaming logs for step %s", entry)
+
+	offset, err := parseOffset(c)
+	if err != nil {
+		retErr := fmt.Errorf("unable to stream logs for step %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// create the log reference for the step
+	l := new(library.Log)
+	l.SetRepoID(r.GetID())
+	l.SetBuildID(b.GetID())
+	l.SetStepID(s.GetID())
+
+	st := stream.FromGinContext(c)
+	if st == nil {
+		retErr := fmt.Errorf("unable to stream logs for step %s: no stream configured", entry)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	// subscribe before capturing the step so no data or status transitions are missed
+	events, err := st.Subscribe(ctx, stream.LogTopic(l), stream.BuildTopic(b.GetID()))
+	if err != nil {
+		retErr := fmt.Errorf("unable to subscribe to logs for step %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	// send API call to capture the current step
+	s, err = database.FromContext(c).GetStep(s.GetID())
+	if err != nil {
+		retErr := fmt.Errorf("unable to get step %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	stream.Open(c)
+
+	ticker := time.NewTicker(stream.Heartbeat)
+	defer ticker.Stop()
+
+	for {
+		// send the data written to the log since the offset
+		offset, err = streamLog(c, l, offset)
+		if err != nil {
+			logrus.Errorf("unable to stream logs for step %s: %v", entry, err)
+
+			return
+		}
+
+		if stream.Final(s.GetStatus()) {
+			stream.Write(c, "", stream.EventEnd, s)
+
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			stream.Ping(c)
+		case data, ok := <-events:
+			if !ok {
+				return
+			}
+
+			e, err := stream.Decode(data)
+			if err != nil {
+				logrus.Errorf("unable to stream logs for step %s: %v", entry, err)
+
+				continue
+			}
+
+			// capture the status transitions of the step
+			if e.Type == stream.EventStep && e.Step.GetID() == s.GetID() {
+				s = e.Step
+			}
+		}
+	}
+}
@@ -0,0 +1,502 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/build"
+	"github.com/go-vela/server/router/middleware/org"
+	"github.com/go-vela/server/router/middleware/repo"
+	"github.com/go-vela/server/router/middleware/service"
+	"github.com/go-vela/server/router/middleware/step"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/stream"
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+)
+
+func TestLog_StreamStepLog(t *testing.T) {
+	// setup database
+	db, err := database.NewTest()
+	if err != nil {
+		t.Errorf("unable to create test database engine: %v", err)
+	}
+
+	defer db.Close()
+
+	u, r, b := testBuild(t, db)
+
+	// setup tests
+	tests := []struct {
+		name       string
+		status     string
+		offset     string
+		append     bool
+		disconnect bool
+		code       int
+		want       []string
+	}{
+		{
+			name:   "complete step",
+			status: constants.StatusSuccess,
+			code:   http.StatusOK,
+			want:   []string{"id:6 event:log", "event:end"},
+		},
+		{
+			name:   "resume from offset",
+			status: constants.StatusSuccess,
+			offset: "6",
+			code:   http.StatusOK,
+			want:   []string{"event:end"},
+		},
+		{
+			name:   "log and status transitions",
+			status: constants.StatusRunning,
+			append: true,
+			code:   http.StatusOK,
+			want:   []string{"id:6 event:log", "id:12 event:log", "event:end"},
+		},
+		{
+			name:       "client disconnect",
+			status:     constants.StatusRunning,
+			disconnect: true,
+			code:       http.StatusOK,
+			want:       []string{"id:6 event:log"},
+		},
+		{
+			name:   "invalid offset",
+			status: constants.StatusRunning,
+			offset: "-1",
+			code:   http.StatusBadRequest,
+			want:   []string{},
+		},
+	}
+
+	// run tests
+	for i, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := new(library.Step)
+			s.SetRepoID(r.GetID())
+			s.SetBuildID(b.GetID())
+			s.SetNumber(i + 1)
+			s.SetName("test")
+			s.SetImage("alpine:latest")
+			s.SetStatus(test.status)
+
+			s, err := db.CreateStep(s)
+			if err != nil {
+				t.Errorf("unable to create test step: %v", err)
+			}
+
+			l := new(library.Log)
+			l.SetRepoID(r.GetID())
+			l.SetBuildID(b.GetID())
+			l.SetStepID(s.GetID())
+
+			testLog(t, db, l)
+
+			f := newFakeStream()
+
+			engine := testStreamEngine(db, f, u, r, b)
+			engine.Use(func(c *gin.Context) {
+				step.ToContext(c, s)
+				c.Next()
+			})
+			engine.GET("/logs", StreamStepLog)
+
+			resp, ctx, cancel, done := serveStream(engine, "/logs?offset="+test.offset)
+			defer cancel()
+
+			if test.code != http.StatusOK {
+				waitFor(t, done)
+
+				if resp.Code != test.code {
+					t.Errorf("StreamStepLog returned %v, want %v", resp.Code, test.code)
+				}
+
+				return
+			}
+
+			want := []string{stream.LogTopic(l), stream.BuildTopic(b.GetID())}
+
+			topics := waitFor(t, f.subscribed)
+			if !reflect.DeepEqual(topics, want) {
+				t.Errorf("StreamStepLog subscribed to %v, want %v", topics, want)
+			}
+
+			if test.append {
+				testAppend(t, db, l, 2, "world\n")
+
+				err = stream.PublishLog(ctx, f, l)
+				if err != nil {
+					t.Errorf("unable to publish log event: %v", err)
+				}
+
+				s.SetStatus(constants.StatusSuccess)
+
+				err = stream.PublishStep(ctx, f, s)
+				if err != nil {
+					t.Errorf("unable to publish step event: %v", err)
+				}
+			}
+
+			if test.disconnect {
+				cancel()
+			}
+
+			waitFor(t, done)
+			waitFor(t, f.unsubscribed)
+
+			if resp.Code != test.code {
+				t.Errorf("StreamStepLog returned %v, want %v", resp.Code, test.code)
+			}
+
+			got := streamEvents(resp.Body.String())
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("StreamStepLog sent events %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestLog_StreamServiceLog(t *testing.T) {
+	// setup database
+	db, err := database.NewTest()
+	if err != nil {
+		t.Errorf("unable to create test database engine: %v", err)
+	}
+
+	defer db.Close()
+
+	u, r, b := testBuild(t, db)
+
+	// setup tests
+	tests := []struct {
+		name       string
+		status     string
+		publish    bool
+		disconnect bool
+		want       []string
+	}{
+		{
+			name:   "complete service",
+			status: constants.StatusSuccess,
+			want:   []string{"id:6 event:log", "event:end"},
+		},
+		{
+			name:    "status transitions",
+			status:  constants.StatusRunning,
+			publish: true,
+			want:    []string{"id:6 event:log", "event:end"},
+		},
+		{
+			name:       "client disconnect",
+			status:     constants.StatusRunning,
+			disconnect: true,
+			want:       []string{"id:6 event:log"},
+		},
+	}
+
+	// run tests
+	for i, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			svc := new(library.Service)
+			svc.SetRepoID(r.GetID())
+			svc.SetBuildID(b.GetID())
+			svc.SetNumber(i + 1)
+			svc.SetName("postgres")
+			svc.SetImage("postgres:latest")
+			svc.SetStatus(test.status)
+
+			svc, err := db.CreateService(context.TODO(), svc)
+			if err != nil {
+				t.Errorf("unable to create test service: %v", err)
+			}
+
+			l := new(library.Log)
+			l.SetRepoID(r.GetID())
+			l.SetBuildID(b.GetID())
+			l.SetServiceID(svc.GetID())
+
+			testLog(t, db, l)
+
+			f := newFakeStream()
+
+			engine := testStreamEngine(db, f, u, r, b)
+			engine.Use(func(c *gin.Context) {
+				service.ToContext(c, svc)
+				c.Next()
+			})
+			engine.GET("/logs", StreamServiceLog)
+
+			resp, ctx, cancel, done := serveStream(engine, "/logs")
+			defer cancel()
+
+			want := []string{stream.LogTopic(l), stream.BuildTopic(b.GetID())}
+
+			topics := waitFor(t, f.subscribed)
+			if !reflect.DeepEqual(topics, want) {
+				t.Errorf("StreamServiceLog subscribed to %v, want %v", topics, want)
+			}
+
+			if test.publish {
+				// events for other services are ignored
+				other := new(library.Service)
+				other.SetID(svc.GetID() + 100)
+				other.SetBuildID(b.GetID())
+				other.SetStatus(constants.StatusSuccess)
+
+				err = stream.PublishService(ctx, f, other)
+				if err != nil {
+					t.Errorf("unable to publish service event: %v", err)
+				}
+
+				svc.SetStatus(constants.StatusSuccess)
+
+				err = stream.PublishService(ctx, f, svc)
+				if err != nil {
+					t.Errorf("unable to publish service event: %v", err)
+				}
+			}
+
+			if test.disconnect {
+				cancel()
+			}
+
+			waitFor(t, done)
+			waitFor(t, f.unsubscribed)
+
+			if resp.Code != http.StatusOK {
+				t.Errorf("StreamServiceLog returned %v, want %v", resp.Code, http.StatusOK)
+			}
+
+			got := streamEvents(resp.Body.String())
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("StreamServiceLog sent events %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+// fakeStream represents a stream Service for testing the stream
+// handlers that records the topics subscribed to and signals
+// when the subscription is canceled.
+type fakeStream struct {
+	events       chan []byte
+	subscribed   chan []string
+	unsubscribed chan struct{}
+}
+
+// newFakeStream is a helper function to create a fake stream
+// that supports a single subscription.
+func newFakeStream() *fakeStream {
+	return &fakeStream{
+		events:       make(chan []byte),
+		subscribed:   make(chan []string, 1),
+		unsubscribed: make(chan struct{}),
+	}
+}
+
+// Driver outputs the configured stream driver.
+func (f *fakeStream) Driver() string {
+	return "fake"
+}
+
+// Publish sends the event to the subscriber.
+func (f *fakeStream) Publish(_ context.Context, topic string, data []byte) error {
+	select {
+	case f.events <- data:
+		return nil
+	case <-f.unsubscribed:
+		return fmt.Errorf("unable to publish to %s: no subscriber", topic)
+	case <-time.After(5 * time.Second):
+		return fmt.Errorf("unable to publish to %s: timed out", topic)
+	}
+}
+
+// Subscribe receives the events published until the context
+// is canceled, closing the channel returned.
+func (f *fakeStream) Subscribe(ctx context.Context, topics ...string) (<-chan []byte, error) {
+	subscriber := make(chan []byte)
+
+	go func() {
+		defer close(f.unsubscribed)
+		defer close(subscriber)
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case data := <-f.events:
+				select {
+				case subscriber <- data:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	f.subscribed <- topics
+
+	return subscriber, nil
+}
+
+// testBuild is a helper function to create the
+// user, repo and build the logs are streamed for.
+func testBuild(t *testing.T, db database.Interface) (*library.User, *library.Repo, *library.Build) {
+	t.Helper()
+
+	u := new(library.User)
+	u.SetName("octocat")
+	u.SetToken("foo")
+	u.SetHash("bar")
+	u.SetActive(true)
+
+	u, err := db.CreateUser(context.TODO(), u)
+	if err != nil {
+		t.Errorf("unable to create test user: %v", err)
+	}
+
+	r := new(library.Repo)
+	r.SetUserID(u.GetID())
+	r.SetOrg("github")
+	r.SetName("octocat")
+	r.SetFullName("github/octocat")
+	r.SetHash("baz")
+	r.SetVisibility(constants.VisibilityPublic)
+
+	r, err = db.CreateRepo(context.TODO(), r)
+	if err != nil {
+		t.Errorf("unable to create test repo: %v", err)
+	}
+
+	b := new(library.Build)
+	b.SetRepoID(r.GetID())
+	b.SetNumber(1)
+	b.SetStatus(constants.StatusRunning)
+
+	b, err = db.CreateBuild(context.TODO(), b)
+	if err != nil {
+		t.Errorf("unable to create test build: %v", err)
+	}
+
+	return u, r, b
+}
+
+// testLog is a helper function to create the log
+// with the first line of data already written.
+func testLog(t *testing.T, db database.Interface, l *library.Log) {
+	t.Helper()
+
+	err := db.CreateLog(context.TODO(), l)
+	if err != nil {
+		t.Errorf("unable to create test log: %v", err)
+	}
+
+	testAppend(t, db, l, 1, "hello\n")
+}
+
+// testAppend is a helper function to append
+// a chunk of data to the log.
+func testAppend(t *testing.T, db database.Interface, l *library.Log, sequence int64, data string) {
+	t.Helper()
+
+	chunk := new(types.LogChunk)
+	chunk.SetSequence(sequence)
+	chunk.SetData([]byte(data))
+
+	err := db.AppendLogChunk(context.TODO(), l, chunk)
+	if err != nil {
+		t.Errorf("unable to append test log chunk: %v", err)
+	}
+}
+
+// testStreamEngine is a helper function to create a gin
+// engine with the middleware values used by the stream handlers.
+func testStreamEngine(db database.Interface, s stream.Service, u *library.User, r *library.Repo, b *library.Build) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	_, engine := gin.CreateTestContext(httptest.NewRecorder())
+
+	engine.Use(func(c *gin.Context) {
+		database.ToContext(c, db)
+		stream.WithGinContext(c, s)
+		org.ToContext(c, r.GetOrg())
+		repo.ToContext(c, r)
+		build.ToContext(c, b)
+		user.ToContext(c, u)
+		c.Next()
+	})
+
+	return engine
+}
+
+// serveStream is a helper function to serve the stream request in
+// the background until the returned cancel function disconnects
+// the client or the handler returns, closing the returned channel.
+func serveStream(engine *gin.Engine, target string) (*httptest.ResponseRecorder, context.Context, context.CancelFunc, chan struct{}) {
+	resp := httptest.NewRecorder()
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		engine.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, target, nil).WithContext(ctx))
+	}()
+
+	return resp, ctx, cancel, done
+}
+
+// waitFor is a helper function to receive from the
+// channel or fail the test when it takes too long.
+func waitFor[T any](t *testing.T, ch <-chan T) T {
+	t.Helper()
+
+	select {
+	case v := <-ch:
+		return v
+	case <-time.After(5 * time.Second):
+		t.Fatalf("timed out waiting for the stream handler")
+	}
+
+	var v T
+
+	return v
+}
+
+// streamEvents is a helper function to capture the id and
+// type of the server-sent events sent to the client.
+func streamEvents(body string) []string {
+	events := []string{}
+
+	for _, message := range strings.Split(body, "\n\n") {
+		fields := []string{}
+
+		for _, line := range strings.Split(message, "\n") {
+			if strings.HasPrefix(line, "id:") || strings.HasPrefix(line, "event:") {
+				fields = append(fields, line)
+			}
+		}
+
+		if len(fields) > 0 {
+			events = append(events, strings.Join(fields, " "))
+		}
+	}
+
+	return events
+}
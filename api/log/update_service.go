@@ -14,6 +14,7 @@ import (
 	"github.com/go-vela/server/router/middleware/repo"
 	"github.com/go-vela/server/router/middleware/service"
 	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/stream"
 	"github.com/go-vela/server/util"
 	"github.com/go-vela/types/library"
 	"github.com/sirupsen/logrus"
@@ -132,5 +133,11 @@ func UpdateServiceLog(c *gin.Context) {
 		return
 	}
 
+	// publish the data written to the log to the stream
+	err = stream.PublishLog(ctx, stream.FromGinContext(c), l)
+	if err != nil {
+		logrus.Errorf("unable to publish logs for service %s to stream: %v", entry, err)
+	}
+
 	c.JSON(http.StatusOK, nil)
 }
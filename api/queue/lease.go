@@ -0,0 +1,170 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package queue
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/queue"
+	"github.com/go-vela/server/router/middleware/claims"
+	"github.com/go-vela/server/util"
+	"github.com/go-vela/types"
+	"github.com/go-vela/types/library"
+	"github.com/sirupsen/logrus"
+)
+
+// swagger:operation POST /api/v1/queue/items/{build}/ack queue AckQueueItem
+//
+// Acknowledge an item popped off the in-memory queue so it is removed
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: build
+//   description: ID of the build for the item
+//   required: true
+//   type: integer
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully acknowledged the item
+//     schema:
+//       type: string
+//   '400':
+//     description: Unable to acknowledge the item
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '404':
+//     description: Unable to find the lease for the item
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// Ack represents the API handler to acknowledge
+// an item popped off the in-memory queue.
+func Ack(c *gin.Context) {
+	lease(c, "acknowledged", func(ctx context.Context, q queue.Service, item *types.Item) error {
+		return q.Ack(ctx, item)
+	})
+}
+
+// swagger:operation POST /api/v1/queue/items/{build}/nack queue NackQueueItem
+//
+// Reject an item popped off the in-memory queue so it is requeued
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: build
+//   description: ID of the build for the item
+//   required: true
+//   type: integer
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully rejected the item
+//     schema:
+//       type: string
+//   '400':
+//     description: Unable to reject the item
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '404':
+//     description: Unable to find the lease for the item
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// Nack represents the API handler to reject an item popped
+// off the in-memory queue so another worker can pop it.
+func Nack(c *gin.Context) {
+	lease(c, "rejected", func(ctx context.Context, q queue.Service, item *types.Item) error {
+		return q.Nack(ctx, item)
+	})
+}
+
+// swagger:operation POST /api/v1/queue/items/{build}/extend queue ExtendQueueItem
+//
+// Renew the lease for an item popped off the in-memory queue
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: build
+//   description: ID of the build for the item
+//   required: true
+//   type: integer
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully renewed the lease for the item
+//     schema:
+//       type: string
+//   '400':
+//     description: Unable to renew the lease for the item
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '404':
+//     description: Unable to find the lease for the item
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// Extend represents the API handler to renew the
+// lease for an item popped off the in-memory queue.
+func Extend(c *gin.Context) {
+	lease(c, "extended the lease for", func(ctx context.Context, q queue.Service, item *types.Item) error {
+		return q.Extend(ctx, item)
+	})
+}
+
+// lease is a helper function to apply the provided
+// action to the lease of the item for a build.
+func lease(c *gin.Context, action string, fn func(context.Context, queue.Service, *types.Item) error) {
+	// capture middleware values
+	cl := claims.Retrieve(c)
+	ctx := c.Request.Context()
+
+	id, err := strconv.ParseInt(util.PathParameter(c, "build"), 10, 64)
+	if err != nil {
+		retErr := fmt.Errorf("unable to convert build parameter %s: %w", util.PathParameter(c, "build"), err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"worker": cl.Subject,
+	}).Infof("updating lease of item for build %d in queue", id)
+
+	q, err := popService(c)
+	if err != nil {
+		util.HandleError(c, http.StatusBadRequest, err)
+
+		return
+	}
+
+	// the leases for items are tracked by the ID of the build
+	b := new(library.Build)
+	b.SetID(id)
+
+	err = fn(ctx, q, &types.Item{Build: b})
+	if err != nil {
+		util.HandleError(c, http.StatusNotFound, err)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, fmt.Sprintf("%s item for build %d", action, id))
+}
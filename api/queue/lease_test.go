@@ -0,0 +1,92 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package queue
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestQueue_Lease(t *testing.T) {
+	// setup types
+	_memory := testMemory(t)
+
+	testPush(t, _memory, 1)
+	testPush(t, _memory, 2)
+
+	// lease the items for the builds
+	for i := 0; i < 2; i++ {
+		_, err := _memory.Pop(context.TODO(), nil)
+		if err != nil {
+			t.Errorf("unable to pop item: %v", err)
+		}
+	}
+
+	// setup tests
+	tests := []struct {
+		name string
+		path string
+		want int
+	}{
+		{
+			name: "extend leased item",
+			path: "/queue/items/1/extend",
+			want: http.StatusOK,
+		},
+		{
+			name: "ack leased item",
+			path: "/queue/items/1/ack",
+			want: http.StatusOK,
+		},
+		{
+			name: "ack item twice",
+			path: "/queue/items/1/ack",
+			want: http.StatusNotFound,
+		},
+		{
+			name: "nack leased item",
+			path: "/queue/items/2/nack",
+			want: http.StatusOK,
+		},
+		{
+			name: "extend unleased item",
+			path: "/queue/items/2/extend",
+			want: http.StatusNotFound,
+		},
+		{
+			name: "invalid build",
+			path: "/queue/items/foo/ack",
+			want: http.StatusBadRequest,
+		},
+	}
+
+	engine := testEngine(_memory)
+	engine.POST("/queue/items/:build/ack", Ack)
+	engine.POST("/queue/items/:build/nack", Nack)
+	engine.POST("/queue/items/:build/extend", Extend)
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resp := httptest.NewRecorder()
+
+			engine.ServeHTTP(resp, httptest.NewRequest(http.MethodPost, test.path, nil))
+
+			if resp.Code != test.want {
+				t.Errorf("%s returned %d, want %d", test.path, resp.Code, test.want)
+			}
+		})
+	}
+
+	// ensure the rejected item was requeued
+	length, err := _memory.Length(context.TODO())
+	if err != nil {
+		t.Errorf("unable to capture queue length: %v", err)
+	}
+
+	if length != 1 {
+		t.Errorf("queue length is %d, want 1", length)
+	}
+}
@@ -0,0 +1,111 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package queue
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	serverconstants "github.com/go-vela/server/constants"
+	"github.com/go-vela/server/queue"
+	"github.com/go-vela/server/router/middleware/claims"
+	"github.com/go-vela/server/util"
+	"github.com/sirupsen/logrus"
+)
+
+// swagger:operation POST /api/v1/queue/pop queue PopQueueItem
+//
+// Pop an item off the in-memory queue for a worker
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: query
+//   name: route
+//   description: Route to pop the item from, defaults to the channels of the queue
+//   required: false
+//   type: array
+//   items:
+//     type: string
+//   collectionFormat: multi
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully popped an item off the queue
+//     schema:
+//       type: object
+//   '204':
+//     description: No item was pushed to the queue before the pop timeout
+//   '400':
+//     description: Unable to pop an item off the queue
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '401':
+//     description: Unauthorized
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to pop an item off the queue
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// Pop represents the API handler to pop an item off the
+// in-memory queue on behalf of a worker, since the queue
+// only exists in the memory of the server process.
+func Pop(c *gin.Context) {
+	// capture middleware values
+	cl := claims.Retrieve(c)
+	ctx := c.Request.Context()
+
+	routes := c.QueryArray("route")
+
+	logrus.WithFields(logrus.Fields{
+		"worker": cl.Subject,
+	}).Infof("popping item from queue %v", routes)
+
+	q, err := popService(c)
+	if err != nil {
+		util.HandleError(c, http.StatusBadRequest, err)
+
+		return
+	}
+
+	// pop blocks until an item is pushed or the pop timeout is reached
+	item, err := q.Pop(ctx, routes)
+	if err != nil {
+		retErr := fmt.Errorf("unable to pop item from queue %v: %w", routes, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	if item == nil {
+		c.Status(http.StatusNoContent)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, item)
+}
+
+// popService is a helper function to capture the queue from
+// the context when the items are popped through the API.
+//
+// Workers pop directly from the other queue drivers, so
+// popping through the API is limited to the in-memory queue.
+func popService(c *gin.Context) (queue.Service, error) {
+	q := queue.FromContext(c)
+	if q == nil {
+		return nil, fmt.Errorf("no queue configured")
+	}
+
+	if q.Driver() != serverconstants.DriverMemory {
+		return nil, fmt.Errorf("unable to pop items through the API for the %s queue driver: workers pop from the queue directly", q.Driver())
+	}
+
+	return q, nil
+}
@@ -0,0 +1,151 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package queue
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	serverconstants "github.com/go-vela/server/constants"
+	"github.com/go-vela/server/internal/token"
+	"github.com/go-vela/server/queue"
+	"github.com/go-vela/server/queue/redis"
+	"github.com/go-vela/server/router/middleware/claims"
+	"github.com/go-vela/types"
+	"github.com/go-vela/types/library"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// setup global variables used for testing.
+var (
+	_signingPrivateKey = "tCIevHOBq6DdN5SSBtteXUusjjd0fOqzk2eyi0DMq04NewmShNKQeUbbp3vkvIckb4pCxc+vxUo+mYf/vzOaSg=="
+	_signingPublicKey  = "DXsJkoTSkHlG26d75LyHJG+KQsXPr8VKPpmH/78zmko="
+)
+
+func TestQueue_Pop(t *testing.T) {
+	// setup types
+	_memory := testMemory(t)
+
+	_redis, err := redis.NewTest(_signingPrivateKey, _signingPublicKey, "vela")
+	if err != nil {
+		t.Errorf("unable to create redis queue: %v", err)
+	}
+
+	testPush(t, _memory, 1)
+
+	// setup tests
+	tests := []struct {
+		name  string
+		queue queue.Service
+		want  int
+		build int64
+	}{
+		{
+			name:  "pushed item",
+			queue: _memory,
+			want:  http.StatusOK,
+			build: 1,
+		},
+		{
+			name:  "empty queue",
+			queue: _memory,
+			want:  http.StatusNoContent,
+		},
+		{
+			name:  "redis driver",
+			queue: _redis,
+			want:  http.StatusBadRequest,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resp := httptest.NewRecorder()
+
+			engine := testEngine(test.queue)
+			engine.POST("/queue/pop", Pop)
+
+			engine.ServeHTTP(resp, httptest.NewRequest(http.MethodPost, "/queue/pop?route=vela", nil))
+
+			if resp.Code != test.want {
+				t.Errorf("Pop for %s returned %d, want %d", test.name, resp.Code, test.want)
+			}
+
+			if test.build == 0 {
+				return
+			}
+
+			got := new(types.Item)
+
+			err := json.Unmarshal(resp.Body.Bytes(), got)
+			if err != nil {
+				t.Errorf("unable to unmarshal item: %v", err)
+			}
+
+			if got.Build.GetID() != test.build {
+				t.Errorf("Pop for %s is build %d, want %d", test.name, got.Build.GetID(), test.build)
+			}
+		})
+	}
+}
+
+// testMemory is a helper function to create
+// a leased memory queue for testing.
+func testMemory(t *testing.T) queue.Service {
+	t.Helper()
+
+	q, err := queue.New(&queue.Setup{
+		Driver:     serverconstants.DriverMemory,
+		Routes:     []string{"vela"},
+		Timeout:    10 * time.Millisecond,
+		PrivateKey: _signingPrivateKey,
+		PublicKey:  _signingPublicKey,
+		Lease:      time.Minute,
+	})
+	if err != nil {
+		t.Errorf("unable to create memory queue: %v", err)
+	}
+
+	return q
+}
+
+// testPush is a helper function to push
+// the item for a build to the queue.
+func testPush(t *testing.T, q queue.Service, id int64) {
+	t.Helper()
+
+	b := new(library.Build)
+	b.SetID(id)
+
+	bytes, err := json.Marshal(queue.ToItem(b, new(library.Repo), new(library.User), 0))
+	if err != nil {
+		t.Errorf("unable to marshal item: %v", err)
+	}
+
+	err = q.Push(context.TODO(), "vela", bytes)
+	if err != nil {
+		t.Errorf("unable to push item: %v", err)
+	}
+}
+
+// testEngine is a helper function to create the
+// gin engine with the queue and worker claims.
+func testEngine(q queue.Service) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	_, engine := gin.CreateTestContext(httptest.NewRecorder())
+
+	engine.Use(func(c *gin.Context) {
+		queue.WithGinContext(c, q)
+		claims.ToContext(c, &token.Claims{RegisteredClaims: jwt.RegisteredClaims{Subject: "worker"}})
+		c.Next()
+	})
+
+	return engine
+}
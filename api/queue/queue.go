@@ -3,17 +3,22 @@
 package queue
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/internal/keyset"
 	"github.com/go-vela/server/router/middleware/claims"
+	"github.com/go-vela/server/util"
 	"github.com/go-vela/types/library"
 	"github.com/sirupsen/logrus"
 )
 
 // swagger:operation POST /api/v1/queue/info queue Info
 //
-// Get queue credentials
+// Get queue credentials, including the public keys accepted
+// for opening items so workers pick up rotated keys on refresh
 //
 // ---
 // produces:
@@ -45,9 +50,43 @@ func Info(c *gin.Context) {
 	// extract the queue-address that was packed into gin context
 	a := c.MustGet("queue-address").(string)
 
-	wr := library.QueueInfo{
-		QueuePublicKey: &k,
-		QueueAddress:   &a,
+	// extract the active key ID that was packed into gin context
+	id := c.GetString("queue-key-id")
+
+	// extract the public keys that were packed into gin context
+	keys := c.GetStringSlice("queue-public-keys")
+
+	wr := types.QueueInfo{
+		QueueInfo: library.QueueInfo{
+			QueuePublicKey: &k,
+			QueueAddress:   &a,
+		},
+		QueueKeyID: id,
+		QueueKeys: []*types.QueueKey{
+			{
+				ID:        id,
+				PublicKey: k,
+				Active:    true,
+			},
+		},
+	}
+
+	// publish the public keys that have not been retired
+	// so workers open items signed before a key rotation
+	for _, key := range keys {
+		keyID, publicKey, err := keyset.Split(key)
+		if err != nil {
+			retErr := fmt.Errorf("unable to publish queue public keys: %w", err)
+
+			util.HandleError(c, http.StatusInternalServerError, retErr)
+
+			return
+		}
+
+		wr.QueueKeys = append(wr.QueueKeys, &types.QueueKey{
+			ID:        keyID,
+			PublicKey: publicKey,
+		})
 	}
 
 	c.JSON(http.StatusOK, wr)
@@ -76,7 +76,7 @@ func CreateRepo(c *gin.Context) {
 	ctx := c.Request.Context()
 
 	// capture body from API request
-	input := new(library.Repo)
+	input := newRepoWithSettings(nil, nil)
 
 	err := c.Bind(input)
 	if err != nil {
@@ -97,7 +97,7 @@ func CreateRepo(c *gin.Context) {
 	}).Infof("creating new repo %s", input.GetFullName())
 
 	// get repo information from the source
-	r, err := scm.FromContext(c).GetRepo(ctx, u, input)
+	r, err := scm.FromContext(c).GetRepo(ctx, u, input.Repo)
 	if err != nil {
 		retErr := fmt.Errorf("unable to retrieve repo info for %s from source: %w", r.GetFullName(), err)
 
@@ -219,8 +219,17 @@ func CreateRepo(c *gin.Context) {
 		return
 	}
 
+	// capture the scm provider of the repo owner
+	provider := scm.SelectedFromContext(c)
+
 	// send API call to capture the repo from the database
-	dbRepo, err := database.FromContext(c).GetRepoForOrg(ctx, r.GetOrg(), r.GetName())
+	dbRepo, dbProvider, err := database.FromContext(c).GetRepoForOrgWithProvider(ctx, r.GetOrg(), r.GetName(), provider)
+	if err == nil && dbProvider != provider {
+		// the repo is only stored for another scm provider
+		dbRepo = new(library.Repo)
+		err = fmt.Errorf("repo %s belongs to another scm provider", r.GetFullName())
+	}
+
 	if err == nil && dbRepo.GetActive() {
 		retErr := fmt.Errorf("unable to activate repo: %s is already active", r.GetFullName())
 
@@ -302,6 +311,18 @@ func CreateRepo(c *gin.Context) {
 		}
 	}
 
+	// store the scm provider of the repo owner with the repo
+	if scm.ProvidersFromContext(c) != nil {
+		err = database.FromContext(c).UpdateRepoProvider(ctx, r, provider)
+		if err != nil {
+			retErr := fmt.Errorf("unable to set scm provider for repo %s: %w", r.GetFullName(), err)
+
+			util.HandleError(c, http.StatusInternalServerError, retErr)
+
+			return
+		}
+	}
+
 	// create init hook in the DB after repo has been added in order to capture its ID
 	if c.Value("webhookvalidation").(bool) {
 		// update initialization hook
@@ -317,5 +338,27 @@ func CreateRepo(c *gin.Context) {
 		}
 	}
 
-	c.JSON(http.StatusCreated, r)
+	// clamp the priority provided for the repo
+	clampPriority(input.RepoSettings)
+
+	// store the settings provided for the repo
+	err = database.FromContext(c).UpdateRepoSettings(ctx, r, input.RepoSettings)
+	if err != nil {
+		retErr := fmt.Errorf("unable to set settings for repo %s: %w", r.GetFullName(), err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	settings, err := database.FromContext(c).GetRepoSettings(ctx, r)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get settings for repo %s: %w", r.GetFullName(), err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusCreated, newRepoWithSettings(r, settings))
 }
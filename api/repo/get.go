@@ -3,12 +3,15 @@
 package repo
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/database"
 	"github.com/go-vela/server/router/middleware/org"
 	"github.com/go-vela/server/router/middleware/repo"
 	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/util"
 	"github.com/sirupsen/logrus"
 )
 
@@ -55,5 +58,14 @@ func GetRepo(c *gin.Context) {
 		"user": u.GetName(),
 	}).Infof("reading repo %s", r.GetFullName())
 
-	c.JSON(http.StatusOK, r)
+	settings, err := database.FromContext(c).GetRepoSettings(c.Request.Context(), r)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get settings for repo %s: %w", r.GetFullName(), err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, newRepoWithSettings(r, settings))
 }
@@ -0,0 +1,51 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package repo
+
+import (
+	"github.com/go-vela/server/api/types"
+	serverconstants "github.com/go-vela/server/constants"
+	"github.com/go-vela/server/util"
+	"github.com/go-vela/types/library"
+)
+
+// repoWithSettings represents the API payload for a repo
+// including the settings not captured by the library repo.
+type repoWithSettings struct {
+	*library.Repo
+	*types.RepoSettings
+}
+
+// newRepoWithSettings is a helper function to create
+// the API payload for a repo and its settings.
+func newRepoWithSettings(r *library.Repo, s *types.RepoSettings) *repoWithSettings {
+	if r == nil {
+		r = new(library.Repo)
+	}
+
+	if s == nil {
+		s = new(types.RepoSettings)
+	}
+
+	return &repoWithSettings{Repo: r, RepoSettings: s}
+}
+
+// clampPriority is a helper function to clamp the priority
+// provided for a repo between the allowed queue priorities.
+func clampPriority(s *types.RepoSettings) {
+	if s == nil || s.Priority == nil {
+		return
+	}
+
+	s.SetPriority(
+		int64(
+			util.MaxInt(
+				int(serverconstants.PriorityMin),
+				util.MinInt(
+					int(s.GetPriority()),
+					int(serverconstants.PriorityMax),
+				), // clamp max
+			), // clamp min
+		),
+	)
+}
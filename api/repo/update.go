@@ -16,7 +16,6 @@ import (
 	"github.com/go-vela/server/scm"
 	"github.com/go-vela/server/util"
 	"github.com/go-vela/types/constants"
-	"github.com/go-vela/types/library"
 	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
 )
@@ -87,7 +86,7 @@ func UpdateRepo(c *gin.Context) {
 	}).Infof("updating repo %s", r.GetFullName())
 
 	// capture body from API request
-	input := new(library.Repo)
+	input := newRepoWithSettings(nil, nil)
 
 	err := c.Bind(input)
 	if err != nil {
@@ -303,5 +302,27 @@ func UpdateRepo(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusOK, r)
+	// clamp the priority provided for the repo
+	clampPriority(input.RepoSettings)
+
+	// send API call to update the settings provided for the repo
+	err = database.FromContext(c).UpdateRepoSettings(ctx, r, input.RepoSettings)
+	if err != nil {
+		retErr := fmt.Errorf("unable to update settings for repo %s: %w", r.GetFullName(), err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	settings, err := database.FromContext(c).GetRepoSettings(ctx, r)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get settings for repo %s: %w", r.GetFullName(), err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, newRepoWithSettings(r, settings))
 }
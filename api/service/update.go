@@ -13,7 +13,9 @@ import (
 	"github.com/go-vela/server/router/middleware/repo"
 	"github.com/go-vela/server/router/middleware/service"
 	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/stream"
 	"github.com/go-vela/server/util"
+	"github.com/go-vela/types/constants"
 	"github.com/go-vela/types/library"
 	"github.com/sirupsen/logrus"
 )
@@ -105,6 +107,9 @@ func UpdateService(c *gin.Context) {
 		return
 	}
 
+	// capture the status before the update
+	status := s.GetStatus()
+
 	// update service fields if provided
 	if len(input.GetStatus()) > 0 {
 		// update status if set
@@ -141,5 +146,26 @@ func UpdateService(c *gin.Context) {
 		return
 	}
 
+	// compact the chunks appended to the service logs once the service is complete
+	if s.GetStatus() != constants.StatusPending && s.GetStatus() != constants.StatusRunning {
+		l := new(library.Log)
+		l.SetRepoID(r.GetID())
+		l.SetBuildID(b.GetID())
+		l.SetServiceID(s.GetID())
+
+		err = database.FromContext(c).CompactLog(ctx, l)
+		if err != nil {
+			logrus.Errorf("unable to compact logs for service %s: %v", entry, err)
+		}
+	}
+
+	// publish the status transition of the service to the stream
+	if s.GetStatus() != status {
+		err = stream.PublishService(ctx, stream.FromGinContext(c), s)
+		if err != nil {
+			logrus.Errorf("unable to publish service %s to stream: %v", entry, err)
+		}
+	}
+
 	c.JSON(http.StatusOK, s)
 }
@@ -13,7 +13,9 @@ import (
 	"github.com/go-vela/server/router/middleware/repo"
 	"github.com/go-vela/server/router/middleware/step"
 	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/stream"
 	"github.com/go-vela/server/util"
+	"github.com/go-vela/types/constants"
 	"github.com/go-vela/types/library"
 	"github.com/sirupsen/logrus"
 )
@@ -103,6 +105,9 @@ func UpdateStep(c *gin.Context) {
 		return
 	}
 
+	// capture the status before the update
+	status := s.GetStatus()
+
 	// update step fields if provided
 	if len(input.GetStatus()) > 0 {
 		// update status if set
@@ -154,5 +159,26 @@ func UpdateStep(c *gin.Context) {
 		return
 	}
 
+	// compact the chunks appended to the step logs once the step is complete
+	if s.GetStatus() != constants.StatusPending && s.GetStatus() != constants.StatusRunning {
+		l := new(library.Log)
+		l.SetRepoID(r.GetID())
+		l.SetBuildID(b.GetID())
+		l.SetStepID(s.GetID())
+
+		err = database.FromContext(c).CompactLog(c.Request.Context(), l)
+		if err != nil {
+			logrus.Errorf("unable to compact logs for step %s: %v", entry, err)
+		}
+	}
+
+	// publish the status transition of the step to the stream
+	if s.GetStatus() != status {
+		err = stream.PublishStep(c.Request.Context(), stream.FromGinContext(c), s)
+		if err != nil {
+			logrus.Errorf("unable to publish step %s to stream: %v", entry, err)
+		}
+	}
+
 	c.JSON(http.StatusOK, s)
 }
@@ -0,0 +1,9 @@
+// SPDX-License-Identifier: Apache-2.0
+
+// Package types provides the API types for the Vela server
+// that are not provided by the github.com/go-vela/types library.
+//
+// Usage:
+//
+//	import "github.com/go-vela/server/api/types"
+package types
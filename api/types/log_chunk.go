@@ -0,0 +1,142 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"fmt"
+)
+
+// LogChunk is the API representation of a part of the
+// log for a step or service, either appended by a worker
+// or read from a byte or line range of the log.
+//
+// swagger:model LogChunk
+type LogChunk struct {
+	// Sequence is the order of the chunk appended to the log, starting at 1.
+	Sequence *int64 `json:"sequence,omitempty"`
+	// Offset is the byte offset of the data in the log.
+	Offset *int64 `json:"offset,omitempty"`
+	// Line is the number of the line, starting at 0, the data begins in.
+	Line *int64 `json:"line,omitempty"`
+	// Data is the content of the chunk.
+	Data *[]byte `json:"data,omitempty"`
+}
+
+// GetSequence returns the Sequence field.
+//
+// When the provided LogChunk type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (c *LogChunk) GetSequence() int64 {
+	// return zero value if LogChunk type or Sequence field is nil
+	if c == nil || c.Sequence == nil {
+		return 0
+	}
+
+	return *c.Sequence
+}
+
+// GetOffset returns the Offset field.
+//
+// When the provided LogChunk type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (c *LogChunk) GetOffset() int64 {
+	// return zero value if LogChunk type or Offset field is nil
+	if c == nil || c.Offset == nil {
+		return 0
+	}
+
+	return *c.Offset
+}
+
+// GetLine returns the Line field.
+//
+// When the provided LogChunk type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (c *LogChunk) GetLine() int64 {
+	// return zero value if LogChunk type or Line field is nil
+	if c == nil || c.Line == nil {
+		return 0
+	}
+
+	return *c.Line
+}
+
+// GetData returns the Data field.
+//
+// When the provided LogChunk type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (c *LogChunk) GetData() []byte {
+	// return zero value if LogChunk type or Data field is nil
+	if c == nil || c.Data == nil {
+		return []byte{}
+	}
+
+	return *c.Data
+}
+
+// SetSequence sets the Sequence field.
+//
+// When the provided LogChunk type is nil, it
+// will set nothing and immediately return.
+func (c *LogChunk) SetSequence(v int64) {
+	// return if LogChunk type is nil
+	if c == nil {
+		return
+	}
+
+	c.Sequence = &v
+}
+
+// SetOffset sets the Offset field.
+//
+// When the provided LogChunk type is nil, it
+// will set nothing and immediately return.
+func (c *LogChunk) SetOffset(v int64) {
+	// return if LogChunk type is nil
+	if c == nil {
+		return
+	}
+
+	c.Offset = &v
+}
+
+// SetLine sets the Line field.
+//
+// When the provided LogChunk type is nil, it
+// will set nothing and immediately return.
+func (c *LogChunk) SetLine(v int64) {
+	// return if LogChunk type is nil
+	if c == nil {
+		return
+	}
+
+	c.Line = &v
+}
+
+// SetData sets the Data field.
+//
+// When the provided LogChunk type is nil, it
+// will set nothing and immediately return.
+func (c *LogChunk) SetData(v []byte) {
+	// return if LogChunk type is nil
+	if c == nil {
+		return
+	}
+
+	c.Data = &v
+}
+
+// String implements the Stringer interface for the LogChunk type.
+func (c *LogChunk) String() string {
+	return fmt.Sprintf(`{
+  Sequence: %d,
+  Offset: %d,
+  Line: %d,
+  Data: %s,
+}`,
+		c.GetSequence(),
+		c.GetOffset(),
+		c.GetLine(),
+		c.GetData(),
+	)
+}
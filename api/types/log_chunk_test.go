@@ -0,0 +1,126 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestTypes_LogChunk_Getters(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		chunk *LogChunk
+		want  *LogChunk
+	}{
+		{
+			chunk: testLogChunk(),
+			want:  testLogChunk(),
+		},
+		{
+			chunk: new(LogChunk),
+			want:  new(LogChunk),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		if test.chunk.GetSequence() != test.want.GetSequence() {
+			t.Errorf("GetSequence is %v, want %v", test.chunk.GetSequence(), test.want.GetSequence())
+		}
+
+		if test.chunk.GetOffset() != test.want.GetOffset() {
+			t.Errorf("GetOffset is %v, want %v", test.chunk.GetOffset(), test.want.GetOffset())
+		}
+
+		if test.chunk.GetLine() != test.want.GetLine() {
+			t.Errorf("GetLine is %v, want %v", test.chunk.GetLine(), test.want.GetLine())
+		}
+
+		if !reflect.DeepEqual(test.chunk.GetData(), test.want.GetData()) {
+			t.Errorf("GetData is %v, want %v", test.chunk.GetData(), test.want.GetData())
+		}
+	}
+}
+
+func TestTypes_LogChunk_Setters(t *testing.T) {
+	// setup types
+	var c *LogChunk
+
+	// setup tests
+	tests := []struct {
+		chunk *LogChunk
+		want  *LogChunk
+	}{
+		{
+			chunk: testLogChunk(),
+			want:  testLogChunk(),
+		},
+		{
+			chunk: c,
+			want:  new(LogChunk),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		test.chunk.SetSequence(test.want.GetSequence())
+		test.chunk.SetOffset(test.want.GetOffset())
+		test.chunk.SetLine(test.want.GetLine())
+		test.chunk.SetData(test.want.GetData())
+
+		if test.chunk.GetSequence() != test.want.GetSequence() {
+			t.Errorf("SetSequence is %v, want %v", test.chunk.GetSequence(), test.want.GetSequence())
+		}
+
+		if test.chunk.GetOffset() != test.want.GetOffset() {
+			t.Errorf("SetOffset is %v, want %v", test.chunk.GetOffset(), test.want.GetOffset())
+		}
+
+		if test.chunk.GetLine() != test.want.GetLine() {
+			t.Errorf("SetLine is %v, want %v", test.chunk.GetLine(), test.want.GetLine())
+		}
+
+		if !reflect.DeepEqual(test.chunk.GetData(), test.want.GetData()) {
+			t.Errorf("SetData is %v, want %v", test.chunk.GetData(), test.want.GetData())
+		}
+	}
+}
+
+func TestTypes_LogChunk_String(t *testing.T) {
+	// setup types
+	c := testLogChunk()
+
+	want := fmt.Sprintf(`{
+  Sequence: %d,
+  Offset: %d,
+  Line: %d,
+  Data: %s,
+}`,
+		c.GetSequence(),
+		c.GetOffset(),
+		c.GetLine(),
+		c.GetData(),
+	)
+
+	// run test
+	got := c.String()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("String is %v, want %v", got, want)
+	}
+}
+
+// testLogChunk is a test helper function to create
+// a LogChunk type with all fields set to a fake value.
+func testLogChunk() *LogChunk {
+	c := new(LogChunk)
+
+	c.SetSequence(2)
+	c.SetOffset(4)
+	c.SetLine(1)
+	c.SetData([]byte("bar\n"))
+
+	return c
+}
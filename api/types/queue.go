@@ -0,0 +1,54 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"github.com/go-vela/types"
+	"github.com/go-vela/types/library"
+)
+
+// QueueItems is the API representation of
+// the items waiting in a route of the queue.
+//
+// swagger:model QueueItems
+type QueueItems struct {
+	Route string        `json:"route"`
+	Items []*types.Item `json:"items"`
+}
+
+// QueueReconciliation is the API representation of the differences
+// between the pending builds in the database and the items in the queue.
+//
+// swagger:model QueueReconciliation
+type QueueReconciliation struct {
+	// MissingFromQueue are the pending builds
+	// without an item waiting in the queue.
+	MissingFromQueue []*library.Build `json:"missing_from_queue"`
+	// MissingFromDatabase are the items waiting in
+	// the queue for builds that are no longer pending.
+	MissingFromDatabase []*QueueItems `json:"missing_from_database"`
+}
+
+// QueueInfo is the API representation of the credentials
+// for a worker to connect to the queue and open its items.
+//
+// swagger:model QueueInfo
+type QueueInfo struct {
+	library.QueueInfo
+	// QueueKeyID is the ID of the active key pair
+	// signing the items pushed to the queue.
+	QueueKeyID string `json:"queue_key_id"`
+	// QueueKeys are the public keys, including the active key,
+	// accepted for opening the items popped from the queue.
+	QueueKeys []*QueueKey `json:"queue_keys"`
+}
+
+// QueueKey is the API representation of a
+// public key for opening items in the queue.
+//
+// swagger:model QueueKey
+type QueueKey struct {
+	ID        string `json:"id"`
+	PublicKey string `json:"public_key"`
+	Active    bool   `json:"active"`
+}
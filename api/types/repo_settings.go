@@ -0,0 +1,141 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"fmt"
+)
+
+// RepoSettings is the API representation of the settings
+// for a repo that are not captured by the library repo.
+//
+// swagger:model RepoSettings
+type RepoSettings struct {
+	AllowRelease *bool `json:"allow_release,omitempty"`
+	AllowReview  *bool `json:"allow_review,omitempty"`
+	// IgnoreSkipDirectives disables skipping builds for commits
+	// with a directive like [skip ci] in the commit message.
+	IgnoreSkipDirectives *bool `json:"ignore_skip_directives,omitempty"`
+	// Priority is the priority of the queue items for builds
+	// of the repo, the event priority is used when not set.
+	Priority *int64 `json:"priority,omitempty"`
+}
+
+// GetAllowRelease returns the AllowRelease field.
+//
+// When the provided RepoSettings type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *RepoSettings) GetAllowRelease() bool {
+	// return zero value if RepoSettings type or AllowRelease field is nil
+	if s == nil || s.AllowRelease == nil {
+		return false
+	}
+
+	return *s.AllowRelease
+}
+
+// GetAllowReview returns the AllowReview field.
+//
+// When the provided RepoSettings type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *RepoSettings) GetAllowReview() bool {
+	// return zero value if RepoSettings type or AllowReview field is nil
+	if s == nil || s.AllowReview == nil {
+		return false
+	}
+
+	return *s.AllowReview
+}
+
+// GetIgnoreSkipDirectives returns the IgnoreSkipDirectives field.
+//
+// When the provided RepoSettings type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *RepoSettings) GetIgnoreSkipDirectives() bool {
+	// return zero value if RepoSettings type or IgnoreSkipDirectives field is nil
+	if s == nil || s.IgnoreSkipDirectives == nil {
+		return false
+	}
+
+	return *s.IgnoreSkipDirectives
+}
+
+// GetPriority returns the Priority field.
+//
+// When the provided RepoSettings type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *RepoSettings) GetPriority() int64 {
+	// return zero value if RepoSettings type or Priority field is nil
+	if s == nil || s.Priority == nil {
+		return 0
+	}
+
+	return *s.Priority
+}
+
+// SetAllowRelease sets the AllowRelease field.
+//
+// When the provided RepoSettings type is nil, it
+// will set nothing and immediately return.
+func (s *RepoSettings) SetAllowRelease(v bool) {
+	// return if RepoSettings type is nil
+	if s == nil {
+		return
+	}
+
+	s.AllowRelease = &v
+}
+
+// SetAllowReview sets the AllowReview field.
+//
+// When the provided RepoSettings type is nil, it
+// will set nothing and immediately return.
+func (s *RepoSettings) SetAllowReview(v bool) {
+	// return if RepoSettings type is nil
+	if s == nil {
+		return
+	}
+
+	s.AllowReview = &v
+}
+
+// SetIgnoreSkipDirectives sets the IgnoreSkipDirectives field.
+//
+// When the provided RepoSettings type is nil, it
+// will set nothing and immediately return.
+func (s *RepoSettings) SetIgnoreSkipDirectives(v bool) {
+	// return if RepoSettings type is nil
+	if s == nil {
+		return
+	}
+
+	s.IgnoreSkipDirectives = &v
+}
+
+// SetPriority sets the Priority field.
+//
+// When the provided RepoSettings type is nil, it
+// will set nothing and immediately return.
+func (s *RepoSettings) SetPriority(v int64) {
+	// return if RepoSettings type is nil
+	if s == nil {
+		return
+	}
+
+	s.Priority = &v
+}
+
+// String implements the Stringer interface for the RepoSettings type.
+func (s *RepoSettings) String() string {
+	return fmt.Sprintf(`{
+  AllowRelease: %t,
+  AllowReview: %t,
+  IgnoreSkipDirectives: %t,
+  Priority: %d,
+}`,
+		s.GetAllowRelease(),
+		s.GetAllowReview(),
+		s.GetIgnoreSkipDirectives(),
+		s.GetPriority(),
+	)
+}
@@ -0,0 +1,126 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestTypes_RepoSettings_Getters(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		settings *RepoSettings
+		want     *RepoSettings
+	}{
+		{
+			settings: testRepoSettings(),
+			want:     testRepoSettings(),
+		},
+		{
+			settings: new(RepoSettings),
+			want:     new(RepoSettings),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		if test.settings.GetAllowRelease() != test.want.GetAllowRelease() {
+			t.Errorf("GetAllowRelease is %v, want %v", test.settings.GetAllowRelease(), test.want.GetAllowRelease())
+		}
+
+		if test.settings.GetAllowReview() != test.want.GetAllowReview() {
+			t.Errorf("GetAllowReview is %v, want %v", test.settings.GetAllowReview(), test.want.GetAllowReview())
+		}
+
+		if test.settings.GetIgnoreSkipDirectives() != test.want.GetIgnoreSkipDirectives() {
+			t.Errorf("GetIgnoreSkipDirectives is %v, want %v", test.settings.GetIgnoreSkipDirectives(), test.want.GetIgnoreSkipDirectives())
+		}
+
+		if test.settings.GetPriority() != test.want.GetPriority() {
+			t.Errorf("GetPriority is %v, want %v", test.settings.GetPriority(), test.want.GetPriority())
+		}
+	}
+}
+
+func TestTypes_RepoSettings_Setters(t *testing.T) {
+	// setup types
+	var s *RepoSettings
+
+	// setup tests
+	tests := []struct {
+		settings *RepoSettings
+		want     *RepoSettings
+	}{
+		{
+			settings: testRepoSettings(),
+			want:     testRepoSettings(),
+		},
+		{
+			settings: s,
+			want:     new(RepoSettings),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		test.settings.SetAllowRelease(test.want.GetAllowRelease())
+		test.settings.SetAllowReview(test.want.GetAllowReview())
+		test.settings.SetIgnoreSkipDirectives(test.want.GetIgnoreSkipDirectives())
+		test.settings.SetPriority(test.want.GetPriority())
+
+		if test.settings.GetAllowRelease() != test.want.GetAllowRelease() {
+			t.Errorf("SetAllowRelease is %v, want %v", test.settings.GetAllowRelease(), test.want.GetAllowRelease())
+		}
+
+		if test.settings.GetAllowReview() != test.want.GetAllowReview() {
+			t.Errorf("SetAllowReview is %v, want %v", test.settings.GetAllowReview(), test.want.GetAllowReview())
+		}
+
+		if test.settings.GetIgnoreSkipDirectives() != test.want.GetIgnoreSkipDirectives() {
+			t.Errorf("SetIgnoreSkipDirectives is %v, want %v", test.settings.GetIgnoreSkipDirectives(), test.want.GetIgnoreSkipDirectives())
+		}
+
+		if test.settings.GetPriority() != test.want.GetPriority() {
+			t.Errorf("SetPriority is %v, want %v", test.settings.GetPriority(), test.want.GetPriority())
+		}
+	}
+}
+
+func TestTypes_RepoSettings_String(t *testing.T) {
+	// setup types
+	s := testRepoSettings()
+
+	want := fmt.Sprintf(`{
+  AllowRelease: %t,
+  AllowReview: %t,
+  IgnoreSkipDirectives: %t,
+  Priority: %d,
+}`,
+		s.GetAllowRelease(),
+		s.GetAllowReview(),
+		s.GetIgnoreSkipDirectives(),
+		s.GetPriority(),
+	)
+
+	// run test
+	got := s.String()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("String is %v, want %v", got, want)
+	}
+}
+
+// testRepoSettings is a test helper function to create
+// a RepoSettings type with all fields set to a fake value.
+func testRepoSettings() *RepoSettings {
+	s := new(RepoSettings)
+
+	s.SetAllowRelease(true)
+	s.SetAllowReview(false)
+	s.SetIgnoreSkipDirectives(true)
+	s.SetPriority(8)
+
+	return s
+}
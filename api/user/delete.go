@@ -26,6 +26,10 @@ import (
 //   description: Name of the user
 //   required: true
 //   type: string
+// - in: query
+//   name: provider
+//   description: Name of the scm provider the user belongs to (empty for the default scm provider)
+//   type: string
 // security:
 //   - ApiKeyAuth: []
 // responses:
@@ -57,8 +61,8 @@ func DeleteUser(c *gin.Context) {
 		"user": u.GetName(),
 	}).Infof("deleting user %s", user)
 
-	// send API call to capture the user
-	u, err := database.FromContext(c).GetUserForName(ctx, user)
+	// send API call to capture the user for the scm provider
+	u, err := database.FromContext(c).GetUserForProvider(ctx, util.QueryParameter(c, "provider", ""), user)
 	if err != nil {
 		retErr := fmt.Errorf("unable to get user %s: %w", user, err)
 
@@ -27,6 +27,10 @@ import (
 //   description: Name of the user
 //   required: true
 //   type: string
+// - in: query
+//   name: provider
+//   description: Name of the scm provider the user belongs to (empty for the default scm provider)
+//   type: string
 // - in: body
 //   name: body
 //   description: Payload containing the user to update
@@ -80,8 +84,8 @@ func UpdateUser(c *gin.Context) {
 		return
 	}
 
-	// send API call to capture the user
-	u, err = database.FromContext(c).GetUserForName(ctx, user)
+	// send API call to capture the user for the scm provider
+	u, err = database.FromContext(c).GetUserForProvider(ctx, util.QueryParameter(c, "provider", ""), user)
 	if err != nil {
 		retErr := fmt.Errorf("unable to get user %s: %w", user, err)
 
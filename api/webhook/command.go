@@ -0,0 +1,259 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/api/build"
+	"github.com/go-vela/server/api/deployment"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/internal/chatops"
+	"github.com/go-vela/server/scm"
+	"github.com/go-vela/server/util"
+	"github.com/go-vela/types"
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+	"github.com/sirupsen/logrus"
+)
+
+// handleCommand is a helper function that processes a command left in a
+// comment on a pull request. The parsed command is returned along with
+// whether the webhook was fully handled by the command.
+//
+// Comments without a recognized command are not handled so they continue
+// to create comment builds. The approve command is also not handled since
+// it creates the comment build once the commenter's access is confirmed.
+//
+//nolint:funlen // ignore function length
+func handleCommand(ctx context.Context, c *gin.Context, m *types.Metadata, h *library.Hook, r *library.Repo, u *library.User, b *library.Build, webhook *types.Webhook) (*chatops.Command, bool) {
+	// only new comments on pull requests can contain commands
+	if !strings.EqualFold(b.GetEvent(), constants.EventComment) ||
+		!strings.EqualFold(b.GetEventAction(), constants.ActionCreated) ||
+		webhook.PRNumber == 0 {
+		return nil, false
+	}
+
+	cmd, err := chatops.Parse(webhook.Comment)
+	if err != nil {
+		retErr := fmt.Errorf("%s: invalid command for %s: %w", baseErr, r.GetFullName(), err)
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		h.SetStatus(constants.StatusFailure)
+		h.SetError(retErr.Error())
+
+		replyCommand(ctx, c, u, r, webhook.PRNumber, fmt.Sprintf("@%s unable to process command: %v", b.GetSender(), err))
+
+		return nil, true
+	}
+
+	if cmd == nil {
+		return nil, false
+	}
+
+	logrus.Debugf("webhook is %s command from %s, processing for %s", cmd, b.GetSender(), r.GetFullName())
+
+	// setup the commenter to check their access with the owner's token
+	sender := new(library.User)
+	sender.SetName(b.GetSender())
+
+	// send API call to capture the commenter's access level for the repo
+	perm, err := scm.FromContext(c).RepoAccess(ctx, sender, u.GetToken(), r.GetOrg(), r.GetName())
+	if err != nil {
+		logrus.Errorf("unable to get user %s access level for repo %s: %v", sender.GetName(), r.GetFullName(), err)
+	}
+
+	// commands require the commenter to have write access to the repo
+	if perm != "admin" && perm != "write" {
+		retErr := fmt.Errorf("%s: user %s does not have 'write' permissions for the repo %s", baseErr, sender.GetName(), r.GetFullName())
+		util.HandleError(c, http.StatusUnauthorized, retErr)
+
+		h.SetStatus(constants.StatusFailure)
+		h.SetError(retErr.Error())
+
+		replyCommand(ctx, c, u, r, webhook.PRNumber, fmt.Sprintf("@%s `%s` requires write access to %s", sender.GetName(), cmd, r.GetFullName()))
+
+		return cmd, true
+	}
+
+	switch cmd.Name {
+	case chatops.CommandApprove:
+		// the comment build is created by the webhook with the commenter's approval
+		return cmd, false
+	case chatops.CommandRestart:
+		restartCommand(ctx, c, m, h, r, u, b, webhook, cmd)
+	case chatops.CommandCancel:
+		cancelCommand(ctx, c, h, r, u, b, webhook, cmd)
+	case chatops.CommandDeploy:
+		deployCommand(ctx, c, h, r, u, b, webhook, cmd)
+	}
+
+	return cmd, true
+}
+
+// restartCommand is a helper function that restarts
+// the latest build for the pull request of a command.
+func restartCommand(ctx context.Context, c *gin.Context, m *types.Metadata, h *library.Hook, r *library.Repo, u *library.User, b *library.Build, webhook *types.Webhook, cmd *chatops.Command) {
+	// create SQL filters for querying the builds for the pull request
+	filters := map[string]interface{}{
+		"ref": b.GetRef(),
+	}
+
+	// send API call to capture the latest build for the pull request
+	bs, _, err := database.FromContext(c).ListBuildsForRepo(ctx, r, filters, time.Now().UTC().Unix(), 0, 1, 1)
+	if err != nil || len(bs) == 0 {
+		retErr := fmt.Errorf("%s: unable to find build to restart for %s pull request %d", baseErr, r.GetFullName(), webhook.PRNumber)
+		util.HandleError(c, http.StatusNotFound, retErr)
+
+		h.SetStatus(constants.StatusFailure)
+		h.SetError(retErr.Error())
+
+		replyCommand(ctx, c, u, r, webhook.PRNumber, fmt.Sprintf("`%s`: no build found to restart for this pull request", cmd))
+
+		return
+	}
+
+	// restart the build
+	//
+	// errors are handled and written to the response by Restart
+	restarted, err := build.Restart(c, m, bs[0], r, b.GetSender())
+	if err != nil {
+		h.SetStatus(constants.StatusFailure)
+		h.SetError(err.Error())
+
+		replyCommand(ctx, c, u, r, webhook.PRNumber, fmt.Sprintf("`%s`: unable to restart build #%d: %v", cmd, bs[0].GetNumber(), err))
+
+		return
+	}
+
+	if restarted == nil {
+		replyCommand(ctx, c, u, r, webhook.PRNumber, fmt.Sprintf("`%s`: build #%d was restarted but skipped since there were no steps to run", cmd, bs[0].GetNumber()))
+
+		return
+	}
+
+	// set the BuildID field
+	h.SetBuildID(restarted.GetID())
+
+	replyCommand(ctx, c, u, r, webhook.PRNumber, fmt.Sprintf("`%s`: restarted build #%d as %s", cmd, bs[0].GetNumber(), buildReference(restarted)))
+}
+
+// cancelCommand is a helper function that cancels the pending
+// and running builds for the pull request of a command.
+func cancelCommand(ctx context.Context, c *gin.Context, h *library.Hook, r *library.Repo, u *library.User, b *library.Build, webhook *types.Webhook, cmd *chatops.Command) {
+	// fetch pending and running builds
+	rBs, err := database.FromContext(c).ListPendingAndRunningBuildsForRepo(c, r)
+	if err != nil {
+		retErr := fmt.Errorf("%s: unable to fetch pending and running builds for %s: %w", baseErr, r.GetFullName(), err)
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		h.SetStatus(constants.StatusFailure)
+		h.SetError(retErr.Error())
+
+		replyCommand(ctx, c, u, r, webhook.PRNumber, fmt.Sprintf("`%s`: unable to fetch builds for this pull request", cmd))
+
+		return
+	}
+
+	canceled := []string{}
+
+	for _, rB := range rBs {
+		// only builds for the pull request are canceled
+		if !strings.EqualFold(rB.GetRef(), b.GetRef()) {
+			continue
+		}
+
+		// call cancel routine for the build
+		ok, err := build.Cancel(c, rB, r, fmt.Sprintf("build was canceled by %s from a pull request comment", b.GetSender()))
+		if err != nil {
+			// continue cancel loop if error, but log based on type of error
+			if ok {
+				logrus.Errorf("unable to update canceled build error message: %v", err)
+			} else {
+				logrus.Errorf("unable to cancel running build: %v", err)
+			}
+		}
+
+		if ok {
+			canceled = append(canceled, fmt.Sprintf("#%d", rB.GetNumber()))
+		}
+	}
+
+	if len(canceled) == 0 {
+		replyCommand(ctx, c, u, r, webhook.PRNumber, fmt.Sprintf("`%s`: no pending or running builds to cancel for this pull request", cmd))
+
+		c.JSON(http.StatusOK, fmt.Sprintf("handled %s for %s pull request %d, no build to cancel", cmd, r.GetFullName(), webhook.PRNumber))
+
+		return
+	}
+
+	replyCommand(ctx, c, u, r, webhook.PRNumber, fmt.Sprintf("`%s`: canceled build %s", cmd, strings.Join(canceled, ", ")))
+
+	c.JSON(http.StatusOK, fmt.Sprintf("handled %s for %s pull request %d, canceled build %s", cmd, r.GetFullName(), webhook.PRNumber, strings.Join(canceled, ", ")))
+}
+
+// deployCommand is a helper function that creates a deployment
+// of the pull request head commit to the target of a command.
+func deployCommand(ctx context.Context, c *gin.Context, h *library.Hook, r *library.Repo, u *library.User, b *library.Build, webhook *types.Webhook, cmd *chatops.Command) {
+	// send API call to capture the head commit for the pull request
+	commit, _, _, _, err := scm.FromContext(c).GetPullRequest(ctx, u, r, webhook.PRNumber)
+	if err != nil {
+		retErr := fmt.Errorf("%s: failed to get pull request info for %s: %w", baseErr, r.GetFullName(), err)
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		h.SetStatus(constants.StatusFailure)
+		h.SetError(retErr.Error())
+
+		replyCommand(ctx, c, u, r, webhook.PRNumber, fmt.Sprintf("`%s`: unable to capture the pull request head commit", cmd))
+
+		return
+	}
+
+	d := new(library.Deployment)
+	d.SetRef(commit)
+	d.SetTarget(cmd.Target)
+	d.SetDescription(fmt.Sprintf("Deployment request from Vela by %s for pull request #%d", b.GetSender(), webhook.PRNumber))
+
+	// send API call to create the deployment
+	err = deployment.Create(c, u, r, d)
+	if err != nil {
+		retErr := fmt.Errorf("%s: unable to create new deployment for %s: %w", baseErr, r.GetFullName(), err)
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		h.SetStatus(constants.StatusFailure)
+		h.SetError(retErr.Error())
+
+		replyCommand(ctx, c, u, r, webhook.PRNumber, fmt.Sprintf("`%s`: unable to create deployment: %v", cmd, err))
+
+		return
+	}
+
+	replyCommand(ctx, c, u, r, webhook.PRNumber, fmt.Sprintf("`%s`: created deployment of %s to %s", cmd, commit, d.GetTarget()))
+
+	c.JSON(http.StatusCreated, d)
+}
+
+// replyCommand is a helper function that replies to a
+// command with a comment on the pull request.
+func replyCommand(ctx context.Context, c *gin.Context, u *library.User, r *library.Repo, number int, body string) {
+	// send API call to create the comment on the pull request
+	err := scm.FromContext(c).CreateComment(ctx, u, r, number, body)
+	if err != nil {
+		logrus.Errorf("unable to reply to command on %s pull request %d: %v", r.GetFullName(), number, err)
+	}
+}
+
+// buildReference is a helper function that creates
+// the reference to a build used in command replies.
+func buildReference(b *library.Build) string {
+	if len(b.GetLink()) == 0 {
+		return fmt.Sprintf("#%d", b.GetNumber())
+	}
+
+	return fmt.Sprintf("[#%d](%s)", b.GetNumber(), b.GetLink())
+}
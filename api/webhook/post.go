@@ -15,7 +15,9 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/go-vela/server/api/build"
 	"github.com/go-vela/server/compiler"
+	serverconstants "github.com/go-vela/server/constants"
 	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/internal/chatops"
 	"github.com/go-vela/server/queue"
 	"github.com/go-vela/server/scm"
 	"github.com/go-vela/server/util"
@@ -110,6 +112,15 @@ func PostWebhook(c *gin.Context) {
 	//
 	// -------------------- End of TODO: --------------------
 
+	// route the webhook to the scm provider it was delivered for
+	_, err = scm.Select(c, util.PathParameter(c, "provider"))
+	if err != nil {
+		retErr := fmt.Errorf("%s: %w", baseErr, err)
+		util.HandleError(c, http.StatusNotFound, retErr)
+
+		return
+	}
+
 	// process the webhook from the source control provider
 	//
 	// populate build, hook, repo resources as well as PR Number / PR Comment if necessary
@@ -184,7 +195,7 @@ func PostWebhook(c *gin.Context) {
 	}()
 
 	// send API call to capture parsed repo from webhook
-	repo, err := database.FromContext(c).GetRepoForOrg(ctx, r.GetOrg(), r.GetName())
+	repo, provider, err := database.FromContext(c).GetRepoForOrgWithProvider(ctx, r.GetOrg(), r.GetName(), scm.SelectedFromContext(c))
 	if err != nil {
 		retErr := fmt.Errorf("%s: failed to get repo %s: %w", baseErr, r.GetFullName(), err)
 		util.HandleError(c, http.StatusBadRequest, retErr)
@@ -195,6 +206,18 @@ func PostWebhook(c *gin.Context) {
 		return
 	}
 
+	// verify the repo belongs to the scm provider the webhook was delivered for
+	err = verifyProvider(c, repo, provider)
+	if err != nil {
+		retErr := fmt.Errorf("%s: %w", baseErr, err)
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		h.SetStatus(constants.StatusFailure)
+		h.SetError(retErr.Error())
+
+		return
+	}
+
 	// set the RepoID fields
 	b.SetRepoID(repo.GetID())
 	h.SetRepoID(repo.GetID())
@@ -255,12 +278,27 @@ func PostWebhook(c *gin.Context) {
 		return
 	}
 
+	// capture the settings for the repo not captured by the library repo
+	settings, err := database.FromContext(c).GetRepoSettings(ctx, repo)
+	if err != nil {
+		retErr := fmt.Errorf("%s: unable to get settings for %s: %w", baseErr, repo.GetFullName(), err)
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		h.SetStatus(constants.StatusFailure)
+		h.SetError(retErr.Error())
+
+		return
+	}
+
 	// verify the build has a valid event and the repo allows that event type
 	if (b.GetEvent() == constants.EventPush && !repo.GetAllowPush()) ||
 		(b.GetEvent() == constants.EventPull && !repo.GetAllowPull()) ||
 		(b.GetEvent() == constants.EventComment && !repo.GetAllowComment()) ||
 		(b.GetEvent() == constants.EventTag && !repo.GetAllowTag()) ||
-		(b.GetEvent() == constants.EventDeploy && !repo.GetAllowDeploy()) {
+		(b.GetEvent() == constants.EventDeploy && !repo.GetAllowDeploy()) ||
+		(b.GetEvent() == serverconstants.EventMergeGroup && !repo.GetAllowPull()) ||
+		(b.GetEvent() == serverconstants.EventRelease && !settings.GetAllowRelease()) ||
+		(b.GetEvent() == serverconstants.EventReview && !settings.GetAllowReview()) {
 		retErr := fmt.Errorf("%s: %s does not have %s events enabled", baseErr, repo.GetFullName(), b.GetEvent())
 		util.HandleError(c, http.StatusBadRequest, retErr)
 
@@ -296,17 +334,57 @@ func PostWebhook(c *gin.Context) {
 	}
 
 	// confirm current repo owner has at least write access to repo (needed for status update later)
-	_, err = scm.FromContext(c).RepoAccess(ctx, u, u.GetToken(), r.GetOrg(), r.GetName())
-	if err != nil {
-		retErr := fmt.Errorf("unable to publish build to queue: repository owner %s no longer has write access to repository %s", u.GetName(), r.GetFullName())
-		util.HandleError(c, http.StatusUnauthorized, retErr)
+	//
+	// the check is skipped when the scm acts on the repo with an app installation
+	// since the owner's token is not used to update the status
+	if !scm.FromContext(c).RepoInstalled(ctx, r.GetOrg(), r.GetName()) {
+		_, err = scm.FromContext(c).RepoAccess(ctx, u, u.GetToken(), r.GetOrg(), r.GetName())
+		if err != nil {
+			retErr := fmt.Errorf("unable to publish build to queue: repository owner %s no longer has write access to repository %s", u.GetName(), r.GetFullName())
+			util.HandleError(c, http.StatusUnauthorized, retErr)
 
-		h.SetStatus(constants.StatusFailure)
-		h.SetError(retErr.Error())
+			h.SetStatus(constants.StatusFailure)
+			h.SetError(retErr.Error())
 
+			return
+		}
+	}
+
+	// if event is check run, restart the requested build and return
+	if strings.EqualFold(h.GetEvent(), serverconstants.EventCheckRun) {
+		handleCheckRunEvent(ctx, c, m, h, repo, b)
+
+		return
+	}
+
+	// if event is a destroyed merge group, cancel the builds for the merge group and return
+	if strings.EqualFold(b.GetEvent(), serverconstants.EventMergeGroup) &&
+		strings.EqualFold(b.GetEventAction(), serverconstants.ActionDestroyed) {
+		handleMergeGroupDestroyed(c, h, repo, b)
+
+		return
+	}
+
+	// if event is a comment on a pull request, handle any command from the comment
+	command, handled := handleCommand(ctx, c, m, h, repo, u, b, webhook)
+	if handled {
 		return
 	}
 
+	// skip the build if the commit message contains a directive like [skip ci]
+	if !settings.GetIgnoreSkipDirectives() {
+		skip := build.SkipDirective(ctx, scm.FromContext(c), u, repo, b)
+		if skip != "" {
+			// set hook status and message
+			h.SetStatus(constants.StatusSkipped)
+			h.SetError(skip)
+
+			c.JSON(http.StatusOK, skip)
+
+			return
+		}
+	}
+
 	// create SQL filters for querying pending and running builds for repo
 	filters := map[string]interface{}{
 		"status": []string{constants.StatusPending, constants.StatusRunning},
@@ -364,12 +442,30 @@ func PostWebhook(c *gin.Context) {
 		b.SetHeadRef(headref)
 	}
 
+	// if the event is release, call SCM for the commit
+	// of the tag not provided in webhook payload
+	if strings.EqualFold(b.GetEvent(), serverconstants.EventRelease) {
+		commit, err := scm.FromContext(c).GetTag(ctx, u, repo, strings.TrimPrefix(b.GetRef(), "refs/tags/"))
+		if err != nil {
+			retErr := fmt.Errorf("%s: failed to get tag info for %s: %w", baseErr, repo.GetFullName(), err)
+			util.HandleError(c, http.StatusInternalServerError, retErr)
+
+			h.SetStatus(constants.StatusFailure)
+			h.SetError(retErr.Error())
+
+			return
+		}
+
+		b.SetCommit(commit)
+	}
+
 	// variable to store changeset files
 	var files []string
 
-	// check if the build event is not issue_comment or pull_request
+	// check if the build event is not issue_comment, pull_request or pull_request_review
 	if !strings.EqualFold(b.GetEvent(), constants.EventComment) &&
-		!strings.EqualFold(b.GetEvent(), constants.EventPull) {
+		!strings.EqualFold(b.GetEvent(), constants.EventPull) &&
+		!strings.EqualFold(b.GetEvent(), serverconstants.EventReview) {
 		// send API call to capture list of files changed for the commit
 		files, err = scm.FromContext(c).Changeset(ctx, u, repo, b.GetCommit())
 		if err != nil {
@@ -383,8 +479,9 @@ func PostWebhook(c *gin.Context) {
 		}
 	}
 
-	// check if the build event is a pull_request
-	if strings.EqualFold(b.GetEvent(), constants.EventPull) && webhook.PRNumber > 0 {
+	// check if the build event is a pull_request or pull_request_review
+	if (strings.EqualFold(b.GetEvent(), constants.EventPull) ||
+		strings.EqualFold(b.GetEvent(), serverconstants.EventReview)) && webhook.PRNumber > 0 {
 		// send API call to capture list of files changed for the pull request
 		files, err = scm.FromContext(c).ChangesetPR(ctx, u, repo, webhook.PRNumber)
 		if err != nil {
@@ -403,6 +500,8 @@ func PostWebhook(c *gin.Context) {
 		config []byte
 		// variable to store executable pipeline
 		p *pipeline.Build
+		// variable to store the route required by the pipeline labels
+		route string
 		// variable to store pipeline configuration
 		pipeline *library.Pipeline
 		// variable to control number of times to retry processing pipeline
@@ -444,7 +543,7 @@ func PostWebhook(c *gin.Context) {
 		}
 
 		// send API call to capture repo for the counter (grabbing repo again to ensure counter is correct)
-		repo, err = database.FromContext(c).GetRepoForOrg(ctx, repo.GetOrg(), repo.GetName())
+		repo, err = database.FromContext(c).GetRepo(ctx, repo.GetID())
 		if err != nil {
 			retErr := fmt.Errorf("%s: unable to get repo %s: %w", baseErr, r.GetFullName(), err)
 
@@ -502,6 +601,11 @@ func PostWebhook(c *gin.Context) {
 			WithRepo(repo).
 			WithUser(u).
 			Compile(config)
+		if err == nil {
+			// verify a registered worker has the labels required by the pipeline
+			route, err = build.LabelRoute(ctx, database.FromContext(c), config, repo.GetPipelineType())
+		}
+
 		if err != nil {
 			// format the error message with extra information
 			err = fmt.Errorf("unable to compile pipeline configuration for %s: %w", repo.GetFullName(), err)
@@ -671,6 +775,11 @@ func PostWebhook(c *gin.Context) {
 		logrus.Errorf("unable to set commit status for %s/%d: %v", repo.GetFullName(), b.GetNumber(), err)
 	}
 
+	// reply to the approve command with the build it created
+	if command != nil && strings.EqualFold(command.Name, chatops.CommandApprove) {
+		replyCommand(ctx, c, u, repo, webhook.PRNumber, fmt.Sprintf("`%s`: approved by %s, started build %s", command, b.GetSender(), buildReference(b)))
+	}
+
 	// publish the build to the queue
 	go build.PublishToQueue(
 		ctx,
@@ -680,6 +789,7 @@ func PostWebhook(c *gin.Context) {
 		b,
 		repo,
 		u,
+		route,
 	)
 
 	// if anything is provided in the auto_cancel metadata, then we start with true
@@ -690,12 +800,13 @@ func PostWebhook(c *gin.Context) {
 		runAutoCancel = false
 	}
 
-	// if event is push or pull_request:synchronize, there is a chance this build could be superceding a stale build
+	// if event is push, pull_request:synchronize or merge_group, there is a chance this build could be superceding a stale build
 	//
 	// fetch pending and running builds for this repo in order to validate their merit to continue running.
 	if runAutoCancel &&
 		((strings.EqualFold(b.GetEvent(), constants.EventPull) && strings.EqualFold(b.GetEventAction(), constants.ActionSynchronize)) ||
-			strings.EqualFold(b.GetEvent(), constants.EventPush)) {
+			strings.EqualFold(b.GetEvent(), constants.EventPush) ||
+			strings.EqualFold(b.GetEvent(), serverconstants.EventMergeGroup)) {
 		// fetch pending and running builds
 		rBs, err := database.FromContext(c).ListPendingAndRunningBuildsForRepo(c, repo)
 		if err != nil {
@@ -746,7 +857,7 @@ func handleRepositoryEvent(ctx context.Context, c *gin.Context, m *types.Metadat
 	case "archived", "unarchived", constants.ActionEdited:
 		logrus.Debugf("repository action %s for %s", h.GetEventAction(), r.GetFullName())
 		// send call to get repository from database
-		dbRepo, err := database.FromContext(c).GetRepoForOrg(ctx, r.GetOrg(), r.GetName())
+		dbRepo, provider, err := database.FromContext(c).GetRepoForOrgWithProvider(ctx, r.GetOrg(), r.GetName(), scm.SelectedFromContext(c))
 		if err != nil {
 			retErr := fmt.Errorf("%s: failed to get repo %s: %w", baseErr, r.GetFullName(), err)
 
@@ -756,6 +867,17 @@ func handleRepositoryEvent(ctx context.Context, c *gin.Context, m *types.Metadat
 			return nil, retErr
 		}
 
+		// verify the repo belongs to the scm provider the webhook was delivered for
+		err = verifyProvider(c, dbRepo, provider)
+		if err != nil {
+			retErr := fmt.Errorf("%s: %w", baseErr, err)
+
+			h.SetStatus(constants.StatusFailure)
+			h.SetError(retErr.Error())
+
+			return nil, retErr
+		}
+
 		// send API call to capture the last hook for the repo
 		lastHook, err := database.FromContext(c).LastHookForRepo(ctx, dbRepo)
 		if err != nil {
@@ -933,3 +1055,103 @@ func renameRepository(ctx context.Context, h *library.Hook, r *library.Repo, c *
 
 	return dbR, nil
 }
+
+// handleCheckRunEvent is a helper function that restarts the build
+// for a check run that was re-requested from the SCM.
+func handleCheckRunEvent(ctx context.Context, c *gin.Context, m *types.Metadata, h *library.Hook, r *library.Repo, b *library.Build) {
+	logrus.Debugf("webhook is check run event, restarting build %s/%d", r.GetFullName(), b.GetNumber())
+
+	// send API call to capture the build for the check run
+	existing, err := database.FromContext(c).GetBuildForRepo(ctx, r, b.GetNumber())
+	if err != nil {
+		retErr := fmt.Errorf("%s: failed to get build %s/%d: %w", baseErr, r.GetFullName(), b.GetNumber(), err)
+		util.HandleError(c, http.StatusNotFound, retErr)
+
+		h.SetStatus(constants.StatusFailure)
+		h.SetError(retErr.Error())
+
+		return
+	}
+
+	// verify the check run belongs to the build
+	if !strings.EqualFold(existing.GetCommit(), b.GetCommit()) {
+		retErr := fmt.Errorf("%s: check run commit %s does not match build %s/%d", baseErr, b.GetCommit(), r.GetFullName(), b.GetNumber())
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		h.SetStatus(constants.StatusFailure)
+		h.SetError(retErr.Error())
+
+		return
+	}
+
+	// restart the build
+	//
+	// errors are handled and written to the response by Restart
+	restarted, err := build.Restart(c, m, existing, r, b.GetSender())
+	if err != nil {
+		h.SetStatus(constants.StatusFailure)
+		h.SetError(err.Error())
+
+		return
+	}
+
+	// set the BuildID field
+	if restarted != nil {
+		h.SetBuildID(restarted.GetID())
+	}
+}
+
+// handleMergeGroupDestroyed is a helper function that cancels the pending and
+// running builds for a merge group that was removed from the merge queue.
+func handleMergeGroupDestroyed(c *gin.Context, h *library.Hook, r *library.Repo, b *library.Build) {
+	logrus.Debugf("webhook is destroyed merge group event, canceling builds for %s on %s", b.GetHeadRef(), r.GetFullName())
+
+	// fetch pending and running builds
+	rBs, err := database.FromContext(c).ListPendingAndRunningBuildsForRepo(c, r)
+	if err != nil {
+		retErr := fmt.Errorf("%s: unable to fetch pending and running builds for %s: %w", baseErr, r.GetFullName(), err)
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		h.SetStatus(constants.StatusFailure)
+		h.SetError(retErr.Error())
+
+		return
+	}
+
+	for _, rB := range rBs {
+		// call cancel routine for the merge group
+		canceled, err := build.CancelMergeGroup(c, rB, r, b.GetHeadRef())
+		if err != nil {
+			// continue cancel loop if error, but log based on type of error
+			if canceled {
+				logrus.Errorf("unable to update canceled build error message: %v", err)
+			} else {
+				logrus.Errorf("unable to cancel running build: %v", err)
+			}
+		}
+	}
+
+	c.JSON(http.StatusOK, fmt.Sprintf("handled destroyed merge group %s, no build to process", b.GetHeadRef()))
+}
+
+// verifyProvider is a helper function to verify the repo
+// belongs to the scm provider the webhook was delivered for.
+func verifyProvider(c *gin.Context, r *library.Repo, provider string) error {
+	p := scm.ProvidersFromContext(c)
+	if p == nil {
+		return nil
+	}
+
+	if provider != scm.SelectedFromContext(c) {
+		name := util.PathParameter(c, "provider")
+
+		// webhooks delivered without a provider are for the default scm provider
+		if len(name) == 0 {
+			name = p.Names()[0]
+		}
+
+		return fmt.Errorf("repo %s does not belong to scm provider %s", r.GetFullName(), name)
+	}
+
+	return nil
+}
@@ -58,9 +58,9 @@ func CreateWorker(c *gin.Context) {
 	ctx := c.Request.Context()
 
 	// capture body from API request
-	input := new(library.Worker)
+	body := newWorkerWithLabels(nil, nil)
 
-	err := c.Bind(input)
+	err := c.Bind(body)
 	if err != nil {
 		retErr := fmt.Errorf("unable to decode JSON for new worker: %w", err)
 
@@ -69,6 +69,18 @@ func CreateWorker(c *gin.Context) {
 		return
 	}
 
+	input := body.Worker
+
+	// verify the labels registered by the worker are valid
+	err = body.Labels.Validate()
+	if err != nil {
+		retErr := fmt.Errorf("unable to create worker %s: %w", input.GetHostname(), err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
 	// verify input host name matches worker hostname
 	if !strings.EqualFold(cl.TokenType, constants.ServerWorkerTokenType) && !strings.EqualFold(cl.Subject, input.GetHostname()) {
 		retErr := fmt.Errorf("unable to add worker; claims subject %s does not match worker hostname %s", cl.Subject, input.GetHostname())
@@ -88,7 +100,7 @@ func CreateWorker(c *gin.Context) {
 		"worker": input.GetHostname(),
 	}).Infof("creating new worker %s", input.GetHostname())
 
-	_, err = database.FromContext(c).CreateWorker(ctx, input)
+	w, err := database.FromContext(c).CreateWorker(ctx, input)
 	if err != nil {
 		retErr := fmt.Errorf("unable to create worker: %w", err)
 
@@ -97,6 +109,16 @@ func CreateWorker(c *gin.Context) {
 		return
 	}
 
+	// send API call to register the labels for the worker
+	err = database.FromContext(c).UpdateWorkerLabels(ctx, w, body.Labels)
+	if err != nil {
+		retErr := fmt.Errorf("unable to set labels for worker %s: %w", w.GetHostname(), err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
 	switch cl.TokenType {
 	// if symmetric token configured, send back symmetric token
 	case constants.ServerWorkerTokenType:
@@ -64,5 +64,15 @@ func GetWorker(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusOK, w)
+	// send API call to capture the labels for the worker
+	l, err := database.FromContext(c).GetWorkerLabels(ctx, w)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get labels for worker %s: %w", w.GetHostname(), err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, newWorkerWithLabels(w, l))
 }
@@ -0,0 +1,25 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package worker
+
+import (
+	"github.com/go-vela/server/internal/labels"
+	"github.com/go-vela/types/library"
+)
+
+// workerWithLabels represents the API payload for a worker
+// including the labels not captured by the library worker.
+type workerWithLabels struct {
+	*library.Worker
+	Labels labels.Set `json:"labels,omitempty"`
+}
+
+// newWorkerWithLabels is a helper function to create
+// the API payload for a worker and its labels.
+func newWorkerWithLabels(w *library.Worker, l labels.Set) *workerWithLabels {
+	if w == nil {
+		w = new(library.Worker)
+	}
+
+	return &workerWithLabels{Worker: w, Labels: l}
+}
@@ -11,7 +11,6 @@ import (
 	"github.com/go-vela/server/router/middleware/user"
 	"github.com/go-vela/server/router/middleware/worker"
 	"github.com/go-vela/server/util"
-	"github.com/go-vela/types/library"
 	"github.com/sirupsen/logrus"
 )
 
@@ -71,9 +70,9 @@ func UpdateWorker(c *gin.Context) {
 	}).Infof("updating worker %s", w.GetHostname())
 
 	// capture body from API request
-	input := new(library.Worker)
+	body := newWorkerWithLabels(nil, nil)
 
-	err := c.Bind(input)
+	err := c.Bind(body)
 	if err != nil {
 		retErr := fmt.Errorf("unable to decode JSON for worker %s: %w", w.GetHostname(), err)
 
@@ -82,6 +81,18 @@ func UpdateWorker(c *gin.Context) {
 		return
 	}
 
+	input := body.Worker
+
+	// verify the labels registered by the worker are valid
+	err = body.Labels.Validate()
+	if err != nil {
+		retErr := fmt.Errorf("unable to update worker %s: %w", w.GetHostname(), err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
 	if len(input.GetAddress()) > 0 {
 		// update address if set
 		w.SetAddress(input.GetAddress())
@@ -132,5 +143,27 @@ func UpdateWorker(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusOK, w)
+	if body.Labels != nil {
+		// send API call to update the labels if set
+		err = database.FromContext(c).UpdateWorkerLabels(ctx, w, body.Labels)
+		if err != nil {
+			retErr := fmt.Errorf("unable to update labels for worker %s: %w", w.GetHostname(), err)
+
+			util.HandleError(c, http.StatusInternalServerError, retErr)
+
+			return
+		}
+	}
+
+	// send API call to capture the labels for the worker
+	l, err := database.FromContext(c).GetWorkerLabels(ctx, w)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get labels for worker %s: %w", w.GetHostname(), err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, newWorkerWithLabels(w, l))
 }
@@ -0,0 +1,179 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+
+	"github.com/go-vela/server/scm/local"
+)
+
+// localHook represents the command to synthesize webhooks
+// for the local scm driver from a git post-receive hook.
+var localHook = &cli.Command{
+	Name:  "local-hook",
+	Usage: "synthesize push and tag webhooks for the local scm driver from the post-receive hook of a bare git repository",
+	Description: "Reads the \"<old> <new> <ref>\" lines provided to a post-receive hook on stdin " +
+		"and sends a signed push webhook to the Vela server for each branch or tag that was pushed.",
+	Action: runLocalHook,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			EnvVars: []string{"VELA_LOCAL_HOOK_SERVER", "VELA_ADDR"},
+			Name:    "server",
+			Usage:   "Vela server address as a fully qualified url (<scheme>://<host>)",
+			Value:   "http://localhost:8080",
+		},
+		&cli.StringFlag{
+			EnvVars: []string{"VELA_LOCAL_HOOK_SECRET"},
+			Name:    "secret",
+			Usage:   "webhook secret from the static configuration file of the local scm driver",
+		},
+		&cli.StringFlag{
+			EnvVars: []string{"VELA_LOCAL_HOOK_PROVIDER"},
+			Name:    "provider",
+			Usage:   "name of the scm provider for the local scm driver when it is not the default scm provider",
+		},
+		&cli.StringFlag{
+			EnvVars: []string{"VELA_LOCAL_HOOK_ORG"},
+			Name:    "org",
+			Usage:   "org of the repository (defaults to the parent directory of the git directory)",
+		},
+		&cli.StringFlag{
+			EnvVars: []string{"VELA_LOCAL_HOOK_REPO"},
+			Name:    "repo",
+			Usage:   "name of the repository (defaults to the git directory without the .git suffix)",
+		},
+		&cli.StringFlag{
+			EnvVars: []string{"VELA_LOCAL_HOOK_SENDER", "USER"},
+			Name:    "sender",
+			Usage:   "name of the user that pushed to the repository",
+		},
+	},
+}
+
+// helper function to send a webhook to the Vela server
+// for each ref updated in a post-receive hook.
+func runLocalHook(c *cli.Context) error {
+	if len(c.String("secret")) == 0 {
+		return fmt.Errorf("no local hook secret provided")
+	}
+
+	org, repo, err := localHookRepo(c.String("org"), c.String("repo"))
+	if err != nil {
+		return err
+	}
+
+	// send webhooks to the endpoint for the named scm provider
+	endpoint := fmt.Sprintf("%s/webhook", strings.TrimSuffix(c.String("server"), "/"))
+	if len(c.String("provider")) > 0 {
+		endpoint = fmt.Sprintf("%s/%s", endpoint, c.String("provider"))
+	}
+
+	client := &http.Client{Timeout: 30 * time.Second}
+
+	scanner := bufio.NewScanner(c.App.Reader)
+
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 3 {
+			continue
+		}
+
+		// only branches and tags trigger builds
+		if !strings.HasPrefix(fields[2], "refs/heads/") && !strings.HasPrefix(fields[2], "refs/tags/") {
+			continue
+		}
+
+		push := &local.Push{
+			Ref:    fields[2],
+			Before: fields[0],
+			After:  fields[1],
+			Org:    org,
+			Repo:   repo,
+			Sender: c.String("sender"),
+		}
+
+		err = sendLocalHook(client, endpoint, c.String("secret"), push)
+		if err != nil {
+			return err
+		}
+
+		logrus.Infof("sent webhook for %s to %s", push.Ref, endpoint)
+	}
+
+	return scanner.Err()
+}
+
+// helper function to capture the org and repo for the
+// post-receive hook from the flags or the git directory.
+func localHookRepo(org, repo string) (string, string, error) {
+	if len(org) > 0 && len(repo) > 0 {
+		return org, repo, nil
+	}
+
+	// git runs hooks from the bare repository with GIT_DIR set
+	dir := os.Getenv("GIT_DIR")
+	if len(dir) == 0 {
+		dir = "."
+	}
+
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", "", fmt.Errorf("unable to capture git directory: %w", err)
+	}
+
+	if len(org) == 0 {
+		org = filepath.Base(filepath.Dir(dir))
+	}
+
+	if len(repo) == 0 {
+		repo = strings.TrimSuffix(filepath.Base(dir), ".git")
+	}
+
+	return org, repo, nil
+}
+
+// helper function to send a signed push webhook to the Vela server.
+func sendLocalHook(client *http.Client, endpoint, secret string, push *local.Push) error {
+	payload, err := json.Marshal(push)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, endpoint, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(local.HeaderEvent, "push")
+	req.Header.Set(local.HeaderDelivery, uuid.New().String())
+	req.Header.Set(local.HeaderSignature, local.Sign(secret, payload))
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("unable to send webhook for %s: %w", push.Ref, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		body, _ := io.ReadAll(resp.Body)
+
+		return fmt.Errorf("unable to send webhook for %s: %s: %s", push.Ref, resp.Status, strings.TrimSpace(string(body)))
+	}
+
+	return nil
+}
@@ -14,6 +14,8 @@ import (
 	"github.com/go-vela/server/queue"
 	"github.com/go-vela/server/scm"
 	"github.com/go-vela/server/secret"
+	"github.com/go-vela/server/storage"
+	"github.com/go-vela/server/stream"
 	"github.com/go-vela/server/version"
 	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli/v2"
@@ -39,6 +41,10 @@ func main() {
 	app.Name = "vela-server"
 	app.Action = server
 	app.Version = v.Semantic()
+	app.Commands = []*cli.Command{
+		localHook,
+		migrate,
+	}
 	app.Flags = []cli.Flag{
 		&cli.StringFlag{
 			EnvVars: []string{"VELA_LOG_LEVEL", "LOG_LEVEL"},
@@ -245,6 +251,12 @@ func main() {
 	// Add Queue Flags
 	app.Flags = append(app.Flags, queue.Flags...)
 
+	// Add Storage Flags
+	app.Flags = append(app.Flags, storage.Flags...)
+
+	// Add Stream Flags
+	app.Flags = append(app.Flags, stream.Flags...)
+
 	// Add Secret Flags
 	app.Flags = append(app.Flags, secret.Flags...)
 
@@ -0,0 +1,222 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/database/migration"
+)
+
+// migrateFlags represents the flags for the
+// subcommands that change the database schema.
+var migrateFlags = []cli.Flag{
+	&cli.IntFlag{
+		Name:  "version",
+		Usage: "version of the schema to migrate the database to",
+	},
+	&cli.BoolFlag{
+		Name:  "dry-run",
+		Usage: "print the queries of the migrations without running them",
+	},
+}
+
+// migrate represents the command to manage
+// the versioned schema of the database.
+var migrate = &cli.Command{
+	Name:  "migrate",
+	Usage: "manage the versioned schema migrations of the database",
+	Description: "Uses the database flags of the server to report, apply or roll back " +
+		"the migrations recorded in the schema_migrations table of the database.",
+	Flags: database.Flags,
+	Subcommands: []*cli.Command{
+		{
+			Name:   "status",
+			Usage:  "print the migrations and whether they are applied to the database",
+			Action: migrateStatus,
+		},
+		{
+			Name:   "up",
+			Usage:  "apply the pending migrations up to the latest or provided version",
+			Flags:  migrateFlags,
+			Action: migrateUp,
+		},
+		{
+			Name:   "down",
+			Usage:  "roll back the latest migration or the migrations after the provided version",
+			Flags:  migrateFlags,
+			Action: migrateDown,
+		},
+	},
+}
+
+// helper function to print the state of the
+// migrations for the schema of the database.
+func migrateStatus(c *cli.Context) error {
+	m, err := database.MigratorFromCLIContext(c)
+	if err != nil {
+		return err
+	}
+
+	defer m.Close()
+
+	statuses, err := m.Status(c.Context)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(c.App.Writer, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(w, "VERSION\tSTATE\tAPPLIED\tDESCRIPTION")
+
+	for _, status := range statuses {
+		state := "pending"
+		applied := "-"
+
+		if status.Applied {
+			state = "applied"
+			applied = time.Unix(status.AppliedAt, 0).UTC().Format(time.RFC3339)
+		}
+
+		// the migration was applied by a newer server
+		if status.Unknown {
+			state = "unknown"
+		}
+
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", status.Version, state, applied, status.Description)
+	}
+
+	return w.Flush()
+}
+
+// helper function to apply the pending
+// migrations for the schema of the database.
+func migrateUp(c *cli.Context) error {
+	m, err := database.MigratorFromCLIContext(c)
+	if err != nil {
+		return err
+	}
+
+	defer m.Close()
+
+	if c.Bool("dry-run") {
+		pending, err := m.PlanUp(c.Context, c.Int("version"))
+		if err != nil {
+			return err
+		}
+
+		printMigrations(c.App.Writer, pending, c.String("database.driver"), true)
+
+		return nil
+	}
+
+	applied, err := m.Up(c.Context, c.Int("version"))
+	if err != nil {
+		return err
+	}
+
+	logrus.Infof("applied %d migrations to the database", len(applied))
+
+	return nil
+}
+
+// helper function to roll back the applied
+// migrations for the schema of the database.
+func migrateDown(c *cli.Context) error {
+	m, err := database.MigratorFromCLIContext(c)
+	if err != nil {
+		return err
+	}
+
+	defer m.Close()
+
+	version := c.Int("version")
+
+	// roll back only the latest migration by default
+	if !c.IsSet("version") {
+		statuses, err := m.Status(c.Context)
+		if err != nil {
+			return err
+		}
+
+		version = previousVersion(statuses)
+	}
+
+	if c.Bool("dry-run") {
+		pending, err := m.PlanDown(c.Context, version)
+		if err != nil {
+			return err
+		}
+
+		printMigrations(c.App.Writer, pending, c.String("database.driver"), false)
+
+		return nil
+	}
+
+	rolledBack, err := m.Down(c.Context, version)
+	if err != nil {
+		return err
+	}
+
+	logrus.Infof("rolled back %d migrations in the database", len(rolledBack))
+
+	return nil
+}
+
+// helper function to capture the version of the migration
+// applied to the database before the latest applied migration.
+func previousVersion(statuses []*migration.Status) int {
+	applied := []int{}
+
+	for _, status := range statuses {
+		if status.Applied {
+			applied = append(applied, status.Version)
+		}
+	}
+
+	if len(applied) < 2 {
+		return 0
+	}
+
+	return applied[len(applied)-2]
+}
+
+// helper function to print the queries of the
+// migrations for the driver of the database.
+func printMigrations(w io.Writer, migrations []*migration.Migration, driver string, up bool) {
+	if len(migrations) == 0 {
+		fmt.Fprintln(w, "-- no migrations to run")
+
+		return
+	}
+
+	for _, m := range migrations {
+		queries := m.Down[driver]
+		if up {
+			queries = m.Up[driver]
+		}
+
+		fmt.Fprintf(w, "-- migration %d: %s\n", m.Version, m.Description)
+
+		for _, query := range queries {
+			fmt.Fprintln(w, strings.TrimSpace(query))
+		}
+
+		if up {
+			for _, column := range m.Columns[driver] {
+				fmt.Fprintf(w, "-- when %s.%s does not exist\n", column.Table, column.Name)
+				fmt.Fprintln(w, strings.TrimSpace(column.Query))
+			}
+		}
+
+		fmt.Fprintln(w)
+	}
+}
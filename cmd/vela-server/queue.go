@@ -3,7 +3,13 @@
 package main
 
 import (
+	"context"
+	"time"
+
+	"github.com/go-vela/server/api/build"
+	"github.com/go-vela/server/database"
 	"github.com/go-vela/server/queue"
+	"github.com/go-vela/server/scm"
 
 	"github.com/sirupsen/logrus"
 
@@ -16,13 +22,22 @@ func setupQueue(c *cli.Context) (queue.Service, error) {
 
 	// queue configuration
 	_setup := &queue.Setup{
-		Driver:     c.String("queue.driver"),
-		Address:    c.String("queue.addr"),
-		Cluster:    c.Bool("queue.cluster"),
-		Routes:     c.StringSlice("queue.routes"),
-		Timeout:    c.Duration("queue.pop.timeout"),
-		PrivateKey: c.String("queue.private-key"),
-		PublicKey:  c.String("queue.public-key"),
+		Driver:              c.String("queue.driver"),
+		Address:             c.String("queue.addr"),
+		Cluster:             c.Bool("queue.cluster"),
+		Routes:              c.StringSlice("queue.routes"),
+		Timeout:             c.Duration("queue.pop.timeout"),
+		PrivateKey:          c.String("queue.private-key"),
+		PublicKey:           c.String("queue.public-key"),
+		KeyID:               c.String("queue.key-id"),
+		PublicKeys:          c.StringSlice("queue.public-keys"),
+		EncryptionKey:       c.String("queue.encryption-key"),
+		EncryptionMigration: c.Bool("queue.encryption.migration"),
+		Lease:               c.Duration("queue.lease.duration"),
+		Priority:            c.Bool("queue.priority"),
+		FairShare:           c.String("queue.fair-share"),
+		FairShareWeights:    c.StringSlice("queue.fair-share.weights"),
+		FairShareCaps:       c.StringSlice("queue.fair-share.caps"),
 	}
 
 	// setup the queue
@@ -30,3 +45,86 @@ func setupQueue(c *cli.Context) (queue.Service, error) {
 	// https://pkg.go.dev/github.com/go-vela/server/queue?tab=doc#New
 	return queue.New(_setup)
 }
+
+// helper function to setup the max time builds wait
+// in the routes of the queue from the CLI arguments.
+func setupMaxTime(c *cli.Context) (queue.MaxTime, error) {
+	logrus.Debug("Parsing queue max time from CLI configuration")
+
+	return queue.ParseMaxTime(c.StringSlice("queue.max-time"))
+}
+
+// helper function to requeue the items popped off the queue
+// with an expired lease until the context is canceled.
+func requeueExpired(ctx context.Context, queue queue.Service, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			total, err := queue.RequeueExpired(ctx)
+			if err != nil {
+				logrus.WithError(err).Warn("unable to requeue items with an expired lease")
+
+				continue
+			}
+
+			if total > 0 {
+				logrus.Infof("requeued %d items with an expired lease", total)
+			}
+		}
+	}
+}
+
+// helper function to release the builds counted in-flight by
+// the queue that are complete until the context is canceled.
+func releaseCompleted(ctx context.Context, queue queue.Service, database database.Interface, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			total, err := build.ReleaseCompleted(ctx, queue, database)
+			if err != nil {
+				logrus.WithError(err).Warn("unable to release completed builds from the queue")
+
+				continue
+			}
+
+			if total > 0 {
+				logrus.Infof("released %d completed builds from the queue", total)
+			}
+		}
+	}
+}
+
+// helper function to expire the builds waiting in the queue
+// longer than the max time until the context is canceled.
+func expireQueued(ctx context.Context, queue queue.Service, database database.Interface, providers *scm.Providers, maxTime queue.MaxTime, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			total, err := build.ExpireQueued(ctx, queue, database, providers, maxTime)
+			if err != nil {
+				logrus.WithError(err).Warn("unable to expire builds waiting in the queue")
+
+				continue
+			}
+
+			if total > 0 {
+				logrus.Infof("expired %d builds waiting in the queue", total)
+			}
+		}
+	}
+}
@@ -30,7 +30,7 @@ const (
 	scheduleWait = "waiting to trigger build for schedule"
 )
 
-func processSchedules(ctx context.Context, start time.Time, compiler compiler.Engine, database database.Interface, metadata *types.Metadata, queue queue.Service, scm scm.Service, allowList []string) error {
+func processSchedules(ctx context.Context, start time.Time, compiler compiler.Engine, database database.Interface, metadata *types.Metadata, queue queue.Service, providers *scm.Providers, allowList []string) error {
 	logrus.Infof("processing active schedules to create builds")
 
 	// send API call to capture the list of active schedules
@@ -123,7 +123,7 @@ func processSchedules(ctx context.Context, start time.Time, compiler compiler.En
 		}
 
 		// process the schedule and trigger a new build
-		err = processSchedule(ctx, schedule, compiler, database, metadata, queue, scm, allowList)
+		err = processSchedule(ctx, schedule, compiler, database, metadata, queue, providers, allowList)
 		if err != nil {
 			logrus.WithError(err).Warnf("%s %s", scheduleErr, schedule.GetName())
 
@@ -135,7 +135,7 @@ func processSchedules(ctx context.Context, start time.Time, compiler compiler.En
 }
 
 //nolint:funlen // ignore function length and number of statements
-func processSchedule(ctx context.Context, s *library.Schedule, compiler compiler.Engine, database database.Interface, metadata *types.Metadata, queue queue.Service, scm scm.Service, allowList []string) error {
+func processSchedule(ctx context.Context, s *library.Schedule, compiler compiler.Engine, database database.Interface, metadata *types.Metadata, queue queue.Service, providers *scm.Providers, allowList []string) error {
 	// send API call to capture the repo for the schedule
 	r, err := database.GetRepo(ctx, s.GetRepoID())
 	if err != nil {
@@ -165,6 +165,18 @@ func processSchedule(ctx context.Context, s *library.Schedule, compiler compiler
 		return fmt.Errorf("unable to get owner for repo %s: %w", r.GetFullName(), err)
 	}
 
+	// send API call to capture the scm provider for the repo
+	provider, err := database.GetRepoProvider(ctx, r)
+	if err != nil {
+		return fmt.Errorf("unable to get scm provider for repo %s: %w", r.GetFullName(), err)
+	}
+
+	// capture the scm provider for the repo
+	_, scm, err := providers.Get(provider)
+	if err != nil {
+		return fmt.Errorf("unable to get scm provider for repo %s: %w", r.GetFullName(), err)
+	}
+
 	// send API call to confirm repo owner has at least write access to repo
 	_, err = scm.RepoAccess(ctx, u, u.GetToken(), r.GetOrg(), r.GetName())
 	if err != nil {
@@ -220,6 +232,8 @@ func processSchedule(ctx context.Context, s *library.Schedule, compiler compiler
 		config []byte
 		// variable to store executable pipeline
 		p *pipeline.Build
+		// variable to store the route required by the pipeline labels
+		route string
 		// variable to store pipeline configuration
 		pipeline *library.Pipeline
 		// variable to control number of times to retry processing pipeline
@@ -304,6 +318,11 @@ func processSchedule(ctx context.Context, s *library.Schedule, compiler compiler
 			WithRepo(r).
 			WithUser(u).
 			Compile(config)
+		if err == nil {
+			// verify a registered worker has the labels required by the pipeline
+			route, err = build.LabelRoute(ctx, database, config, r.GetPipelineType())
+		}
+
 		if err != nil {
 			return fmt.Errorf("unable to compile pipeline config for %s/%s: %w", r.GetFullName(), b.GetCommit(), err)
 		}
@@ -398,6 +417,7 @@ func processSchedule(ctx context.Context, s *library.Schedule, compiler compiler
 		b,
 		r,
 		u,
+		route,
 	)
 
 	return nil
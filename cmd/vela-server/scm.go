@@ -3,14 +3,38 @@
 package main
 
 import (
+	"fmt"
+	"os"
+
+	"github.com/buildkite/yaml"
 	"github.com/go-vela/server/scm"
+	"github.com/go-vela/server/scm/gitea"
+	"github.com/go-vela/types/constants"
 	"github.com/sirupsen/logrus"
 
 	"github.com/urfave/cli/v2"
 )
 
-// helper function to setup the scm from the CLI arguments.
-func setupSCM(c *cli.Context) (scm.Service, error) {
+// scmProvider represents an additional named scm
+// provider defined in the scm providers file.
+type scmProvider struct {
+	Name             string   `yaml:"name"`
+	Driver           string   `yaml:"driver"`
+	Address          string   `yaml:"addr"`
+	ClientID         string   `yaml:"client"`
+	ClientSecret     string   `yaml:"secret"`
+	WebhookAddress   string   `yaml:"webhook_addr"`
+	StatusContext    string   `yaml:"context"`
+	Scopes           []string `yaml:"scopes"`
+	AppID            int64    `yaml:"app_id"`
+	AppPrivateKey    string   `yaml:"app_private_key"`
+	AppWebhookSecret string   `yaml:"app_webhook_secret"`
+	Checks           bool     `yaml:"checks"`
+	LocalStaticFile  string   `yaml:"local_static_file"`
+}
+
+// helper function to setup the scm providers from the CLI arguments.
+func setupSCM(c *cli.Context) (*scm.Providers, error) {
 	logrus.Debug("Creating scm client from CLI configuration")
 
 	// scm configuration
@@ -24,10 +48,122 @@ func setupSCM(c *cli.Context) (scm.Service, error) {
 		StatusContext:        c.String("scm.context"),
 		WebUIAddress:         c.String("webui-addr"),
 		Scopes:               c.StringSlice("scm.scopes"),
+		AppID:                c.Int64("scm.app.id"),
+		AppPrivateKey:        c.String("scm.app.private-key"),
+		AppWebhookSecret:     c.String("scm.app.webhook-secret"),
+		Checks:               c.Bool("scm.checks"),
+		LocalStaticFile:      c.String("scm.local.static-file"),
+	}
+
+	// read the GitHub App private key from the provided path
+	if len(c.String("scm.app.private-key.path")) > 0 {
+		key, err := os.ReadFile(c.String("scm.app.private-key.path"))
+		if err != nil {
+			return nil, fmt.Errorf("unable to read scm app private key: %w", err)
+		}
+
+		_setup.AppPrivateKey = string(key)
+	}
+
+	// the default scopes are specific to GitHub so replace
+	// them when GitLab or Gitea is the scm provider
+	if !c.IsSet("scm.scopes") {
+		_setup.Scopes = defaultScopes(_setup.Driver)
 	}
 
 	// setup the scm
 	//
 	// https://pkg.go.dev/github.com/go-vela/server/scm?tab=doc#New
-	return scm.New(_setup)
+	s, err := scm.New(_setup)
+	if err != nil {
+		return nil, err
+	}
+
+	// the default scm provider is named after the driver unless a name is provided
+	name := c.String("scm.name")
+	if len(name) == 0 {
+		name = _setup.Driver
+	}
+
+	providers := scm.NewProviders(name, s)
+
+	// check if additional scm providers were provided
+	if len(c.String("scm.providers.file")) == 0 {
+		return providers, nil
+	}
+
+	// read the additional scm providers from the provided path
+	data, err := os.ReadFile(c.String("scm.providers.file"))
+	if err != nil {
+		return nil, fmt.Errorf("unable to read scm providers file: %w", err)
+	}
+
+	var extra []*scmProvider
+
+	err = yaml.Unmarshal(data, &extra)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse scm providers file: %w", err)
+	}
+
+	for _, p := range extra {
+		logrus.Debugf("Creating scm client for provider %s", p.Name)
+
+		// additional scm providers share the Vela server and web UI addresses
+		_extra := &scm.Setup{
+			Driver:               p.Driver,
+			Name:                 p.Name,
+			Address:              p.Address,
+			ClientID:             p.ClientID,
+			ClientSecret:         p.ClientSecret,
+			ServerAddress:        _setup.ServerAddress,
+			ServerWebhookAddress: p.WebhookAddress,
+			StatusContext:        p.StatusContext,
+			WebUIAddress:         _setup.WebUIAddress,
+			Scopes:               p.Scopes,
+			AppID:                p.AppID,
+			AppPrivateKey:        p.AppPrivateKey,
+			AppWebhookSecret:     p.AppWebhookSecret,
+			Checks:               p.Checks,
+			LocalStaticFile:      p.LocalStaticFile,
+		}
+
+		// fallback to the default scopes for the scm driver
+		if len(_extra.Scopes) == 0 {
+			_extra.Scopes = defaultScopes(p.Driver)
+		}
+
+		// fallback to the default scm status context
+		if len(_extra.StatusContext) == 0 {
+			_extra.StatusContext = _setup.StatusContext
+		}
+
+		// fallback to the default scm webhook address
+		if len(_extra.ServerWebhookAddress) == 0 {
+			_extra.ServerWebhookAddress = _setup.ServerWebhookAddress
+		}
+
+		s, err := scm.New(_extra)
+		if err != nil {
+			return nil, fmt.Errorf("unable to create scm provider %s: %w", p.Name, err)
+		}
+
+		err = providers.Add(p.Name, s)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return providers, nil
+}
+
+// helper function to return the default OAuth scopes for the scm driver.
+func defaultScopes(driver string) []string {
+	switch driver {
+	case constants.DriverGitlab:
+		return []string{"api", "read_user"}
+	case gitea.DriverGitea:
+		return []string{"read:user", "read:organization", "write:repository"}
+	default:
+		return []string{"repo", "repo:status", "user:email", "read:user", "read:org"}
+	}
 }
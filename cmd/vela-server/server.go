@@ -72,7 +72,12 @@ func server(c *cli.Context) error {
 		return err
 	}
 
-	database, err := database.FromCLIContext(c)
+	storage, err := setupStorage(c)
+	if err != nil {
+		return err
+	}
+
+	database, err := database.FromCLIContext(c, database.WithLogStorage(storage))
 	if err != nil {
 		return err
 	}
@@ -82,12 +87,22 @@ func server(c *cli.Context) error {
 		return err
 	}
 
+	stream, err := setupStream(c)
+	if err != nil {
+		return err
+	}
+
+	maxTime, err := setupMaxTime(c)
+	if err != nil {
+		return err
+	}
+
 	secrets, err := setupSecrets(c, database)
 	if err != nil {
 		return err
 	}
 
-	scm, err := setupSCM(c)
+	providers, err := setupSCM(c)
 	if err != nil {
 		return err
 	}
@@ -104,12 +119,15 @@ func server(c *cli.Context) error {
 		middleware.Metadata(metadata),
 		middleware.TokenManager(setupTokenManager(c)),
 		middleware.Queue(queue),
+		middleware.Stream(stream),
 		middleware.RequestVersion,
 		middleware.Secret(c.String("vela-secret")),
 		middleware.Secrets(secrets),
-		middleware.Scm(scm),
+		middleware.ScmProviders(providers),
 		middleware.QueueSigningPrivateKey(c.String("queue.private-key")),
 		middleware.QueueSigningPublicKey(c.String("queue.public-key")),
+		middleware.QueueSigningKeyID(c.String("queue.key-id")),
+		middleware.QueueSigningPublicKeys(c.StringSlice("queue.public-keys")),
 		middleware.QueueAddress(c.String("queue.addr")),
 		middleware.Allowlist(c.StringSlice("vela-repo-allowlist")),
 		middleware.DefaultBuildLimit(c.Int64("default-build-limit")),
@@ -212,7 +230,7 @@ func server(c *cli.Context) error {
 			// sleep for a duration of time before processing schedules
 			time.Sleep(jitter)
 
-			err = processSchedules(ctx, start, compiler, database, metadata, queue, scm, c.StringSlice("vela-schedule-allowlist"))
+			err = processSchedules(ctx, start, compiler, database, metadata, queue, providers, c.StringSlice("vela-schedule-allowlist"))
 			if err != nil {
 				logrus.WithError(err).Warn("unable to process schedules")
 			} else {
@@ -221,6 +239,50 @@ func server(c *cli.Context) error {
 		}
 	})
 
+	// spawn goroutine for requeueing items with an expired lease
+	if c.Duration("queue.lease.duration") > 0 {
+		g.Go(func() error {
+			logrus.Info("starting queue lease reaper")
+
+			requeueExpired(gctx, queue, c.Duration("queue.lease.interval"))
+
+			return nil
+		})
+	}
+
+	// spawn goroutine for releasing completed builds still counted against their owner
+	if len(c.String("queue.fair-share")) > 0 {
+		g.Go(func() error {
+			logrus.Info("starting queue fair share reaper")
+
+			releaseCompleted(gctx, queue, database, c.Duration("queue.lease.interval"))
+
+			return nil
+		})
+	}
+
+	// spawn goroutine for expiring builds waiting in the queue past the max time
+	if len(maxTime) > 0 {
+		g.Go(func() error {
+			logrus.Info("starting queue max time reaper")
+
+			expireQueued(gctx, queue, database, providers, maxTime, c.Duration("queue.max-time.interval"))
+
+			return nil
+		})
+	}
+
+	// spawn goroutine for moving log data from the database to the log storage
+	if storage != nil && c.Duration("storage.migrate.interval") > 0 {
+		g.Go(func() error {
+			logrus.Infof("starting log storage migrator for %s storage", storage.Driver())
+
+			migrateLogs(gctx, database, c.Duration("storage.migrate.interval"), c.Int("storage.migrate.batch"))
+
+			return nil
+		})
+	}
+
 	// wait for errors from server subprocesses
 	return g.Wait()
 }
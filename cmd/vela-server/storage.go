@@ -0,0 +1,63 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/storage"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/urfave/cli/v2"
+)
+
+// helper function to setup the log storage from the CLI arguments.
+func setupStorage(c *cli.Context) (storage.Service, error) {
+	logrus.Debug("Creating log storage client from CLI configuration")
+
+	// log storage configuration
+	_setup := &storage.Setup{
+		Driver:    c.String("storage.driver"),
+		Directory: c.String("storage.dir"),
+		Address:   c.String("storage.addr"),
+		Bucket:    c.String("storage.bucket"),
+		Region:    c.String("storage.region"),
+		AccessKey: c.String("storage.access-key"),
+		SecretKey: c.String("storage.secret-key"),
+		Prefix:    c.String("storage.prefix"),
+		PathStyle: c.Bool("storage.path-style"),
+	}
+
+	// setup the log storage
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/storage?tab=doc#New
+	return storage.New(_setup)
+}
+
+// helper function to move the log data from the database
+// to the log storage in batches until the context is canceled.
+func migrateLogs(ctx context.Context, database database.Interface, interval time.Duration, batch int) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			total, err := database.MigrateLogs(ctx, batch)
+			if err != nil {
+				logrus.WithError(err).Warn("unable to move logs to the log storage")
+
+				continue
+			}
+
+			if total > 0 {
+				logrus.Infof("moved %d logs to the log storage", total)
+			}
+		}
+	}
+}
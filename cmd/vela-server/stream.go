@@ -0,0 +1,28 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"github.com/go-vela/server/stream"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/urfave/cli/v2"
+)
+
+// helper function to setup the stream from the CLI arguments.
+func setupStream(c *cli.Context) (stream.Service, error) {
+	logrus.Debug("Creating stream client from CLI configuration")
+
+	// stream configuration
+	_setup := &stream.Setup{
+		Driver:  c.String("stream.driver"),
+		Address: c.String("stream.addr"),
+		Buffer:  c.Int("stream.buffer"),
+	}
+
+	// setup the stream
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/stream?tab=doc#New
+	return stream.New(_setup)
+}
@@ -1893,6 +1893,70 @@ func TestNative_Compile_StepsandStages(t *testing.T) {
 	}
 }
 
+func TestNative_Compile_MergeGroup(t *testing.T) {
+	// setup types
+	set := flag.NewFlagSet("test", 0)
+	set.String("clone-image", defaultCloneImage, "doc")
+	c := cli.NewContext(nil, set, nil)
+
+	m := &types.Metadata{
+		Database: &types.Database{
+			Driver: "foo",
+			Host:   "foo",
+		},
+		Queue: &types.Queue{
+			Channel: "foo",
+			Driver:  "foo",
+			Host:    "foo",
+		},
+		Source: &types.Source{
+			Driver: "foo",
+			Host:   "foo",
+		},
+		Vela: &types.Vela{
+			Address:    "foo",
+			WebAddress: "foo",
+		},
+	}
+	name := "foo"
+	author := "author"
+	number := 1
+	event := "merge_group"
+	action := "checks_requested"
+
+	// run test
+	yaml, err := os.ReadFile("testdata/merge_group.yml")
+	if err != nil {
+		t.Errorf("Reading yaml file return err: %v", err)
+	}
+
+	compiler, err := New(c)
+	if err != nil {
+		t.Errorf("Creating compiler returned err: %v", err)
+	}
+
+	compiler.repo = &library.Repo{Name: &author}
+	compiler.build = &library.Build{Author: &name, Number: &number, Event: &event, EventAction: &action}
+	compiler.WithMetadata(m)
+
+	got, _, err := compiler.Compile(yaml)
+	if err != nil {
+		t.Fatalf("Compile returned err: %v", err)
+	}
+
+	steps := []string{}
+
+	for _, step := range got.Steps {
+		steps = append(steps, step.Name)
+	}
+
+	want := []string{"init", "clone", "test", "merge_group"}
+
+	if diff := cmp.Diff(want, steps); diff != "" {
+		t.Errorf("Compile steps mismatch (-want +got):\n%s", diff)
+	}
+}
+
 // convertResponse converts the build to the ModifyResponse.
 func convertResponse(build *yaml.Build) (*ModifyResponse, error) {
 	data, err := yml.Marshal(build)
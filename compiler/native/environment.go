@@ -7,6 +7,7 @@ import (
 	"os"
 	"strings"
 
+	serverconstants "github.com/go-vela/server/constants"
 	"github.com/go-vela/types"
 	"github.com/go-vela/types/constants"
 	"github.com/go-vela/types/library"
@@ -324,8 +325,46 @@ func environment(b *library.Build, m *types.Metadata, r *library.Repo, u *librar
 	env = appendMap(env, r.Environment())
 	// populate environment variables from build library
 	env = appendMap(env, b.Environment(workspace, channel))
+	// populate environment variables for server events
+	env = appendMap(env, eventEnvironment(b))
 	// populate environment variables from user library
 	env = appendMap(env, u.Environment())
 
 	return env
 }
+
+// helper function that creates the environment variables for the
+// events handled by the server that are not provided by the build library.
+func eventEnvironment(b *library.Build) map[string]string {
+	env := make(map[string]string)
+
+	switch b.GetEvent() {
+	case serverconstants.EventRelease:
+		// capture the tag for the release
+		tag := strings.TrimPrefix(b.GetRef(), "refs/tags/")
+
+		env["BUILD_TAG"] = tag
+		env["VELA_BUILD_TAG"] = tag
+		env["VELA_RELEASE_TAG"] = tag
+		env["VELA_RELEASE_NAME"] = b.GetMessage()
+		env["VELA_RELEASE_PRERELEASE"] = library.ToString(
+			strings.EqualFold(b.GetEventAction(), serverconstants.ActionPrereleased),
+		)
+	case serverconstants.EventReview:
+		// capture the pull request number from refs/pull/<number>/head
+		number := ""
+		if parts := strings.SplitN(b.GetRef(), "/", 4); len(parts) > 2 {
+			number = parts[2]
+		}
+
+		env["BUILD_PULL_REQUEST_NUMBER"] = number
+		env["VELA_BUILD_PULL_REQUEST"] = number
+		env["VELA_PULL_REQUEST"] = number
+		env["VELA_PULL_REQUEST_SOURCE"] = b.GetHeadRef()
+		env["VELA_PULL_REQUEST_TARGET"] = b.GetBaseRef()
+		env["VELA_PULL_REQUEST_REVIEW_STATE"] = b.GetEventAction()
+		env["VELA_PULL_REQUEST_REVIEWER"] = b.GetSender()
+	}
+
+	return env
+}
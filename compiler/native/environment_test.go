@@ -643,6 +643,60 @@ func TestNative_environment(t *testing.T) {
 	}
 }
 
+func TestNative_eventEnvironment(t *testing.T) {
+	// setup types
+	str := "foo"
+	// release
+	release := "release"
+	published := "published"
+	prereleased := "prereleased"
+	tagref := "refs/tags/v1.0.0"
+	// pull_request_review
+	review := "pull_request_review"
+	approved := "approved"
+	pullref := "refs/pull/1/head"
+	headref := "changes"
+	baseref := "main"
+
+	tests := []struct {
+		name string
+		b    *library.Build
+		want map[string]string
+	}{
+		{
+			name: "push",
+			b:    &library.Build{Event: &str, Ref: &str},
+			want: map[string]string{},
+		},
+		{
+			name: "release",
+			b:    &library.Build{Event: &release, EventAction: &published, Message: &str, Ref: &tagref},
+			want: map[string]string{"BUILD_TAG": "v1.0.0", "VELA_BUILD_TAG": "v1.0.0", "VELA_RELEASE_TAG": "v1.0.0", "VELA_RELEASE_NAME": "foo", "VELA_RELEASE_PRERELEASE": "false"},
+		},
+		{
+			name: "prerelease",
+			b:    &library.Build{Event: &release, EventAction: &prereleased, Message: &str, Ref: &tagref},
+			want: map[string]string{"BUILD_TAG": "v1.0.0", "VELA_BUILD_TAG": "v1.0.0", "VELA_RELEASE_TAG": "v1.0.0", "VELA_RELEASE_NAME": "foo", "VELA_RELEASE_PRERELEASE": "true"},
+		},
+		{
+			name: "pull_request_review",
+			b:    &library.Build{Event: &review, EventAction: &approved, Sender: &str, Ref: &pullref, HeadRef: &headref, BaseRef: &baseref},
+			want: map[string]string{"BUILD_PULL_REQUEST_NUMBER": "1", "VELA_BUILD_PULL_REQUEST": "1", "VELA_PULL_REQUEST": "1", "VELA_PULL_REQUEST_SOURCE": "changes", "VELA_PULL_REQUEST_TARGET": "main", "VELA_PULL_REQUEST_REVIEW_STATE": "approved", "VELA_PULL_REQUEST_REVIEWER": "foo"},
+		},
+	}
+
+	// run test
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := eventEnvironment(test.b)
+
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("eventEnvironment mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func Test_mergeMap(t *testing.T) {
 	type args struct {
 		combinedMap map[string]string
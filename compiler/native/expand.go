@@ -89,6 +89,9 @@ func (c *client) ExpandSteps(s *yaml.Build, tmpls map[string]*yaml.Template, r *
 				Steps: *check.ToPipeline(),
 			}
 
+			// expand the server events in the ruleset for the step
+			expandEvents(pipeline)
+
 			pipeline, err := pipeline.Purge(r)
 			if err != nil {
 				return nil, fmt.Errorf("unable to purge pipeline: %w", err)
@@ -4,7 +4,9 @@ package native
 
 import (
 	"fmt"
+	"strings"
 
+	serverconstants "github.com/go-vela/server/constants"
 	"github.com/go-vela/types/pipeline"
 	"github.com/go-vela/types/yaml"
 )
@@ -35,6 +37,24 @@ const (
 	secretID = "secret_%s_%s_%d_%s"
 )
 
+// eventActions represents the actions for the events handled
+// by the server that a ruleset matches when the event is
+// provided without an action (i.e. release).
+var eventActions = map[string][]string{
+	serverconstants.EventMergeGroup: {
+		serverconstants.ActionChecksRequested,
+	},
+	serverconstants.EventRelease: {
+		serverconstants.ActionPublished,
+		serverconstants.ActionPrereleased,
+	},
+	serverconstants.EventReview: {
+		serverconstants.ActionApproved,
+		serverconstants.ActionChangesRequested,
+		serverconstants.ActionCommented,
+	},
+}
+
 // TransformStages converts a yaml configuration with stages into an executable pipeline.
 func (c *client) TransformStages(r *pipeline.RuleData, p *yaml.Build) (*pipeline.Build, error) {
 	// capture variables for setting the unique ID fields
@@ -113,6 +133,9 @@ func (c *client) TransformStages(r *pipeline.RuleData, p *yaml.Build) (*pipeline
 		secret.Origin.ID = pattern
 	}
 
+	// expand the server events in the rulesets for the executable pipeline
+	expandEvents(pipeline)
+
 	build, err := pipeline.Purge(r)
 	if err != nil {
 		return nil, fmt.Errorf("unable to purge pipeline: %w", err)
@@ -197,6 +220,9 @@ func (c *client) TransformSteps(r *pipeline.RuleData, p *yaml.Build) (*pipeline.
 		secret.Origin.ID = pattern
 	}
 
+	// expand the server events in the rulesets for the executable pipeline
+	expandEvents(pipeline)
+
 	build, err := pipeline.Purge(r)
 	if err != nil {
 		return nil, fmt.Errorf("unable to purge pipeline: %w", err)
@@ -204,3 +230,50 @@ func (c *client) TransformSteps(r *pipeline.RuleData, p *yaml.Build) (*pipeline.
 
 	return build, nil
 }
+
+// expandEvents is a helper function to expand the server events
+// without an action in the rulesets of an executable pipeline into
+// the event:action pairs matched by the ruledata for the build.
+func expandEvents(p *pipeline.Build) {
+	containers := pipeline.ContainerSlice{}
+	containers = append(containers, p.Steps...)
+	containers = append(containers, p.Services...)
+
+	for _, stage := range p.Stages {
+		containers = append(containers, stage.Steps...)
+	}
+
+	for _, container := range containers {
+		if container == nil {
+			continue
+		}
+
+		container.Ruleset.If.Event = expandEventRuletype(container.Ruleset.If.Event)
+		container.Ruleset.Unless.Event = expandEventRuletype(container.Ruleset.Unless.Event)
+	}
+}
+
+// expandEventRuletype is a helper function to expand the
+// server events without an action in an event ruletype.
+func expandEventRuletype(events pipeline.Ruletype) pipeline.Ruletype {
+	if len(events) == 0 {
+		return events
+	}
+
+	expanded := pipeline.Ruletype{}
+
+	for _, event := range events {
+		actions, ok := eventActions[strings.ToLower(event)]
+		if !ok {
+			expanded = append(expanded, event)
+
+			continue
+		}
+
+		for _, action := range actions {
+			expanded = append(expanded, event+":"+action)
+		}
+	}
+
+	return expanded
+}
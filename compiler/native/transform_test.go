@@ -460,3 +460,72 @@ func TestNative_TransformSteps(t *testing.T) {
 		}
 	}
 }
+
+func TestNative_expandEvents(t *testing.T) {
+	// setup types
+	p := &pipeline.Build{
+		Stages: pipeline.StageSlice{
+			{
+				Name: "release",
+				Steps: pipeline.ContainerSlice{
+					{
+						Name: "publish",
+						Ruleset: pipeline.Ruleset{
+							If: pipeline.Rules{Event: []string{"release"}},
+						},
+					},
+				},
+			},
+		},
+		Steps: pipeline.ContainerSlice{
+			{
+				Name: "approved",
+				Ruleset: pipeline.Ruleset{
+					If: pipeline.Rules{Event: []string{"pull_request_review:approved"}},
+				},
+			},
+			{
+				Name: "reviews",
+				Ruleset: pipeline.Ruleset{
+					If:     pipeline.Rules{Event: []string{"push", "pull_request_review"}},
+					Unless: pipeline.Rules{Event: []string{"merge_group"}},
+				},
+			},
+		},
+		Services: pipeline.ContainerSlice{
+			{
+				Name: "postgres",
+			},
+		},
+	}
+
+	// run test
+	expandEvents(p)
+
+	if got, want := p.Stages[0].Steps[0].Ruleset.If.Event, (pipeline.Ruletype{"release:published", "release:prereleased"}); !reflect.DeepEqual(got, want) {
+		t.Errorf("expandEvents for stage step is %v, want %v", got, want)
+	}
+
+	if got, want := p.Steps[0].Ruleset.If.Event, (pipeline.Ruletype{"pull_request_review:approved"}); !reflect.DeepEqual(got, want) {
+		t.Errorf("expandEvents for step with action is %v, want %v", got, want)
+	}
+
+	want := pipeline.Ruletype{
+		"push",
+		"pull_request_review:approved",
+		"pull_request_review:changes_requested",
+		"pull_request_review:commented",
+	}
+
+	if got := p.Steps[1].Ruleset.If.Event; !reflect.DeepEqual(got, want) {
+		t.Errorf("expandEvents for step is %v, want %v", got, want)
+	}
+
+	if got, want := p.Steps[1].Ruleset.Unless.Event, (pipeline.Ruletype{"merge_group:checks_requested"}); !reflect.DeepEqual(got, want) {
+		t.Errorf("expandEvents for unless is %v, want %v", got, want)
+	}
+
+	if got := p.Services[0].Ruleset.If.Event; len(got) != 0 {
+		t.Errorf("expandEvents for service is %v, want empty", got)
+	}
+}
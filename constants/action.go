@@ -0,0 +1,33 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package constants
+
+// Server event actions.
+const (
+	// ActionRerequested defines the action for re-requesting a check run.
+	ActionRerequested = "rerequested"
+
+	// ActionChecksRequested defines the action for requesting checks on a merge group.
+	ActionChecksRequested = "checks_requested"
+
+	// ActionDestroyed defines the action for destroying a merge group.
+	ActionDestroyed = "destroyed"
+
+	// ActionPublished defines the action for publishing a release.
+	ActionPublished = "published"
+
+	// ActionPrereleased defines the action for publishing a pre-release.
+	ActionPrereleased = "prereleased"
+
+	// ActionSubmitted defines the action for submitting a pull request review.
+	ActionSubmitted = "submitted"
+
+	// ActionApproved defines the action for an approved pull request review.
+	ActionApproved = "approved"
+
+	// ActionChangesRequested defines the action for a pull request review requesting changes.
+	ActionChangesRequested = "changes_requested"
+
+	// ActionCommented defines the action for a commented pull request review.
+	ActionCommented = "commented"
+)
@@ -0,0 +1,18 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package constants
+
+// Server database drivers.
+const (
+	// DriverMySQL defines the driver type when integrating with a MySQL or MariaDB database.
+	DriverMySQL = "mysql"
+)
+
+// Server database tables.
+const (
+	// TableSchemaMigration defines the table type for the database schema_migrations table.
+	TableSchemaMigration = "schema_migrations"
+
+	// TableLogChunk defines the table type for the database log_chunks table.
+	TableLogChunk = "log_chunks"
+)
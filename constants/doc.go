@@ -0,0 +1,9 @@
+// SPDX-License-Identifier: Apache-2.0
+
+// Package constants provides the defined constant types for the
+// Vela server that are not yet provided by the go-vela/types module.
+//
+// Usage:
+//
+//	import "github.com/go-vela/server/constants"
+package constants
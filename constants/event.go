@@ -0,0 +1,18 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package constants
+
+// Server events.
+const (
+	// EventCheckRun defines the event type for check run events.
+	EventCheckRun = "check_run"
+
+	// EventMergeGroup defines the event type for merge queue merge group events.
+	EventMergeGroup = "merge_group"
+
+	// EventRelease defines the event type for release events.
+	EventRelease = "release"
+
+	// EventReview defines the event type for pull request review events.
+	EventReview = "pull_request_review"
+)
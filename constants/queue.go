@@ -0,0 +1,27 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package constants
+
+// Server queue priorities.
+const (
+	// PriorityMin defines the lowest priority for a queue item.
+	PriorityMin int64 = 0
+
+	// PriorityLow defines the priority for queue items of scheduled builds.
+	PriorityLow int64 = 2
+
+	// PriorityDefault defines the priority for queue items without a priority.
+	PriorityDefault int64 = 5
+
+	// PriorityHigh defines the priority for queue items of deployment builds.
+	PriorityHigh int64 = 8
+
+	// PriorityMax defines the highest priority for a queue item.
+	PriorityMax int64 = 10
+)
+
+// Server queue drivers.
+const (
+	// DriverMemory defines the driver type when integrating with an in-memory queue.
+	DriverMemory = "memory"
+)
@@ -0,0 +1,15 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package constants
+
+// Server log storage drivers.
+const (
+	// DriverDatabase defines the driver type when storing log data in the database.
+	DriverDatabase = "database"
+
+	// DriverFilesystem defines the driver type when storing log data in a local directory.
+	DriverFilesystem = "filesystem"
+
+	// DriverS3 defines the driver type when storing log data in an S3-compatible object store.
+	DriverS3 = "s3"
+)
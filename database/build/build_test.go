@@ -13,6 +13,7 @@ import (
 	"github.com/go-vela/types/library"
 	"github.com/sirupsen/logrus"
 
+	"gorm.io/driver/mysql"
 	"gorm.io/driver/postgres"
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
@@ -154,6 +155,42 @@ func testPostgres(t *testing.T) (*engine, sqlmock.Sqlmock) {
 	return _engine, _mock
 }
 
+// testMySQL is a helper function to create a MySQL engine for testing.
+func testMySQL(t *testing.T) (*engine, sqlmock.Sqlmock) {
+	// create the new mock sql database
+	//
+	// https://pkg.go.dev/github.com/DATA-DOG/go-sqlmock#New
+	_sql, _mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Errorf("unable to create new SQL mock: %v", err)
+	}
+
+	_mock.ExpectExec(CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+
+	// create the new mock MySQL database client
+	//
+	// https://pkg.go.dev/gorm.io/gorm#Open
+	_mysql, err := gorm.Open(
+		mysql.New(mysql.Config{Conn: _sql, SkipInitializeWithVersion: true}),
+		&gorm.Config{SkipDefaultTransaction: true},
+	)
+	if err != nil {
+		t.Errorf("unable to create new mysql database: %v", err)
+	}
+
+	_engine, err := New(
+		WithContext(context.TODO()),
+		WithClient(_mysql),
+		WithLogger(logrus.NewEntry(logrus.StandardLogger())),
+		WithSkipCreation(false),
+	)
+	if err != nil {
+		t.Errorf("unable to create new mysql build engine: %v", err)
+	}
+
+	return _engine, _mock
+}
+
 // testSqlite is a helper function to create a Sqlite engine for testing.
 func testSqlite(t *testing.T) *engine {
 	_sqlite, err := gorm.Open(
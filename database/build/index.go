@@ -2,7 +2,11 @@
 
 package build
 
-import "context"
+import (
+	"context"
+
+	"github.com/go-vela/server/constants"
+)
 
 const (
 	// CreateCreatedIndex represents a query to create an
@@ -46,6 +50,11 @@ ON builds (status);
 func (e *engine) CreateBuildIndexes(ctx context.Context) error {
 	e.logger.Tracef("creating indexes for builds table in the database")
 
+	// the indexes are created with the MySQL builds table
+	if e.client.Config.Dialector.Name() == constants.DriverMySQL {
+		return nil
+	}
+
 	// create the created column index for the builds table
 	err := e.client.Exec(CreateCreatedIndex).Error
 	if err != nil {
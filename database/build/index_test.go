@@ -19,6 +19,9 @@ func TestBuild_Engine_CreateBuildIndexes(t *testing.T) {
 	_mock.ExpectExec(CreateSourceIndex).WillReturnResult(sqlmock.NewResult(1, 1))
 	_mock.ExpectExec(CreateStatusIndex).WillReturnResult(sqlmock.NewResult(1, 1))
 
+	_mysql, _ := testMySQL(t)
+	defer func() { _sql, _ := _mysql.client.DB(); _sql.Close() }()
+
 	_sqlite := testSqlite(t)
 	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
 
@@ -28,6 +31,11 @@ func TestBuild_Engine_CreateBuildIndexes(t *testing.T) {
 		name     string
 		database *engine
 	}{
+		{
+			failure:  false,
+			name:     "mysql",
+			database: _mysql,
+		},
 		{
 			failure:  false,
 			name:     "postgres",
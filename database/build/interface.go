@@ -60,6 +60,10 @@ type BuildInterface interface {
 	ListPendingAndRunningBuilds(context.Context, string) ([]*library.BuildQueue, error)
 	// ListPendingAndRunningBuildsForRepo defines a function that gets a list of pending and running builds for a repo.
 	ListPendingAndRunningBuildsForRepo(context.Context, *library.Repo) ([]*library.Build, error)
+	// ListPendingBuilds defines a function that gets a list of pending builds.
+	ListPendingBuilds(context.Context, string) ([]*library.Build, error)
 	// UpdateBuild defines a function that updates an existing build.
 	UpdateBuild(context.Context, *library.Build) (*library.Build, error)
+	// UpdateBuildFromStatus defines a function that updates an existing build only when it still has a given status.
+	UpdateBuildFromStatus(context.Context, *library.Build, string) (bool, error)
 }
@@ -5,6 +5,7 @@ package build
 import (
 	"context"
 
+	serverconstants "github.com/go-vela/server/constants"
 	"github.com/go-vela/types/constants"
 )
 
@@ -89,6 +90,53 @@ builds (
 	timestamp      INTEGER,
 	UNIQUE(repo_id, number)
 );
+`
+
+	// CreateMySQLTable represents a query to create the MySQL builds table
+	// with its indexes, since MySQL does not support creating an index
+	// only if it does not exist.
+	CreateMySQLTable = `
+CREATE TABLE
+IF NOT EXISTS
+builds (
+	id             INTEGER NOT NULL AUTO_INCREMENT PRIMARY KEY,
+	repo_id        INTEGER,
+	pipeline_id    INTEGER,
+	number         INTEGER,
+	parent         INTEGER,
+	event          VARCHAR(250),
+	event_action   VARCHAR(250),
+	status         VARCHAR(250),
+	error          VARCHAR(1000),
+	enqueued       INTEGER,
+	created        INTEGER,
+	started        INTEGER,
+	finished       INTEGER,
+	deploy         VARCHAR(500),
+	deploy_payload VARCHAR(2000),
+	clone          VARCHAR(1000),
+	source         VARCHAR(1000),
+	title          VARCHAR(1000),
+	message        VARCHAR(2000),
+	commit         VARCHAR(500),
+	sender         VARCHAR(250),
+	author         VARCHAR(250),
+	email          VARCHAR(500),
+	link           VARCHAR(1000),
+	branch         VARCHAR(500),
+	ref            VARCHAR(500),
+	base_ref       VARCHAR(500),
+	head_ref       VARCHAR(500),
+	host           VARCHAR(250),
+	runtime        VARCHAR(250),
+	distribution   VARCHAR(250),
+	timestamp      INTEGER,
+	UNIQUE(repo_id, number),
+	INDEX builds_created (created),
+	INDEX builds_repo_id (repo_id),
+	INDEX builds_source (source(250)),
+	INDEX builds_status (status)
+);
 `
 )
 
@@ -101,6 +149,9 @@ func (e *engine) CreateBuildTable(ctx context.Context, driver string) error {
 	case constants.DriverPostgres:
 		// create the builds table for Postgres
 		return e.client.Exec(CreatePostgresTable).Error
+	case serverconstants.DriverMySQL:
+		// create the builds table for MySQL
+		return e.client.Exec(CreateMySQLTable).Error
 	case constants.DriverSqlite:
 		fallthrough
 	default:
@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package build
+
+import (
+	"context"
+
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/database"
+	"github.com/go-vela/types/library"
+	"github.com/sirupsen/logrus"
+)
+
+// UpdateBuildFromStatus updates an existing build in the database only when
+// the build still has the provided status, reporting if the build was updated.
+func (e *engine) UpdateBuildFromStatus(ctx context.Context, b *library.Build, status string) (bool, error) {
+	e.logger.WithFields(logrus.Fields{
+		"build": b.GetNumber(),
+	}).Tracef("updating %s build %d in the database", status, b.GetNumber())
+
+	// cast the library type to database type
+	//
+	// https://pkg.go.dev/github.com/go-vela/types/database#BuildFromLibrary
+	build := database.BuildFromLibrary(b)
+
+	// validate the necessary fields are populated
+	//
+	// https://pkg.go.dev/github.com/go-vela/types/database#Build.Validate
+	err := build.Validate()
+	if err != nil {
+		return false, err
+	}
+
+	// crop build if any columns are too large
+	build = build.Crop()
+
+	// send query to the database
+	result := e.client.
+		Table(constants.TableBuild).
+		Where("status = ?", status).
+		Select("*").
+		Updates(build)
+
+	return result.RowsAffected > 0, result.Error
+}
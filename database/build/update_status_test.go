@@ -0,0 +1,130 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package build
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-vela/types/constants"
+)
+
+func TestBuild_Engine_UpdateBuildFromStatus(t *testing.T) {
+	// setup types
+	_build := testBuild()
+	_build.SetID(1)
+	_build.SetRepoID(1)
+	_build.SetNumber(1)
+	_build.SetDeployPayload(nil)
+	_build.SetStatus(constants.StatusError)
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// ensure the mock expects the query
+	_mock.ExpectExec(`UPDATE "builds" SET "repo_id"=$1,"pipeline_id"=$2,"number"=$3,"parent"=$4,"event"=$5,"event_action"=$6,"status"=$7,"error"=$8,"enqueued"=$9,"created"=$10,"started"=$11,"finished"=$12,"deploy"=$13,"deploy_payload"=$14,"clone"=$15,"source"=$16,"title"=$17,"message"=$18,"commit"=$19,"sender"=$20,"author"=$21,"email"=$22,"link"=$23,"branch"=$24,"ref"=$25,"base_ref"=$26,"head_ref"=$27,"host"=$28,"runtime"=$29,"distribution"=$30 WHERE status = $31 AND "id" = $32`).
+		WithArgs(1, nil, 1, nil, nil, nil, constants.StatusError, nil, nil, nil, nil, nil, nil, AnyArgument{}, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, constants.StatusPending, 1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	_pending := testBuild()
+	_pending.SetRepoID(1)
+	_pending.SetNumber(1)
+	_pending.SetStatus(constants.StatusPending)
+
+	_pending, err := _sqlite.CreateBuild(context.TODO(), _pending)
+	if err != nil {
+		t.Errorf("unable to create test build for sqlite: %v", err)
+	}
+
+	_running := testBuild()
+	_running.SetRepoID(1)
+	_running.SetNumber(2)
+	_running.SetStatus(constants.StatusRunning)
+
+	_running, err = _sqlite.CreateBuild(context.TODO(), _running)
+	if err != nil {
+		t.Errorf("unable to create test build for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		id       int64
+		want     bool
+		status   string
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			id:       1,
+			want:     true,
+			status:   constants.StatusError,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3 pending",
+			database: _sqlite,
+			id:       _pending.GetID(),
+			want:     true,
+			status:   constants.StatusError,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3 running",
+			database: _sqlite,
+			id:       _running.GetID(),
+			want:     false,
+			status:   constants.StatusRunning,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			b := testBuild()
+			b.SetID(test.id)
+			b.SetRepoID(1)
+			b.SetNumber(int(test.id))
+			b.SetDeployPayload(nil)
+			b.SetStatus(constants.StatusError)
+
+			got, err := test.database.UpdateBuildFromStatus(context.TODO(), b, constants.StatusPending)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("UpdateBuildFromStatus for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("UpdateBuildFromStatus for %s returned err: %v", test.name, err)
+			}
+
+			if got != test.want {
+				t.Errorf("UpdateBuildFromStatus for %s is %v, want %v", test.name, got, test.want)
+			}
+
+			if test.database == _postgres {
+				return
+			}
+
+			stored, err := test.database.GetBuild(context.TODO(), test.id)
+			if err != nil {
+				t.Errorf("unable to get test build for %s: %v", test.name, err)
+			}
+
+			if stored.GetStatus() != test.status {
+				t.Errorf("UpdateBuildFromStatus for %s stored status %s, want %s", test.name, stored.GetStatus(), test.status)
+			}
+		})
+	}
+}
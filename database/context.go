@@ -5,6 +5,7 @@ package database
 import (
 	"context"
 
+	"github.com/go-vela/server/database/migration"
 	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli/v2"
 )
@@ -37,11 +38,12 @@ func ToContext(c Setter, d Interface) {
 	c.Set(key, d)
 }
 
-// FromCLIContext creates and returns a database engine from the urfave/cli context.
-func FromCLIContext(c *cli.Context) (Interface, error) {
+// FromCLIContext creates and returns a database engine from the urfave/cli context
+// with the provided options applied after the options from the CLI configuration.
+func FromCLIContext(c *cli.Context, opts ...EngineOpt) (Interface, error) {
 	logrus.Debug("creating database engine from CLI configuration")
 
-	return New(
+	return New(append([]EngineOpt{
 		WithAddress(c.String("database.addr")),
 		WithCompressionLevel(c.Int("database.compression.level")),
 		WithConnectionLife(c.Duration("database.connection.life")),
@@ -50,5 +52,20 @@ func FromCLIContext(c *cli.Context) (Interface, error) {
 		WithDriver(c.String("database.driver")),
 		WithEncryptionKey(c.String("database.encryption.key")),
 		WithSkipCreation(c.Bool("database.skip_creation")),
+	}, opts...)...)
+}
+
+// MigratorFromCLIContext creates and returns a migrator for the schema of the database from the urfave/cli context.
+func MigratorFromCLIContext(c *cli.Context) (*migration.Migrator, error) {
+	logrus.Debug("creating database migrator from CLI configuration")
+
+	return NewMigrator(
+		WithAddress(c.String("database.addr")),
+		WithCompressionLevel(c.Int("database.compression.level")),
+		WithConnectionLife(c.Duration("database.connection.life")),
+		WithConnectionIdle(c.Int("database.connection.idle")),
+		WithConnectionOpen(c.Int("database.connection.open")),
+		WithDriver(c.String("database.driver")),
+		WithEncryptionKey(c.String("database.encryption.key")),
 	)
 }
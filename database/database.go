@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"time"
 
+	serverconstants "github.com/go-vela/server/constants"
 	"github.com/go-vela/server/database/build"
 	"github.com/go-vela/server/database/executable"
 	"github.com/go-vela/server/database/hook"
@@ -19,9 +20,11 @@ import (
 	"github.com/go-vela/server/database/step"
 	"github.com/go-vela/server/database/user"
 	"github.com/go-vela/server/database/worker"
+	"github.com/go-vela/server/storage"
 	"github.com/go-vela/types/constants"
 	"github.com/sirupsen/logrus"
 
+	"gorm.io/driver/mysql"
 	"gorm.io/driver/postgres"
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
@@ -44,7 +47,7 @@ type (
 		Driver string
 		// specifies the encryption key to use for the database engine
 		EncryptionKey string
-		// specifies to skip creating tables and indexes for the database engine
+		// specifies to skip applying the schema migrations for the database engine
 		SkipCreation bool
 	}
 
@@ -58,6 +61,8 @@ type (
 		ctx context.Context
 		// sirupsen/logrus logger used in database functions
 		logger *logrus.Entry
+		// log storage client used in log functions, nil when log data is kept in the database
+		storage storage.Service
 
 		build.BuildInterface
 		executable.BuildExecutableInterface
@@ -78,10 +83,34 @@ type (
 //
 // Currently, the following database providers are supported:
 //
+// * mysql
 // * postgres
 // * sqlite3
 // .
 func New(opts ...EngineOpt) (Interface, error) {
+	e, err := open(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	// verify the schema and apply the pending migrations for the database
+	err = e.migrate(e.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// create database agnostic engines for resources
+	err = e.NewResources(e.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return e, nil
+}
+
+// open is a helper function to create an engine with a verified
+// connection to the configured database provider.
+func open(opts ...EngineOpt) (*engine, error) {
 	// create new database engine
 	e := new(engine)
 
@@ -117,6 +146,12 @@ func New(opts ...EngineOpt) (Interface, error) {
 		if err != nil {
 			return nil, err
 		}
+	case serverconstants.DriverMySQL:
+		// create the new MySQL database client
+		e.client, err = gorm.Open(mysql.Open(e.config.Address), &gorm.Config{})
+		if err != nil {
+			return nil, err
+		}
 	case constants.DriverSqlite:
 		// create the new Sqlite database client
 		e.client, err = gorm.Open(sqlite.Open(e.config.Address), &gorm.Config{})
@@ -147,12 +182,6 @@ func New(opts ...EngineOpt) (Interface, error) {
 		return nil, err
 	}
 
-	// create database agnostic engines for resources
-	err = e.NewResources(e.ctx)
-	if err != nil {
-		return nil, err
-	}
-
 	return e, nil
 }
 
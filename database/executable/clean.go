@@ -5,6 +5,7 @@ package executable
 import (
 	"context"
 
+	serverconstants "github.com/go-vela/server/constants"
 	"github.com/go-vela/types/constants"
 	"github.com/sirupsen/logrus"
 )
@@ -16,6 +17,14 @@ const CleanExecutablesPostgres = `
 	AND builds.status = 'error';
 `
 
+const CleanExecutablesMySQL = `
+	DELETE build_executables
+	FROM build_executables
+	INNER JOIN builds
+		ON builds.id = build_executables.build_id
+	WHERE builds.status = 'error';
+`
+
 const CleanExecutablesSqlite = `
 	DELETE FROM build_executables
 	WHERE build_id IN (
@@ -34,6 +43,9 @@ func (e *engine) CleanBuildExecutables(ctx context.Context) (int64, error) {
 	case constants.DriverPostgres:
 		res := e.client.Exec(CleanExecutablesPostgres)
 		return res.RowsAffected, res.Error
+	case serverconstants.DriverMySQL:
+		res := e.client.Exec(CleanExecutablesMySQL)
+		return res.RowsAffected, res.Error
 	default:
 		res := e.client.Exec(CleanExecutablesSqlite)
 		return res.RowsAffected, res.Error
@@ -49,6 +49,16 @@ func TestExecutable_Engine_CleanExecutables(t *testing.T) {
 
 	_mock.ExpectQuery(`DELETE FROM "build_executables" WHERE build_id = $1 RETURNING *`).WithArgs(2).WillReturnError(fmt.Errorf("not found"))
 
+	_mysql, _mysqlMock := testMySQL(t)
+	defer func() { _sql, _ := _mysql.client.DB(); _sql.Close() }()
+
+	_mysqlMock.ExpectExec("DELETE build_executables FROM build_executables INNER JOIN builds ON builds.id = build_executables.build_id WHERE builds.status = 'error';").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	_mysqlMock.ExpectBegin()
+	_mysqlMock.ExpectQuery("SELECT * FROM `build_executables` WHERE build_id = ? LIMIT 1 FOR UPDATE").WithArgs(2).WillReturnError(fmt.Errorf("not found"))
+	_mysqlMock.ExpectRollback()
+
 	_sqlite := testSqlite(t)
 	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
 
@@ -88,6 +98,11 @@ func TestExecutable_Engine_CleanExecutables(t *testing.T) {
 			name:     "postgres",
 			database: _postgres,
 		},
+		{
+			failure:  false,
+			name:     "mysql",
+			database: _mysql,
+		},
 		{
 			failure:  false,
 			name:     "sqlite3",
@@ -66,7 +66,7 @@ func New(opts ...EngineOpt) (*engine, error) {
 
 	// check if we should skip creating build executable database objects
 	if e.config.SkipCreation {
-		e.logger.Warning("skipping creation of build executables table and indexes in the database")
+		e.logger.Trace("skipping creation of build executables table and indexes in the database")
 
 		return e, nil
 	}
@@ -8,10 +8,12 @@ import (
 	"testing"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	serverconstants "github.com/go-vela/server/constants"
 	"github.com/go-vela/types/constants"
 	"github.com/go-vela/types/library"
 	"github.com/sirupsen/logrus"
 
+	"gorm.io/driver/mysql"
 	"gorm.io/driver/postgres"
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
@@ -163,6 +165,44 @@ func testPostgres(t *testing.T) (*engine, sqlmock.Sqlmock) {
 	return _engine, _mock
 }
 
+// testMySQL is a helper function to create a MySQL engine for testing.
+func testMySQL(t *testing.T) (*engine, sqlmock.Sqlmock) {
+	// create the new mock sql database
+	//
+	// https://pkg.go.dev/github.com/DATA-DOG/go-sqlmock#New
+	_sql, _mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Errorf("unable to create new SQL mock: %v", err)
+	}
+
+	_mock.ExpectExec(CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+
+	// create the new mock MySQL database client
+	//
+	// https://pkg.go.dev/gorm.io/gorm#Open
+	_mysql, err := gorm.Open(
+		mysql.New(mysql.Config{Conn: _sql, SkipInitializeWithVersion: true}),
+		&gorm.Config{SkipDefaultTransaction: true},
+	)
+	if err != nil {
+		t.Errorf("unable to create new mysql database: %v", err)
+	}
+
+	_engine, err := New(
+		WithClient(_mysql),
+		WithCompressionLevel(0),
+		WithEncryptionKey("A1B2C3D4E5G6H7I8J9K0LMNOPQRSTUVW"),
+		WithLogger(logrus.NewEntry(logrus.StandardLogger())),
+		WithSkipCreation(false),
+		WithDriver(serverconstants.DriverMySQL),
+	)
+	if err != nil {
+		t.Errorf("unable to create new mysql build_itnerary engine: %v", err)
+	}
+
+	return _engine, _mock
+}
+
 // testSqlite is a helper function to create a Sqlite engine for testing.
 func testSqlite(t *testing.T) *engine {
 	_sqlite, err := gorm.Open(
@@ -5,9 +5,11 @@ package executable
 import (
 	"context"
 
+	serverconstants "github.com/go-vela/server/constants"
 	"github.com/go-vela/types/constants"
 	"github.com/go-vela/types/database"
 	"github.com/go-vela/types/library"
+	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 )
 
@@ -34,6 +36,31 @@ func (e *engine) PopBuildExecutable(ctx context.Context, id int64) (*library.Bui
 			return nil, err
 		}
 
+	case serverconstants.DriverMySQL:
+		// MySQL does not support RETURNING so we lock the row
+		// while selecting it to delete within a transaction.
+		err := e.client.Transaction(func(tx *gorm.DB) error {
+			// send query to the database and store result in variable
+			err := tx.
+				Table(constants.TableBuildExecutable).
+				Clauses(clause.Locking{Strength: "UPDATE"}).
+				Where("build_id = ?", id).
+				Take(b).
+				Error
+			if err != nil {
+				return err
+			}
+
+			// send query to the database to delete result just got
+			return tx.
+				Table(constants.TableBuildExecutable).
+				Delete(b).
+				Error
+		})
+		if err != nil {
+			return nil, err
+		}
+
 	case constants.DriverSqlite:
 		// send query to the database and store result in variable
 		err := e.client.
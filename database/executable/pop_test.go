@@ -29,6 +29,20 @@ func TestExecutable_Engine_PopBuildExecutable(t *testing.T) {
 	// ensure the mock expects the query
 	_mock.ExpectQuery(`DELETE FROM "build_executables" WHERE build_id = $1 RETURNING *`).WithArgs(1).WillReturnRows(_rows)
 
+	_mysql, _mysqlMock := testMySQL(t)
+	defer func() { _sql, _ := _mysql.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_mysqlRows := sqlmock.NewRows(
+		[]string{"id", "build_id", "data"}).
+		AddRow(1, 1, "+//18dbf7mF+v7ZPK3Wo5h2TD6v4Zg95sCMUJYO2tpwY37DEgTxW5xdyt3Tey9w=")
+
+	// ensure the mock expects the queries
+	_mysqlMock.ExpectBegin()
+	_mysqlMock.ExpectQuery("SELECT * FROM `build_executables` WHERE build_id = ? LIMIT 1 FOR UPDATE").WithArgs(1).WillReturnRows(_mysqlRows)
+	_mysqlMock.ExpectExec("DELETE FROM `build_executables` WHERE `build_executables`.`id` = ?").WithArgs(1).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mysqlMock.ExpectCommit()
+
 	_sqlite := testSqlite(t)
 	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
 
@@ -50,6 +64,12 @@ func TestExecutable_Engine_PopBuildExecutable(t *testing.T) {
 			database: _postgres,
 			want:     _bExecutable,
 		},
+		{
+			failure:  false,
+			name:     "mysql",
+			database: _mysql,
+			want:     _bExecutable,
+		},
 		{
 			failure:  false,
 			name:     "sqlite3",
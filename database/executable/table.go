@@ -5,6 +5,7 @@ package executable
 import (
 	"context"
 
+	serverconstants "github.com/go-vela/server/constants"
 	"github.com/go-vela/types/constants"
 )
 
@@ -31,6 +32,18 @@ build_executables (
 	data             BLOB,
 	UNIQUE(build_id)
 );
+`
+
+	// CreateMySQLTable represents a query to create the MySQL build_executables table.
+	CreateMySQLTable = `
+CREATE TABLE
+IF NOT EXISTS
+build_executables (
+	id               INTEGER NOT NULL AUTO_INCREMENT PRIMARY KEY,
+	build_id         INTEGER,
+	data             LONGBLOB,
+	UNIQUE(build_id)
+);
 `
 )
 
@@ -43,6 +56,9 @@ func (e *engine) CreateBuildExecutableTable(ctx context.Context, driver string)
 	case constants.DriverPostgres:
 		// create the build_executables table for Postgres
 		return e.client.Exec(CreatePostgresTable).Error
+	case serverconstants.DriverMySQL:
+		// create the build_executables table for MySQL
+		return e.client.Exec(CreateMySQLTable).Error
 	case constants.DriverSqlite:
 		fallthrough
 	default:
@@ -16,6 +16,11 @@ func TestExecutable_Engine_CreateBuildExecutableTable(t *testing.T) {
 
 	_mock.ExpectExec(CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
 
+	_mysql, _mysqlMock := testMySQL(t)
+	defer func() { _sql, _ := _mysql.client.DB(); _sql.Close() }()
+
+	_mysqlMock.ExpectExec(CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+
 	_sqlite := testSqlite(t)
 	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
 
@@ -25,6 +30,11 @@ func TestExecutable_Engine_CreateBuildExecutableTable(t *testing.T) {
 		name     string
 		database *engine
 	}{
+		{
+			failure:  false,
+			name:     "mysql",
+			database: _mysql,
+		},
 		{
 			failure:  false,
 			name:     "postgres",
@@ -63,6 +63,6 @@ var Flags = []cli.Flag{
 		EnvVars:  []string{"VELA_DATABASE_SKIP_CREATION", "DATABASE_SKIP_CREATION"},
 		FilePath: "/vela/database/skip_creation",
 		Name:     "database.skip_creation",
-		Usage:    "enables skipping the creation of tables and indexes in the database",
+		Usage:    "enables skipping the schema migrations on startup - apply them with the migrate command instead",
 	},
 }
@@ -16,6 +16,9 @@ func TestHook_Engine_CreateHookIndexes(t *testing.T) {
 
 	_mock.ExpectExec(CreateRepoIDIndex).WillReturnResult(sqlmock.NewResult(1, 1))
 
+	_mysql, _ := testMySQL(t)
+	defer func() { _sql, _ := _mysql.client.DB(); _sql.Close() }()
+
 	_sqlite := testSqlite(t)
 	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
 
@@ -25,6 +28,11 @@ func TestHook_Engine_CreateHookIndexes(t *testing.T) {
 		name     string
 		database *engine
 	}{
+		{
+			failure:  false,
+			name:     "mysql",
+			database: _mysql,
+		},
 		{
 			failure:  false,
 			name:     "postgres",
@@ -5,6 +5,7 @@ package hook
 import (
 	"context"
 
+	serverconstants "github.com/go-vela/server/constants"
 	"github.com/go-vela/types/constants"
 )
 
@@ -53,6 +54,32 @@ hooks (
 	webhook_id   INTEGER,
 	UNIQUE(repo_id, number)
 );
+`
+
+	// CreateMySQLTable represents a query to create the MySQL hooks table
+	// with its indexes, since MySQL does not support creating an index
+	// only if it does not exist.
+	CreateMySQLTable = `
+CREATE TABLE
+IF NOT EXISTS
+hooks (
+	id           INTEGER NOT NULL AUTO_INCREMENT PRIMARY KEY,
+	repo_id      INTEGER,
+	build_id     INTEGER,
+	number       INTEGER,
+	source_id    VARCHAR(250),
+	created      INTEGER,
+	host         VARCHAR(250),
+	event        VARCHAR(250),
+	event_action VARCHAR(250),
+	branch       VARCHAR(500),
+	error        VARCHAR(500),
+	status       VARCHAR(250),
+	link         VARCHAR(1000),
+	webhook_id   INTEGER,
+	UNIQUE(repo_id, number),
+	INDEX hooks_repo_id (repo_id)
+);
 `
 )
 
@@ -65,6 +92,9 @@ func (e *engine) CreateHookTable(ctx context.Context, driver string) error {
 	case constants.DriverPostgres:
 		// create the hooks table for Postgres
 		return e.client.Exec(CreatePostgresTable).Error
+	case serverconstants.DriverMySQL:
+		// create the hooks table for MySQL
+		return e.client.Exec(CreateMySQLTable).Error
 	case constants.DriverSqlite:
 		fallthrough
 	default:
@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/go-vela/server/api/types"
 	"github.com/go-vela/server/database/build"
 	"github.com/go-vela/server/database/executable"
 	"github.com/go-vela/server/database/hook"
@@ -22,6 +23,8 @@ import (
 	"github.com/go-vela/server/database/step"
 	"github.com/go-vela/server/database/user"
 	"github.com/go-vela/server/database/worker"
+	"github.com/go-vela/server/internal/labels"
+	"github.com/go-vela/server/storage/filesystem"
 	"github.com/go-vela/types/constants"
 	"github.com/go-vela/types/library"
 	"github.com/go-vela/types/raw"
@@ -71,6 +74,19 @@ func TestDatabase_Integration(t *testing.T) {
 				SkipCreation:     false,
 			},
 		},
+		{
+			name: "mysql",
+			config: &config{
+				Driver:           "mysql",
+				Address:          os.Getenv("MYSQL_ADDR"),
+				CompressionLevel: 3,
+				ConnectionLife:   10 * time.Second,
+				ConnectionIdle:   5,
+				ConnectionOpen:   20,
+				EncryptionKey:    "A1B2C3D4E5G6H7I8J9K0LMNOPQRSTUVW",
+				SkipCreation:     false,
+			},
+		},
 		{
 			name: "sqlite3",
 			config: &config{
@@ -92,6 +108,12 @@ func TestDatabase_Integration(t *testing.T) {
 			// create resources for testing
 			resources := newResources()
 
+			// create a log storage for testing
+			_storage, err := filesystem.New(filesystem.WithDirectory(t.TempDir()))
+			if err != nil {
+				t.Errorf("unable to create new log storage for %s: %v", test.name, err)
+			}
+
 			db, err := New(
 				WithAddress(test.config.Address),
 				WithCompressionLevel(test.config.CompressionLevel),
@@ -101,6 +123,7 @@ func TestDatabase_Integration(t *testing.T) {
 				WithDriver(test.config.Driver),
 				WithEncryptionKey(test.config.EncryptionKey),
 				WithSkipCreation(test.config.SkipCreation),
+				WithLogStorage(_storage),
 			)
 			if err != nil {
 				t.Errorf("unable to create new database engine for %s: %v", test.name, err)
@@ -319,6 +342,16 @@ func testBuilds(t *testing.T, db Interface, resources *Resources) {
 	}
 	methods["ListPendingAndRunningBuilds"] = true
 
+	// list the pending builds
+	list, err = db.ListPendingBuilds(context.TODO(), "0")
+	if err != nil {
+		t.Errorf("unable to list pending builds: %v", err)
+	}
+	if len(list) != 0 {
+		t.Errorf("ListPendingBuilds() is %v, want %v", list, []*library.Build{})
+	}
+	methods["ListPendingBuilds"] = true
+
 	// lookup the last build by repo
 	got, err := db.LastBuildForRepo(context.TODO(), resources.Repos[0], "main")
 	if err != nil {
@@ -729,6 +762,57 @@ func testLogs(t *testing.T, db Interface, resources *Resources) {
 	methods["UpdateLog"] = true
 	methods["GetLog"] = true
 
+	// move the logs to the log storage
+	count, err = db.MigrateLogs(context.TODO(), 10)
+	if err != nil {
+		t.Errorf("unable to migrate logs: %v", err)
+	}
+	// the logs were already written to the log storage
+	if count != 0 {
+		t.Errorf("MigrateLogs() is %v, want %v", count, 0)
+	}
+	methods["MigrateLogs"] = true
+
+	// append chunks to the logs
+	for _, log := range resources.Logs {
+		chunk := new(types.LogChunk)
+		chunk.SetSequence(1)
+		chunk.SetData([]byte("\nbaz"))
+
+		err = db.AppendLogChunk(context.TODO(), log, chunk)
+		if err != nil {
+			t.Errorf("unable to append chunk to log %d: %v", log.GetID(), err)
+		}
+
+		// lookup the lines of the log
+		got, err := db.GetLogLines(context.TODO(), log, 1, 0)
+		if err != nil {
+			t.Errorf("unable to get lines of log %d: %v", log.GetID(), err)
+		}
+		if got.GetOffset() != 4 || string(got.GetData()) != "baz" {
+			t.Errorf("GetLogLines() is %v, want offset %d and data %s", got, 4, "baz")
+		}
+
+		// compact the chunks into the log
+		err = db.CompactLog(context.TODO(), log)
+		if err != nil {
+			t.Errorf("unable to compact log %d: %v", log.GetID(), err)
+		}
+
+		// lookup the bytes of the log
+		got, err = db.GetLogBytes(context.TODO(), log, 0, 0)
+		if err != nil {
+			t.Errorf("unable to get bytes of log %d: %v", log.GetID(), err)
+		}
+		if got.GetSequence() != 1 || string(got.GetData()) != "bar\nbaz" {
+			t.Errorf("GetLogBytes() is %v, want sequence %d and data %s", got, 1, "bar\nbaz")
+		}
+	}
+	methods["AppendLogChunk"] = true
+	methods["GetLogLines"] = true
+	methods["CompactLog"] = true
+	methods["GetLogBytes"] = true
+
 	// delete the logs
 	for _, log := range resources.Logs {
 		err = db.DeleteLog(context.TODO(), log)
@@ -991,6 +1075,48 @@ func testRepos(t *testing.T, db Interface, resources *Resources) {
 	methods["UpdateRepo"] = true
 	methods["GetRepo"] = true
 
+	// update and lookup the scm provider for the repos
+	for _, repo := range resources.Repos {
+		err = db.UpdateRepoProvider(context.TODO(), repo, "gitlab")
+		if err != nil {
+			t.Errorf("unable to update scm provider for repo %d: %v", repo.GetID(), err)
+		}
+
+		got, err := db.GetRepoProvider(context.TODO(), repo)
+		if err != nil {
+			t.Errorf("unable to get scm provider for repo %d: %v", repo.GetID(), err)
+		}
+		if got != "gitlab" {
+			t.Errorf("GetRepoProvider() is %v, want %v", got, "gitlab")
+		}
+	}
+	methods["UpdateRepoProvider"] = true
+	methods["GetRepoProvider"] = true
+
+	// update and lookup the settings for the repos
+	for _, repo := range resources.Repos {
+		settings := new(types.RepoSettings)
+		settings.SetAllowRelease(true)
+		settings.SetAllowReview(true)
+		settings.SetIgnoreSkipDirectives(true)
+		settings.SetPriority(8)
+
+		err = db.UpdateRepoSettings(context.TODO(), repo, settings)
+		if err != nil {
+			t.Errorf("unable to update settings for repo %d: %v", repo.GetID(), err)
+		}
+
+		got, err := db.GetRepoSettings(context.TODO(), repo)
+		if err != nil {
+			t.Errorf("unable to get settings for repo %d: %v", repo.GetID(), err)
+		}
+		if !cmp.Equal(got, settings) {
+			t.Errorf("GetRepoSettings() is %v, want %v", got, settings)
+		}
+	}
+	methods["UpdateRepoSettings"] = true
+	methods["GetRepoSettings"] = true
+
 	// delete the repos
 	for _, repo := range resources.Repos {
 		err = db.DeleteRepo(context.TODO(), repo)
@@ -1774,6 +1900,33 @@ func testUsers(t *testing.T, db Interface, resources *Resources) {
 	methods["UpdateUser"] = true
 	methods["GetUser"] = true
 
+	// update and lookup the scm provider for the users
+	for _, user := range resources.Users {
+		err = db.UpdateUserProvider(context.TODO(), user, "gitlab")
+		if err != nil {
+			t.Errorf("unable to update scm provider for user %d: %v", user.GetID(), err)
+		}
+
+		got, err := db.GetUserProvider(context.TODO(), user)
+		if err != nil {
+			t.Errorf("unable to get scm provider for user %d: %v", user.GetID(), err)
+		}
+		if got != "gitlab" {
+			t.Errorf("GetUserProvider() is %v, want %v", got, "gitlab")
+		}
+
+		gotUser, err := db.GetUserForProvider(context.TODO(), "gitlab", user.GetName())
+		if err != nil {
+			t.Errorf("unable to get user %s for scm provider: %v", user.GetName(), err)
+		}
+		if !cmp.Equal(gotUser, user) {
+			t.Errorf("GetUserForProvider() is %v, want %v", gotUser, user)
+		}
+	}
+	methods["UpdateUserProvider"] = true
+	methods["GetUserProvider"] = true
+	methods["GetUserForProvider"] = true
+
 	// delete the users
 	for _, user := range resources.Users {
 		err = db.DeleteUser(context.TODO(), user)
@@ -1865,6 +2018,37 @@ func testWorkers(t *testing.T, db Interface, resources *Resources) {
 	methods["UpdateWorker"] = true
 	methods["GetWorker"] = true
 
+	// update the labels for the workers
+	for _, worker := range resources.Workers {
+		l := labels.Set{"arch": "arm64", "worker": worker.GetHostname()}
+
+		err = db.UpdateWorkerLabels(context.TODO(), worker, l)
+		if err != nil {
+			t.Errorf("unable to update labels for worker %d: %v", worker.GetID(), err)
+		}
+
+		got, err := db.GetWorkerLabels(context.TODO(), worker)
+		if err != nil {
+			t.Errorf("unable to get labels for worker %d: %v", worker.GetID(), err)
+		}
+
+		if !cmp.Equal(got, l) {
+			t.Errorf("GetWorkerLabels() is %v, want %v", got, l)
+		}
+	}
+	methods["UpdateWorkerLabels"] = true
+	methods["GetWorkerLabels"] = true
+
+	// list the labels for the workers
+	workerLabels, err := db.ListWorkerLabels(context.TODO())
+	if err != nil {
+		t.Errorf("unable to list labels for workers: %v", err)
+	}
+	if len(workerLabels) != len(resources.Workers) {
+		t.Errorf("ListWorkerLabels() is %v, want %d workers", workerLabels, len(resources.Workers))
+	}
+	methods["ListWorkerLabels"] = true
+
 	// delete the workers
 	for _, worker := range resources.Workers {
 		err = db.DeleteWorker(context.TODO(), worker)
@@ -0,0 +1,132 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/go-vela/server/api/types"
+	serverconstants "github.com/go-vela/server/constants"
+	"github.com/go-vela/types/library"
+	"gorm.io/gorm/clause"
+)
+
+// AppendLogChunk appends a chunk of data to an existing log in the database.
+//
+// The chunks of a log must be appended in sequence starting at 1.
+// A chunk with a sequence already appended is ignored so the
+// request can safely be retried.
+func (e *engine) AppendLogChunk(ctx context.Context, l *library.Log, c *types.LogChunk) error {
+	// check what the log entry is for
+	switch {
+	case l.GetServiceID() > 0:
+		e.logger.Tracef("appending chunk %d to log for service %d for build %d in the database", c.GetSequence(), l.GetServiceID(), l.GetBuildID())
+	case l.GetStepID() > 0:
+		e.logger.Tracef("appending chunk %d to log for step %d for build %d in the database", c.GetSequence(), l.GetStepID(), l.GetBuildID())
+	}
+
+	// validate the necessary fields are populated
+	if c.GetSequence() < 1 {
+		return fmt.Errorf("%w: sequence %d must be greater than 0", ErrLogChunkSequence, c.GetSequence())
+	}
+
+	if len(c.GetData()) == 0 {
+		return ErrEmptyLogChunk
+	}
+
+	id, err := e.logID(ctx, l)
+	if err != nil {
+		return err
+	}
+
+	// variable to store query results
+	last := []*chunk{}
+
+	// send query to the database and store result in variable
+	err = e.client.
+		Table(serverconstants.TableLogChunk).
+		Select("id", "log_id", "sequence", "byte_start", "byte_end", "line_start", "line_end").
+		Where("log_id = ?", id).
+		Order("sequence DESC").
+		Limit(1).
+		Find(&last).
+		Error
+	if err != nil {
+		return err
+	}
+
+	// variables to store the bounds the chunk starts at
+	sequence, offset, line := int64(0), int64(0), int64(0)
+
+	if len(last) > 0 {
+		sequence = last[0].Sequence.Int64
+		offset = last[0].ByteEnd.Int64
+		line = last[0].LineEnd.Int64
+	} else {
+		// the first chunk starts after the data already in the log
+		r, err := e.blob(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		offset, line, err = count(r)
+		if err != nil {
+			return fmt.Errorf("unable to read log %d: %w", id, err)
+		}
+	}
+
+	switch {
+	case c.GetSequence() <= sequence:
+		// ignore the chunk since it was already appended
+		return nil
+	case c.GetSequence() > sequence+1:
+		return fmt.Errorf("%w: expected sequence %d but got %d", ErrLogChunkSequence, sequence+1, c.GetSequence())
+	}
+
+	data := c.GetData()
+
+	// send query to the database
+	//
+	// the chunk is ignored when a retry of the same request
+	// appended a chunk with the same sequence in the meantime
+	return e.client.
+		Table(serverconstants.TableLogChunk).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&chunk{
+			LogID:     sql.NullInt64{Int64: id, Valid: true},
+			Sequence:  sql.NullInt64{Int64: c.GetSequence(), Valid: true},
+			ByteStart: sql.NullInt64{Int64: offset, Valid: true},
+			ByteEnd:   sql.NullInt64{Int64: offset + int64(len(data)), Valid: true},
+			LineStart: sql.NullInt64{Int64: line, Valid: true},
+			LineEnd:   sql.NullInt64{Int64: line + int64(bytes.Count(data, []byte("\n"))), Valid: true},
+			Data:      data,
+		}).
+		Error
+}
+
+// count is a helper function to capture the
+// number of bytes and lines read from the reader.
+func count(r io.Reader) (int64, int64, error) {
+	size, lines := int64(0), int64(0)
+	buf := make([]byte, 32*1024)
+
+	for {
+		n, err := r.Read(buf)
+
+		size += int64(n)
+		lines += int64(bytes.Count(buf[:n], []byte("\n")))
+
+		if errors.Is(err, io.EOF) {
+			return size, lines, nil
+		}
+
+		if err != nil {
+			return size, lines, err
+		}
+	}
+}
@@ -0,0 +1,152 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-vela/server/api/types"
+)
+
+func TestLog_Engine_AppendLogChunk(t *testing.T) {
+	// setup types
+	_log := testLog()
+	_log.SetID(1)
+	_log.SetRepoID(1)
+	_log.SetBuildID(1)
+	_log.SetStepID(1)
+	_log.SetData([]byte("foo\n"))
+
+	_step := testStep()
+	_step.SetID(1)
+	_step.SetRepoID(1)
+	_step.SetBuildID(1)
+
+	_first := testLogChunk(1, "bar\n")
+	_second := testLogChunk(2, "baz")
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows(
+		[]string{"id", "log_id", "sequence", "byte_start", "byte_end", "line_start", "line_end"}).
+		AddRow(1, 1, 1, 4, 8, 1, 2)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT "id","log_id","sequence","byte_start","byte_end","line_start","line_end" FROM "log_chunks" WHERE log_id = $1 ORDER BY sequence DESC LIMIT 1`).
+		WithArgs(1).
+		WillReturnRows(_rows)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`INSERT INTO "log_chunks" ("log_id","sequence","byte_start","byte_end","line_start","line_end","data") VALUES ($1,$2,$3,$4,$5,$6,$7) ON CONFLICT DO NOTHING RETURNING "id"`).
+		WithArgs(1, 2, 8, 11, 2, 2, AnyArgument{}).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(2))
+
+	// ensure the mock expects the query for the chunk appended by a concurrent retry
+	_mock.ExpectQuery(`SELECT "id","log_id","sequence","byte_start","byte_end","line_start","line_end" FROM "log_chunks" WHERE log_id = $1 ORDER BY sequence DESC LIMIT 1`).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows(
+			[]string{"id", "log_id", "sequence", "byte_start", "byte_end", "line_start", "line_end"}).
+			AddRow(1, 1, 1, 4, 8, 1, 2))
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`INSERT INTO "log_chunks" ("log_id","sequence","byte_start","byte_end","line_start","line_end","data") VALUES ($1,$2,$3,$4,$5,$6,$7) ON CONFLICT DO NOTHING RETURNING "id"`).
+		WithArgs(1, 2, 8, 11, 2, 2, AnyArgument{}).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateLog(context.TODO(), _log)
+	if err != nil {
+		t.Errorf("unable to create test log for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		chunk    *types.LogChunk
+		want     error
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			chunk:    _second,
+		},
+		{
+			failure:  false,
+			name:     "postgres with duplicate sequence",
+			database: _postgres,
+			chunk:    _second,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			chunk:    _first,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3 with retried chunk",
+			database: _sqlite,
+			chunk:    _first,
+		},
+		{
+			failure:  true,
+			name:     "sqlite3 with skipped chunk",
+			database: _sqlite,
+			chunk:    testLogChunk(3, "qux"),
+			want:     ErrLogChunkSequence,
+		},
+		{
+			failure:  true,
+			name:     "sqlite3 with empty chunk",
+			database: _sqlite,
+			chunk:    testLogChunk(2, ""),
+			want:     ErrEmptyLogChunk,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3 with next chunk",
+			database: _sqlite,
+			chunk:    _second,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err = test.database.AppendLogChunk(context.TODO(), _log, test.chunk)
+
+			if test.failure {
+				if !errors.Is(err, test.want) {
+					t.Errorf("AppendLogChunk for %s returned err %v, want %v", test.name, err, test.want)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("AppendLogChunk for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+
+	// ensure the chunks are appended to the log
+	got, err := _sqlite.GetLogForStep(context.TODO(), _step)
+	if err != nil {
+		t.Errorf("GetLogForStep returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got.GetData(), []byte("foo\nbar\nbaz")) {
+		t.Errorf("GetLogForStep returned data %s, want %s", got.GetData(), "foo\nbar\nbaz")
+	}
+}
@@ -0,0 +1,197 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"bytes"
+	"compress/zlib"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+
+	serverconstants "github.com/go-vela/server/constants"
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+)
+
+var (
+	// ErrEmptyLogChunk defines the error type when a
+	// LogChunk type has an empty Data field provided.
+	ErrEmptyLogChunk = errors.New("empty log chunk data provided")
+
+	// ErrLogChunkSequence defines the error type when a LogChunk
+	// type has a Sequence field provided out of order.
+	ErrLogChunkSequence = errors.New("log chunk sequence out of order")
+)
+
+// chunk is the database representation of a chunk appended to a log.
+//
+// The bounds are the byte offset and line number the data starts
+// and ends at in the log. Once compacted, only the last chunk of a
+// log is kept, without data, to track the sequence and bounds of
+// the log for the chunks appended after it.
+type chunk struct {
+	ID        sql.NullInt64 `sql:"id"`
+	LogID     sql.NullInt64 `sql:"log_id"`
+	Sequence  sql.NullInt64 `sql:"sequence"`
+	ByteStart sql.NullInt64 `sql:"byte_start"`
+	ByteEnd   sql.NullInt64 `sql:"byte_end"`
+	LineStart sql.NullInt64 `sql:"line_start"`
+	LineEnd   sql.NullInt64 `sql:"line_end"`
+	Data      []byte        `sql:"data"`
+}
+
+// compacted returns true when the data of the chunk was compacted into the log.
+func (c *chunk) compacted() bool {
+	return c.ByteEnd.Int64 <= c.ByteStart.Int64
+}
+
+// start returns the byte offset, or line number, the chunk starts at.
+func (c *chunk) start(lines bool) int64 {
+	if lines {
+		return c.LineStart.Int64
+	}
+
+	return c.ByteStart.Int64
+}
+
+// overlaps returns true when the chunk contains data within the
+// range of bytes, or lines, ending at the end of the log when end is 0.
+func (c *chunk) overlaps(start, end int64, lines bool) bool {
+	if lines {
+		return c.LineEnd.Int64 >= start && (end <= 0 || c.LineStart.Int64 < end)
+	}
+
+	return c.ByteEnd.Int64 > start && (end <= 0 || c.ByteStart.Int64 < end)
+}
+
+// logID is a helper function to capture the ID of the log
+// from the ID, or the service or step ID, of the provided log.
+func (e *engine) logID(ctx context.Context, l *library.Log) (int64, error) {
+	if l.GetID() > 0 {
+		return l.GetID(), nil
+	}
+
+	query := e.client.
+		Table(constants.TableLog).
+		Select("id")
+
+	// check what the log entry is for
+	switch {
+	case l.GetServiceID() > 0:
+		query = query.Where("service_id = ?", l.GetServiceID())
+	case l.GetStepID() > 0:
+		query = query.Where("step_id = ?", l.GetStepID())
+	default:
+		return 0, fmt.Errorf("unable to capture log: no ID, service ID or step ID provided")
+	}
+
+	// variable to store query result
+	id := int64(0)
+
+	err := query.Take(&id).Error
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// chunks is a helper function to capture the bounds
+// of the chunks appended to a log in sequence order.
+func (e *engine) chunks(ctx context.Context, id int64) ([]*chunk, error) {
+	// variable to store query results
+	c := []*chunk{}
+
+	// send query to the database and store result in variable
+	err := e.client.
+		Table(serverconstants.TableLogChunk).
+		Select("id", "log_id", "sequence", "byte_start", "byte_end", "line_start", "line_end").
+		Where("log_id = ?", id).
+		Order("sequence ASC").
+		Find(&c).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// withChunks is a helper function to append the data
+// of the chunks not yet compacted to the provided logs.
+func (e *engine) withChunks(ctx context.Context, logs ...*library.Log) error {
+	ids := []int64{}
+	byID := make(map[int64]*library.Log)
+
+	for _, log := range logs {
+		ids = append(ids, log.GetID())
+		byID[log.GetID()] = log
+	}
+
+	// short-circuit if there are no logs
+	if len(ids) == 0 {
+		return nil
+	}
+
+	// variable to store query results
+	c := []*chunk{}
+
+	// send query to the database and store result in variable
+	err := e.client.
+		Table(serverconstants.TableLogChunk).
+		Select("log_id", "data").
+		Where("log_id IN ?", ids).
+		Where("byte_end > byte_start").
+		Order("sequence ASC").
+		Find(&c).
+		Error
+	if err != nil {
+		return err
+	}
+
+	for _, chunk := range c {
+		byID[chunk.LogID.Int64].AppendData(chunk.Data)
+	}
+
+	return nil
+}
+
+// blob is a helper function to create a reader that
+// decompresses the data compacted into a log as it is read.
+func (e *engine) blob(ctx context.Context, id int64) (io.Reader, error) {
+	// variable to store query results
+	r := new(record)
+
+	// send query to the database and store result in variable
+	err := e.client.
+		Table(constants.TableLog).
+		Where("id = ?", id).
+		Take(r).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	// read the log data from the log storage if moved out of the database
+	err = e.load(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(r.Data) == 0 {
+		return bytes.NewReader(nil), nil
+	}
+
+	// https://pkg.go.dev/compress/zlib#NewReader
+	z, err := zlib.NewReader(bytes.NewReader(r.Data))
+	if err != nil {
+		// ensures that the change is backwards compatible
+		// by reading uncompressed logs as they are stored
+		return bytes.NewReader(r.Data), nil
+	}
+
+	return z, nil
+}
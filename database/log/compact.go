@@ -0,0 +1,141 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"context"
+	"errors"
+
+	serverconstants "github.com/go-vela/server/constants"
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+	"gorm.io/gorm"
+)
+
+// CompactLog compacts the chunks appended to an existing log into the log in the database.
+//
+// The last chunk is kept without data so the chunks appended
+// afterwards continue the sequence and bounds of the log.
+// Logs without chunks and resources without a log are left as is.
+func (e *engine) CompactLog(ctx context.Context, l *library.Log) error {
+	// check what the log entry is for
+	switch {
+	case l.GetServiceID() > 0:
+		e.logger.Tracef("compacting log for service %d for build %d in the database", l.GetServiceID(), l.GetBuildID())
+	case l.GetStepID() > 0:
+		e.logger.Tracef("compacting log for step %d for build %d in the database", l.GetStepID(), l.GetBuildID())
+	}
+
+	id, err := e.logID(ctx, l)
+	if err != nil {
+		// short-circuit if there is no log to compact
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+
+		return err
+	}
+
+	// variable to store query results
+	c := []*chunk{}
+
+	// send query to the database and store result in variable
+	err = e.client.
+		Table(serverconstants.TableLogChunk).
+		Where("log_id = ?", id).
+		Where("byte_end > byte_start").
+		Order("sequence ASC").
+		Find(&c).
+		Error
+	if err != nil {
+		return err
+	}
+
+	// short-circuit if there are no chunks to compact
+	if len(c) == 0 {
+		return nil
+	}
+
+	// variable to store query results
+	r := new(record)
+
+	// send query to the database and store result in variable
+	err = e.client.
+		Table(constants.TableLog).
+		Where("id = ?", id).
+		Take(r).
+		Error
+	if err != nil {
+		return err
+	}
+
+	// read the log data from the log storage if moved out of the database
+	err = e.load(ctx, r)
+	if err != nil {
+		return err
+	}
+
+	// decompress log data
+	//
+	// https://pkg.go.dev/github.com/go-vela/types/database#Log.Decompress
+	err = r.Decompress()
+	if err != nil {
+		// ensures that the change is backwards compatible
+		// by logging the error instead of returning it
+		// which allows us to compact uncompressed logs
+		e.logger.Errorf("unable to decompress log %d: %v", id, err)
+	}
+
+	for _, chunk := range c {
+		r.Data = append(r.Data, chunk.Data...)
+	}
+
+	// compress log data for the resource
+	//
+	// https://pkg.go.dev/github.com/go-vela/types/database#Log.Compress
+	err = r.Compress(e.config.CompressionLevel)
+	if err != nil {
+		return err
+	}
+
+	// move the log data to the log storage if configured
+	err = e.store(ctx, r)
+	if err != nil {
+		return err
+	}
+
+	last := c[len(c)-1]
+
+	return e.client.Transaction(func(tx *gorm.DB) error {
+		// send query to the database
+		err := tx.
+			Table(constants.TableLog).
+			Save(r).
+			Error
+		if err != nil {
+			return err
+		}
+
+		// send query to the database to remove the compacted chunks
+		err = tx.
+			Table(serverconstants.TableLogChunk).
+			Where("log_id = ?", id).
+			Where("sequence < ?", last.Sequence.Int64).
+			Delete(new(chunk)).
+			Error
+		if err != nil {
+			return err
+		}
+
+		// send query to the database to keep the bounds of the last chunk
+		return tx.
+			Table(serverconstants.TableLogChunk).
+			Where("id = ?", last.ID.Int64).
+			Updates(map[string]interface{}{
+				"byte_start": last.ByteEnd.Int64,
+				"line_start": last.LineEnd.Int64,
+				"data":       nil,
+			}).
+			Error
+	})
+}
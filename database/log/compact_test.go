@@ -0,0 +1,182 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	serverconstants "github.com/go-vela/server/constants"
+	"github.com/go-vela/types/library"
+)
+
+func TestLog_Engine_CompactLog(t *testing.T) {
+	// setup types
+	_log := testLog()
+	_log.SetID(1)
+	_log.SetRepoID(1)
+	_log.SetBuildID(1)
+	_log.SetStepID(1)
+	_log.SetData([]byte("foo\n"))
+
+	_step := testStep()
+	_step.SetID(1)
+	_step.SetRepoID(1)
+	_step.SetBuildID(1)
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "log_chunks" WHERE log_id = $1 AND byte_end > byte_start ORDER BY sequence ASC`).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "log_id", "sequence", "byte_start", "byte_end", "line_start", "line_end", "data"}))
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateLog(context.TODO(), _log)
+	if err != nil {
+		t.Errorf("unable to create test log for sqlite: %v", err)
+	}
+
+	err = _sqlite.AppendLogChunk(context.TODO(), _log, testLogChunk(1, "bar\n"))
+	if err != nil {
+		t.Errorf("unable to append test log chunk for sqlite: %v", err)
+	}
+
+	err = _sqlite.AppendLogChunk(context.TODO(), _log, testLogChunk(2, "baz\n"))
+	if err != nil {
+		t.Errorf("unable to append test log chunk for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err = test.database.CompactLog(context.TODO(), _log)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("CompactLog for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("CompactLog for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+
+	// ensure only the last chunk is kept without data
+	c := []*chunk{}
+
+	err = _sqlite.client.Table(serverconstants.TableLogChunk).Find(&c).Error
+	if err != nil {
+		t.Errorf("unable to capture test log chunks: %v", err)
+	}
+
+	if len(c) != 1 || c[0].Sequence.Int64 != 2 || !c[0].compacted() || len(c[0].Data) > 0 {
+		t.Errorf("CompactLog kept chunks %v, want only the last chunk without data", c)
+	}
+
+	// ensure the chunks appended afterwards continue the log
+	err = _sqlite.AppendLogChunk(context.TODO(), _log, testLogChunk(3, "qux"))
+	if err != nil {
+		t.Errorf("AppendLogChunk returned err: %v", err)
+	}
+
+	got, err := _sqlite.GetLogForStep(context.TODO(), _step)
+	if err != nil {
+		t.Errorf("GetLogForStep returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got.GetData(), []byte("foo\nbar\nbaz\nqux")) {
+		t.Errorf("GetLogForStep returned data %s, want %s", got.GetData(), "foo\nbar\nbaz\nqux")
+	}
+}
+
+func TestLog_Engine_CompactLog_Empty(t *testing.T) {
+	// setup types
+	_log := testLog()
+	_log.SetID(1)
+	_log.SetRepoID(1)
+	_log.SetBuildID(1)
+	_log.SetStepID(1)
+	_log.SetData([]byte("foo\n"))
+
+	_missing := testLog()
+	_missing.SetRepoID(1)
+	_missing.SetBuildID(1)
+	_missing.SetServiceID(1)
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT id FROM "logs" WHERE service_id = $1 LIMIT 1`).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateLog(context.TODO(), _log)
+	if err != nil {
+		t.Errorf("unable to create test log for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		name     string
+		database *engine
+		log      *library.Log
+	}{
+		{
+			name:     "postgres without log",
+			database: _postgres,
+			log:      _missing,
+		},
+		{
+			name:     "sqlite3 without log",
+			database: _sqlite,
+			log:      _missing,
+		},
+		{
+			name:     "sqlite3 without chunks",
+			database: _sqlite,
+			log:      _log,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err = test.database.CompactLog(context.TODO(), test.log)
+			if err != nil {
+				t.Errorf("CompactLog for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+}
@@ -32,16 +32,16 @@ func TestLog_Engine_CreateLog(t *testing.T) {
 
 	// ensure the mock expects the service query
 	_mock.ExpectQuery(`INSERT INTO "logs"
-("build_id","repo_id","service_id","step_id","data","id")
-VALUES ($1,$2,$3,$4,$5,$6) RETURNING "id"`).
-		WithArgs(1, 1, 1, nil, AnyArgument{}, 1).
+("build_id","repo_id","service_id","step_id","data","storage_key","id")
+VALUES ($1,$2,$3,$4,$5,$6,$7) RETURNING "id"`).
+		WithArgs(1, 1, 1, nil, AnyArgument{}, nil, 1).
 		WillReturnRows(_rows)
 
 	// ensure the mock expects the step query
 	_mock.ExpectQuery(`INSERT INTO "logs"
-("build_id","repo_id","service_id","step_id","data","id")
-VALUES ($1,$2,$3,$4,$5,$6) RETURNING "id"`).
-		WithArgs(1, 1, nil, 1, AnyArgument{}, 2).
+("build_id","repo_id","service_id","step_id","data","storage_key","id")
+VALUES ($1,$2,$3,$4,$5,$6,$7) RETURNING "id"`).
+		WithArgs(1, 1, nil, 1, AnyArgument{}, nil, 2).
 		WillReturnRows(_rows)
 
 	_sqlite := testSqlite(t)
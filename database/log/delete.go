@@ -5,6 +5,7 @@ package log
 import (
 	"context"
 
+	serverconstants "github.com/go-vela/server/constants"
 	"github.com/go-vela/types/constants"
 	"github.com/go-vela/types/database"
 	"github.com/go-vela/types/library"
@@ -25,9 +26,29 @@ func (e *engine) DeleteLog(ctx context.Context, l *library.Log) error {
 	// https://pkg.go.dev/github.com/go-vela/types/database#LogFromLibrary
 	log := database.LogFromLibrary(l)
 
+	// send query to the database to remove the chunks appended to the log
+	err := e.client.
+		Table(serverconstants.TableLogChunk).
+		Where("log_id = ?", l.GetID()).
+		Delete(new(chunk)).
+		Error
+	if err != nil {
+		return err
+	}
+
 	// send query to the database
-	return e.client.
+	err = e.client.
 		Table(constants.TableLog).
 		Delete(log).
 		Error
+	if err != nil {
+		return err
+	}
+
+	// remove the log data from the log storage if configured
+	if e.storage != nil {
+		return e.storage.Delete(ctx, storageKey(log))
+	}
+
+	return nil
 }
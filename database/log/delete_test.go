@@ -20,6 +20,11 @@ func TestLog_Engine_DeleteLog(t *testing.T) {
 	_postgres, _mock := testPostgres(t)
 	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
 
+	// ensure the mock expects the chunks query
+	_mock.ExpectExec(`DELETE FROM "log_chunks" WHERE log_id = $1`).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(1, 0))
+
 	// ensure the mock expects the query
 	_mock.ExpectExec(`DELETE FROM "logs" WHERE "logs"."id" = $1`).
 		WithArgs(1).
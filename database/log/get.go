@@ -6,7 +6,6 @@ import (
 	"context"
 
 	"github.com/go-vela/types/constants"
-	"github.com/go-vela/types/database"
 	"github.com/go-vela/types/library"
 )
 
@@ -15,7 +14,7 @@ func (e *engine) GetLog(ctx context.Context, id int64) (*library.Log, error) {
 	e.logger.Tracef("getting log %d from the database", id)
 
 	// variable to store query results
-	l := new(database.Log)
+	l := new(record)
 
 	// send query to the database and store result in variable
 	err := e.client.
@@ -27,6 +26,12 @@ func (e *engine) GetLog(ctx context.Context, id int64) (*library.Log, error) {
 		return nil, err
 	}
 
+	// read the log data from the log storage if moved out of the database
+	err = e.load(ctx, l)
+	if err != nil {
+		return nil, err
+	}
+
 	// decompress log data
 	//
 	// https://pkg.go.dev/github.com/go-vela/types/database#Log.Decompress
@@ -36,13 +41,18 @@ func (e *engine) GetLog(ctx context.Context, id int64) (*library.Log, error) {
 		// by logging the error instead of returning it
 		// which allows us to fetch uncompressed logs
 		e.logger.Errorf("unable to decompress log %d: %v", id, err)
-
-		// return the uncompressed log
-		return l.ToLibrary(), nil
 	}
 
-	// return the log
+	// convert query result to library type
 	//
 	// https://pkg.go.dev/github.com/go-vela/types/database#Log.ToLibrary
-	return l.ToLibrary(), nil
+	log := l.ToLibrary()
+
+	// append the data of the chunks not yet compacted to the log
+	err = e.withChunks(ctx, log)
+	if err != nil {
+		return nil, err
+	}
+
+	return log, nil
 }
@@ -7,7 +7,6 @@ import (
 	"context"
 
 	"github.com/go-vela/types/constants"
-	"github.com/go-vela/types/database"
 	"github.com/go-vela/types/library"
 )
 
@@ -16,7 +15,7 @@ func (e *engine) GetLogForService(ctx context.Context, s *library.Service) (*lib
 	e.logger.Tracef("getting log for service %d for build %d from the database", s.GetID(), s.GetBuildID())
 
 	// variable to store query results
-	l := new(database.Log)
+	l := new(record)
 
 	// send query to the database and store result in variable
 	err := e.client.
@@ -28,6 +27,12 @@ func (e *engine) GetLogForService(ctx context.Context, s *library.Service) (*lib
 		return nil, err
 	}
 
+	// read the log data from the log storage if moved out of the database
+	err = e.load(ctx, l)
+	if err != nil {
+		return nil, err
+	}
+
 	// decompress log data for the service
 	//
 	// https://pkg.go.dev/github.com/go-vela/types/database#Log.Decompress
@@ -37,13 +42,18 @@ func (e *engine) GetLogForService(ctx context.Context, s *library.Service) (*lib
 		// by logging the error instead of returning it
 		// which allows us to fetch uncompressed logs
 		e.logger.Errorf("unable to decompress log for service %d for build %d: %v", s.GetID(), s.GetBuildID(), err)
-
-		// return the uncompressed log
-		return l.ToLibrary(), nil
 	}
 
-	// return the log
+	// convert query result to library type
 	//
 	// https://pkg.go.dev/github.com/go-vela/types/database#Log.ToLibrary
-	return l.ToLibrary(), nil
+	log := l.ToLibrary()
+
+	// append the data of the chunks not yet compacted to the log
+	err = e.withChunks(ctx, log)
+	if err != nil {
+		return nil, err
+	}
+
+	return log, nil
 }
@@ -38,6 +38,11 @@ func TestLog_Engine_GetLogForService(t *testing.T) {
 	// ensure the mock expects the query
 	_mock.ExpectQuery(`SELECT * FROM "logs" WHERE service_id = $1 LIMIT 1`).WithArgs(1).WillReturnRows(_rows)
 
+	// ensure the mock expects the chunks query
+	_mock.ExpectQuery(`SELECT "log_id","data" FROM "log_chunks" WHERE log_id IN ($1) AND byte_end > byte_start ORDER BY sequence ASC`).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"log_id", "data"}))
+
 	_sqlite := testSqlite(t)
 	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
 
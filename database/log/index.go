@@ -2,7 +2,11 @@
 
 package log
 
-import "context"
+import (
+	"context"
+
+	"github.com/go-vela/server/constants"
+)
 
 const (
 	// CreateBuildIDIndex represents a query to create an
@@ -19,6 +23,11 @@ ON logs (build_id);
 func (e *engine) CreateLogIndexes(ctx context.Context) error {
 	e.logger.Tracef("creating indexes for logs table in the database")
 
+	// the indexes are created with the MySQL logs table
+	if e.client.Config.Dialector.Name() == constants.DriverMySQL {
+		return nil
+	}
+
 	// create the build_id column index for the logs table
 	return e.client.Exec(CreateBuildIDIndex).Error
 }
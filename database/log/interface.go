@@ -5,6 +5,7 @@ package log
 import (
 	"context"
 
+	"github.com/go-vela/server/api/types"
 	"github.com/go-vela/types/library"
 )
 
@@ -26,6 +27,10 @@ type LogInterface interface {
 	//
 	// https://en.wikipedia.org/wiki/Data_manipulation_language
 
+	// AppendLogChunk defines a function that appends a chunk of data to an existing log.
+	AppendLogChunk(context.Context, *library.Log, *types.LogChunk) error
+	// CompactLog defines a function that compacts the chunks appended to an existing log.
+	CompactLog(context.Context, *library.Log) error
 	// CountLogs defines a function that gets the count of all logs.
 	CountLogs(context.Context) (int64, error)
 	// CountLogsForBuild defines a function that gets the count of logs by build ID.
@@ -36,14 +41,20 @@ type LogInterface interface {
 	DeleteLog(context.Context, *library.Log) error
 	// GetLog defines a function that gets a log by ID.
 	GetLog(context.Context, int64) (*library.Log, error)
+	// GetLogBytes defines a function that gets a range of bytes from an existing log.
+	GetLogBytes(context.Context, *library.Log, int64, int64) (*types.LogChunk, error)
 	// GetLogForService defines a function that gets a log by service ID.
 	GetLogForService(context.Context, *library.Service) (*library.Log, error)
 	// GetLogForStep defines a function that gets a log by step ID.
 	GetLogForStep(context.Context, *library.Step) (*library.Log, error)
+	// GetLogLines defines a function that gets a range of lines from an existing log.
+	GetLogLines(context.Context, *library.Log, int64, int64) (*types.LogChunk, error)
 	// ListLogs defines a function that gets a list of all logs.
 	ListLogs(context.Context) ([]*library.Log, error)
 	// ListLogsForBuild defines a function that gets a list of logs by build ID.
 	ListLogsForBuild(context.Context, *library.Build, int, int) ([]*library.Log, int64, error)
+	// MigrateLogs defines a function that moves the data of logs for completed builds to the log storage.
+	MigrateLogs(context.Context, int) (int64, error)
 	// UpdateLog defines a function that updates an existing log.
 	UpdateLog(context.Context, *library.Log) error
 }
@@ -6,7 +6,6 @@ import (
 	"context"
 
 	"github.com/go-vela/types/constants"
-	"github.com/go-vela/types/database"
 	"github.com/go-vela/types/library"
 )
 
@@ -16,7 +15,7 @@ func (e *engine) ListLogs(ctx context.Context) ([]*library.Log, error) {
 
 	// variables to store query results and return value
 	count := int64(0)
-	l := new([]database.Log)
+	l := new([]record)
 	logs := []*library.Log{}
 
 	// count the results
@@ -44,6 +43,12 @@ func (e *engine) ListLogs(ctx context.Context) ([]*library.Log, error) {
 		// https://golang.org/doc/faq#closures_and_goroutines
 		tmp := log
 
+		// read the log data from the log storage if moved out of the database
+		err = e.load(ctx, &tmp)
+		if err != nil {
+			return nil, err
+		}
+
 		// decompress log data
 		//
 		// https://pkg.go.dev/github.com/go-vela/types/database#Log.Decompress
@@ -61,5 +66,11 @@ func (e *engine) ListLogs(ctx context.Context) ([]*library.Log, error) {
 		logs = append(logs, tmp.ToLibrary())
 	}
 
+	// append the data of the chunks not yet compacted to the logs
+	err = e.withChunks(ctx, logs...)
+	if err != nil {
+		return nil, err
+	}
+
 	return logs, nil
 }
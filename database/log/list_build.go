@@ -5,8 +5,8 @@ package log
 import (
 	"context"
 
+	serverconstants "github.com/go-vela/server/constants"
 	"github.com/go-vela/types/constants"
-	"github.com/go-vela/types/database"
 	"github.com/go-vela/types/library"
 )
 
@@ -16,7 +16,7 @@ func (e *engine) ListLogsForBuild(ctx context.Context, b *library.Build, page, p
 
 	// variables to store query results and return value
 	count := int64(0)
-	l := new([]database.Log)
+	l := new([]record)
 	logs := []*library.Log{}
 
 	// count the results
@@ -33,11 +33,18 @@ func (e *engine) ListLogsForBuild(ctx context.Context, b *library.Build, page, p
 	// calculate offset for pagination through results
 	offset := perPage * (page - 1)
 
+	order := "service_id ASC NULLS LAST"
+
+	// MySQL does not support NULLS LAST so the nulls are sorted explicitly
+	if e.client.Config.Dialector.Name() == serverconstants.DriverMySQL {
+		order = "service_id IS NULL, service_id ASC"
+	}
+
 	// send query to the database and store result in variable
 	err = e.client.
 		Table(constants.TableLog).
 		Where("build_id = ?", b.GetID()).
-		Order("service_id ASC NULLS LAST").
+		Order(order).
 		Order("step_id ASC").
 		Limit(perPage).
 		Offset(offset).
@@ -52,6 +59,12 @@ func (e *engine) ListLogsForBuild(ctx context.Context, b *library.Build, page, p
 		// https://golang.org/doc/faq#closures_and_goroutines
 		tmp := log
 
+		// read the log data from the log storage if moved out of the database
+		err = e.load(ctx, &tmp)
+		if err != nil {
+			return nil, count, err
+		}
+
 		// decompress log data for the build
 		//
 		// https://pkg.go.dev/github.com/go-vela/types/database#Log.Decompress
@@ -69,5 +82,11 @@ func (e *engine) ListLogsForBuild(ctx context.Context, b *library.Build, page, p
 		logs = append(logs, tmp.ToLibrary())
 	}
 
+	// append the data of the chunks not yet compacted to the logs
+	err = e.withChunks(ctx, logs...)
+	if err != nil {
+		return nil, count, err
+	}
+
 	return logs, count, nil
 }
@@ -50,6 +50,33 @@ func TestLog_Engine_ListLogsForBuild(t *testing.T) {
 	// ensure the mock expects the query
 	_mock.ExpectQuery(`SELECT * FROM "logs" WHERE build_id = $1 ORDER BY service_id ASC NULLS LAST,step_id ASC LIMIT 10`).WithArgs(1).WillReturnRows(_rows)
 
+	// ensure the mock expects the chunks query
+	_mock.ExpectQuery(`SELECT "log_id","data" FROM "log_chunks" WHERE log_id IN ($1,$2) AND byte_end > byte_start ORDER BY sequence ASC`).
+		WithArgs(1, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"log_id", "data"}))
+
+	_mysql, _mysqlMock := testMySQL(t)
+	defer func() { _sql, _ := _mysql.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows = sqlmock.NewRows([]string{"count"}).AddRow(2)
+
+	// ensure the mock expects the query
+	_mysqlMock.ExpectQuery("SELECT count(*) FROM `logs` WHERE build_id = ?").WithArgs(1).WillReturnRows(_rows)
+
+	// create expected result in mock
+	_rows = sqlmock.NewRows(
+		[]string{"id", "build_id", "repo_id", "service_id", "step_id", "data"}).
+		AddRow(1, 1, 1, 1, 0, []byte{}).AddRow(2, 1, 1, 0, 1, []byte{})
+
+	// ensure the mock expects the query
+	_mysqlMock.ExpectQuery("SELECT * FROM `logs` WHERE build_id = ? ORDER BY service_id IS NULL, service_id ASC,step_id ASC LIMIT 10").WithArgs(1).WillReturnRows(_rows)
+
+	// ensure the mock expects the chunks query
+	_mysqlMock.ExpectQuery("SELECT `log_id`,`data` FROM `log_chunks` WHERE log_id IN (?,?) AND byte_end > byte_start ORDER BY sequence ASC").
+		WithArgs(1, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"log_id", "data"}))
+
 	_sqlite := testSqlite(t)
 	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
 
@@ -76,6 +103,12 @@ func TestLog_Engine_ListLogsForBuild(t *testing.T) {
 			database: _postgres,
 			want:     []*library.Log{_service, _step},
 		},
+		{
+			failure:  false,
+			name:     "mysql",
+			database: _mysql,
+			want:     []*library.Log{_service, _step},
+		},
 		{
 			failure:  false,
 			name:     "sqlite3",
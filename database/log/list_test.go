@@ -44,6 +44,11 @@ func TestLog_Engine_ListLogs(t *testing.T) {
 	// ensure the mock expects the query
 	_mock.ExpectQuery(`SELECT * FROM "logs"`).WillReturnRows(_rows)
 
+	// ensure the mock expects the chunks query
+	_mock.ExpectQuery(`SELECT "log_id","data" FROM "log_chunks" WHERE log_id IN ($1,$2) AND byte_end > byte_start ORDER BY sequence ASC`).
+		WithArgs(1, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"log_id", "data"}))
+
 	_sqlite := testSqlite(t)
 	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
 
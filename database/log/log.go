@@ -6,6 +6,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/go-vela/server/storage"
 	"github.com/go-vela/types/constants"
 	"github.com/sirupsen/logrus"
 
@@ -33,6 +34,9 @@ type (
 		// https://pkg.go.dev/gorm.io/gorm#DB
 		client *gorm.DB
 
+		// log storage client used in log functions, nil when log data is kept in the database
+		storage storage.Service
+
 		// sirupsen/logrus logger used in log functions
 		//
 		// https://pkg.go.dev/github.com/sirupsen/logrus#Entry
@@ -62,7 +66,7 @@ func New(opts ...EngineOpt) (*engine, error) {
 
 	// check if we should skip creating log database objects
 	if e.config.SkipCreation {
-		e.logger.Warning("skipping creation of logs table and indexes in the database")
+		e.logger.Trace("skipping creation of logs table and indexes in the database")
 
 		return e, nil
 	}
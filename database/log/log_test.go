@@ -8,9 +8,11 @@ import (
 	"testing"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-vela/server/api/types"
 	"github.com/go-vela/types/library"
 	"github.com/sirupsen/logrus"
 
+	"gorm.io/driver/mysql"
 	"gorm.io/driver/postgres"
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
@@ -27,6 +29,8 @@ func TestLog_New(t *testing.T) {
 	defer _sql.Close()
 
 	_mock.ExpectExec(CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(AddStorageKeyPostgresColumn).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(CreatePostgresChunkTable).WillReturnResult(sqlmock.NewResult(1, 1))
 	_mock.ExpectExec(CreateBuildIDIndex).WillReturnResult(sqlmock.NewResult(1, 1))
 
 	_config := &gorm.Config{SkipDefaultTransaction: true}
@@ -118,6 +122,8 @@ func testPostgres(t *testing.T) (*engine, sqlmock.Sqlmock) {
 	}
 
 	_mock.ExpectExec(CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(AddStorageKeyPostgresColumn).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(CreatePostgresChunkTable).WillReturnResult(sqlmock.NewResult(1, 1))
 	_mock.ExpectExec(CreateBuildIDIndex).WillReturnResult(sqlmock.NewResult(1, 1))
 
 	// create the new mock Postgres database client
@@ -143,6 +149,44 @@ func testPostgres(t *testing.T) (*engine, sqlmock.Sqlmock) {
 	return _engine, _mock
 }
 
+// testMySQL is a helper function to create a MySQL engine for testing.
+func testMySQL(t *testing.T) (*engine, sqlmock.Sqlmock) {
+	// create the new mock sql database
+	//
+	// https://pkg.go.dev/github.com/DATA-DOG/go-sqlmock#New
+	_sql, _mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Errorf("unable to create new SQL mock: %v", err)
+	}
+
+	_mock.ExpectExec(CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectQuery(CountStorageKeyMySQLColumn).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	_mock.ExpectExec(AddStorageKeyMySQLColumn).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(CreateMySQLChunkTable).WillReturnResult(sqlmock.NewResult(1, 1))
+
+	// create the new mock MySQL database client
+	//
+	// https://pkg.go.dev/gorm.io/gorm#Open
+	_mysql, err := gorm.Open(
+		mysql.New(mysql.Config{Conn: _sql, SkipInitializeWithVersion: true}),
+		&gorm.Config{SkipDefaultTransaction: true},
+	)
+	if err != nil {
+		t.Errorf("unable to create new mysql database: %v", err)
+	}
+
+	_engine, err := New(
+		WithClient(_mysql),
+		WithLogger(logrus.NewEntry(logrus.StandardLogger())),
+		WithSkipCreation(false),
+	)
+	if err != nil {
+		t.Errorf("unable to create new mysql log engine: %v", err)
+	}
+
+	return _engine, _mock
+}
+
 // testSqlite is a helper function to create a Sqlite engine for testing.
 func testSqlite(t *testing.T) *engine {
 	_sqlite, err := gorm.Open(
@@ -228,6 +272,17 @@ func testLog() *library.Log {
 	}
 }
 
+// testLogChunk is a test helper function to create a
+// LogChunk type with the provided sequence and data.
+func testLogChunk(sequence int64, data string) *types.LogChunk {
+	c := new(types.LogChunk)
+
+	c.SetSequence(sequence)
+	c.SetData([]byte(data))
+
+	return c
+}
+
 // testService is a test helper function to create a library
 // Service type with all fields set to their zero values.
 func testService() *library.Service {
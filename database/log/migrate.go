@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/go-vela/types/constants"
+)
+
+// MigrateLogs moves the data of logs for completed builds
+// from the database to the log storage in batches.
+//
+// Logs for pending and running builds are skipped since
+// they are still being updated by the workers.
+func (e *engine) MigrateLogs(ctx context.Context, batch int) (int64, error) {
+	e.logger.Tracef("moving up to %d logs from the database to the log storage", batch)
+
+	// check if a log storage is configured
+	if e.storage == nil {
+		return 0, fmt.Errorf("unable to migrate logs: no log storage configured")
+	}
+
+	// variables to store query results and return value
+	count := int64(0)
+	l := new([]record)
+
+	// capture the builds that are still being updated
+	running := e.client.
+		Table(constants.TableBuild).
+		Select("id").
+		Where("status IN ?", []string{constants.StatusPending, constants.StatusRunning})
+
+	// send query to the database and store result in variable
+	err := e.client.
+		Table(constants.TableLog).
+		Where("storage_key IS NULL").
+		Where("build_id NOT IN (?)", running).
+		Order("id ASC").
+		Limit(batch).
+		Find(&l).
+		Error
+	if err != nil {
+		return count, err
+	}
+
+	// iterate through all query results
+	for _, log := range *l {
+		// https://golang.org/doc/faq#closures_and_goroutines
+		tmp := log
+
+		key := storageKey(&tmp.Log)
+
+		// write the log data as stored in the database
+		// so uncompressed logs remain readable
+		err = e.storage.Put(ctx, key, tmp.Data)
+		if err != nil {
+			return count, fmt.Errorf("unable to write log %d to %s storage: %w", tmp.ID.Int64, e.storage.Driver(), err)
+		}
+
+		// send query to the database to replace the data with the key
+		err = e.client.
+			Table(constants.TableLog).
+			Where("id = ?", tmp.ID.Int64).
+			Where("storage_key IS NULL").
+			Updates(map[string]interface{}{
+				"data":        nil,
+				"storage_key": sql.NullString{String: key, Valid: true},
+			}).
+			Error
+		if err != nil {
+			return count, err
+		}
+
+		count++
+	}
+
+	return count, nil
+}
@@ -0,0 +1,146 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-vela/types/constants"
+)
+
+func TestLog_Engine_MigrateLogs(t *testing.T) {
+	// setup types
+	_complete := testLog()
+	_complete.SetRepoID(1)
+	_complete.SetBuildID(1)
+	_complete.SetStepID(1)
+	_complete.SetData([]byte("foo"))
+
+	_running := testLog()
+	_running.SetRepoID(1)
+	_running.SetBuildID(2)
+	_running.SetStepID(2)
+	_running.SetData([]byte("bar"))
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	_postgres.storage = testStorage(t)
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows(
+		[]string{"id", "repo_id", "build_id", "service_id", "step_id", "data", "storage_key"}).
+		AddRow(1, 1, 1, nil, 1, []byte{}, nil)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "logs" WHERE storage_key IS NULL AND build_id NOT IN (SELECT id FROM "builds" WHERE status IN ($1,$2)) ORDER BY id ASC LIMIT 10`).
+		WithArgs(constants.StatusPending, constants.StatusRunning).
+		WillReturnRows(_rows)
+
+	_mock.ExpectExec(`UPDATE "logs" SET "data"=$1,"storage_key"=$2 WHERE id = $3 AND storage_key IS NULL`).
+		WithArgs(nil, "repos/1/builds/1/steps/1", 1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.client.Exec(`CREATE TABLE IF NOT EXISTS builds (id INTEGER PRIMARY KEY, status TEXT);`).Error
+	if err != nil {
+		t.Errorf("unable to create test builds table for sqlite: %v", err)
+	}
+
+	err = _sqlite.client.Exec(`INSERT INTO builds (id, status) VALUES (1, ?), (2, ?);`, constants.StatusSuccess, constants.StatusRunning).Error
+	if err != nil {
+		t.Errorf("unable to create test builds for sqlite: %v", err)
+	}
+
+	err = _sqlite.CreateLog(context.TODO(), _complete)
+	if err != nil {
+		t.Errorf("unable to create test log for sqlite: %v", err)
+	}
+
+	err = _sqlite.CreateLog(context.TODO(), _running)
+	if err != nil {
+		t.Errorf("unable to create test log for sqlite: %v", err)
+	}
+
+	_sqlite.storage = testStorage(t)
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		want     int64
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     1,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     1,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.MigrateLogs(context.TODO(), 10)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("MigrateLogs for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("MigrateLogs for %s returned err: %v", test.name, err)
+			}
+
+			if got != test.want {
+				t.Errorf("MigrateLogs for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+
+	// ensure the moved log is still readable
+	_step := testStep()
+	_step.SetID(1)
+	_step.SetBuildID(1)
+
+	got, err := _sqlite.GetLogForStep(context.TODO(), _step)
+	if err != nil {
+		t.Errorf("GetLogForStep returned err: %v", err)
+	}
+
+	if string(got.GetData()) != "foo" {
+		t.Errorf("GetLogForStep is %s, want %s", got.GetData(), "foo")
+	}
+
+	// ensure the logs are only moved once
+	count, err := _sqlite.MigrateLogs(context.TODO(), 10)
+	if err != nil {
+		t.Errorf("MigrateLogs returned err: %v", err)
+	}
+
+	if count != 0 {
+		t.Errorf("MigrateLogs moved %d logs again, want 0", count)
+	}
+
+	// ensure moving logs fails without the log storage
+	_sqlite.storage = nil
+
+	_, err = _sqlite.MigrateLogs(context.TODO(), 10)
+	if err == nil {
+		t.Errorf("MigrateLogs without log storage should have returned err")
+	}
+}
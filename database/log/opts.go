@@ -5,6 +5,7 @@ package log
 import (
 	"context"
 
+	"github.com/go-vela/server/storage"
 	"github.com/sirupsen/logrus"
 
 	"gorm.io/gorm"
@@ -43,6 +44,16 @@ func WithLogger(logger *logrus.Entry) EngineOpt {
 	}
 }
 
+// WithStorage sets the log storage client in the database engine for Logs.
+func WithStorage(s storage.Service) EngineOpt {
+	return func(e *engine) error {
+		// set the log storage client in the log engine
+		e.storage = s
+
+		return nil
+	}
+}
+
 // WithSkipCreation sets the skip creation logic in the database engine for Logs.
 func WithSkipCreation(skipCreation bool) EngineOpt {
 	return func(e *engine) error {
@@ -7,6 +7,7 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/go-vela/server/storage"
 	"github.com/sirupsen/logrus"
 
 	"gorm.io/gorm"
@@ -165,6 +166,57 @@ func TestLog_EngineOpt_WithLogger(t *testing.T) {
 	}
 }
 
+func TestLog_EngineOpt_WithStorage(t *testing.T) {
+	// setup types
+	e := new(engine)
+
+	_storage := testStorage(t)
+
+	// setup tests
+	tests := []struct {
+		failure bool
+		name    string
+		storage storage.Service
+		want    storage.Service
+	}{
+		{
+			failure: false,
+			name:    "storage set to filesystem",
+			storage: _storage,
+			want:    _storage,
+		},
+		{
+			failure: false,
+			name:    "storage set to nil",
+			storage: nil,
+			want:    nil,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := WithStorage(test.storage)(e)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("WithStorage for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("WithStorage returned err: %v", err)
+			}
+
+			if !reflect.DeepEqual(e.storage, test.want) {
+				t.Errorf("WithStorage is %v, want %v", e.storage, test.want)
+			}
+		})
+	}
+}
+
 func TestLog_EngineOpt_WithSkipCreation(t *testing.T) {
 	// setup types
 	e := &engine{config: new(config)}
@@ -0,0 +1,192 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"io"
+
+	"github.com/go-vela/server/api/types"
+	serverconstants "github.com/go-vela/server/constants"
+	"github.com/go-vela/types/library"
+)
+
+// GetLogBytes gets a range of bytes from an existing log in the database.
+//
+// The range starts at the start byte offset and ends before the
+// end byte offset, or at the end of the log when end is 0.
+func (e *engine) GetLogBytes(ctx context.Context, l *library.Log, start, end int64) (*types.LogChunk, error) {
+	e.logger.Tracef("getting bytes %d to %d of log %d from the database", start, end, l.GetID())
+
+	return e.window(ctx, l, start, end, false)
+}
+
+// GetLogLines gets a range of lines from an existing log in the database.
+//
+// The range starts at the start line number and ends before the
+// end line number, or at the end of the log when end is 0.
+func (e *engine) GetLogLines(ctx context.Context, l *library.Log, start, end int64) (*types.LogChunk, error) {
+	e.logger.Tracef("getting lines %d to %d of log %d from the database", start, end, l.GetID())
+
+	return e.window(ctx, l, start, end, true)
+}
+
+// window is a helper function to capture a range of bytes,
+// or lines, from an existing log in the database.
+//
+// Only the chunks overlapping the range are read, and the data
+// compacted into the log is only read when the range starts
+// before the chunks not yet compacted.
+func (e *engine) window(ctx context.Context, l *library.Log, start, end int64, lines bool) (*types.LogChunk, error) {
+	id, err := e.logID(ctx, l)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := e.chunks(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// variables to store the readers and the bounds they start at
+	readers := []io.Reader{}
+	offset, line := int64(0), int64(0)
+
+	// read the data compacted into the log when the range starts before the chunks
+	compacted := len(c) == 0 || start < c[0].start(lines)
+	if compacted {
+		r, err := e.blob(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		readers = append(readers, r)
+	}
+
+	// capture the sequence of the chunks overlapping the range
+	first, last, selected := int64(0), int64(0), 0
+
+	for _, chunk := range c {
+		if chunk.compacted() || !chunk.overlaps(start, end, lines) {
+			continue
+		}
+
+		if first == 0 {
+			first = chunk.Sequence.Int64
+		}
+
+		last = chunk.Sequence.Int64
+		selected++
+	}
+
+	switch {
+	case first > 0:
+		// variable to store query results
+		data := []*chunk{}
+
+		// send query to the database and store result in variable
+		err = e.client.
+			Table(serverconstants.TableLogChunk).
+			Where("log_id = ?", id).
+			Where("sequence BETWEEN ? AND ?", first, last).
+			Order("sequence ASC").
+			Find(&data).
+			Error
+		if err != nil {
+			return nil, err
+		}
+
+		// read the log again when the chunks were compacted in the meantime
+		if len(data) != selected || data[0].compacted() {
+			return e.window(ctx, l, start, end, lines)
+		}
+
+		for _, chunk := range data {
+			if !compacted && len(readers) == 0 {
+				offset = chunk.ByteStart.Int64
+				line = chunk.LineStart.Int64
+			}
+
+			readers = append(readers, bytes.NewReader(chunk.Data))
+		}
+	case !compacted:
+		// the range starts after the end of the log
+		offset = c[len(c)-1].ByteEnd.Int64
+		line = c[len(c)-1].LineEnd.Int64
+	}
+
+	w, err := scan(io.MultiReader(readers...), offset, line, start, end, lines)
+	if err != nil {
+		return nil, err
+	}
+
+	// set the sequence of the last chunk appended to the log
+	sequence := int64(0)
+	if len(c) > 0 {
+		sequence = c[len(c)-1].Sequence.Int64
+	}
+
+	w.SetSequence(sequence)
+
+	return w, nil
+}
+
+// scan is a helper function to capture a range of bytes, or lines,
+// from the reader starting at the provided byte offset and line number.
+func scan(r io.Reader, offset, line, start, end int64, lines bool) (*types.LogChunk, error) {
+	b := bufio.NewReader(r)
+	w := new(types.LogChunk)
+	data := []byte{}
+	found := false
+
+	for {
+		position := offset
+		if lines {
+			position = line
+		}
+
+		// stop reading at the end of the range
+		if end > 0 && position >= end {
+			break
+		}
+
+		char, err := b.ReadByte()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if position >= start {
+			if !found {
+				w.SetOffset(offset)
+				w.SetLine(line)
+
+				found = true
+			}
+
+			data = append(data, char)
+		}
+
+		offset++
+
+		if char == '\n' {
+			line++
+		}
+	}
+
+	// the range starts after the end of the reader
+	if !found {
+		w.SetOffset(offset)
+		w.SetLine(line)
+	}
+
+	w.SetData(data)
+
+	return w, nil
+}
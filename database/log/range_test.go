@@ -0,0 +1,258 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/library"
+)
+
+func TestLog_Engine_GetLogBytes(t *testing.T) {
+	// setup types
+	_log := testRangeLog()
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// ensure the mock expects the chunks query
+	_mock.ExpectQuery(`SELECT "id","log_id","sequence","byte_start","byte_end","line_start","line_end" FROM "log_chunks" WHERE log_id = $1 ORDER BY sequence ASC`).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "log_id", "sequence", "byte_start", "byte_end", "line_start", "line_end"}))
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows(
+		[]string{"id", "build_id", "repo_id", "service_id", "step_id", "data"}).
+		AddRow(1, 1, 1, 0, 1, []byte("foo\nbar\n"))
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "logs" WHERE id = $1 LIMIT 1`).WithArgs(1).WillReturnRows(_rows)
+
+	_sqlite := testRangeSqlite(t, _log)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	// setup tests
+	tests := []struct {
+		failure    bool
+		name       string
+		database   *engine
+		start, end int64
+		want       *types.LogChunk
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			start:    2,
+			end:      6,
+			want:     testRange(0, 2, 0, "o\nba"),
+		},
+		{
+			failure:  false,
+			name:     "sqlite3 with entire log",
+			database: _sqlite,
+			start:    0,
+			end:      0,
+			want:     testRange(3, 0, 0, "foo\nbar\nbaz\nqux\nquux"),
+		},
+		{
+			failure:  false,
+			name:     "sqlite3 with compacted data",
+			database: _sqlite,
+			start:    5,
+			end:      10,
+			want:     testRange(3, 5, 1, "ar\nba"),
+		},
+		{
+			failure:  false,
+			name:     "sqlite3 with appended chunks",
+			database: _sqlite,
+			start:    14,
+			end:      18,
+			want:     testRange(3, 14, 3, "x\nqu"),
+		},
+		{
+			failure:  false,
+			name:     "sqlite3 past the end",
+			database: _sqlite,
+			start:    100,
+			end:      0,
+			want:     testRange(3, 20, 4, ""),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.GetLogBytes(context.TODO(), _log, test.start, test.end)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("GetLogBytes for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("GetLogBytes for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("GetLogBytes for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
+
+func TestLog_Engine_GetLogLines(t *testing.T) {
+	// setup types
+	_log := testRangeLog()
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows(
+		[]string{"id", "log_id", "sequence", "byte_start", "byte_end", "line_start", "line_end"}).
+		AddRow(1, 1, 1, 8, 12, 2, 3)
+
+	// ensure the mock expects the chunks query
+	_mock.ExpectQuery(`SELECT "id","log_id","sequence","byte_start","byte_end","line_start","line_end" FROM "log_chunks" WHERE log_id = $1 ORDER BY sequence ASC`).
+		WithArgs(1).
+		WillReturnRows(_rows)
+
+	// create expected result in mock
+	_rows = sqlmock.NewRows(
+		[]string{"id", "log_id", "sequence", "byte_start", "byte_end", "line_start", "line_end", "data"}).
+		AddRow(1, 1, 1, 8, 12, 2, 3, []byte("baz\n"))
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "log_chunks" WHERE log_id = $1 AND (sequence BETWEEN $2 AND $3) ORDER BY sequence ASC`).
+		WithArgs(1, 1, 1).
+		WillReturnRows(_rows)
+
+	_sqlite := testRangeSqlite(t, _log)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	// setup tests
+	tests := []struct {
+		failure    bool
+		name       string
+		database   *engine
+		start, end int64
+		want       *types.LogChunk
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			start:    2,
+			end:      3,
+			want:     testRange(1, 8, 2, "baz\n"),
+		},
+		{
+			failure:  false,
+			name:     "sqlite3 with compacted data",
+			database: _sqlite,
+			start:    1,
+			end:      3,
+			want:     testRange(3, 4, 1, "bar\nbaz\n"),
+		},
+		{
+			failure:  false,
+			name:     "sqlite3 with appended chunks",
+			database: _sqlite,
+			start:    3,
+			end:      0,
+			want:     testRange(3, 12, 3, "qux\nquux"),
+		},
+		{
+			failure:  false,
+			name:     "sqlite3 with last line",
+			database: _sqlite,
+			start:    4,
+			end:      5,
+			want:     testRange(3, 16, 4, "quux"),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.GetLogLines(context.TODO(), _log, test.start, test.end)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("GetLogLines for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("GetLogLines for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("GetLogLines for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
+
+// testRangeLog is a test helper function to create
+// a library Log type for reading ranges of data.
+func testRangeLog() *library.Log {
+	l := testLog()
+	l.SetID(1)
+	l.SetRepoID(1)
+	l.SetBuildID(1)
+	l.SetStepID(1)
+	l.SetData([]byte("foo\nbar\n"))
+
+	return l
+}
+
+// testRangeSqlite is a test helper function to create a Sqlite
+// engine with the log partially compacted into the database.
+func testRangeSqlite(t *testing.T, l *library.Log) *engine {
+	_sqlite := testSqlite(t)
+
+	err := _sqlite.CreateLog(context.TODO(), l)
+	if err != nil {
+		t.Errorf("unable to create test log for sqlite: %v", err)
+	}
+
+	for i, data := range []string{"baz\n", "qux\n", "quux"} {
+		err = _sqlite.AppendLogChunk(context.TODO(), l, testLogChunk(int64(i+1), data))
+		if err != nil {
+			t.Errorf("unable to append test log chunk for sqlite: %v", err)
+		}
+
+		// compact the first chunk into the log
+		if i == 0 {
+			err = _sqlite.CompactLog(context.TODO(), l)
+			if err != nil {
+				t.Errorf("unable to compact test log for sqlite: %v", err)
+			}
+		}
+	}
+
+	return _sqlite
+}
+
+// testRange is a test helper function to create a LogChunk
+// type with the provided sequence, bounds and data.
+func testRange(sequence, offset, line int64, data string) *types.LogChunk {
+	c := testLogChunk(sequence, data)
+
+	c.SetOffset(offset)
+	c.SetLine(line)
+
+	return c
+}
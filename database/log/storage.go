@@ -0,0 +1,76 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/go-vela/types/database"
+)
+
+// record is the database representation of a log
+// with the key of its data in the log storage.
+type record struct {
+	database.Log
+
+	// StorageKey is the key of the log data in the
+	// log storage, NULL when the data is in the database
+	StorageKey sql.NullString `sql:"storage_key"`
+}
+
+// storageKey is a helper function to create the
+// key of the log data in the log storage.
+func storageKey(l *database.Log) string {
+	if l.ServiceID.Valid {
+		return fmt.Sprintf("repos/%d/builds/%d/services/%d", l.RepoID.Int64, l.BuildID.Int64, l.ServiceID.Int64)
+	}
+
+	return fmt.Sprintf("repos/%d/builds/%d/steps/%d", l.RepoID.Int64, l.BuildID.Int64, l.StepID.Int64)
+}
+
+// store is a helper function to write the compressed log data
+// to the log storage, leaving only the key in the database.
+//
+// The data is kept in the database when no log storage is configured.
+func (e *engine) store(ctx context.Context, r *record) error {
+	if e.storage == nil {
+		r.StorageKey = sql.NullString{}
+
+		return nil
+	}
+
+	key := storageKey(&r.Log)
+
+	err := e.storage.Put(ctx, key, r.Data)
+	if err != nil {
+		return fmt.Errorf("unable to write log data to %s storage: %w", e.storage.Driver(), err)
+	}
+
+	r.Data = nil
+	r.StorageKey = sql.NullString{String: key, Valid: true}
+
+	return nil
+}
+
+// load is a helper function to read the compressed log data
+// from the log storage when it was moved out of the database.
+func (e *engine) load(ctx context.Context, r *record) error {
+	if !r.StorageKey.Valid {
+		return nil
+	}
+
+	if e.storage == nil {
+		return fmt.Errorf("unable to read log %d: no log storage configured for %s", r.ID.Int64, r.StorageKey.String)
+	}
+
+	data, err := e.storage.Get(ctx, r.StorageKey.String)
+	if err != nil {
+		return fmt.Errorf("unable to read log data from %s storage: %w", e.storage.Driver(), err)
+	}
+
+	r.Data = data
+
+	return nil
+}
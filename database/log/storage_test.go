@@ -0,0 +1,156 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/go-vela/server/storage"
+	"github.com/go-vela/server/storage/filesystem"
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/database"
+	"github.com/go-vela/types/library"
+)
+
+func TestLog_Engine_Storage(t *testing.T) {
+	// setup types
+	_build := testBuild()
+	_build.SetID(1)
+	_build.SetRepoID(1)
+
+	_step := testStep()
+	_step.SetID(1)
+	_step.SetRepoID(1)
+	_step.SetBuildID(1)
+
+	_log := testLog()
+	_log.SetRepoID(1)
+	_log.SetBuildID(1)
+	_log.SetStepID(1)
+	_log.SetData([]byte("foo"))
+
+	_storage := testStorage(t)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	_sqlite.storage = _storage
+
+	// run test
+	err := _sqlite.CreateLog(context.TODO(), _log)
+	if err != nil {
+		t.Errorf("CreateLog returned err: %v", err)
+	}
+
+	// ensure only the key of the log data is in the database
+	r := new(record)
+
+	err = _sqlite.client.Table(constants.TableLog).Where("step_id = ?", 1).Take(r).Error
+	if err != nil {
+		t.Errorf("unable to capture test log record: %v", err)
+	}
+
+	if len(r.Data) > 0 || r.StorageKey.String != "repos/1/builds/1/steps/1" {
+		t.Errorf("CreateLog stored data %v with key %s, want key %s", r.Data, r.StorageKey.String, "repos/1/builds/1/steps/1")
+	}
+
+	got, err := _sqlite.GetLogForStep(context.TODO(), _step)
+	if err != nil {
+		t.Errorf("GetLogForStep returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got.GetData(), _log.GetData()) {
+		t.Errorf("GetLogForStep is %s, want %s", got.GetData(), _log.GetData())
+	}
+
+	got.AppendData([]byte("bar"))
+
+	err = _sqlite.UpdateLog(context.TODO(), got)
+	if err != nil {
+		t.Errorf("UpdateLog returned err: %v", err)
+	}
+
+	logs, _, err := _sqlite.ListLogsForBuild(context.TODO(), _build, 1, 10)
+	if err != nil {
+		t.Errorf("ListLogsForBuild returned err: %v", err)
+	}
+
+	if len(logs) != 1 || !reflect.DeepEqual(logs[0].GetData(), []byte("foobar")) {
+		t.Errorf("ListLogsForBuild is %v, want %s", logs, "foobar")
+	}
+
+	// ensure reading the log fails without the log storage
+	_sqlite.storage = nil
+
+	_, err = _sqlite.GetLog(context.TODO(), got.GetID())
+	if err == nil {
+		t.Errorf("GetLog without log storage should have returned err")
+	}
+
+	_sqlite.storage = _storage
+
+	err = _sqlite.DeleteLog(context.TODO(), got)
+	if err != nil {
+		t.Errorf("DeleteLog returned err: %v", err)
+	}
+
+	_, err = _storage.Get(context.TODO(), "repos/1/builds/1/steps/1")
+	if err == nil {
+		t.Errorf("DeleteLog did not remove the log data from the log storage")
+	}
+}
+
+func TestLog_storageKey(t *testing.T) {
+	// setup types
+	_service := testLog()
+	_service.SetRepoID(1)
+	_service.SetBuildID(2)
+	_service.SetServiceID(3)
+
+	_step := testLog()
+	_step.SetRepoID(1)
+	_step.SetBuildID(2)
+	_step.SetStepID(3)
+
+	// setup tests
+	tests := []struct {
+		name string
+		log  *library.Log
+		want string
+	}{
+		{
+			name: "service",
+			log:  _service,
+			want: "repos/1/builds/2/services/3",
+		},
+		{
+			name: "step",
+			log:  _step,
+			want: "repos/1/builds/2/steps/3",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := storageKey(database.LogFromLibrary(test.log))
+
+			if got != test.want {
+				t.Errorf("storageKey for %s is %s, want %s", test.name, got, test.want)
+			}
+		})
+	}
+}
+
+// testStorage is a helper function to create
+// a log storage in a temporary directory.
+func testStorage(t *testing.T) storage.Service {
+	_storage, err := filesystem.New(filesystem.WithDirectory(t.TempDir()))
+	if err != nil {
+		t.Fatalf("unable to create new filesystem log storage: %v", err)
+	}
+
+	return _storage
+}
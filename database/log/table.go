@@ -5,6 +5,7 @@ package log
 import (
 	"context"
 
+	serverconstants "github.com/go-vela/server/constants"
 	"github.com/go-vela/types/constants"
 )
 
@@ -39,22 +40,179 @@ logs (
 	UNIQUE(step_id),
 	UNIQUE(service_id)
 );
+`
+
+	// CreateMySQLTable represents a query to create the MySQL logs table
+	// with its indexes, since MySQL does not support creating an index
+	// only if it does not exist.
+	CreateMySQLTable = `
+CREATE TABLE
+IF NOT EXISTS
+logs (
+	id            INTEGER NOT NULL AUTO_INCREMENT PRIMARY KEY,
+	build_id      INTEGER,
+	repo_id       INTEGER,
+	service_id    INTEGER,
+	step_id       INTEGER,
+	data          LONGBLOB,
+	UNIQUE(step_id),
+	UNIQUE(service_id),
+	INDEX logs_build_id (build_id)
+);
+`
+
+	// CreatePostgresChunkTable represents a query to create the Postgres log_chunks table.
+	CreatePostgresChunkTable = `
+CREATE TABLE
+IF NOT EXISTS
+log_chunks (
+	id            SERIAL PRIMARY KEY,
+	log_id        INTEGER,
+	sequence      INTEGER,
+	byte_start    INTEGER,
+	byte_end      INTEGER,
+	line_start    INTEGER,
+	line_end      INTEGER,
+	data          BYTEA,
+	UNIQUE(log_id, sequence)
+);
+`
+
+	// CreateSqliteChunkTable represents a query to create the Sqlite log_chunks table.
+	CreateSqliteChunkTable = `
+CREATE TABLE
+IF NOT EXISTS
+log_chunks (
+	id            INTEGER PRIMARY KEY AUTOINCREMENT,
+	log_id        INTEGER,
+	sequence      INTEGER,
+	byte_start    INTEGER,
+	byte_end      INTEGER,
+	line_start    INTEGER,
+	line_end      INTEGER,
+	data          BLOB,
+	UNIQUE(log_id, sequence)
+);
+`
+
+	// CreateMySQLChunkTable represents a query to create the MySQL log_chunks table.
+	CreateMySQLChunkTable = `
+CREATE TABLE
+IF NOT EXISTS
+log_chunks (
+	id            INTEGER NOT NULL AUTO_INCREMENT PRIMARY KEY,
+	log_id        INTEGER,
+	sequence      INTEGER,
+	byte_start    INTEGER,
+	byte_end      INTEGER,
+	line_start    INTEGER,
+	line_end      INTEGER,
+	data          LONGBLOB,
+	UNIQUE(log_id, sequence)
+);
+`
+
+	// AddStorageKeyPostgresColumn represents a query to add the storage_key
+	// column to a Postgres logs table created before it was introduced.
+	AddStorageKeyPostgresColumn = `ALTER TABLE logs ADD COLUMN IF NOT EXISTS storage_key VARCHAR(250);`
+
+	// AddStorageKeyMySQLColumn represents a query to add the storage_key
+	// column to a MySQL logs table created before it was introduced.
+	AddStorageKeyMySQLColumn = `ALTER TABLE logs ADD COLUMN storage_key VARCHAR(250);`
+
+	// AddStorageKeySqliteColumn represents a query to add the storage_key
+	// column to a Sqlite logs table created before it was introduced.
+	AddStorageKeySqliteColumn = `ALTER TABLE logs ADD COLUMN storage_key TEXT;`
+
+	// CountStorageKeyMySQLColumn represents a query to check if the storage_key
+	// column exists, since MySQL does not support adding a column only if it
+	// does not exist.
+	CountStorageKeyMySQLColumn = `
+SELECT COUNT(*)
+FROM INFORMATION_SCHEMA.COLUMNS
+WHERE table_schema = DATABASE()
+AND table_name = 'logs'
+AND column_name = 'storage_key';
 `
 )
 
-// CreateLogTable creates the logs table in the database.
+// CreateLogTable creates the logs and log_chunks tables in the database.
 func (e *engine) CreateLogTable(ctx context.Context, driver string) error {
 	e.logger.Tracef("creating logs table in the database")
 
+	err := e.createLogTable(ctx, driver)
+	if err != nil {
+		return err
+	}
+
+	e.logger.Tracef("creating log_chunks table in the database")
+
+	// handle the driver provided to create the table
+	switch driver {
+	case constants.DriverPostgres:
+		// create the log_chunks table for Postgres
+		return e.client.Exec(CreatePostgresChunkTable).Error
+	case serverconstants.DriverMySQL:
+		// create the log_chunks table for MySQL
+		return e.client.Exec(CreateMySQLChunkTable).Error
+	case constants.DriverSqlite:
+		fallthrough
+	default:
+		// create the log_chunks table for Sqlite
+		return e.client.Exec(CreateSqliteChunkTable).Error
+	}
+}
+
+// createLogTable is a helper function to create
+// the logs table in the database.
+func (e *engine) createLogTable(ctx context.Context, driver string) error {
 	// handle the driver provided to create the table
 	switch driver {
 	case constants.DriverPostgres:
 		// create the logs table for Postgres
-		return e.client.Exec(CreatePostgresTable).Error
+		err := e.client.Exec(CreatePostgresTable).Error
+		if err != nil {
+			return err
+		}
+
+		// add the storage_key column for existing logs tables
+		return e.client.Exec(AddStorageKeyPostgresColumn).Error
+	case serverconstants.DriverMySQL:
+		// create the logs table for MySQL
+		err := e.client.Exec(CreateMySQLTable).Error
+		if err != nil {
+			return err
+		}
+
+		// variable to store the count of storage_key columns
+		count := int64(0)
+
+		err = e.client.Raw(CountStorageKeyMySQLColumn).Scan(&count).Error
+		if err != nil {
+			return err
+		}
+
+		if count > 0 {
+			return nil
+		}
+
+		// add the storage_key column for existing logs tables
+		return e.client.Exec(AddStorageKeyMySQLColumn).Error
 	case constants.DriverSqlite:
 		fallthrough
 	default:
 		// create the logs table for Sqlite
-		return e.client.Exec(CreateSqliteTable).Error
+		err := e.client.Exec(CreateSqliteTable).Error
+		if err != nil {
+			return err
+		}
+
+		// Sqlite does not support adding a column only if it does not exist
+		if e.client.Migrator().HasColumn(constants.TableLog, "storage_key") {
+			return nil
+		}
+
+		// add the storage_key column for existing logs tables
+		return e.client.Exec(AddStorageKeySqliteColumn).Error
 	}
 }
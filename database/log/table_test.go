@@ -15,6 +15,15 @@ func TestLog_Engine_CreateLogTable(t *testing.T) {
 	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
 
 	_mock.ExpectExec(CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(AddStorageKeyPostgresColumn).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(CreatePostgresChunkTable).WillReturnResult(sqlmock.NewResult(1, 1))
+
+	_mysql, _mysqlMock := testMySQL(t)
+	defer func() { _sql, _ := _mysql.client.DB(); _sql.Close() }()
+
+	_mysqlMock.ExpectExec(CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mysqlMock.ExpectQuery(CountStorageKeyMySQLColumn).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	_mysqlMock.ExpectExec(CreateMySQLChunkTable).WillReturnResult(sqlmock.NewResult(1, 1))
 
 	_sqlite := testSqlite(t)
 	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
@@ -25,6 +34,11 @@ func TestLog_Engine_CreateLogTable(t *testing.T) {
 		name     string
 		database *engine
 	}{
+		{
+			failure:  false,
+			name:     "mysql",
+			database: _mysql,
+		},
 		{
 			failure:  false,
 			name:     "postgres",
@@ -48,9 +48,17 @@ func (e *engine) UpdateLog(ctx context.Context, l *library.Log) error {
 		}
 	}
 
+	r := &record{Log: *log}
+
+	// move the log data to the log storage if configured
+	err = e.store(ctx, r)
+	if err != nil {
+		return err
+	}
+
 	// send query to the database
 	return e.client.
 		Table(constants.TableLog).
-		Save(log).
+		Save(r).
 		Error
 }
@@ -31,16 +31,16 @@ func TestLog_Engine_UpdateLog(t *testing.T) {
 
 	// ensure the mock expects the service query
 	_mock.ExpectExec(`UPDATE "logs"
-SET "build_id"=$1,"repo_id"=$2,"service_id"=$3,"step_id"=$4,"data"=$5
-WHERE "id" = $6`).
-		WithArgs(1, 1, 1, nil, AnyArgument{}, 1).
+SET "build_id"=$1,"repo_id"=$2,"service_id"=$3,"step_id"=$4,"data"=$5,"storage_key"=$6
+WHERE "id" = $7`).
+		WithArgs(1, 1, 1, nil, AnyArgument{}, nil, 1).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 
 	// ensure the mock expects the step query
 	_mock.ExpectExec(`UPDATE "logs"
-SET "build_id"=$1,"repo_id"=$2,"service_id"=$3,"step_id"=$4,"data"=$5
-WHERE "id" = $6`).
-		WithArgs(1, 1, nil, 1, AnyArgument{}, 2).
+SET "build_id"=$1,"repo_id"=$2,"service_id"=$3,"step_id"=$4,"data"=$5,"storage_key"=$6
+WHERE "id" = $7`).
+		WithArgs(1, 1, nil, 1, AnyArgument{}, nil, 2).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 
 	_sqlite := testSqlite(t)
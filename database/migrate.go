@@ -0,0 +1,54 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package database
+
+import (
+	"context"
+
+	"github.com/go-vela/server/database/migration"
+)
+
+// NewMigrator creates and returns a migrator for the schema of the configured database provider.
+func NewMigrator(opts ...EngineOpt) (*migration.Migrator, error) {
+	e, err := open(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return e.migrator()
+}
+
+// migrate is a helper function to verify the schema of the database is
+// supported by the server and apply the pending migrations for the schema.
+func (e *engine) migrate(ctx context.Context) error {
+	m, err := e.migrator()
+	if err != nil {
+		return err
+	}
+
+	// refuse to run against a schema created by a newer server
+	err = m.Check(ctx)
+	if err != nil {
+		return err
+	}
+
+	// check if we should skip applying the migrations
+	if e.config.SkipCreation {
+		e.logger.Warning("skipping migrations of the database schema")
+
+		return nil
+	}
+
+	_, err = m.Up(ctx, 0)
+
+	return err
+}
+
+// migrator is a helper function to create the migrator for the engine.
+func (e *engine) migrator() (*migration.Migrator, error) {
+	return migration.New(
+		migration.WithClient(e.client),
+		migration.WithDriver(e.config.Driver),
+		migration.WithLogger(e.logger),
+	)
+}
@@ -0,0 +1,102 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package database
+
+import (
+	"context"
+	"testing"
+
+	serverconstants "github.com/go-vela/server/constants"
+	"github.com/go-vela/server/database/migration"
+	"github.com/go-vela/types/constants"
+)
+
+func TestDatabase_NewMigrator(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		name    string
+		opts    []EngineOpt
+	}{
+		{
+			name:    "success with sqlite3",
+			failure: false,
+			opts: []EngineOpt{
+				WithAddress("file::memory:?cache=shared"),
+				WithDriver("sqlite3"),
+				WithEncryptionKey("A1B2C3D4E5G6H7I8J9K0LMNOPQRSTUVW"),
+			},
+		},
+		{
+			name:    "failure with invalid config",
+			failure: true,
+			opts: []EngineOpt{
+				WithAddress(""),
+				WithDriver("sqlite3"),
+				WithEncryptionKey("A1B2C3D4E5G6H7I8J9K0LMNOPQRSTUVW"),
+			},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := NewMigrator(test.opts...)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("NewMigrator for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("NewMigrator for %s returned err: %v", test.name, err)
+			}
+
+			defer got.Close()
+
+			if got.Latest() != migration.Latest(migration.Migrations) {
+				t.Errorf("NewMigrator for %s is at latest %d, want %d", test.name, got.Latest(), migration.Latest(migration.Migrations))
+			}
+		})
+	}
+}
+
+func TestDatabase_Engine_migrate(t *testing.T) {
+	// setup types
+	_sqlite := testSqlite(t)
+	defer _sqlite.Close()
+
+	// run test
+	err := _sqlite.migrate(context.TODO())
+	if err != nil {
+		t.Errorf("migrate returned err: %v", err)
+	}
+
+	if !_sqlite.client.Migrator().HasTable(constants.TableBuild) {
+		t.Errorf("migrate did not create %s table", constants.TableBuild)
+	}
+
+	newer := &migration.Record{Version: migration.Latest(migration.Migrations) + 1}
+
+	// record a migration applied by a newer server
+	err = _sqlite.client.Table(serverconstants.TableSchemaMigration).Create(newer).Error
+	if err != nil {
+		t.Errorf("unable to create test migration record: %v", err)
+	}
+
+	// remove the record since the in-memory database is shared
+	defer _sqlite.client.Table(serverconstants.TableSchemaMigration).Delete(newer)
+
+	// ensure the newer schema is refused even when skipping migrations
+	for _, skip := range []bool{false, true} {
+		_sqlite.config.SkipCreation = skip
+
+		err = _sqlite.migrate(context.TODO())
+		if err == nil {
+			t.Errorf("migrate with skip creation %t should have returned err", skip)
+		}
+	}
+}
@@ -0,0 +1,141 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package migration
+
+import (
+	"fmt"
+
+	serverconstants "github.com/go-vela/server/constants"
+	"github.com/go-vela/server/database/build"
+	"github.com/go-vela/server/database/executable"
+	"github.com/go-vela/server/database/hook"
+	"github.com/go-vela/server/database/log"
+	"github.com/go-vela/server/database/pipeline"
+	"github.com/go-vela/server/database/repo"
+	"github.com/go-vela/server/database/schedule"
+	"github.com/go-vela/server/database/secret"
+	"github.com/go-vela/server/database/service"
+	"github.com/go-vela/server/database/step"
+	"github.com/go-vela/server/database/user"
+	"github.com/go-vela/server/database/worker"
+	"github.com/go-vela/types/constants"
+)
+
+// baselineTables represents the tables created by the baseline migration.
+var baselineTables = []string{
+	constants.TableBuild,
+	constants.TableBuildExecutable,
+	constants.TableHook,
+	constants.TableLog,
+	constants.TablePipeline,
+	constants.TableRepo,
+	constants.TableSchedule,
+	constants.TableSecret,
+	constants.TableService,
+	constants.TableStep,
+	constants.TableUser,
+	constants.TableWorker,
+}
+
+// baseline represents the first migration that creates the tables
+// and indexes previously created by the engines on every startup.
+//
+// The queries only create the objects that do not exist so the
+// baseline can be applied to databases created before migrations.
+var baseline = &Migration{
+	Version:     1,
+	Description: "create the tables and indexes for all resources",
+	Up: map[string][]string{
+		constants.DriverPostgres: {
+			build.CreatePostgresTable,
+			build.CreateCreatedIndex,
+			build.CreateRepoIDIndex,
+			build.CreateSourceIndex,
+			build.CreateStatusIndex,
+			executable.CreatePostgresTable,
+			hook.CreatePostgresTable,
+			hook.CreateRepoIDIndex,
+			log.CreatePostgresTable,
+			log.CreateBuildIDIndex,
+			pipeline.CreatePostgresTable,
+			pipeline.CreateRepoIDIndex,
+			repo.CreatePostgresTable,
+			repo.AddProviderPostgresColumn,
+			repo.AddSettingsPostgresColumns,
+			repo.CreateOrgNameIndex,
+			schedule.CreatePostgresTable,
+			schedule.CreateRepoIDIndex,
+			secret.CreatePostgresTable,
+			secret.CreateTypeOrgRepo,
+			secret.CreateTypeOrgTeam,
+			secret.CreateTypeOrg,
+			service.CreatePostgresTable,
+			step.CreatePostgresTable,
+			user.CreatePostgresTable,
+			user.AddProviderPostgresColumn,
+			user.CreateUserRefreshIndex,
+			worker.CreatePostgresTable,
+			worker.AddLabelsPostgresColumn,
+			worker.CreateHostnameAddressIndex,
+		},
+		serverconstants.DriverMySQL: {
+			build.CreateMySQLTable,
+			executable.CreateMySQLTable,
+			hook.CreateMySQLTable,
+			log.CreateMySQLTable,
+			pipeline.CreateMySQLTable,
+			repo.CreateMySQLTable,
+			schedule.CreateMySQLTable,
+			secret.CreateMySQLTable,
+			service.CreateMySQLTable,
+			step.CreateMySQLTable,
+			user.CreateMySQLTable,
+			worker.CreateMySQLTable,
+		},
+		constants.DriverSqlite: {
+			build.CreateSqliteTable,
+			build.CreateCreatedIndex,
+			build.CreateRepoIDIndex,
+			build.CreateSourceIndex,
+			build.CreateStatusIndex,
+			executable.CreateSqliteTable,
+			hook.CreateSqliteTable,
+			hook.CreateRepoIDIndex,
+			log.CreateSqliteTable,
+			log.CreateBuildIDIndex,
+			pipeline.CreateSqliteTable,
+			pipeline.CreateRepoIDIndex,
+			repo.CreateSqliteTable,
+			repo.CreateOrgNameIndex,
+			schedule.CreateSqliteTable,
+			schedule.CreateRepoIDIndex,
+			secret.CreateSqliteTable,
+			secret.CreateTypeOrgRepo,
+			secret.CreateTypeOrgTeam,
+			secret.CreateTypeOrg,
+			service.CreateSqliteTable,
+			step.CreateSqliteTable,
+			user.CreateSqliteTable,
+			user.CreateUserRefreshIndex,
+			worker.CreateSqliteTable,
+			worker.CreateHostnameAddressIndex,
+		},
+	},
+	Down: map[string][]string{
+		constants.DriverPostgres:    dropTables(baselineTables),
+		serverconstants.DriverMySQL: dropTables(baselineTables),
+		constants.DriverSqlite:      dropTables(baselineTables),
+	},
+}
+
+// dropTables is a helper function to create the queries
+// to drop the provided tables in the reverse order.
+func dropTables(tables []string) []string {
+	queries := []string{}
+
+	for i := len(tables) - 1; i >= 0; i-- {
+		queries = append(queries, fmt.Sprintf("DROP TABLE IF EXISTS %s;", tables[i]))
+	}
+
+	return queries
+}
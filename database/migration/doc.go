@@ -0,0 +1,10 @@
+// SPDX-License-Identifier: Apache-2.0
+
+// Package migration provides the ability for Vela to
+// manage the schema of the database with ordered,
+// versioned migrations for each supported driver.
+//
+// Usage:
+//
+//	import "github.com/go-vela/server/database/migration"
+package migration
@@ -0,0 +1,26 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package migration
+
+import (
+	serverconstants "github.com/go-vela/server/constants"
+	"github.com/go-vela/server/database/log"
+	"github.com/go-vela/types/constants"
+)
+
+// logChunks represents the migration that creates the table
+// for the chunks appended to logs until they are compacted.
+var logChunks = &Migration{
+	Version:     3,
+	Description: "create the log_chunks table",
+	Up: map[string][]string{
+		constants.DriverPostgres:    {log.CreatePostgresChunkTable},
+		serverconstants.DriverMySQL: {log.CreateMySQLChunkTable},
+		constants.DriverSqlite:      {log.CreateSqliteChunkTable},
+	},
+	Down: map[string][]string{
+		constants.DriverPostgres:    dropTables([]string{serverconstants.TableLogChunk}),
+		serverconstants.DriverMySQL: dropTables([]string{serverconstants.TableLogChunk}),
+		constants.DriverSqlite:      dropTables([]string{serverconstants.TableLogChunk}),
+	},
+}
@@ -0,0 +1,32 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package migration
+
+import (
+	serverconstants "github.com/go-vela/server/constants"
+	"github.com/go-vela/server/database/log"
+	"github.com/go-vela/types/constants"
+)
+
+// logStorage represents the migration that adds the column
+// pointing to the log data moved out of the database.
+var logStorage = &Migration{
+	Version:     2,
+	Description: "add the storage_key column to the logs table",
+	Up: map[string][]string{
+		constants.DriverPostgres:    {log.AddStorageKeyPostgresColumn},
+		serverconstants.DriverMySQL: {},
+		constants.DriverSqlite:      {log.AddStorageKeySqliteColumn},
+	},
+	// MySQL does not support adding a column only if it does not exist
+	Columns: map[string][]*Column{
+		serverconstants.DriverMySQL: {
+			{Table: constants.TableLog, Name: "storage_key", Query: log.AddStorageKeyMySQLColumn},
+		},
+	},
+	Down: map[string][]string{
+		constants.DriverPostgres:    {"ALTER TABLE logs DROP COLUMN IF EXISTS storage_key;"},
+		serverconstants.DriverMySQL: {"ALTER TABLE logs DROP COLUMN storage_key;"},
+		constants.DriverSqlite:      {"ALTER TABLE logs DROP COLUMN storage_key;"},
+	},
+}
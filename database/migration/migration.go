@@ -0,0 +1,76 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package migration
+
+// Column represents a column added to an existing table
+// only when it is missing, since not every driver supports
+// adding a column only if it does not exist.
+type Column struct {
+	// Table is the name of the table to add the column to
+	Table string
+	// Name is the name of the column to add
+	Name string
+	// Query is the query to add the column to the table
+	Query string
+}
+
+// Index represents an index created on an existing table
+// only when it is missing, since not every driver supports
+// creating an index only if it does not exist.
+type Index struct {
+	// Table is the name of the table to create the index on
+	Table string
+	// Name is the name of the index to create
+	Name string
+	// Query is the query to create the index on the table
+	Query string
+}
+
+// Migration represents a versioned change to the schema of the database.
+type Migration struct {
+	// Version is the unique, increasing number of the migration
+	Version int
+	// Description is a short summary of the change made by the migration
+	Description string
+	// Up is the list of queries to apply the migration for each driver
+	Up map[string][]string
+	// Columns is the list of columns to add when missing for each driver after the Up queries
+	Columns map[string][]*Column
+	// Indexes is the list of indexes to create when missing for each driver after the Columns
+	Indexes map[string][]*Index
+	// Down is the list of queries to roll back the migration for each driver
+	Down map[string][]string
+}
+
+// Migrations represents the ordered list of migrations
+// for the schema of the database supported by the server.
+//
+// New migrations must be appended with the next version
+// and provide queries for every supported driver.
+//
+// MySQL implicitly commits every query that changes the schema, so a
+// migration failing partway is left half applied without being recorded.
+// The queries for MySQL must be safe to run again, with columns and
+// indexes added through Columns and Indexes so they are skipped once
+// they exist.
+var Migrations = []*Migration{
+	baseline,
+	logStorage,
+	logChunks,
+	sqliteColumns,
+	repoProvider,
+	userProvider,
+}
+
+// Latest returns the version of the last migration in the list.
+func Latest(migrations []*Migration) int {
+	latest := 0
+
+	for _, m := range migrations {
+		if m.Version > latest {
+			latest = m.Version
+		}
+	}
+
+	return latest
+}
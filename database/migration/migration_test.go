@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package migration
+
+import (
+	"testing"
+
+	serverconstants "github.com/go-vela/server/constants"
+	"github.com/go-vela/types/constants"
+)
+
+func TestMigration_Latest(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name       string
+		migrations []*Migration
+		want       int
+	}{
+		{
+			name:       "ordered migrations",
+			migrations: []*Migration{{Version: 1}, {Version: 2}, {Version: 5}},
+			want:       5,
+		},
+		{
+			name:       "no migrations",
+			migrations: []*Migration{},
+			want:       0,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := Latest(test.migrations)
+
+			if got != test.want {
+				t.Errorf("Latest is %d, want %d", got, test.want)
+			}
+		})
+	}
+}
+
+func TestMigration_Migrations(t *testing.T) {
+	// setup types
+	drivers := []string{
+		constants.DriverPostgres,
+		serverconstants.DriverMySQL,
+		constants.DriverSqlite,
+	}
+
+	// ensure the migrations are ordered
+	err := WithMigrations(Migrations)(new(Migrator))
+	if err != nil {
+		t.Errorf("Migrations are not ordered: %v", err)
+	}
+
+	// ensure every migration supports every driver
+	for _, migration := range Migrations {
+		for _, driver := range drivers {
+			up, ok := migration.Up[driver]
+			if !ok {
+				t.Errorf("Migration %d has no up queries for %s", migration.Version, driver)
+			}
+
+			down, ok := migration.Down[driver]
+			if !ok {
+				t.Errorf("Migration %d has no down queries for %s", migration.Version, driver)
+			}
+
+			// migrations that only add missing columns for some drivers
+			// have no queries for the other drivers to apply or roll back
+			if len(up) == 0 && len(migration.Columns) == 0 {
+				t.Errorf("Migration %d has no up queries or columns for %s", migration.Version, driver)
+			}
+
+			if len(down) == 0 && len(migration.Columns) == 0 {
+				t.Errorf("Migration %d has no down queries for %s", migration.Version, driver)
+			}
+		}
+	}
+}
@@ -0,0 +1,396 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package migration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	serverconstants "github.com/go-vela/server/constants"
+	"github.com/sirupsen/logrus"
+
+	"gorm.io/gorm"
+)
+
+type (
+	// config represents the settings required to create the Migrator.
+	config struct {
+		// specifies the driver of the database for the Migrator
+		Driver string
+	}
+
+	// Migrator represents the functionality to apply and roll back
+	// the versioned migrations for the schema of the database.
+	Migrator struct {
+		// Migrator configuration settings used in migration functions
+		config *config
+
+		// gorm.io/gorm database client used in migration functions
+		//
+		// https://pkg.go.dev/gorm.io/gorm#DB
+		client *gorm.DB
+
+		// sirupsen/logrus logger used in migration functions
+		//
+		// https://pkg.go.dev/github.com/sirupsen/logrus#Entry
+		logger *logrus.Entry
+
+		// ordered migrations for the schema of the database
+		migrations []*Migration
+	}
+
+	// Status represents the state of a migration in the database.
+	Status struct {
+		// Version is the version of the migration
+		Version int
+		// Description is the summary of the migration
+		Description string
+		// Applied is set when the migration was applied to the database
+		Applied bool
+		// AppliedAt is the time in unix seconds the migration was applied
+		AppliedAt int64
+		// Unknown is set when the migration was applied by a newer server
+		Unknown bool
+	}
+)
+
+// New creates and returns a Migrator for the schema of the database.
+func New(opts ...MigratorOpt) (*Migrator, error) {
+	// create new Migrator
+	m := new(Migrator)
+
+	// create new fields
+	m.client = new(gorm.DB)
+	m.config = new(config)
+	m.logger = logrus.NewEntry(logrus.StandardLogger())
+	m.migrations = Migrations
+
+	// apply all provided configuration options
+	for _, opt := range opts {
+		err := opt(m)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// verify a driver was provided
+	if len(m.config.Driver) == 0 {
+		return nil, fmt.Errorf("no migration driver provided")
+	}
+
+	return m, nil
+}
+
+// Latest returns the version of the last migration known by the Migrator.
+func (m *Migrator) Latest() int {
+	return Latest(m.migrations)
+}
+
+// Version returns the version of the last migration applied to the database.
+func (m *Migrator) Version(ctx context.Context) (int, error) {
+	records, err := m.records(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(records) == 0 {
+		return 0, nil
+	}
+
+	return records[len(records)-1].Version, nil
+}
+
+// Check verifies the schema of the database is not
+// newer than the latest migration known by the Migrator.
+func (m *Migrator) Check(ctx context.Context) error {
+	m.logger.Trace("checking schema version of the database")
+
+	version, err := m.Version(ctx)
+	if err != nil {
+		return err
+	}
+
+	if version > m.Latest() {
+		return fmt.Errorf("database schema version %d is newer than the latest version %d supported by this server", version, m.Latest())
+	}
+
+	return nil
+}
+
+// Status returns the state of the known migrations and
+// any migrations applied to the database by a newer server.
+func (m *Migrator) Status(ctx context.Context) ([]*Status, error) {
+	m.logger.Trace("capturing status of migrations in the database")
+
+	records, err := m.records(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	applied := make(map[int]*Record)
+
+	for _, record := range records {
+		applied[record.Version] = record
+	}
+
+	statuses := []*Status{}
+
+	for _, migration := range m.migrations {
+		status := &Status{
+			Version:     migration.Version,
+			Description: migration.Description,
+		}
+
+		if record, ok := applied[migration.Version]; ok {
+			status.Applied = true
+			status.AppliedAt = record.AppliedAt
+
+			delete(applied, migration.Version)
+		}
+
+		statuses = append(statuses, status)
+	}
+
+	// append the migrations applied by a newer server
+	for _, record := range records {
+		if _, ok := applied[record.Version]; !ok {
+			continue
+		}
+
+		statuses = append(statuses, &Status{
+			Version:     record.Version,
+			Description: record.Description,
+			Applied:     true,
+			AppliedAt:   record.AppliedAt,
+			Unknown:     true,
+		})
+	}
+
+	return statuses, nil
+}
+
+// PlanUp returns the migrations not yet applied to the database up to
+// and including the provided version, or all of them for version 0.
+func (m *Migrator) PlanUp(ctx context.Context, version int) ([]*Migration, error) {
+	if version == 0 {
+		version = m.Latest()
+	}
+
+	if version < 0 || version > m.Latest() {
+		return nil, fmt.Errorf("invalid migration version provided: %d", version)
+	}
+
+	err := m.Check(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := m.records(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	applied := make(map[int]bool)
+
+	for _, record := range records {
+		applied[record.Version] = true
+	}
+
+	pending := []*Migration{}
+
+	for _, migration := range m.migrations {
+		if migration.Version > version || applied[migration.Version] {
+			continue
+		}
+
+		if _, ok := migration.Up[m.config.Driver]; !ok {
+			return nil, fmt.Errorf("migration %d does not support the %s driver", migration.Version, m.config.Driver)
+		}
+
+		pending = append(pending, migration)
+	}
+
+	return pending, nil
+}
+
+// Up applies the migrations not yet applied to the database up to
+// and including the provided version, or all of them for version 0.
+func (m *Migrator) Up(ctx context.Context, version int) ([]*Migration, error) {
+	pending, err := m.PlanUp(ctx, version)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(pending) == 0 {
+		return pending, nil
+	}
+
+	// create the schema_migrations table
+	err = m.client.WithContext(ctx).Exec(CreateTable).Error
+	if err != nil {
+		return nil, fmt.Errorf("unable to create %s table: %w", serverconstants.TableSchemaMigration, err)
+	}
+
+	for i, migration := range pending {
+		m.logger.Infof("applying migration %d: %s", migration.Version, migration.Description)
+
+		err = m.client.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			for _, query := range migration.Up[m.config.Driver] {
+				err := tx.Exec(query).Error
+				if err != nil {
+					return err
+				}
+			}
+
+			for _, column := range migration.Columns[m.config.Driver] {
+				// skip adding the column when it already exists
+				if tx.Migrator().HasColumn(column.Table, column.Name) {
+					continue
+				}
+
+				err := tx.Exec(column.Query).Error
+				if err != nil {
+					return err
+				}
+			}
+
+			for _, index := range migration.Indexes[m.config.Driver] {
+				// skip creating the index when it already exists
+				if tx.Migrator().HasIndex(index.Table, index.Name) {
+					continue
+				}
+
+				err := tx.Exec(index.Query).Error
+				if err != nil {
+					return err
+				}
+			}
+
+			// record the migration as applied
+			return tx.
+				Table(serverconstants.TableSchemaMigration).
+				Create(&Record{
+					Version:     migration.Version,
+					Description: migration.Description,
+					AppliedAt:   time.Now().UTC().Unix(),
+				}).
+				Error
+		})
+		if err != nil {
+			return pending[:i], fmt.Errorf("unable to apply migration %d: %w", migration.Version, err)
+		}
+	}
+
+	return pending, nil
+}
+
+// PlanDown returns the migrations applied to the database
+// after the provided version, starting with the latest.
+func (m *Migrator) PlanDown(ctx context.Context, version int) ([]*Migration, error) {
+	if version < 0 {
+		return nil, fmt.Errorf("invalid migration version provided: %d", version)
+	}
+
+	records, err := m.records(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	known := make(map[int]*Migration)
+
+	for _, migration := range m.migrations {
+		known[migration.Version] = migration
+	}
+
+	pending := []*Migration{}
+
+	for i := len(records) - 1; i >= 0; i-- {
+		if records[i].Version <= version {
+			break
+		}
+
+		migration, ok := known[records[i].Version]
+		if !ok {
+			return nil, fmt.Errorf("unable to roll back unknown migration %d: %s", records[i].Version, records[i].Description)
+		}
+
+		if _, ok := migration.Down[m.config.Driver]; !ok {
+			return nil, fmt.Errorf("migration %d does not support the %s driver", migration.Version, m.config.Driver)
+		}
+
+		pending = append(pending, migration)
+	}
+
+	return pending, nil
+}
+
+// Down rolls back the migrations applied to the database
+// after the provided version, starting with the latest.
+func (m *Migrator) Down(ctx context.Context, version int) ([]*Migration, error) {
+	pending, err := m.PlanDown(ctx, version)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, migration := range pending {
+		m.logger.Infof("rolling back migration %d: %s", migration.Version, migration.Description)
+
+		err = m.client.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			for _, query := range migration.Down[m.config.Driver] {
+				err := tx.Exec(query).Error
+				if err != nil {
+					return err
+				}
+			}
+
+			// remove the record of the migration
+			return tx.
+				Table(serverconstants.TableSchemaMigration).
+				Where("version = ?", migration.Version).
+				Delete(&Record{}).
+				Error
+		})
+		if err != nil {
+			return pending[:i], fmt.Errorf("unable to roll back migration %d: %w", migration.Version, err)
+		}
+	}
+
+	return pending, nil
+}
+
+// Close closes the connection to the database for the Migrator.
+func (m *Migrator) Close() error {
+	m.logger.Trace("closing migrator connection to the database")
+
+	db, err := m.client.DB()
+	if err != nil {
+		return err
+	}
+
+	return db.Close()
+}
+
+// records is a helper function to capture the migrations
+// applied to the database ordered by the version.
+func (m *Migrator) records(ctx context.Context) ([]*Record, error) {
+	records := []*Record{}
+
+	// the migrations were never applied without the table
+	if !m.client.WithContext(ctx).Migrator().HasTable(serverconstants.TableSchemaMigration) {
+		return records, nil
+	}
+
+	err := m.client.
+		WithContext(ctx).
+		Table(serverconstants.TableSchemaMigration).
+		Order("version ASC").
+		Find(&records).
+		Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fmt.Errorf("unable to capture migrations from %s table: %w", serverconstants.TableSchemaMigration, err)
+	}
+
+	return records, nil
+}
@@ -0,0 +1,683 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package migration
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	serverconstants "github.com/go-vela/server/constants"
+	"github.com/go-vela/types/constants"
+	"github.com/sirupsen/logrus"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func TestMigration_New(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		name    string
+		opts    []MigratorOpt
+	}{
+		{
+			failure: false,
+			name:    "sqlite3",
+			opts:    []MigratorOpt{WithDriver(constants.DriverSqlite)},
+		},
+		{
+			failure: true,
+			name:    "no driver",
+			opts:    []MigratorOpt{},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := New(test.opts...)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("New for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("New for %s returned err: %v", test.name, err)
+			}
+
+			if got.Latest() != Latest(Migrations) {
+				t.Errorf("New for %s is at latest %d, want %d", test.name, got.Latest(), Latest(Migrations))
+			}
+		})
+	}
+}
+
+func TestMigration_Migrator_Baseline(t *testing.T) {
+	// setup types
+	_sqlite := testSqlite(t, t.Name(), Migrations)
+	defer _sqlite.Close()
+
+	// run test
+	got, err := _sqlite.Up(context.TODO(), 0)
+	if err != nil {
+		t.Errorf("Up returned err: %v", err)
+	}
+
+	if len(got) != len(Migrations) {
+		t.Errorf("Up applied %d migrations, want %d", len(got), len(Migrations))
+	}
+
+	for _, table := range baselineTables {
+		if !_sqlite.client.Migrator().HasTable(table) {
+			t.Errorf("Up did not create %s table", table)
+		}
+	}
+
+	// ensure applying the migrations again does nothing
+	got, err = _sqlite.Up(context.TODO(), 0)
+	if err != nil {
+		t.Errorf("Up returned err: %v", err)
+	}
+
+	if len(got) != 0 {
+		t.Errorf("Up applied %d migrations again, want 0", len(got))
+	}
+
+	got, err = _sqlite.Down(context.TODO(), 0)
+	if err != nil {
+		t.Errorf("Down returned err: %v", err)
+	}
+
+	if len(got) != len(Migrations) {
+		t.Errorf("Down rolled back %d migrations, want %d", len(got), len(Migrations))
+	}
+
+	for _, table := range baselineTables {
+		if _sqlite.client.Migrator().HasTable(table) {
+			t.Errorf("Down did not drop %s table", table)
+		}
+	}
+}
+
+func TestMigration_Migrator_Columns(t *testing.T) {
+	// setup types
+	_sqlite := testSqlite(t, t.Name(), Migrations)
+	defer _sqlite.Close()
+
+	// create the tables as they existed before the columns were introduced
+	legacy := []string{
+		repoLegacyTable,
+		userLegacyTable,
+		"CREATE TABLE workers (id INTEGER PRIMARY KEY AUTOINCREMENT, hostname TEXT, address TEXT);",
+	}
+
+	for _, query := range legacy {
+		err := _sqlite.client.Exec(query).Error
+		if err != nil {
+			t.Errorf("unable to create legacy table: %v", err)
+		}
+	}
+
+	// run test
+	_, err := _sqlite.Up(context.TODO(), 0)
+	if err != nil {
+		t.Errorf("Up returned err: %v", err)
+	}
+
+	for _, column := range sqliteColumns.Columns[constants.DriverSqlite] {
+		if !_sqlite.client.Migrator().HasColumn(column.Table, column.Name) {
+			t.Errorf("Up did not add %s column to %s table", column.Name, column.Table)
+		}
+	}
+}
+
+func TestMigration_Migrator_Indexes(t *testing.T) {
+	// setup types
+	migrations := []*Migration{
+		{
+			Version:     1,
+			Description: "create the foo_id index",
+			Up:          map[string][]string{constants.DriverSqlite: {}},
+			Indexes: map[string][]*Index{
+				constants.DriverSqlite: {
+					{Table: "foo", Name: "foo_id", Query: "CREATE UNIQUE INDEX foo_id ON foo (id);"},
+				},
+			},
+			Down: map[string][]string{constants.DriverSqlite: {"DROP INDEX foo_id;"}},
+		},
+	}
+
+	_sqlite := testSqlite(t, t.Name(), migrations)
+	defer _sqlite.Close()
+
+	// create the index as left by a migration that was not recorded
+	for _, query := range []string{"CREATE TABLE foo (id INTEGER);", "CREATE UNIQUE INDEX foo_id ON foo (id);"} {
+		err := _sqlite.client.Exec(query).Error
+		if err != nil {
+			t.Errorf("unable to create partial migration: %v", err)
+		}
+	}
+
+	// run test
+	got, err := _sqlite.Up(context.TODO(), 0)
+	if err != nil {
+		t.Errorf("Up returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(versions(got), []int{1}) {
+		t.Errorf("Up is %v, want %v", versions(got), []int{1})
+	}
+
+	if !_sqlite.client.Migrator().HasIndex("foo", "foo_id") {
+		t.Errorf("Up dropped foo_id index")
+	}
+}
+
+func TestMigration_Migrator_RepoProvider(t *testing.T) {
+	// setup types
+	_sqlite := testSqlite(t, t.Name(), Migrations)
+	defer _sqlite.Close()
+
+	// create the repos table as it existed before the scm_provider column
+	err := _sqlite.client.Exec(repoLegacyTable).Error
+	if err != nil {
+		t.Errorf("unable to create legacy table: %v", err)
+	}
+
+	err = _sqlite.client.Exec("INSERT INTO repos (org, name, full_name) VALUES ('foo', 'bar', 'foo/bar');").Error
+	if err != nil {
+		t.Errorf("unable to create legacy repo: %v", err)
+	}
+
+	// run test
+	_, err = _sqlite.Up(context.TODO(), 0)
+	if err != nil {
+		t.Errorf("Up returned err: %v", err)
+	}
+
+	var provider *string
+
+	err = _sqlite.client.Raw("SELECT scm_provider FROM repos WHERE full_name = 'foo/bar';").Row().Scan(&provider)
+	if err != nil {
+		t.Errorf("unable to get scm provider for legacy repo: %v", err)
+	}
+
+	if provider == nil || len(*provider) > 0 {
+		t.Errorf("Up set scm provider for legacy repo to %v, want default provider", provider)
+	}
+
+	// the same repo can be stored for another scm provider
+	err = _sqlite.client.Exec("INSERT INTO repos (org, name, full_name, scm_provider) VALUES ('foo', 'bar', 'foo/bar', 'gitlab');").Error
+	if err != nil {
+		t.Errorf("unable to create repo for another scm provider: %v", err)
+	}
+
+	// the same repo cannot be stored twice for the same scm provider
+	err = _sqlite.client.Exec("INSERT INTO repos (org, name, full_name, scm_provider) VALUES ('foo', 'bar', 'foo/bar', '');").Error
+	if err == nil {
+		t.Errorf("creating the same repo for the same scm provider should have returned err")
+	}
+
+	// rolling back fails while the same repo is stored for more than one scm provider
+	_, err = _sqlite.Down(context.TODO(), 4)
+	if err == nil {
+		t.Errorf("Down should have returned err")
+	}
+
+	err = _sqlite.client.Exec("DELETE FROM repos WHERE scm_provider = 'gitlab';").Error
+	if err != nil {
+		t.Errorf("unable to delete repo for another scm provider: %v", err)
+	}
+
+	_, err = _sqlite.Down(context.TODO(), 4)
+	if err != nil {
+		t.Errorf("Down returned err: %v", err)
+	}
+
+	err = _sqlite.client.Exec("INSERT INTO repos (org, name, full_name, scm_provider) VALUES ('foo', 'bar', 'foo/bar', 'gitlab');").Error
+	if err == nil {
+		t.Errorf("creating the same repo for another scm provider after Down should have returned err")
+	}
+}
+
+func TestMigration_Migrator_UserProvider(t *testing.T) {
+	// setup types
+	_sqlite := testSqlite(t, t.Name(), Migrations)
+	defer _sqlite.Close()
+
+	// create the users table as it existed before the scm_provider column
+	err := _sqlite.client.Exec(userLegacyTable).Error
+	if err != nil {
+		t.Errorf("unable to create legacy table: %v", err)
+	}
+
+	err = _sqlite.client.Exec("INSERT INTO users (name, refresh_token) VALUES ('octocat', 'foo');").Error
+	if err != nil {
+		t.Errorf("unable to create legacy user: %v", err)
+	}
+
+	// run test
+	_, err = _sqlite.Up(context.TODO(), 0)
+	if err != nil {
+		t.Errorf("Up returned err: %v", err)
+	}
+
+	var provider *string
+
+	err = _sqlite.client.Raw("SELECT scm_provider FROM users WHERE name = 'octocat';").Row().Scan(&provider)
+	if err != nil {
+		t.Errorf("unable to get scm provider for legacy user: %v", err)
+	}
+
+	if provider == nil || len(*provider) > 0 {
+		t.Errorf("Up set scm provider for legacy user to %v, want default provider", provider)
+	}
+
+	if !_sqlite.client.Migrator().HasIndex("users", "users_refresh") {
+		t.Errorf("Up did not recreate users_refresh index")
+	}
+
+	// the same login can be stored for another scm provider
+	err = _sqlite.client.Exec("INSERT INTO users (name, scm_provider) VALUES ('octocat', 'gitlab');").Error
+	if err != nil {
+		t.Errorf("unable to create user for another scm provider: %v", err)
+	}
+
+	// the same login cannot be stored twice for the same scm provider
+	err = _sqlite.client.Exec("INSERT INTO users (name, scm_provider) VALUES ('octocat', '');").Error
+	if err == nil {
+		t.Errorf("creating the same user for the same scm provider should have returned err")
+	}
+
+	// rolling back fails while the same login is stored for more than one scm provider
+	_, err = _sqlite.Down(context.TODO(), 5)
+	if err == nil {
+		t.Errorf("Down should have returned err")
+	}
+
+	err = _sqlite.client.Exec("DELETE FROM users WHERE scm_provider = 'gitlab';").Error
+	if err != nil {
+		t.Errorf("unable to delete user for another scm provider: %v", err)
+	}
+
+	_, err = _sqlite.Down(context.TODO(), 5)
+	if err != nil {
+		t.Errorf("Down returned err: %v", err)
+	}
+
+	err = _sqlite.client.Exec("INSERT INTO users (name, scm_provider) VALUES ('octocat', 'gitlab');").Error
+	if err == nil {
+		t.Errorf("creating the same user for another scm provider after Down should have returned err")
+	}
+}
+
+func TestMigration_Migrator_Up(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		name    string
+		version int
+		want    []int
+		tables  []string
+	}{
+		{
+			failure: false,
+			name:    "latest",
+			version: 0,
+			want:    []int{1, 2},
+			tables:  []string{"foo", "bar"},
+		},
+		{
+			failure: false,
+			name:    "first",
+			version: 1,
+			want:    []int{1},
+			tables:  []string{"foo"},
+		},
+		{
+			failure: true,
+			name:    "unknown",
+			version: 3,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_sqlite := testSqlite(t, t.Name(), testMigrations())
+			defer _sqlite.Close()
+
+			plan, err := _sqlite.PlanUp(context.TODO(), test.version)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("PlanUp for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("PlanUp for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(versions(plan), test.want) {
+				t.Errorf("PlanUp for %s is %v, want %v", test.name, versions(plan), test.want)
+			}
+
+			// ensure planning does not create the schema_migrations table
+			if _sqlite.client.Migrator().HasTable(serverconstants.TableSchemaMigration) {
+				t.Errorf("PlanUp for %s created %s table", test.name, serverconstants.TableSchemaMigration)
+			}
+
+			got, err := _sqlite.Up(context.TODO(), test.version)
+			if err != nil {
+				t.Errorf("Up for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(versions(got), test.want) {
+				t.Errorf("Up for %s is %v, want %v", test.name, versions(got), test.want)
+			}
+
+			for _, table := range test.tables {
+				if !_sqlite.client.Migrator().HasTable(table) {
+					t.Errorf("Up for %s did not create %s table", test.name, table)
+				}
+			}
+
+			version, err := _sqlite.Version(context.TODO())
+			if err != nil {
+				t.Errorf("Version for %s returned err: %v", test.name, err)
+			}
+
+			if version != test.want[len(test.want)-1] {
+				t.Errorf("Version for %s is %d, want %d", test.name, version, test.want[len(test.want)-1])
+			}
+		})
+	}
+}
+
+func TestMigration_Migrator_Up_Failure(t *testing.T) {
+	// setup types
+	migrations := testMigrations()
+	migrations[1].Up[constants.DriverSqlite] = []string{"CREATE TABLE bar (id INTEGER);", "CREATE TABLE bar (id INTEGER);"}
+
+	_sqlite := testSqlite(t, t.Name(), migrations)
+	defer _sqlite.Close()
+
+	// run test
+	got, err := _sqlite.Up(context.TODO(), 0)
+	if err == nil {
+		t.Errorf("Up should have returned err")
+	}
+
+	if !reflect.DeepEqual(versions(got), []int{1}) {
+		t.Errorf("Up is %v, want %v", versions(got), []int{1})
+	}
+
+	version, err := _sqlite.Version(context.TODO())
+	if err != nil {
+		t.Errorf("Version returned err: %v", err)
+	}
+
+	if version != 1 {
+		t.Errorf("Version is %d, want %d", version, 1)
+	}
+
+	// ensure the failed migration was rolled back
+	if _sqlite.client.Migrator().HasTable("bar") {
+		t.Errorf("Up did not roll back bar table")
+	}
+}
+
+func TestMigration_Migrator_Down(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		name    string
+		version int
+		want    []int
+		current int
+	}{
+		{
+			failure: false,
+			name:    "all",
+			version: 0,
+			want:    []int{2, 1},
+			current: 0,
+		},
+		{
+			failure: false,
+			name:    "previous",
+			version: 1,
+			want:    []int{2},
+			current: 1,
+		},
+		{
+			failure: false,
+			name:    "current",
+			version: 2,
+			want:    []int{},
+			current: 2,
+		},
+		{
+			failure: true,
+			name:    "negative",
+			version: -1,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_sqlite := testSqlite(t, t.Name(), testMigrations())
+			defer _sqlite.Close()
+
+			_, err := _sqlite.Up(context.TODO(), 0)
+			if err != nil {
+				t.Errorf("unable to apply test migrations: %v", err)
+			}
+
+			plan, err := _sqlite.PlanDown(context.TODO(), test.version)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("PlanDown for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("PlanDown for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(versions(plan), test.want) {
+				t.Errorf("PlanDown for %s is %v, want %v", test.name, versions(plan), test.want)
+			}
+
+			got, err := _sqlite.Down(context.TODO(), test.version)
+			if err != nil {
+				t.Errorf("Down for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(versions(got), test.want) {
+				t.Errorf("Down for %s is %v, want %v", test.name, versions(got), test.want)
+			}
+
+			version, err := _sqlite.Version(context.TODO())
+			if err != nil {
+				t.Errorf("Version for %s returned err: %v", test.name, err)
+			}
+
+			if version != test.current {
+				t.Errorf("Version for %s is %d, want %d", test.name, version, test.current)
+			}
+		})
+	}
+}
+
+func TestMigration_Migrator_Newer(t *testing.T) {
+	// setup types
+	_sqlite := testSqlite(t, t.Name(), testMigrations())
+	defer _sqlite.Close()
+
+	_, err := _sqlite.Up(context.TODO(), 0)
+	if err != nil {
+		t.Errorf("unable to apply test migrations: %v", err)
+	}
+
+	// record a migration applied by a newer server
+	err = _sqlite.client.
+		Table(serverconstants.TableSchemaMigration).
+		Create(&Record{Version: 3, Description: "create the baz table", AppliedAt: 1}).
+		Error
+	if err != nil {
+		t.Errorf("unable to create test migration record: %v", err)
+	}
+
+	// run tests
+	err = _sqlite.Check(context.TODO())
+	if err == nil {
+		t.Errorf("Check should have returned err")
+	}
+
+	_, err = _sqlite.Up(context.TODO(), 0)
+	if err == nil {
+		t.Errorf("Up should have returned err")
+	}
+
+	_, err = _sqlite.Down(context.TODO(), 0)
+	if err == nil {
+		t.Errorf("Down should have returned err")
+	}
+
+	got, err := _sqlite.Status(context.TODO())
+	if err != nil {
+		t.Errorf("Status returned err: %v", err)
+	}
+
+	if len(got) != 3 {
+		t.Errorf("Status returned %d migrations, want %d", len(got), 3)
+	}
+
+	want := &Status{Version: 3, Description: "create the baz table", Applied: true, AppliedAt: 1, Unknown: true}
+
+	if !reflect.DeepEqual(got[2], want) {
+		t.Errorf("Status is %v, want %v", got[2], want)
+	}
+}
+
+func TestMigration_Migrator_Status(t *testing.T) {
+	// setup types
+	_sqlite := testSqlite(t, t.Name(), testMigrations())
+	defer _sqlite.Close()
+
+	_, err := _sqlite.Up(context.TODO(), 1)
+	if err != nil {
+		t.Errorf("unable to apply test migrations: %v", err)
+	}
+
+	// run test
+	got, err := _sqlite.Status(context.TODO())
+	if err != nil {
+		t.Errorf("Status returned err: %v", err)
+	}
+
+	if len(got) != 2 {
+		t.Errorf("Status returned %d migrations, want %d", len(got), 2)
+	}
+
+	if !got[0].Applied || got[0].AppliedAt == 0 || got[0].Unknown {
+		t.Errorf("Status for migration 1 is %v, want applied", got[0])
+	}
+
+	want := &Status{Version: 2, Description: "create the bar table"}
+
+	if !reflect.DeepEqual(got[1], want) {
+		t.Errorf("Status is %v, want %v", got[1], want)
+	}
+}
+
+// repoLegacyTable represents a query to create the Sqlite
+// repos table as it existed before the scm_provider column.
+const repoLegacyTable = `
+CREATE TABLE repos (
+	id INTEGER PRIMARY KEY AUTOINCREMENT, user_id INTEGER, hash TEXT, org TEXT, name TEXT, full_name TEXT,
+	link TEXT, clone TEXT, branch TEXT, topics TEXT, build_limit INTEGER, timeout INTEGER, counter INTEGER,
+	visibility TEXT, private BOOLEAN, trusted BOOLEAN, active BOOLEAN, allow_pull BOOLEAN, allow_push BOOLEAN,
+	allow_deploy BOOLEAN, allow_tag BOOLEAN, allow_comment BOOLEAN, pipeline_type TEXT, previous_name TEXT,
+	UNIQUE(full_name)
+);
+`
+
+// userLegacyTable represents a query to create the Sqlite
+// users table as it existed before the scm_provider column.
+const userLegacyTable = `
+CREATE TABLE users (
+	id INTEGER PRIMARY KEY AUTOINCREMENT, name TEXT, refresh_token TEXT, token TEXT,
+	hash TEXT, favorites TEXT, active BOOLEAN, admin BOOLEAN,
+	UNIQUE(name)
+);
+`
+
+// testSqlite is a helper function to create a Sqlite migrator for testing.
+func testSqlite(t *testing.T, name string, migrations []*Migration) *Migrator {
+	_sqlite, err := gorm.Open(
+		sqlite.Open(fmt.Sprintf("file:%s?mode=memory&cache=shared", name)),
+		&gorm.Config{SkipDefaultTransaction: true},
+	)
+	if err != nil {
+		t.Errorf("unable to create new sqlite database: %v", err)
+	}
+
+	_migrator, err := New(
+		WithClient(_sqlite),
+		WithDriver(constants.DriverSqlite),
+		WithLogger(logrus.NewEntry(logrus.StandardLogger())),
+		WithMigrations(migrations),
+	)
+	if err != nil {
+		t.Errorf("unable to create new sqlite migrator: %v", err)
+	}
+
+	return _migrator
+}
+
+// testMigrations is a helper function to create
+// the ordered migrations for testing.
+func testMigrations() []*Migration {
+	return []*Migration{
+		{
+			Version:     1,
+			Description: "create the foo table",
+			Up:          map[string][]string{constants.DriverSqlite: {"CREATE TABLE foo (id INTEGER);"}},
+			Down:        map[string][]string{constants.DriverSqlite: {"DROP TABLE foo;"}},
+		},
+		{
+			Version:     2,
+			Description: "create the bar table",
+			Up:          map[string][]string{constants.DriverSqlite: {"CREATE TABLE bar (id INTEGER);"}},
+			Down:        map[string][]string{constants.DriverSqlite: {"DROP TABLE bar;"}},
+		},
+	}
+}
+
+// versions is a helper function to capture
+// the versions of the provided migrations.
+func versions(migrations []*Migration) []int {
+	got := []int{}
+
+	for _, migration := range migrations {
+		got = append(got, migration.Version)
+	}
+
+	return got
+}
@@ -0,0 +1,70 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package migration
+
+import (
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+
+	"gorm.io/gorm"
+)
+
+// MigratorOpt represents a configuration option to initialize the migrator for the database.
+type MigratorOpt func(*Migrator) error
+
+// WithClient sets the gorm.io/gorm client in the migrator for the database.
+func WithClient(client *gorm.DB) MigratorOpt {
+	return func(m *Migrator) error {
+		// set the gorm.io/gorm client in the migrator
+		m.client = client
+
+		return nil
+	}
+}
+
+// WithDriver sets the driver in the migrator for the database.
+func WithDriver(driver string) MigratorOpt {
+	return func(m *Migrator) error {
+		// check if the driver provided is empty
+		if len(driver) == 0 {
+			return fmt.Errorf("no migration driver provided")
+		}
+
+		// set the driver in the migrator
+		m.config.Driver = driver
+
+		return nil
+	}
+}
+
+// WithLogger sets the github.com/sirupsen/logrus logger in the migrator for the database.
+func WithLogger(logger *logrus.Entry) MigratorOpt {
+	return func(m *Migrator) error {
+		// set the github.com/sirupsen/logrus logger in the migrator
+		m.logger = logger
+
+		return nil
+	}
+}
+
+// WithMigrations sets the ordered migrations in the migrator for the database.
+func WithMigrations(migrations []*Migration) MigratorOpt {
+	return func(m *Migrator) error {
+		// verify the migrations are ordered by a unique version
+		for i, migration := range migrations {
+			if migration.Version <= 0 {
+				return fmt.Errorf("invalid migration version provided: %d", migration.Version)
+			}
+
+			if i > 0 && migration.Version <= migrations[i-1].Version {
+				return fmt.Errorf("invalid migration version provided: %d must be greater than %d", migration.Version, migrations[i-1].Version)
+			}
+		}
+
+		// set the migrations in the migrator
+		m.migrations = migrations
+
+		return nil
+	}
+}
@@ -0,0 +1,175 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package migration
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+
+	"gorm.io/gorm"
+)
+
+func TestMigration_MigratorOpt_WithClient(t *testing.T) {
+	// setup types
+	m := &Migrator{client: new(gorm.DB)}
+
+	// setup tests
+	tests := []struct {
+		name   string
+		client *gorm.DB
+		want   *gorm.DB
+	}{
+		{
+			name:   "client set to new database",
+			client: new(gorm.DB),
+			want:   new(gorm.DB),
+		},
+		{
+			name:   "client set to nil",
+			client: nil,
+			want:   nil,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := WithClient(test.client)(m)
+			if err != nil {
+				t.Errorf("WithClient returned err: %v", err)
+			}
+
+			if !reflect.DeepEqual(m.client, test.want) {
+				t.Errorf("WithClient is %v, want %v", m.client, test.want)
+			}
+		})
+	}
+}
+
+func TestMigration_MigratorOpt_WithDriver(t *testing.T) {
+	// setup types
+	m := &Migrator{config: new(config)}
+
+	// setup tests
+	tests := []struct {
+		failure bool
+		name    string
+		driver  string
+		want    string
+	}{
+		{
+			failure: false,
+			name:    "driver set to sqlite3",
+			driver:  "sqlite3",
+			want:    "sqlite3",
+		},
+		{
+			failure: true,
+			name:    "driver set to empty",
+			driver:  "",
+			want:    "",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := WithDriver(test.driver)(m)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("WithDriver for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("WithDriver returned err: %v", err)
+			}
+
+			if !reflect.DeepEqual(m.config.Driver, test.want) {
+				t.Errorf("WithDriver is %v, want %v", m.config.Driver, test.want)
+			}
+		})
+	}
+}
+
+func TestMigration_MigratorOpt_WithLogger(t *testing.T) {
+	// setup types
+	m := &Migrator{logger: new(logrus.Entry)}
+
+	logger := logrus.NewEntry(logrus.StandardLogger())
+
+	err := WithLogger(logger)(m)
+	if err != nil {
+		t.Errorf("WithLogger returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(m.logger, logger) {
+		t.Errorf("WithLogger is %v, want %v", m.logger, logger)
+	}
+}
+
+func TestMigration_MigratorOpt_WithMigrations(t *testing.T) {
+	// setup types
+	m := new(Migrator)
+
+	// setup tests
+	tests := []struct {
+		failure    bool
+		name       string
+		migrations []*Migration
+	}{
+		{
+			failure:    false,
+			name:       "ordered migrations",
+			migrations: []*Migration{{Version: 1}, {Version: 2}, {Version: 5}},
+		},
+		{
+			failure:    false,
+			name:       "no migrations",
+			migrations: []*Migration{},
+		},
+		{
+			failure:    true,
+			name:       "unordered migrations",
+			migrations: []*Migration{{Version: 2}, {Version: 1}},
+		},
+		{
+			failure:    true,
+			name:       "duplicate migrations",
+			migrations: []*Migration{{Version: 1}, {Version: 1}},
+		},
+		{
+			failure:    true,
+			name:       "zero version",
+			migrations: []*Migration{{Version: 0}},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := WithMigrations(test.migrations)(m)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("WithMigrations for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("WithMigrations returned err: %v", err)
+			}
+
+			if !reflect.DeepEqual(m.migrations, test.migrations) {
+				t.Errorf("WithMigrations is %v, want %v", m.migrations, test.migrations)
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package migration
+
+import (
+	serverconstants "github.com/go-vela/server/constants"
+	"github.com/go-vela/server/database/repo"
+	"github.com/go-vela/types/constants"
+)
+
+const (
+	// defaultRepoProvider represents a query to store the empty name of the
+	// default scm provider for repos created before the scm_provider column
+	// so the unique index also applies to them.
+	defaultRepoProvider = `UPDATE repos SET scm_provider = '' WHERE scm_provider IS NULL;`
+
+	// renameSqliteRepos represents a query to move the Sqlite repos table aside
+	// since the unique full_name constraint created with it cannot be dropped.
+	renameSqliteRepos = `ALTER TABLE repos RENAME TO repos_full_name;`
+
+	// createSqliteRepos represents a query to create the Sqlite
+	// repos table without the unique full_name constraint.
+	createSqliteRepos = `
+CREATE TABLE
+repos (
+	id            INTEGER PRIMARY KEY AUTOINCREMENT,
+	user_id       INTEGER,
+	hash          TEXT,
+	org           TEXT,
+	name          TEXT,
+	full_name     TEXT,
+	link          TEXT,
+	clone         TEXT,
+	branch        TEXT,
+	topics        TEXT,
+	build_limit   INTEGER,
+	timeout       INTEGER,
+	counter       INTEGER,
+	visibility    TEXT,
+	private       BOOLEAN,
+	trusted       BOOLEAN,
+	active        BOOLEAN,
+	allow_pull    BOOLEAN,
+	allow_push    BOOLEAN,
+	allow_deploy  BOOLEAN,
+	allow_tag     BOOLEAN,
+	allow_comment BOOLEAN,
+	pipeline_type TEXT,
+	previous_name TEXT,
+	scm_provider  TEXT,
+	allow_release BOOLEAN,
+	allow_review  BOOLEAN,
+	ignore_skip_directives BOOLEAN,
+	priority      INTEGER
+);
+`
+
+	// copySqliteRepos represents a query to copy the repos
+	// from the Sqlite repos table that was moved aside.
+	copySqliteRepos = `
+INSERT INTO repos (
+	id, user_id, hash, org, name, full_name, link, clone, branch, topics,
+	build_limit, timeout, counter, visibility, private, trusted, active,
+	allow_pull, allow_push, allow_deploy, allow_tag, allow_comment,
+	pipeline_type, previous_name, scm_provider, allow_release, allow_review,
+	ignore_skip_directives, priority
+)
+SELECT
+	id, user_id, hash, org, name, full_name, link, clone, branch, topics,
+	build_limit, timeout, counter, visibility, private, trusted, active,
+	allow_pull, allow_push, allow_deploy, allow_tag, allow_comment,
+	pipeline_type, previous_name, scm_provider, allow_release, allow_review,
+	ignore_skip_directives, priority
+FROM repos_full_name;
+`
+)
+
+// repoProvider represents the migration that allows the same repo
+// to be stored once for each scm provider it is enabled from.
+//
+// The unique constraint on the full_name column of the repos table
+// is replaced with a unique index on the scm_provider and full_name
+// columns. Sqlite does not support dropping the constraint so the
+// repos table is recreated without it.
+var repoProvider = &Migration{
+	Version:     5,
+	Description: "make repos unique by scm_provider and full_name",
+	Up: map[string][]string{
+		constants.DriverPostgres: {
+			defaultRepoProvider,
+			"ALTER TABLE repos DROP CONSTRAINT IF EXISTS repos_full_name_key;",
+			repo.CreateProviderFullNameIndex,
+		},
+		serverconstants.DriverMySQL: {
+			defaultRepoProvider,
+		},
+		constants.DriverSqlite: {
+			defaultRepoProvider,
+			renameSqliteRepos,
+			createSqliteRepos,
+			copySqliteRepos,
+			"DROP TABLE repos_full_name;",
+			repo.CreateOrgNameIndex,
+			repo.CreateProviderFullNameIndex,
+		},
+	},
+	// MySQL does not support creating an index only if it does not exist
+	Indexes: map[string][]*Index{
+		serverconstants.DriverMySQL: {
+			{Table: constants.TableRepo, Name: "repos_scm_provider_full_name", Query: repo.CreateMySQLProviderFullNameIndex},
+		},
+	},
+	// rolling back fails while the same repo is stored for more than one scm provider
+	Down: map[string][]string{
+		constants.DriverPostgres: {
+			"DROP INDEX IF EXISTS repos_scm_provider_full_name;",
+			"ALTER TABLE repos ADD CONSTRAINT repos_full_name_key UNIQUE (full_name);",
+		},
+		serverconstants.DriverMySQL: {
+			"ALTER TABLE repos DROP INDEX repos_scm_provider_full_name, ADD UNIQUE INDEX full_name (full_name);",
+		},
+		constants.DriverSqlite: {
+			"DROP INDEX IF EXISTS repos_scm_provider_full_name;",
+			"CREATE UNIQUE INDEX IF NOT EXISTS repos_full_name ON repos (full_name);",
+		},
+	},
+}
@@ -0,0 +1,44 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package migration
+
+import (
+	serverconstants "github.com/go-vela/server/constants"
+	"github.com/go-vela/server/database/repo"
+	"github.com/go-vela/server/database/user"
+	"github.com/go-vela/server/database/worker"
+	"github.com/go-vela/types/constants"
+)
+
+// sqliteColumns represents the migration that adds the columns
+// missing from Sqlite tables created before they were introduced.
+//
+// The engines added these columns on startup before migrations,
+// but the baseline only creates the tables with the columns so
+// Sqlite databases older than the columns never received them.
+var sqliteColumns = &Migration{
+	Version:     4,
+	Description: "add the columns missing from existing sqlite tables",
+	Up: map[string][]string{
+		constants.DriverPostgres:    {},
+		serverconstants.DriverMySQL: {},
+		constants.DriverSqlite:      {},
+	},
+	Columns: map[string][]*Column{
+		constants.DriverSqlite: {
+			{Table: constants.TableRepo, Name: "scm_provider", Query: repo.AddProviderSqliteColumn},
+			{Table: constants.TableRepo, Name: "allow_release", Query: repo.AddSettingsSqliteColumns["allow_release"]},
+			{Table: constants.TableRepo, Name: "allow_review", Query: repo.AddSettingsSqliteColumns["allow_review"]},
+			{Table: constants.TableRepo, Name: "ignore_skip_directives", Query: repo.AddSettingsSqliteColumns["ignore_skip_directives"]},
+			{Table: constants.TableRepo, Name: "priority", Query: repo.AddSettingsSqliteColumns["priority"]},
+			{Table: constants.TableUser, Name: "scm_provider", Query: user.AddProviderSqliteColumn},
+			{Table: constants.TableWorker, Name: "labels", Query: worker.AddLabelsSqliteColumn},
+		},
+	},
+	// the columns are part of the tables created by the baseline
+	Down: map[string][]string{
+		constants.DriverPostgres:    {},
+		serverconstants.DriverMySQL: {},
+		constants.DriverSqlite:      {},
+	},
+}
@@ -0,0 +1,23 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package migration
+
+// CreateTable represents a query to create the schema_migrations table
+// for all supported drivers.
+const CreateTable = `
+CREATE TABLE
+IF NOT EXISTS
+schema_migrations (
+	version     INTEGER PRIMARY KEY,
+	description VARCHAR(250),
+	applied_at  INTEGER
+);
+`
+
+// Record represents a migration applied to the database
+// and stored in the schema_migrations table.
+type Record struct {
+	Version     int    `gorm:"column:version;primaryKey;autoIncrement:false"`
+	Description string `gorm:"column:description"`
+	AppliedAt   int64  `gorm:"column:applied_at"`
+}
@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package migration
+
+import (
+	serverconstants "github.com/go-vela/server/constants"
+	"github.com/go-vela/server/database/user"
+	"github.com/go-vela/types/constants"
+)
+
+const (
+	// defaultUserProvider represents a query to store the empty name of the
+	// default scm provider for users created before the scm_provider column
+	// so the unique index also applies to them.
+	defaultUserProvider = `UPDATE users SET scm_provider = '' WHERE scm_provider IS NULL;`
+
+	// renameSqliteUsers represents a query to move the Sqlite users table aside
+	// since the unique name constraint created with it cannot be dropped.
+	renameSqliteUsers = `ALTER TABLE users RENAME TO users_name;`
+
+	// createSqliteUsers represents a query to create the Sqlite
+	// users table without the unique name constraint.
+	createSqliteUsers = `
+CREATE TABLE
+users (
+	id             INTEGER PRIMARY KEY AUTOINCREMENT,
+	name           TEXT,
+	refresh_token  TEXT,
+	token          TEXT,
+	hash           TEXT,
+	favorites      TEXT,
+	active         BOOLEAN,
+	admin          BOOLEAN,
+	scm_provider   TEXT
+);
+`
+
+	// copySqliteUsers represents a query to copy the users
+	// from the Sqlite users table that was moved aside.
+	copySqliteUsers = `
+INSERT INTO users (
+	id, name, refresh_token, token, hash, favorites, active, admin, scm_provider
+)
+SELECT
+	id, name, refresh_token, token, hash, favorites, active, admin, scm_provider
+FROM users_name;
+`
+)
+
+// userProvider represents the migration that allows the same login
+// to be stored once for each scm provider users log in from.
+//
+// The unique constraint on the name column of the users table is
+// replaced with a unique index on the scm_provider and name columns.
+// Sqlite does not support dropping the constraint so the users
+// table is recreated without it.
+var userProvider = &Migration{
+	Version:     6,
+	Description: "make users unique by scm_provider and name",
+	Up: map[string][]string{
+		constants.DriverPostgres: {
+			defaultUserProvider,
+			"ALTER TABLE users DROP CONSTRAINT IF EXISTS users_name_key;",
+			user.CreateProviderNameIndex,
+		},
+		serverconstants.DriverMySQL: {
+			defaultUserProvider,
+		},
+		constants.DriverSqlite: {
+			defaultUserProvider,
+			renameSqliteUsers,
+			createSqliteUsers,
+			copySqliteUsers,
+			"DROP TABLE users_name;",
+			user.CreateUserRefreshIndex,
+			user.CreateProviderNameIndex,
+		},
+	},
+	// MySQL does not support creating an index only if it does not exist
+	Indexes: map[string][]*Index{
+		serverconstants.DriverMySQL: {
+			{Table: constants.TableUser, Name: "users_scm_provider_name", Query: user.CreateMySQLProviderNameIndex},
+		},
+	},
+	// rolling back fails while the same login is stored for more than one scm provider
+	Down: map[string][]string{
+		constants.DriverPostgres: {
+			"DROP INDEX IF EXISTS users_scm_provider_name;",
+			"ALTER TABLE users ADD CONSTRAINT users_name_key UNIQUE (name);",
+		},
+		serverconstants.DriverMySQL: {
+			"ALTER TABLE users DROP INDEX users_scm_provider_name, ADD UNIQUE INDEX name (name);",
+		},
+		constants.DriverSqlite: {
+			"DROP INDEX IF EXISTS users_scm_provider_name;",
+			"CREATE UNIQUE INDEX IF NOT EXISTS users_name ON users (name);",
+		},
+	},
+}
@@ -5,6 +5,8 @@ package database
 import (
 	"context"
 	"time"
+
+	"github.com/go-vela/server/storage"
 )
 
 // EngineOpt represents a configuration option to initialize the database engine.
@@ -30,6 +32,16 @@ func WithCompressionLevel(level int) EngineOpt {
 	}
 }
 
+// WithLogStorage sets the log storage client in the database engine.
+func WithLogStorage(s storage.Service) EngineOpt {
+	return func(e *engine) error {
+		// set the log storage client for logs in the database engine
+		e.storage = s
+
+		return nil
+	}
+}
+
 // WithConnectionLife sets the life of connections in the database engine.
 func WithConnectionLife(connectionLife time.Duration) EngineOpt {
 	return func(e *engine) error {
@@ -6,6 +6,9 @@ import (
 	"reflect"
 	"testing"
 	"time"
+
+	"github.com/go-vela/server/storage"
+	"github.com/go-vela/server/storage/filesystem"
 )
 
 func TestDatabase_EngineOpt_WithAddress(t *testing.T) {
@@ -405,3 +408,57 @@ func TestDatabase_EngineOpt_WithSkipCreation(t *testing.T) {
 		})
 	}
 }
+
+func TestDatabase_EngineOpt_WithLogStorage(t *testing.T) {
+	// setup types
+	e := &engine{config: new(config)}
+
+	_storage, err := filesystem.New(filesystem.WithDirectory(t.TempDir()))
+	if err != nil {
+		t.Errorf("unable to create new filesystem log storage: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure bool
+		name    string
+		storage storage.Service
+		want    storage.Service
+	}{
+		{
+			failure: false,
+			name:    "log storage set to filesystem",
+			storage: _storage,
+			want:    _storage,
+		},
+		{
+			failure: false,
+			name:    "log storage set to nil",
+			storage: nil,
+			want:    nil,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := WithLogStorage(test.storage)(e)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("WithLogStorage for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("WithLogStorage returned err: %v", err)
+			}
+
+			if !reflect.DeepEqual(e.storage, test.want) {
+				t.Errorf("WithLogStorage is %v, want %v", e.storage, test.want)
+			}
+		})
+	}
+}
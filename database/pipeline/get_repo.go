@@ -9,6 +9,7 @@ import (
 	"github.com/go-vela/types/database"
 	"github.com/go-vela/types/library"
 	"github.com/sirupsen/logrus"
+	"gorm.io/gorm/clause"
 )
 
 // GetPipelineForRepo gets a pipeline by number and repo ID from the database.
@@ -26,7 +27,8 @@ func (e *engine) GetPipelineForRepo(ctx context.Context, commit string, r *libra
 	err := e.client.
 		Table(constants.TablePipeline).
 		Where("repo_id = ?", r.GetID()).
-		Where("\"commit\" = ?", commit).
+		// quote the commit column for the dialect since it is a keyword
+		Where(clause.Eq{Column: clause.Column{Name: "commit"}, Value: commit}).
 		Take(p).
 		Error
 	if err != nil {
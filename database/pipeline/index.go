@@ -2,7 +2,11 @@
 
 package pipeline
 
-import "context"
+import (
+	"context"
+
+	"github.com/go-vela/server/constants"
+)
 
 const (
 	// CreateRepoIDIndex represents a query to create an
@@ -19,6 +23,11 @@ ON pipelines (repo_id);
 func (e *engine) CreatePipelineIndexes(ctx context.Context) error {
 	e.logger.Tracef("creating indexes for pipelines table in the database")
 
+	// the indexes are created with the MySQL pipelines table
+	if e.client.Config.Dialector.Name() == constants.DriverMySQL {
+		return nil
+	}
+
 	// create the repo_id column index for the pipelines table
 	return e.client.Exec(CreateRepoIDIndex).Error
 }
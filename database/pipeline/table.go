@@ -5,6 +5,7 @@ package pipeline
 import (
 	"context"
 
+	serverconstants "github.com/go-vela/server/constants"
 	"github.com/go-vela/types/constants"
 )
 
@@ -55,6 +56,33 @@ pipelines (
 	data             BLOB,
 	UNIQUE(repo_id, 'commit')
 );
+`
+
+	// CreateMySQLTable represents a query to create the MySQL pipelines table
+	// with its indexes, since MySQL does not support creating an index
+	// only if it does not exist.
+	CreateMySQLTable = `
+CREATE TABLE
+IF NOT EXISTS
+pipelines (
+	id               INTEGER NOT NULL AUTO_INCREMENT PRIMARY KEY,
+	repo_id          INTEGER,
+	commit           VARCHAR(500),
+	flavor           VARCHAR(100),
+	platform         VARCHAR(100),
+	ref              VARCHAR(500),
+	type             VARCHAR(100),
+	version          VARCHAR(50),
+	external_secrets BOOLEAN,
+	internal_secrets BOOLEAN,
+	services         BOOLEAN,
+	stages           BOOLEAN,
+	steps            BOOLEAN,
+	templates        BOOLEAN,
+	data             LONGBLOB,
+	UNIQUE(repo_id, commit),
+	INDEX pipelines_repo_id (repo_id)
+);
 `
 )
 
@@ -67,6 +95,9 @@ func (e *engine) CreatePipelineTable(ctx context.Context, driver string) error {
 	case constants.DriverPostgres:
 		// create the pipelines table for Postgres
 		return e.client.Exec(CreatePostgresTable).Error
+	case serverconstants.DriverMySQL:
+		// create the pipelines table for MySQL
+		return e.client.Exec(CreateMySQLTable).Error
 	case constants.DriverSqlite:
 		fallthrough
 	default:
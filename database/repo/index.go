@@ -2,7 +2,11 @@
 
 package repo
 
-import "context"
+import (
+	"context"
+
+	"github.com/go-vela/server/constants"
+)
 
 const (
 	// CreateOrgNameIndex represents a query to create an
@@ -12,6 +16,24 @@ CREATE INDEX
 IF NOT EXISTS
 repos_org_name
 ON repos (org, name);
+`
+
+	// CreateProviderFullNameIndex represents a query to create a unique
+	// index on the repos table for the scm_provider and full_name columns.
+	CreateProviderFullNameIndex = `
+CREATE UNIQUE INDEX
+IF NOT EXISTS
+repos_scm_provider_full_name
+ON repos (scm_provider, full_name);
+`
+
+	// CreateMySQLProviderFullNameIndex represents a query to replace the unique
+	// index on the MySQL repos table for the full_name column with a unique
+	// index for the scm_provider and full_name columns.
+	CreateMySQLProviderFullNameIndex = `
+ALTER TABLE repos
+DROP INDEX full_name,
+ADD UNIQUE INDEX repos_scm_provider_full_name (scm_provider, full_name);
 `
 )
 
@@ -19,6 +41,11 @@ ON repos (org, name);
 func (e *engine) CreateRepoIndexes(ctx context.Context) error {
 	e.logger.Tracef("creating indexes for repos table in the database")
 
+	// the indexes are created with the MySQL repos table
+	if e.client.Config.Dialector.Name() == constants.DriverMySQL {
+		return nil
+	}
+
 	// create the org and name columns index for the repos table
 	return e.client.Exec(CreateOrgNameIndex).Error
 }
@@ -5,6 +5,7 @@ package repo
 import (
 	"context"
 
+	"github.com/go-vela/server/api/types"
 	"github.com/go-vela/types/library"
 )
 
@@ -40,6 +41,12 @@ type RepoInterface interface {
 	GetRepo(context.Context, int64) (*library.Repo, error)
 	// GetRepoForOrg defines a function that gets a repo by org and repo name.
 	GetRepoForOrg(context.Context, string, string) (*library.Repo, error)
+	// GetRepoForOrgWithProvider defines a function that gets a repo by org and repo name along with its scm provider name.
+	GetRepoForOrgWithProvider(context.Context, string, string, string) (*library.Repo, string, error)
+	// GetRepoProvider defines a function that gets the scm provider name for a repo.
+	GetRepoProvider(context.Context, *library.Repo) (string, error)
+	// GetRepoSettings defines a function that gets the settings for a repo.
+	GetRepoSettings(context.Context, *library.Repo) (*types.RepoSettings, error)
 	// ListRepos defines a function that gets a list of all repos.
 	ListRepos(context.Context) ([]*library.Repo, error)
 	// ListReposForOrg defines a function that gets a list of repos by org name.
@@ -48,4 +55,8 @@ type RepoInterface interface {
 	ListReposForUser(context.Context, *library.User, string, map[string]interface{}, int, int) ([]*library.Repo, int64, error)
 	// UpdateRepo defines a function that updates an existing repo.
 	UpdateRepo(context.Context, *library.Repo) (*library.Repo, error)
+	// UpdateRepoProvider defines a function that updates the scm provider name for a repo.
+	UpdateRepoProvider(context.Context, *library.Repo, string) error
+	// UpdateRepoSettings defines a function that updates the settings for a repo.
+	UpdateRepoSettings(context.Context, *library.Repo, *types.RepoSettings) error
 }
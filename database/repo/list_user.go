@@ -5,6 +5,7 @@ package repo
 import (
 	"context"
 
+	serverconstants "github.com/go-vela/server/constants"
 	"github.com/go-vela/types/constants"
 	"github.com/go-vela/types/database"
 	"github.com/go-vela/types/library"
@@ -40,6 +41,13 @@ func (e *engine) ListReposForUser(ctx context.Context, u *library.User, sortBy s
 
 	switch sortBy {
 	case "latest":
+		order := "latest_build DESC NULLS LAST"
+
+		// MySQL does not support NULLS LAST but sorts the nulls last when descending
+		if e.client.Config.Dialector.Name() == serverconstants.DriverMySQL {
+			order = "latest_build DESC"
+		}
+
 		query := e.client.
 			Table(constants.TableBuild).
 			Select("repos.id, MAX(builds.created) AS latest_build").
@@ -51,7 +59,7 @@ func (e *engine) ListReposForUser(ctx context.Context, u *library.User, sortBy s
 			Table(constants.TableRepo).
 			Select("repos.*").
 			Joins("LEFT JOIN (?) t on repos.id = t.id", query).
-			Order("latest_build DESC NULLS LAST").
+			Order(order).
 			Limit(perPage).
 			Offset(offset).
 			Find(&r).
@@ -0,0 +1,115 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package repo
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/database"
+	"github.com/go-vela/types/library"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm/clause"
+)
+
+// repoWithProvider represents a repo stored in the database
+// along with the name of the scm provider it belongs to.
+type repoWithProvider struct {
+	database.Repo
+
+	SCMProvider sql.NullString `gorm:"column:scm_provider"`
+}
+
+// GetRepoProvider gets the name of the scm provider for a repo from the database.
+//
+// An empty name is returned for repos that belong to the default scm provider.
+func (e *engine) GetRepoProvider(ctx context.Context, r *library.Repo) (string, error) {
+	e.logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+	}).Tracef("getting scm provider for repo %s from the database", r.GetFullName())
+
+	// variable to store query results
+	var provider sql.NullString
+
+	// send query to the database and store result in variable
+	err := e.client.
+		Table(constants.TableRepo).
+		Select("scm_provider").
+		Where("id = ?", r.GetID()).
+		Row().
+		Scan(&provider)
+	if err != nil {
+		return "", err
+	}
+
+	return provider.String, nil
+}
+
+// GetRepoForOrgWithProvider gets a repo by org and repo name from the database
+// along with the name of the scm provider the repo belongs to.
+//
+// The same repo may be stored once for each scm provider, in which case the
+// repo that belongs to the provided scm provider is returned if it exists.
+func (e *engine) GetRepoForOrgWithProvider(ctx context.Context, org, name, provider string) (*library.Repo, string, error) {
+	e.logger.WithFields(logrus.Fields{
+		"org":      org,
+		"repo":     name,
+		"provider": provider,
+	}).Tracef("getting repo %s/%s with scm provider from the database", org, name)
+
+	// variable to store query results
+	r := new(repoWithProvider)
+
+	// send query to the database and store result in variable
+	err := e.client.
+		Table(constants.TableRepo).
+		Where("org = ?", org).
+		Where("name = ?", name).
+		Clauses(clause.OrderBy{
+			Expression: clause.Expr{
+				SQL:                "CASE WHEN COALESCE(scm_provider, '') = ? THEN 0 ELSE 1 END",
+				Vars:               []interface{}{provider},
+				WithoutParentheses: true,
+			},
+		}).
+		Take(r).
+		Error
+	if err != nil {
+		return nil, "", err
+	}
+
+	// decrypt the fields for the repo
+	//
+	// https://pkg.go.dev/github.com/go-vela/types/database#Repo.Decrypt
+	err = r.Decrypt(e.config.EncryptionKey)
+	if err != nil {
+		// TODO: remove backwards compatibility before 1.x.x release
+		//
+		// ensures that the change is backwards compatible
+		// by logging the error instead of returning it
+		// which allows us to fetch unencrypted repos
+		e.logger.Errorf("unable to decrypt repo %s/%s: %v", org, name, err)
+	}
+
+	// return the repo and scm provider
+	//
+	// https://pkg.go.dev/github.com/go-vela/types/database#Repo.ToLibrary
+	return r.ToLibrary(), r.SCMProvider.String, nil
+}
+
+// UpdateRepoProvider updates the name of the scm provider for a repo in the database.
+func (e *engine) UpdateRepoProvider(ctx context.Context, r *library.Repo, provider string) error {
+	e.logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+	}).Tracef("updating scm provider for repo %s in the database", r.GetFullName())
+
+	// send query to the database
+	return e.client.
+		Table(constants.TableRepo).
+		Where("id = ?", r.GetID()).
+		Update("scm_provider", provider).
+		Error
+}
@@ -0,0 +1,282 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package repo
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestRepo_Engine_GetRepoProvider(t *testing.T) {
+	// setup types
+	_repo := testRepo()
+	_repo.SetID(1)
+	_repo.SetUserID(1)
+	_repo.SetHash("baz")
+	_repo.SetOrg("foo")
+	_repo.SetName("bar")
+	_repo.SetFullName("foo/bar")
+	_repo.SetVisibility("public")
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows([]string{"scm_provider"}).AddRow("gitlab")
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT scm_provider FROM "repos" WHERE id = $1`).WithArgs(1).WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	_, err := _sqlite.CreateRepo(context.TODO(), _repo)
+	if err != nil {
+		t.Errorf("unable to create test repo for sqlite: %v", err)
+	}
+
+	err = _sqlite.UpdateRepoProvider(context.TODO(), _repo, "gitlab")
+	if err != nil {
+		t.Errorf("unable to update test repo provider for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		want     string
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     "gitlab",
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     "gitlab",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.GetRepoProvider(context.TODO(), _repo)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("GetRepoProvider for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("GetRepoProvider for %s returned err: %v", test.name, err)
+			}
+
+			if got != test.want {
+				t.Errorf("GetRepoProvider for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
+
+func TestRepo_Engine_GetRepoProvider_Default(t *testing.T) {
+	// setup types
+	_repo := testRepo()
+	_repo.SetID(1)
+	_repo.SetUserID(1)
+	_repo.SetHash("baz")
+	_repo.SetOrg("foo")
+	_repo.SetName("bar")
+	_repo.SetFullName("foo/bar")
+	_repo.SetVisibility("public")
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	_, err := _sqlite.CreateRepo(context.TODO(), _repo)
+	if err != nil {
+		t.Errorf("unable to create test repo for sqlite: %v", err)
+	}
+
+	// run test
+	got, err := _sqlite.GetRepoProvider(context.TODO(), _repo)
+	if err != nil {
+		t.Errorf("GetRepoProvider returned err: %v", err)
+	}
+
+	if got != "" {
+		t.Errorf("GetRepoProvider is %v, want empty provider", got)
+	}
+}
+
+func TestRepo_Engine_GetRepoForOrgWithProvider(t *testing.T) {
+	// setup types
+	_repo := testRepo()
+	_repo.SetID(1)
+	_repo.SetUserID(1)
+	_repo.SetHash("baz")
+	_repo.SetOrg("foo")
+	_repo.SetName("bar")
+	_repo.SetFullName("foo/bar")
+	_repo.SetVisibility("public")
+	_repo.SetPipelineType("yaml")
+	_repo.SetTopics([]string{})
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows(
+		[]string{"id", "user_id", "hash", "org", "name", "full_name", "link", "clone", "branch", "topics", "build_limit", "timeout", "counter", "visibility", "private", "trusted", "active", "allow_pull", "allow_push", "allow_deploy", "allow_tag", "allow_comment", "pipeline_type", "previous_name", "scm_provider"}).
+		AddRow(1, 1, "baz", "foo", "bar", "foo/bar", "", "", "", "{}", 0, 0, 0, "public", false, false, false, false, false, false, false, false, "yaml", "", "gitlab")
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "repos" WHERE org = $1 AND name = $2 ORDER BY CASE WHEN COALESCE(scm_provider, '') = $3 THEN 0 ELSE 1 END LIMIT 1`).
+		WithArgs("foo", "bar", "gitlab").
+		WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	_, err := _sqlite.CreateRepo(context.TODO(), _repo)
+	if err != nil {
+		t.Errorf("unable to create test repo for sqlite: %v", err)
+	}
+
+	err = _sqlite.UpdateRepoProvider(context.TODO(), _repo, "gitlab")
+	if err != nil {
+		t.Errorf("unable to update test repo provider for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		provider string
+		want     string
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			provider: "gitlab",
+			want:     "gitlab",
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			provider: "gitlab",
+			want:     "gitlab",
+		},
+		{
+			failure:  false,
+			name:     "sqlite3 with other provider",
+			database: _sqlite,
+			provider: "",
+			want:     "gitlab",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, provider, err := test.database.GetRepoForOrgWithProvider(context.TODO(), "foo", "bar", test.provider)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("GetRepoForOrgWithProvider for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("GetRepoForOrgWithProvider for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, _repo) {
+				t.Errorf("GetRepoForOrgWithProvider for %s is %v, want %v", test.name, got, _repo)
+			}
+
+			if provider != test.want {
+				t.Errorf("GetRepoForOrgWithProvider for %s provider is %v, want %v", test.name, provider, test.want)
+			}
+		})
+	}
+}
+
+func TestRepo_Engine_UpdateRepoProvider(t *testing.T) {
+	// setup types
+	_repo := testRepo()
+	_repo.SetID(1)
+	_repo.SetUserID(1)
+	_repo.SetHash("baz")
+	_repo.SetOrg("foo")
+	_repo.SetName("bar")
+	_repo.SetFullName("foo/bar")
+	_repo.SetVisibility("public")
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// ensure the mock expects the query
+	_mock.ExpectExec(`UPDATE "repos" SET "scm_provider"=$1 WHERE id = $2`).
+		WithArgs("gitlab", 1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	_, err := _sqlite.CreateRepo(context.TODO(), _repo)
+	if err != nil {
+		t.Errorf("unable to create test repo for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err = test.database.UpdateRepoProvider(context.TODO(), _repo, "gitlab")
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("UpdateRepoProvider for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("UpdateRepoProvider for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+}
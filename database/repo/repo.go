@@ -62,7 +62,7 @@ func New(opts ...EngineOpt) (*engine, error) {
 
 	// check if we should skip creating repo database objects
 	if e.config.SkipCreation {
-		e.logger.Warning("skipping creation of repos table and indexes in the database")
+		e.logger.Trace("skipping creation of repos table and indexes in the database")
 
 		return e, nil
 	}
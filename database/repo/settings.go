@@ -0,0 +1,91 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package repo
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+	"github.com/sirupsen/logrus"
+)
+
+// GetRepoSettings gets the settings for a repo from the database.
+func (e *engine) GetRepoSettings(ctx context.Context, r *library.Repo) (*types.RepoSettings, error) {
+	e.logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+	}).Tracef("getting settings for repo %s from the database", r.GetFullName())
+
+	// variables to store query results
+	var allowRelease, allowReview, ignoreSkipDirectives sql.NullBool
+
+	var priority sql.NullInt64
+
+	// send query to the database and store result in variables
+	err := e.client.
+		Table(constants.TableRepo).
+		Select("allow_release", "allow_review", "ignore_skip_directives", "priority").
+		Where("id = ?", r.GetID()).
+		Row().
+		Scan(&allowRelease, &allowReview, &ignoreSkipDirectives, &priority)
+	if err != nil {
+		return nil, err
+	}
+
+	s := new(types.RepoSettings)
+	s.SetAllowRelease(allowRelease.Bool)
+	s.SetAllowReview(allowReview.Bool)
+	s.SetIgnoreSkipDirectives(ignoreSkipDirectives.Bool)
+
+	// the priority is only set when configured for the repo
+	if priority.Valid {
+		s.SetPriority(priority.Int64)
+	}
+
+	return s, nil
+}
+
+// UpdateRepoSettings updates the settings provided for a repo in the database.
+func (e *engine) UpdateRepoSettings(ctx context.Context, r *library.Repo, s *types.RepoSettings) error {
+	e.logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+	}).Tracef("updating settings for repo %s in the database", r.GetFullName())
+
+	// only update the settings provided
+	columns := make(map[string]interface{})
+
+	if s == nil {
+		return nil
+	}
+
+	if s.AllowRelease != nil {
+		columns["allow_release"] = s.GetAllowRelease()
+	}
+
+	if s.AllowReview != nil {
+		columns["allow_review"] = s.GetAllowReview()
+	}
+
+	if s.IgnoreSkipDirectives != nil {
+		columns["ignore_skip_directives"] = s.GetIgnoreSkipDirectives()
+	}
+
+	if s.Priority != nil {
+		columns["priority"] = s.GetPriority()
+	}
+
+	if len(columns) == 0 {
+		return nil
+	}
+
+	// send query to the database
+	return e.client.
+		Table(constants.TableRepo).
+		Where("id = ?", r.GetID()).
+		Updates(columns).
+		Error
+}
@@ -0,0 +1,168 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package repo
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+
+	"github.com/go-vela/server/api/types"
+)
+
+func TestRepo_Engine_GetRepoSettings(t *testing.T) {
+	// setup types
+	_repo := testRepo()
+	_repo.SetID(1)
+	_repo.SetUserID(1)
+	_repo.SetHash("baz")
+	_repo.SetOrg("foo")
+	_repo.SetName("bar")
+	_repo.SetFullName("foo/bar")
+	_repo.SetVisibility("public")
+
+	_settings := new(types.RepoSettings)
+	_settings.SetAllowRelease(true)
+	_settings.SetAllowReview(false)
+	_settings.SetIgnoreSkipDirectives(false)
+	_settings.SetPriority(8)
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows([]string{"allow_release", "allow_review", "ignore_skip_directives", "priority"}).AddRow(true, nil, false, 8)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT allow_release,allow_review,ignore_skip_directives,priority FROM "repos" WHERE id = $1`).WithArgs(1).WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	_, err := _sqlite.CreateRepo(context.TODO(), _repo)
+	if err != nil {
+		t.Errorf("unable to create test repo for sqlite: %v", err)
+	}
+
+	err = _sqlite.UpdateRepoSettings(context.TODO(), _repo, &types.RepoSettings{AllowRelease: _settings.AllowRelease, Priority: _settings.Priority})
+	if err != nil {
+		t.Errorf("unable to update test repo settings for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		want     *types.RepoSettings
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     _settings,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     _settings,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.GetRepoSettings(context.TODO(), _repo)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("GetRepoSettings for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("GetRepoSettings for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("GetRepoSettings for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
+
+func TestRepo_Engine_UpdateRepoSettings(t *testing.T) {
+	// setup types
+	_repo := testRepo()
+	_repo.SetID(1)
+	_repo.SetUserID(1)
+	_repo.SetHash("baz")
+	_repo.SetOrg("foo")
+	_repo.SetName("bar")
+	_repo.SetFullName("foo/bar")
+	_repo.SetVisibility("public")
+
+	_settings := new(types.RepoSettings)
+	_settings.SetAllowRelease(true)
+	_settings.SetAllowReview(true)
+	_settings.SetIgnoreSkipDirectives(true)
+	_settings.SetPriority(8)
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// ensure the mock expects the query
+	_mock.ExpectExec(`UPDATE "repos" SET "allow_release"=$1,"allow_review"=$2,"ignore_skip_directives"=$3,"priority"=$4 WHERE id = $5`).
+		WithArgs(true, true, true, 8, 1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	_, err := _sqlite.CreateRepo(context.TODO(), _repo)
+	if err != nil {
+		t.Errorf("unable to create test repo for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err = test.database.UpdateRepoSettings(context.TODO(), _repo, _settings)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("UpdateRepoSettings for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("UpdateRepoSettings for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ package repo
 import (
 	"context"
 
+	serverconstants "github.com/go-vela/server/constants"
 	"github.com/go-vela/types/constants"
 )
 
@@ -38,6 +39,11 @@ repos (
 	allow_comment BOOLEAN,
 	pipeline_type TEXT,
 	previous_name VARCHAR(100),
+	scm_provider  VARCHAR(250),
+	allow_release BOOLEAN,
+	allow_review  BOOLEAN,
+	ignore_skip_directives BOOLEAN,
+	priority      INTEGER,
 	UNIQUE(full_name)
 );
 `
@@ -71,11 +77,83 @@ repos (
 	allow_comment BOOLEAN,
 	pipeline_type TEXT,
 	previous_name TEXT,
+	scm_provider  TEXT,
+	allow_release BOOLEAN,
+	allow_review  BOOLEAN,
+	ignore_skip_directives BOOLEAN,
+	priority      INTEGER,
 	UNIQUE(full_name)
 );
+`
+
+	// CreateMySQLTable represents a query to create the MySQL repos table
+	// with its indexes, since MySQL does not support creating an index
+	// only if it does not exist.
+	CreateMySQLTable = `
+CREATE TABLE
+IF NOT EXISTS
+repos (
+	id            INTEGER NOT NULL AUTO_INCREMENT PRIMARY KEY,
+	user_id       INTEGER,
+	hash          VARCHAR(500),
+	org           VARCHAR(250),
+	name          VARCHAR(250),
+	full_name     VARCHAR(500),
+	link          VARCHAR(1000),
+	clone         VARCHAR(1000),
+	branch        VARCHAR(250),
+	topics        VARCHAR(1020),
+	build_limit   INTEGER,
+	timeout       INTEGER,
+	counter       INTEGER,
+	visibility    TEXT,
+	private       BOOLEAN,
+	trusted       BOOLEAN,
+	active        BOOLEAN,
+	allow_pull    BOOLEAN,
+	allow_push    BOOLEAN,
+	allow_deploy  BOOLEAN,
+	allow_tag     BOOLEAN,
+	allow_comment BOOLEAN,
+	pipeline_type TEXT,
+	previous_name VARCHAR(100),
+	scm_provider  VARCHAR(250),
+	allow_release BOOLEAN,
+	allow_review  BOOLEAN,
+	ignore_skip_directives BOOLEAN,
+	priority      INTEGER,
+	UNIQUE(full_name),
+	INDEX repos_org_name (org, name)
+);
+`
+	// AddProviderPostgresColumn represents a query to add the scm_provider
+	// column to a Postgres repos table created before it was introduced.
+	AddProviderPostgresColumn = `ALTER TABLE repos ADD COLUMN IF NOT EXISTS scm_provider VARCHAR(250);`
+
+	// AddProviderSqliteColumn represents a query to add the scm_provider
+	// column to a Sqlite repos table created before it was introduced.
+	AddProviderSqliteColumn = `ALTER TABLE repos ADD COLUMN scm_provider TEXT;`
+
+	// AddSettingsPostgresColumns represents a query to add the repo settings
+	// columns to a Postgres repos table created before they were introduced.
+	AddSettingsPostgresColumns = `
+ALTER TABLE repos
+ADD COLUMN IF NOT EXISTS allow_release BOOLEAN,
+ADD COLUMN IF NOT EXISTS allow_review  BOOLEAN,
+ADD COLUMN IF NOT EXISTS ignore_skip_directives BOOLEAN,
+ADD COLUMN IF NOT EXISTS priority      INTEGER;
 `
 )
 
+// AddSettingsSqliteColumns represents the queries to add each of the repo settings
+// columns to a Sqlite repos table created before they were introduced.
+var AddSettingsSqliteColumns = map[string]string{
+	"allow_release":          `ALTER TABLE repos ADD COLUMN allow_release BOOLEAN;`,
+	"allow_review":           `ALTER TABLE repos ADD COLUMN allow_review BOOLEAN;`,
+	"ignore_skip_directives": `ALTER TABLE repos ADD COLUMN ignore_skip_directives BOOLEAN;`,
+	"priority":               `ALTER TABLE repos ADD COLUMN priority INTEGER;`,
+}
+
 // CreateRepoTable creates the repos table in the database.
 func (e *engine) CreateRepoTable(ctx context.Context, driver string) error {
 	e.logger.Tracef("creating repos table in the database")
@@ -84,11 +162,52 @@ func (e *engine) CreateRepoTable(ctx context.Context, driver string) error {
 	switch driver {
 	case constants.DriverPostgres:
 		// create the repos table for Postgres
-		return e.client.Exec(CreatePostgresTable).Error
+		err := e.client.Exec(CreatePostgresTable).Error
+		if err != nil {
+			return err
+		}
+
+		// add the scm_provider column for existing repos tables
+		err = e.client.Exec(AddProviderPostgresColumn).Error
+		if err != nil {
+			return err
+		}
+
+		// add the repo settings columns for existing repos tables
+		return e.client.Exec(AddSettingsPostgresColumns).Error
+	case serverconstants.DriverMySQL:
+		// create the repos table for MySQL
+		return e.client.Exec(CreateMySQLTable).Error
 	case constants.DriverSqlite:
 		fallthrough
 	default:
 		// create the repos table for Sqlite
-		return e.client.Exec(CreateSqliteTable).Error
+		err := e.client.Exec(CreateSqliteTable).Error
+		if err != nil {
+			return err
+		}
+
+		// Sqlite does not support adding a column only if it does not exist
+		if !e.client.Migrator().HasColumn(constants.TableRepo, "scm_provider") {
+			// add the scm_provider column for existing repos tables
+			err = e.client.Exec(AddProviderSqliteColumn).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		// add the repo settings columns for existing repos tables
+		for column, query := range AddSettingsSqliteColumns {
+			if e.client.Migrator().HasColumn(constants.TableRepo, column) {
+				continue
+			}
+
+			err = e.client.Exec(query).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
 	}
 }
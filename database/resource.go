@@ -20,6 +20,9 @@ import (
 )
 
 // NewResources creates and returns the database agnostic engines for resources.
+//
+// The engines skip creating tables and indexes since
+// the schema of the database is managed by migrations.
 func (e *engine) NewResources(ctx context.Context) error {
 	var err error
 
@@ -28,7 +31,7 @@ func (e *engine) NewResources(ctx context.Context) error {
 		build.WithContext(e.ctx),
 		build.WithClient(e.client),
 		build.WithLogger(e.logger),
-		build.WithSkipCreation(e.config.SkipCreation),
+		build.WithSkipCreation(true),
 	)
 	if err != nil {
 		return err
@@ -39,7 +42,7 @@ func (e *engine) NewResources(ctx context.Context) error {
 		executable.WithContext(e.ctx),
 		executable.WithClient(e.client),
 		executable.WithLogger(e.logger),
-		executable.WithSkipCreation(e.config.SkipCreation),
+		executable.WithSkipCreation(true),
 		executable.WithEncryptionKey(e.config.EncryptionKey),
 		executable.WithDriver(e.config.Driver),
 	)
@@ -52,7 +55,7 @@ func (e *engine) NewResources(ctx context.Context) error {
 		hook.WithContext(e.ctx),
 		hook.WithClient(e.client),
 		hook.WithLogger(e.logger),
-		hook.WithSkipCreation(e.config.SkipCreation),
+		hook.WithSkipCreation(true),
 	)
 	if err != nil {
 		return err
@@ -64,7 +67,8 @@ func (e *engine) NewResources(ctx context.Context) error {
 		log.WithClient(e.client),
 		log.WithCompressionLevel(e.config.CompressionLevel),
 		log.WithLogger(e.logger),
-		log.WithSkipCreation(e.config.SkipCreation),
+		log.WithStorage(e.storage),
+		log.WithSkipCreation(true),
 	)
 	if err != nil {
 		return err
@@ -76,7 +80,7 @@ func (e *engine) NewResources(ctx context.Context) error {
 		pipeline.WithClient(e.client),
 		pipeline.WithCompressionLevel(e.config.CompressionLevel),
 		pipeline.WithLogger(e.logger),
-		pipeline.WithSkipCreation(e.config.SkipCreation),
+		pipeline.WithSkipCreation(true),
 	)
 	if err != nil {
 		return err
@@ -88,7 +92,7 @@ func (e *engine) NewResources(ctx context.Context) error {
 		repo.WithClient(e.client),
 		repo.WithEncryptionKey(e.config.EncryptionKey),
 		repo.WithLogger(e.logger),
-		repo.WithSkipCreation(e.config.SkipCreation),
+		repo.WithSkipCreation(true),
 	)
 	if err != nil {
 		return err
@@ -99,7 +103,7 @@ func (e *engine) NewResources(ctx context.Context) error {
 		schedule.WithContext(e.ctx),
 		schedule.WithClient(e.client),
 		schedule.WithLogger(e.logger),
-		schedule.WithSkipCreation(e.config.SkipCreation),
+		schedule.WithSkipCreation(true),
 	)
 	if err != nil {
 		return err
@@ -113,7 +117,7 @@ func (e *engine) NewResources(ctx context.Context) error {
 		secret.WithClient(e.client),
 		secret.WithEncryptionKey(e.config.EncryptionKey),
 		secret.WithLogger(e.logger),
-		secret.WithSkipCreation(e.config.SkipCreation),
+		secret.WithSkipCreation(true),
 	)
 	if err != nil {
 		return err
@@ -123,7 +127,7 @@ func (e *engine) NewResources(ctx context.Context) error {
 	e.ServiceInterface, err = service.New(
 		service.WithClient(e.client),
 		service.WithLogger(e.logger),
-		service.WithSkipCreation(e.config.SkipCreation),
+		service.WithSkipCreation(true),
 	)
 	if err != nil {
 		return err
@@ -133,7 +137,7 @@ func (e *engine) NewResources(ctx context.Context) error {
 	e.StepInterface, err = step.New(
 		step.WithClient(e.client),
 		step.WithLogger(e.logger),
-		step.WithSkipCreation(e.config.SkipCreation),
+		step.WithSkipCreation(true),
 	)
 	if err != nil {
 		return err
@@ -145,7 +149,7 @@ func (e *engine) NewResources(ctx context.Context) error {
 		user.WithClient(e.client),
 		user.WithEncryptionKey(e.config.EncryptionKey),
 		user.WithLogger(e.logger),
-		user.WithSkipCreation(e.config.SkipCreation),
+		user.WithSkipCreation(true),
 	)
 	if err != nil {
 		return err
@@ -156,7 +160,7 @@ func (e *engine) NewResources(ctx context.Context) error {
 		worker.WithContext(e.ctx),
 		worker.WithClient(e.client),
 		worker.WithLogger(e.logger),
-		worker.WithSkipCreation(e.config.SkipCreation),
+		worker.WithSkipCreation(true),
 	)
 	if err != nil {
 		return err
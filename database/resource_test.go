@@ -5,64 +5,15 @@ package database
 import (
 	"context"
 	"testing"
-
-	"github.com/DATA-DOG/go-sqlmock"
-	"github.com/go-vela/server/database/build"
-	"github.com/go-vela/server/database/executable"
-	"github.com/go-vela/server/database/hook"
-	"github.com/go-vela/server/database/log"
-	"github.com/go-vela/server/database/pipeline"
-	"github.com/go-vela/server/database/repo"
-	"github.com/go-vela/server/database/schedule"
-	"github.com/go-vela/server/database/secret"
-	"github.com/go-vela/server/database/service"
-	"github.com/go-vela/server/database/step"
-	"github.com/go-vela/server/database/user"
-	"github.com/go-vela/server/database/worker"
 )
 
 func TestDatabase_Engine_NewResources(t *testing.T) {
-	_postgres, _mock := testPostgres(t)
+	// the mock fails any query so the migrations are skipped
+	// since the engines do not create the tables and indexes
+	_postgres, _ := testPostgres(t)
 	defer _postgres.Close()
 
-	// ensure the mock expects the build queries
-	_mock.ExpectExec(build.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
-	_mock.ExpectExec(build.CreateCreatedIndex).WillReturnResult(sqlmock.NewResult(1, 1))
-	_mock.ExpectExec(build.CreateRepoIDIndex).WillReturnResult(sqlmock.NewResult(1, 1))
-	_mock.ExpectExec(build.CreateSourceIndex).WillReturnResult(sqlmock.NewResult(1, 1))
-	_mock.ExpectExec(build.CreateStatusIndex).WillReturnResult(sqlmock.NewResult(1, 1))
-	// ensure the mock expects the build executable queries
-	_mock.ExpectExec(executable.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
-	// ensure the mock expects the hook queries
-	_mock.ExpectExec(hook.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
-	_mock.ExpectExec(hook.CreateRepoIDIndex).WillReturnResult(sqlmock.NewResult(1, 1))
-	// ensure the mock expects the log queries
-	_mock.ExpectExec(log.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
-	_mock.ExpectExec(log.CreateBuildIDIndex).WillReturnResult(sqlmock.NewResult(1, 1))
-	// ensure the mock expects the pipeline queries
-	_mock.ExpectExec(pipeline.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
-	_mock.ExpectExec(pipeline.CreateRepoIDIndex).WillReturnResult(sqlmock.NewResult(1, 1))
-	// ensure the mock expects the repo queries
-	_mock.ExpectExec(repo.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
-	_mock.ExpectExec(repo.CreateOrgNameIndex).WillReturnResult(sqlmock.NewResult(1, 1))
-	// ensure the mock expects the schedule queries
-	_mock.ExpectExec(schedule.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
-	_mock.ExpectExec(schedule.CreateRepoIDIndex).WillReturnResult(sqlmock.NewResult(1, 1))
-	// ensure the mock expects the secret queries
-	_mock.ExpectExec(secret.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
-	_mock.ExpectExec(secret.CreateTypeOrgRepo).WillReturnResult(sqlmock.NewResult(1, 1))
-	_mock.ExpectExec(secret.CreateTypeOrgTeam).WillReturnResult(sqlmock.NewResult(1, 1))
-	_mock.ExpectExec(secret.CreateTypeOrg).WillReturnResult(sqlmock.NewResult(1, 1))
-	// ensure the mock expects the service queries
-	_mock.ExpectExec(service.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
-	// ensure the mock expects the step queries
-	_mock.ExpectExec(step.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
-	// ensure the mock expects the user queries
-	_mock.ExpectExec(user.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
-	_mock.ExpectExec(user.CreateUserRefreshIndex).WillReturnResult(sqlmock.NewResult(1, 1))
-	// ensure the mock expects the worker queries
-	_mock.ExpectExec(worker.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
-	_mock.ExpectExec(worker.CreateHostnameAddressIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	_postgres.config.SkipCreation = true
 
 	// create a test database without mocking the call
 	_unmocked, _ := testPostgres(t)
@@ -77,7 +28,7 @@ func TestDatabase_Engine_NewResources(t *testing.T) {
 		database *engine
 	}{
 		{
-			name:     "success with postgres",
+			name:     "success with postgres skipping migrations",
 			failure:  false,
 			database: _postgres,
 		},
@@ -96,7 +47,11 @@ func TestDatabase_Engine_NewResources(t *testing.T) {
 	// run tests
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			err := test.database.NewResources(context.TODO())
+			// apply the migrations before creating the engines
+			err := test.database.migrate(context.TODO())
+			if err == nil {
+				err = test.database.NewResources(context.TODO())
+			}
 
 			if test.failure {
 				if err == nil {
@@ -2,7 +2,11 @@
 
 package schedule
 
-import "context"
+import (
+	"context"
+
+	"github.com/go-vela/server/constants"
+)
 
 const (
 	// CreateRepoIDIndex represents a query to create an
@@ -19,6 +23,11 @@ ON schedules (repo_id);
 func (e *engine) CreateScheduleIndexes(ctx context.Context) error {
 	e.logger.Tracef("creating indexes for schedules table in the database")
 
+	// the indexes are created with the MySQL schedules table
+	if e.client.Config.Dialector.Name() == constants.DriverMySQL {
+		return nil
+	}
+
 	// create the repo_id column index for the schedules table
 	return e.client.Exec(CreateRepoIDIndex).Error
 }
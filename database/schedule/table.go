@@ -5,6 +5,7 @@ package schedule
 import (
 	"context"
 
+	serverconstants "github.com/go-vela/server/constants"
 	"github.com/go-vela/types/constants"
 )
 
@@ -47,6 +48,29 @@ schedules (
 	branch       TEXT,
 	UNIQUE(repo_id, name)
 );
+`
+
+	// CreateMySQLTable represents a query to create the MySQL schedules table
+	// with its indexes, since MySQL does not support creating an index
+	// only if it does not exist.
+	CreateMySQLTable = `
+CREATE TABLE
+IF NOT EXISTS
+schedules (
+	id           INTEGER NOT NULL AUTO_INCREMENT PRIMARY KEY,
+	repo_id      INTEGER,
+	active       BOOLEAN,
+	name         VARCHAR(100),
+	entry        VARCHAR(100),
+	created_at   INTEGER,
+	created_by   VARCHAR(250),
+	updated_at   INTEGER,
+	updated_by   VARCHAR(250),
+	scheduled_at INTEGER,
+	branch       VARCHAR(250),
+	UNIQUE(repo_id, name),
+	INDEX schedules_repo_id (repo_id)
+);
 `
 )
 
@@ -59,6 +83,9 @@ func (e *engine) CreateScheduleTable(ctx context.Context, driver string) error {
 	case constants.DriverPostgres:
 		// create the schedules table for Postgres
 		return e.client.Exec(CreatePostgresTable).Error
+	case serverconstants.DriverMySQL:
+		// create the schedules table for MySQL
+		return e.client.Exec(CreateMySQLTable).Error
 	case constants.DriverSqlite:
 		fallthrough
 	default:
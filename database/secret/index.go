@@ -2,7 +2,11 @@
 
 package secret
 
-import "context"
+import (
+	"context"
+
+	"github.com/go-vela/server/constants"
+)
 
 const (
 	// CreateTypeOrgRepo represents a query to create an
@@ -35,6 +39,11 @@ ON secrets (type, org);
 func (e *engine) CreateSecretIndexes(ctx context.Context) error {
 	e.logger.Tracef("creating indexes for secrets table in the database")
 
+	// the indexes are created with the MySQL secrets table
+	if e.client.Config.Dialector.Name() == constants.DriverMySQL {
+		return nil
+	}
+
 	// create the type, org and repo columns index for the secrets table
 	err := e.client.Exec(CreateTypeOrgRepo).Error
 	if err != nil {
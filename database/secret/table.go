@@ -5,6 +5,7 @@ package secret
 import (
 	"context"
 
+	serverconstants "github.com/go-vela/server/constants"
 	"github.com/go-vela/types/constants"
 )
 
@@ -55,6 +56,35 @@ secrets (
 	UNIQUE(type, org, repo, name),
 	UNIQUE(type, org, team, name)
 );
+`
+
+	// CreateMySQLTable represents a query to create the MySQL secrets table
+	// with its indexes, since MySQL does not support creating an index
+	// only if it does not exist.
+	CreateMySQLTable = `
+CREATE TABLE
+IF NOT EXISTS
+secrets (
+	id            INTEGER NOT NULL AUTO_INCREMENT PRIMARY KEY,
+	type          VARCHAR(100),
+	org           VARCHAR(250),
+	repo          VARCHAR(250),
+	team          VARCHAR(250),
+	name          VARCHAR(250),
+	value         LONGBLOB,
+	images        VARCHAR(1000),
+	events        VARCHAR(1000),
+	allow_command BOOLEAN,
+	created_at    INTEGER,
+	created_by    VARCHAR(250),
+	updated_at    INTEGER,
+	updated_by    VARCHAR(250),
+	UNIQUE(type, org, repo, name(150)),
+	UNIQUE(type, org, team, name(150)),
+	INDEX secrets_type_org_repo (type, org, repo),
+	INDEX secrets_type_org_team (type, org, team),
+	INDEX secrets_type_org (type, org)
+);
 `
 )
 
@@ -67,6 +97,9 @@ func (e *engine) CreateSecretTable(ctx context.Context, driver string) error {
 	case constants.DriverPostgres:
 		// create the secrets table for Postgres
 		return e.client.Exec(CreatePostgresTable).Error
+	case serverconstants.DriverMySQL:
+		// create the secrets table for MySQL
+		return e.client.Exec(CreateMySQLTable).Error
 	case constants.DriverSqlite:
 		fallthrough
 	default:
@@ -5,6 +5,7 @@ package service
 import (
 	"context"
 
+	serverconstants "github.com/go-vela/server/constants"
 	"github.com/go-vela/types/constants"
 )
 
@@ -55,6 +56,30 @@ services (
 	distribution  TEXT,
 	UNIQUE(build_id, number)
 );
+`
+
+	// CreateMySQLTable represents a query to create the MySQL services table.
+	CreateMySQLTable = `
+CREATE TABLE
+IF NOT EXISTS
+services (
+	id            INTEGER NOT NULL AUTO_INCREMENT PRIMARY KEY,
+	repo_id       INTEGER,
+	build_id      INTEGER,
+	number        INTEGER,
+	name          VARCHAR(250),
+	image         VARCHAR(500),
+	status        VARCHAR(250),
+	error         VARCHAR(500),
+	exit_code     INTEGER,
+	created       INTEGER,
+	started       INTEGER,
+	finished      INTEGER,
+	host          VARCHAR(250),
+	runtime       VARCHAR(250),
+	distribution  VARCHAR(250),
+	UNIQUE(build_id, number)
+);
 `
 )
 
@@ -67,6 +92,9 @@ func (e *engine) CreateServiceTable(ctx context.Context, driver string) error {
 	case constants.DriverPostgres:
 		// create the services table for Postgres
 		return e.client.Exec(CreatePostgresTable).Error
+	case serverconstants.DriverMySQL:
+		// create the services table for MySQL
+		return e.client.Exec(CreateMySQLTable).Error
 	case constants.DriverSqlite:
 		fallthrough
 	default:
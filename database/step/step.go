@@ -57,7 +57,7 @@ func New(opts ...EngineOpt) (*engine, error) {
 
 	// check if we should skip creating step database objects
 	if e.config.SkipCreation {
-		e.logger.Warning("skipping creation of steps table in the database")
+		e.logger.Trace("skipping creation of steps table in the database")
 
 		return e, nil
 	}
@@ -3,6 +3,7 @@
 package step
 
 import (
+	serverconstants "github.com/go-vela/server/constants"
 	"github.com/go-vela/types/constants"
 )
 
@@ -55,6 +56,31 @@ steps (
 	distribution  TEXT,
 	UNIQUE(build_id, number)
 );
+`
+
+	// CreateMySQLTable represents a query to create the MySQL steps table.
+	CreateMySQLTable = `
+CREATE TABLE
+IF NOT EXISTS
+steps (
+	id            INTEGER NOT NULL AUTO_INCREMENT PRIMARY KEY,
+	repo_id       INTEGER,
+	build_id      INTEGER,
+	number        INTEGER,
+	name          VARCHAR(250),
+	image         VARCHAR(500),
+	stage         VARCHAR(250),
+	status        VARCHAR(250),
+	error         VARCHAR(500),
+	exit_code     INTEGER,
+	created       INTEGER,
+	started       INTEGER,
+	finished      INTEGER,
+	host          VARCHAR(250),
+	runtime       VARCHAR(250),
+	distribution  VARCHAR(250),
+	UNIQUE(build_id, number)
+);
 `
 )
 
@@ -67,6 +93,9 @@ func (e *engine) CreateStepTable(driver string) error {
 	case constants.DriverPostgres:
 		// create the steps table for Postgres
 		return e.client.Exec(CreatePostgresTable).Error
+	case serverconstants.DriverMySQL:
+		// create the steps table for MySQL
+		return e.client.Exec(CreateMySQLTable).Error
 	case constants.DriverSqlite:
 		fallthrough
 	default:
@@ -2,7 +2,11 @@
 
 package user
 
-import "context"
+import (
+	"context"
+
+	"github.com/go-vela/server/constants"
+)
 
 const (
 	// CreateUserRefreshIndex represents a query to create an
@@ -12,6 +16,24 @@ CREATE INDEX
 IF NOT EXISTS
 users_refresh
 ON users (refresh_token);
+`
+
+	// CreateProviderNameIndex represents a query to create a unique
+	// index on the users table for the scm_provider and name columns.
+	CreateProviderNameIndex = `
+CREATE UNIQUE INDEX
+IF NOT EXISTS
+users_scm_provider_name
+ON users (scm_provider, name);
+`
+
+	// CreateMySQLProviderNameIndex represents a query to replace the unique
+	// index on the MySQL users table for the name column with a unique
+	// index for the scm_provider and name columns.
+	CreateMySQLProviderNameIndex = `
+ALTER TABLE users
+DROP INDEX name,
+ADD UNIQUE INDEX users_scm_provider_name (scm_provider, name);
 `
 )
 
@@ -19,6 +41,11 @@ ON users (refresh_token);
 func (e *engine) CreateUserIndexes(ctx context.Context) error {
 	e.logger.Tracef("creating indexes for users table in the database")
 
+	// the indexes are created with the MySQL users table
+	if e.client.Config.Dialector.Name() == constants.DriverMySQL {
+		return nil
+	}
+
 	// create the refresh_token column index for the users table
 	return e.client.Exec(CreateUserRefreshIndex).Error
 }
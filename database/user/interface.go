@@ -36,10 +36,16 @@ type UserInterface interface {
 	GetUser(context.Context, int64) (*library.User, error)
 	// GetUserForName defines a function that gets a user by name.
 	GetUserForName(context.Context, string) (*library.User, error)
+	// GetUserForProvider defines a function that gets a user by scm provider name and name.
+	GetUserForProvider(context.Context, string, string) (*library.User, error)
+	// GetUserProvider defines a function that gets the scm provider name for a user.
+	GetUserProvider(context.Context, *library.User) (string, error)
 	// ListUsers defines a function that gets a list of all users.
 	ListUsers(context.Context) ([]*library.User, error)
 	// ListLiteUsers defines a function that gets a lite list of users.
 	ListLiteUsers(context.Context, int, int) ([]*library.User, int64, error)
 	// UpdateUser defines a function that updates an existing user.
 	UpdateUser(context.Context, *library.User) (*library.User, error)
+	// UpdateUserProvider defines a function that updates the scm provider name for a user.
+	UpdateUserProvider(context.Context, *library.User, string) error
 }
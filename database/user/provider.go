@@ -0,0 +1,95 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package user
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/database"
+	"github.com/go-vela/types/library"
+	"github.com/sirupsen/logrus"
+)
+
+// GetUserProvider gets the name of the scm provider for a user from the database.
+//
+// An empty name is returned for users that belong to the default scm provider.
+func (e *engine) GetUserProvider(ctx context.Context, u *library.User) (string, error) {
+	e.logger.WithFields(logrus.Fields{
+		"user": u.GetName(),
+	}).Tracef("getting scm provider for user %s from the database", u.GetName())
+
+	// variable to store query results
+	var provider sql.NullString
+
+	// send query to the database and store result in variable
+	err := e.client.
+		Table(constants.TableUser).
+		Select("scm_provider").
+		Where("id = ?", u.GetID()).
+		Row().
+		Scan(&provider)
+	if err != nil {
+		return "", err
+	}
+
+	return provider.String, nil
+}
+
+// GetUserForProvider gets a user by name from the database
+// for the scm provider the user belongs to.
+//
+// An empty provider name gets the user for the default scm provider.
+func (e *engine) GetUserForProvider(ctx context.Context, provider, name string) (*library.User, error) {
+	e.logger.WithFields(logrus.Fields{
+		"provider": provider,
+		"user":     name,
+	}).Tracef("getting user %s for scm provider %q from the database", name, provider)
+
+	// variable to store query results
+	u := new(database.User)
+
+	// send query to the database and store result in variable
+	err := e.client.
+		Table(constants.TableUser).
+		Where("COALESCE(scm_provider, '') = ?", provider).
+		Where("name = ?", name).
+		Take(u).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	// decrypt the fields for the user
+	//
+	// https://pkg.go.dev/github.com/go-vela/types/database#User.Decrypt
+	err = u.Decrypt(e.config.EncryptionKey)
+	if err != nil {
+		// TODO: remove backwards compatibility before 1.x.x release
+		//
+		// ensures that the change is backwards compatible
+		// by logging the error instead of returning it
+		// which allows us to fetch unencrypted users
+		e.logger.Errorf("unable to decrypt user %d: %v", u.ID.Int64, err)
+	}
+
+	// return the user
+	//
+	// https://pkg.go.dev/github.com/go-vela/types/database#User.ToLibrary
+	return u.ToLibrary(), nil
+}
+
+// UpdateUserProvider updates the name of the scm provider for a user in the database.
+func (e *engine) UpdateUserProvider(ctx context.Context, u *library.User, provider string) error {
+	e.logger.WithFields(logrus.Fields{
+		"user": u.GetName(),
+	}).Tracef("updating scm provider for user %s in the database", u.GetName())
+
+	// send query to the database
+	return e.client.
+		Table(constants.TableUser).
+		Where("id = ?", u.GetID()).
+		Update("scm_provider", provider).
+		Error
+}
@@ -0,0 +1,260 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package user
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestUser_Engine_GetUserProvider(t *testing.T) {
+	// setup types
+	_user := testUser()
+	_user.SetID(1)
+	_user.SetName("foo")
+	_user.SetToken("bar")
+	_user.SetHash("baz")
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows([]string{"scm_provider"}).AddRow("gitlab")
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT scm_provider FROM "users" WHERE id = $1`).WithArgs(1).WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	_, err := _sqlite.CreateUser(context.TODO(), _user)
+	if err != nil {
+		t.Errorf("unable to create test user for sqlite: %v", err)
+	}
+
+	err = _sqlite.UpdateUserProvider(context.TODO(), _user, "gitlab")
+	if err != nil {
+		t.Errorf("unable to update test user provider for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		want     string
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     "gitlab",
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     "gitlab",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.GetUserProvider(context.TODO(), _user)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("GetUserProvider for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("GetUserProvider for %s returned err: %v", test.name, err)
+			}
+
+			if got != test.want {
+				t.Errorf("GetUserProvider for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
+
+func TestUser_Engine_GetUserForProvider(t *testing.T) {
+	// setup types
+	_user := testUser()
+	_user.SetID(1)
+	_user.SetName("foo")
+	_user.SetToken("bar")
+	_user.SetHash("baz")
+	_user.SetFavorites([]string{})
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows(
+		[]string{"id", "name", "refresh_token", "token", "hash", "favorites", "active", "admin"}).
+		AddRow(1, "foo", "", "bar", "baz", "{}", false, false)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "users" WHERE COALESCE(scm_provider, '') = $1 AND name = $2 LIMIT 1`).
+		WithArgs("gitlab", "foo").WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	_, err := _sqlite.CreateUser(context.TODO(), _user)
+	if err != nil {
+		t.Errorf("unable to create test user for sqlite: %v", err)
+	}
+
+	err = _sqlite.UpdateUserProvider(context.TODO(), _user, "gitlab")
+	if err != nil {
+		t.Errorf("unable to update test user provider for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		provider string
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			provider: "gitlab",
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			provider: "gitlab",
+		},
+		{
+			failure:  true,
+			name:     "sqlite3 default provider",
+			database: _sqlite,
+			provider: "",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.GetUserForProvider(context.TODO(), test.provider, "foo")
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("GetUserForProvider for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("GetUserForProvider for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, _user) {
+				t.Errorf("GetUserForProvider for %s is %v, want %v", test.name, got, _user)
+			}
+		})
+	}
+}
+
+func TestUser_Engine_GetUserProvider_Default(t *testing.T) {
+	// setup types
+	_user := testUser()
+	_user.SetID(1)
+	_user.SetName("foo")
+	_user.SetToken("bar")
+	_user.SetHash("baz")
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	_, err := _sqlite.CreateUser(context.TODO(), _user)
+	if err != nil {
+		t.Errorf("unable to create test user for sqlite: %v", err)
+	}
+
+	// run test
+	got, err := _sqlite.GetUserProvider(context.TODO(), _user)
+	if err != nil {
+		t.Errorf("GetUserProvider returned err: %v", err)
+	}
+
+	if got != "" {
+		t.Errorf("GetUserProvider is %v, want empty provider", got)
+	}
+}
+
+func TestUser_Engine_UpdateUserProvider(t *testing.T) {
+	// setup types
+	_user := testUser()
+	_user.SetID(1)
+	_user.SetName("foo")
+	_user.SetToken("bar")
+	_user.SetHash("baz")
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// ensure the mock expects the query
+	_mock.ExpectExec(`UPDATE "users" SET "scm_provider"=$1 WHERE id = $2`).
+		WithArgs("gitlab", 1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	_, err := _sqlite.CreateUser(context.TODO(), _user)
+	if err != nil {
+		t.Errorf("unable to create test user for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err = test.database.UpdateUserProvider(context.TODO(), _user, "gitlab")
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("UpdateUserProvider for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("UpdateUserProvider for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+}
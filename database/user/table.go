@@ -5,6 +5,7 @@ package user
 import (
 	"context"
 
+	serverconstants "github.com/go-vela/server/constants"
 	"github.com/go-vela/types/constants"
 )
 
@@ -22,6 +23,7 @@ users (
 	favorites      VARCHAR(5000),
 	active         BOOLEAN,
 	admin          BOOLEAN,
+	scm_provider   VARCHAR(250),
 	UNIQUE(name)
 );
 `
@@ -39,9 +41,38 @@ users (
 	favorites      TEXT,
 	active         BOOLEAN,
 	admin          BOOLEAN,
+	scm_provider   TEXT,
 	UNIQUE(name)
 );
 `
+
+	// CreateMySQLTable represents a query to create the MySQL users table
+	// with its indexes, since MySQL does not support creating an index
+	// only if it does not exist.
+	CreateMySQLTable = `
+CREATE TABLE
+IF NOT EXISTS
+users (
+	id             INTEGER NOT NULL AUTO_INCREMENT PRIMARY KEY,
+	name           VARCHAR(250),
+	refresh_token  VARCHAR(500),
+	token          VARCHAR(500),
+	hash           VARCHAR(500),
+	favorites      VARCHAR(5000),
+	active         BOOLEAN,
+	admin          BOOLEAN,
+	scm_provider   VARCHAR(250),
+	UNIQUE(name),
+	INDEX users_refresh (refresh_token)
+);
+`
+	// AddProviderPostgresColumn represents a query to add the scm_provider
+	// column to a Postgres users table created before it was introduced.
+	AddProviderPostgresColumn = `ALTER TABLE users ADD COLUMN IF NOT EXISTS scm_provider VARCHAR(250);`
+
+	// AddProviderSqliteColumn represents a query to add the scm_provider
+	// column to a Sqlite users table created before it was introduced.
+	AddProviderSqliteColumn = `ALTER TABLE users ADD COLUMN scm_provider TEXT;`
 )
 
 // CreateUserTable creates the users table in the database.
@@ -52,11 +83,31 @@ func (e *engine) CreateUserTable(ctx context.Context, driver string) error {
 	switch driver {
 	case constants.DriverPostgres:
 		// create the users table for Postgres
-		return e.client.Exec(CreatePostgresTable).Error
+		err := e.client.Exec(CreatePostgresTable).Error
+		if err != nil {
+			return err
+		}
+
+		// add the scm_provider column for existing users tables
+		return e.client.Exec(AddProviderPostgresColumn).Error
+	case serverconstants.DriverMySQL:
+		// create the users table for MySQL
+		return e.client.Exec(CreateMySQLTable).Error
 	case constants.DriverSqlite:
 		fallthrough
 	default:
 		// create the users table for Sqlite
-		return e.client.Exec(CreateSqliteTable).Error
+		err := e.client.Exec(CreateSqliteTable).Error
+		if err != nil {
+			return err
+		}
+
+		// Sqlite does not support adding a column only if it does not exist
+		if e.client.Migrator().HasColumn(constants.TableUser, "scm_provider") {
+			return nil
+		}
+
+		// add the scm_provider column for existing users tables
+		return e.client.Exec(AddProviderSqliteColumn).Error
 	}
 }
@@ -2,7 +2,11 @@
 
 package worker
 
-import "context"
+import (
+	"context"
+
+	"github.com/go-vela/server/constants"
+)
 
 const (
 	// CreateHostnameAddressIndex represents a query to create an
@@ -19,6 +23,11 @@ ON workers (hostname, address);
 func (e *engine) CreateWorkerIndexes(ctx context.Context) error {
 	e.logger.Tracef("creating indexes for workers table in the database")
 
+	// the indexes are created with the MySQL workers table
+	if e.client.Config.Dialector.Name() == constants.DriverMySQL {
+		return nil
+	}
+
 	// create the hostname and address columns index for the workers table
 	return e.client.Exec(CreateHostnameAddressIndex).Error
 }
@@ -5,6 +5,7 @@ package worker
 import (
 	"context"
 
+	"github.com/go-vela/server/internal/labels"
 	"github.com/go-vela/types/library"
 )
 
@@ -36,8 +37,14 @@ type WorkerInterface interface {
 	GetWorker(context.Context, int64) (*library.Worker, error)
 	// GetWorkerForHostname defines a function that gets a worker by hostname.
 	GetWorkerForHostname(context.Context, string) (*library.Worker, error)
+	// GetWorkerLabels defines a function that gets the labels registered by a worker.
+	GetWorkerLabels(context.Context, *library.Worker) (labels.Set, error)
 	// ListWorkers defines a function that gets a list of all workers.
 	ListWorkers(context.Context) ([]*library.Worker, error)
+	// ListWorkerLabels defines a function that gets the labels registered by all workers.
+	ListWorkerLabels(context.Context) (map[int64]labels.Set, error)
 	// UpdateWorker defines a function that updates an existing worker.
 	UpdateWorker(context.Context, *library.Worker) (*library.Worker, error)
+	// UpdateWorkerLabels defines a function that updates the labels registered by a worker.
+	UpdateWorkerLabels(context.Context, *library.Worker, labels.Set) error
 }
@@ -0,0 +1,92 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package worker
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/go-vela/server/internal/labels"
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+	"github.com/sirupsen/logrus"
+)
+
+// GetWorkerLabels gets the labels registered by a worker from the database.
+func (e *engine) GetWorkerLabels(ctx context.Context, w *library.Worker) (labels.Set, error) {
+	e.logger.WithFields(logrus.Fields{
+		"worker": w.GetHostname(),
+	}).Tracef("getting labels for worker %s from the database", w.GetHostname())
+
+	// variable to store query results
+	var l sql.NullString
+
+	// send query to the database and store result in variable
+	err := e.client.
+		Table(constants.TableWorker).
+		Select("labels").
+		Where("id = ?", w.GetID()).
+		Row().
+		Scan(&l)
+	if err != nil {
+		return nil, err
+	}
+
+	return labels.Parse(l.String)
+}
+
+// ListWorkerLabels gets the labels registered by all workers from the database.
+func (e *engine) ListWorkerLabels(ctx context.Context) (map[int64]labels.Set, error) {
+	e.logger.Trace("listing labels for all workers from the database")
+
+	// variables to store query results
+	results := []struct {
+		ID     int64
+		Labels sql.NullString
+	}{}
+
+	// send query to the database and store result in variable
+	err := e.client.
+		Table(constants.TableWorker).
+		Select("id", "labels").
+		Find(&results).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	workers := make(map[int64]labels.Set, len(results))
+
+	for _, result := range results {
+		l, err := labels.Parse(result.Labels.String)
+		if err != nil {
+			e.logger.Warnf("skipping invalid labels for worker %d: %v", result.ID, err)
+
+			continue
+		}
+
+		workers[result.ID] = l
+	}
+
+	return workers, nil
+}
+
+// UpdateWorkerLabels updates the labels registered by a worker in the database.
+func (e *engine) UpdateWorkerLabels(ctx context.Context, w *library.Worker, l labels.Set) error {
+	e.logger.WithFields(logrus.Fields{
+		"worker": w.GetHostname(),
+	}).Tracef("updating labels for worker %s in the database", w.GetHostname())
+
+	// verify the labels are valid before storing them
+	err := l.Validate()
+	if err != nil {
+		return err
+	}
+
+	// send query to the database
+	return e.client.
+		Table(constants.TableWorker).
+		Where("id = ?", w.GetID()).
+		Update("labels", l.String()).
+		Error
+}
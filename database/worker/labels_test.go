@@ -0,0 +1,251 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package worker
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-vela/server/internal/labels"
+	"github.com/go-vela/types/library"
+)
+
+func TestWorker_Engine_GetWorkerLabels(t *testing.T) {
+	// setup types
+	_worker := testWorker()
+	_worker.SetID(1)
+	_worker.SetHostname("worker_0")
+	_worker.SetAddress("localhost")
+	_worker.SetActive(true)
+
+	_labels := labels.Set{"arch": "arm64", "gpu": "false"}
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows([]string{"labels"}).AddRow("arch=arm64,gpu=false")
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT labels FROM "workers" WHERE id = $1`).WithArgs(1).WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	_, err := _sqlite.CreateWorker(context.TODO(), _worker)
+	if err != nil {
+		t.Errorf("unable to create test worker for sqlite: %v", err)
+	}
+
+	err = _sqlite.UpdateWorkerLabels(context.TODO(), _worker, _labels)
+	if err != nil {
+		t.Errorf("unable to update test worker labels for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		want     labels.Set
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     _labels,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     _labels,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.GetWorkerLabels(context.TODO(), _worker)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("GetWorkerLabels for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("GetWorkerLabels for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("GetWorkerLabels for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
+
+func TestWorker_Engine_ListWorkerLabels(t *testing.T) {
+	// setup types
+	_workerOne := testWorker()
+	_workerOne.SetID(1)
+	_workerOne.SetHostname("worker_0")
+	_workerOne.SetAddress("localhost")
+	_workerOne.SetActive(true)
+
+	_workerTwo := testWorker()
+	_workerTwo.SetID(2)
+	_workerTwo.SetHostname("worker_1")
+	_workerTwo.SetAddress("localhost")
+	_workerTwo.SetActive(true)
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows([]string{"id", "labels"}).
+		AddRow(1, "arch=arm64").
+		AddRow(2, nil)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT "id","labels" FROM "workers"`).WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	for _, worker := range []*library.Worker{_workerOne, _workerTwo} {
+		_, err := _sqlite.CreateWorker(context.TODO(), worker)
+		if err != nil {
+			t.Errorf("unable to create test worker for sqlite: %v", err)
+		}
+	}
+
+	err := _sqlite.UpdateWorkerLabels(context.TODO(), _workerOne, labels.Set{"arch": "arm64"})
+	if err != nil {
+		t.Errorf("unable to update test worker labels for sqlite: %v", err)
+	}
+
+	want := map[int64]labels.Set{
+		1: {"arch": "arm64"},
+		2: {},
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		want     map[int64]labels.Set
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     want,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     want,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.ListWorkerLabels(context.TODO())
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("ListWorkerLabels for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("ListWorkerLabels for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("ListWorkerLabels for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
+
+func TestWorker_Engine_UpdateWorkerLabels(t *testing.T) {
+	// setup types
+	_worker := testWorker()
+	_worker.SetID(1)
+	_worker.SetHostname("worker_0")
+	_worker.SetAddress("localhost")
+	_worker.SetActive(true)
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// ensure the mock expects the query
+	_mock.ExpectExec(`UPDATE "workers" SET "labels"=$1 WHERE id = $2`).
+		WithArgs("arch=arm64,gpu=false", 1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	_, err := _sqlite.CreateWorker(context.TODO(), _worker)
+	if err != nil {
+		t.Errorf("unable to create test worker for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		labels   labels.Set
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			labels:   labels.Set{"arch": "arm64", "gpu": "false"},
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			labels:   labels.Set{"arch": "arm64", "gpu": "false"},
+		},
+		{
+			failure:  true,
+			name:     "invalid labels",
+			database: _sqlite,
+			labels:   labels.Set{"arch": "arm64,amd64"},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.database.UpdateWorkerLabels(context.TODO(), _worker, test.labels)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("UpdateWorkerLabels for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("UpdateWorkerLabels for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ package worker
 import (
 	"context"
 
+	serverconstants "github.com/go-vela/server/constants"
 	"github.com/go-vela/types/constants"
 )
 
@@ -26,6 +27,7 @@ workers (
 	last_build_finished_at INTEGER,
 	last_checked_in        INTEGER,
 	build_limit            INTEGER,
+	labels                 VARCHAR(1000),
 	UNIQUE(hostname)
 );
 `
@@ -46,9 +48,43 @@ workers (
 	last_build_finished_at INTEGER,
 	last_checked_in	       INTEGER,
 	build_limit            INTEGER,
+	labels                 TEXT,
 	UNIQUE(hostname)
 );
 `
+
+	// CreateMySQLTable represents a query to create the MySQL workers table
+	// with its indexes, since MySQL does not support creating an index
+	// only if it does not exist.
+	CreateMySQLTable = `
+CREATE TABLE
+IF NOT EXISTS
+workers (
+	id                     INTEGER NOT NULL AUTO_INCREMENT PRIMARY KEY,
+	hostname               VARCHAR(250),
+	address                VARCHAR(250),
+	routes                 VARCHAR(1000),
+	active                 BOOLEAN,
+	status                 VARCHAR(50),
+	last_status_update_at  INTEGER,
+	running_build_ids      VARCHAR(500),
+	last_build_started_at  INTEGER,
+	last_build_finished_at INTEGER,
+	last_checked_in        INTEGER,
+	build_limit            INTEGER,
+	labels                 VARCHAR(1000),
+	UNIQUE(hostname),
+	INDEX workers_hostname_address (hostname, address)
+);
+`
+
+	// AddLabelsPostgresColumn represents a query to add the labels
+	// column to a Postgres workers table created before it was introduced.
+	AddLabelsPostgresColumn = `ALTER TABLE workers ADD COLUMN IF NOT EXISTS labels VARCHAR(1000);`
+
+	// AddLabelsSqliteColumn represents a query to add the labels
+	// column to a Sqlite workers table created before it was introduced.
+	AddLabelsSqliteColumn = `ALTER TABLE workers ADD COLUMN labels TEXT;`
 )
 
 // CreateWorkerTable creates the workers table in the database.
@@ -59,11 +95,31 @@ func (e *engine) CreateWorkerTable(ctx context.Context, driver string) error {
 	switch driver {
 	case constants.DriverPostgres:
 		// create the workers table for Postgres
-		return e.client.Exec(CreatePostgresTable).Error
+		err := e.client.Exec(CreatePostgresTable).Error
+		if err != nil {
+			return err
+		}
+
+		// add the labels column for existing workers tables
+		return e.client.Exec(AddLabelsPostgresColumn).Error
+	case serverconstants.DriverMySQL:
+		// create the workers table for MySQL
+		return e.client.Exec(CreateMySQLTable).Error
 	case constants.DriverSqlite:
 		fallthrough
 	default:
 		// create the workers table for Sqlite
-		return e.client.Exec(CreateSqliteTable).Error
+		err := e.client.Exec(CreateSqliteTable).Error
+		if err != nil {
+			return err
+		}
+
+		// Sqlite does not support adding a column only if it does not exist
+		if e.client.Migrator().HasColumn(constants.TableWorker, "labels") {
+			return nil
+		}
+
+		// add the labels column for existing workers tables
+		return e.client.Exec(AddLabelsSqliteColumn).Error
 	}
 }
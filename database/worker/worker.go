@@ -60,7 +60,7 @@ func New(opts ...EngineOpt) (*engine, error) {
 
 	// check if we should skip creating worker database objects
 	if e.config.SkipCreation {
-		e.logger.Warning("skipping creation of workers table and indexes in the database")
+		e.logger.Trace("skipping creation of workers table and indexes in the database")
 
 		return e, nil
 	}
@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package chatops
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// Prefix defines the prefix for a command in a comment.
+	Prefix = "/vela"
+
+	// CommandApprove defines the command for approving a comment build.
+	CommandApprove = "approve"
+
+	// CommandCancel defines the command for canceling builds.
+	CommandCancel = "cancel"
+
+	// CommandDeploy defines the command for creating a deployment.
+	CommandDeploy = "deploy"
+
+	// CommandRestart defines the command for restarting a build.
+	CommandRestart = "restart"
+)
+
+// Command represents a command parsed from a comment.
+type Command struct {
+	// Name is the name of the command.
+	Name string
+	// Target is the deployment target for the deploy command.
+	Target string
+}
+
+// String implements the Stringer interface for the Command type.
+func (c *Command) String() string {
+	if len(c.Target) > 0 {
+		return fmt.Sprintf("%s %s %s", Prefix, c.Name, c.Target)
+	}
+
+	return fmt.Sprintf("%s %s", Prefix, c.Name)
+}
+
+// Parse captures the command from the first line of a comment.
+//
+// A nil command and nil error is returned for comments that
+// do not contain a recognized command, so those comments can
+// be handled as they were before commands were supported.
+func Parse(comment string) (*Command, error) {
+	// only the first line of the comment is considered
+	line, _, _ := strings.Cut(strings.TrimSpace(comment), "\n")
+
+	fields := strings.Fields(line)
+	if len(fields) < 2 || !strings.EqualFold(fields[0], Prefix) {
+		return nil, nil
+	}
+
+	c := &Command{
+		Name: strings.ToLower(fields[1]),
+	}
+
+	args := fields[2:]
+
+	switch c.Name {
+	case CommandApprove, CommandCancel, CommandRestart:
+		if len(args) > 0 {
+			return nil, fmt.Errorf("%s does not accept arguments", c)
+		}
+	case CommandDeploy:
+		if len(args) != 1 {
+			return nil, fmt.Errorf("%s requires a single target: %s %s <target>", c, Prefix, CommandDeploy)
+		}
+
+		c.Target = args[0]
+	default:
+		return nil, nil
+	}
+
+	return c, nil
+}
@@ -0,0 +1,123 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package chatops
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestChatOps_Parse(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name    string
+		comment string
+		want    *Command
+		failure bool
+	}{
+		{
+			name:    "restart",
+			comment: "/vela restart",
+			want:    &Command{Name: CommandRestart},
+		},
+		{
+			name:    "cancel with surrounding whitespace",
+			comment: "  /vela cancel \n",
+			want:    &Command{Name: CommandCancel},
+		},
+		{
+			name:    "approve mixed case",
+			comment: "/Vela Approve",
+			want:    &Command{Name: CommandApprove},
+		},
+		{
+			name:    "deploy",
+			comment: "/vela deploy production\nshipping the fix",
+			want:    &Command{Name: CommandDeploy, Target: "production"},
+		},
+		{
+			name:    "deploy without target",
+			comment: "/vela deploy",
+			failure: true,
+		},
+		{
+			name:    "deploy with multiple targets",
+			comment: "/vela deploy staging production",
+			failure: true,
+		},
+		{
+			name:    "restart with arguments",
+			comment: "/vela restart now",
+			failure: true,
+		},
+		{
+			name:    "unknown command",
+			comment: "/vela foo",
+		},
+		{
+			name:    "prefix only",
+			comment: "/vela",
+		},
+		{
+			name:    "command not on first line",
+			comment: "looks good\n/vela restart",
+		},
+		{
+			name:    "plain comment",
+			comment: "run the build",
+		},
+		{
+			name:    "prefix inside word",
+			comment: "/velarestart",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := Parse(test.comment)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("Parse should have returned err")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("Parse returned err: %v", err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("Parse is %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestChatOps_Command_String(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		command *Command
+		want    string
+	}{
+		{
+			command: &Command{Name: CommandRestart},
+			want:    "/vela restart",
+		},
+		{
+			command: &Command{Name: CommandDeploy, Target: "production"},
+			want:    "/vela deploy production",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got := test.command.String()
+
+		if got != test.want {
+			t.Errorf("String is %v, want %v", got, test.want)
+		}
+	}
+}
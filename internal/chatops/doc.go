@@ -0,0 +1,9 @@
+// SPDX-License-Identifier: Apache-2.0
+
+// Package chatops provides the ability for Vela to parse
+// commands from comments left on pull requests.
+//
+// Usage:
+//
+//	import "github.com/go-vela/server/internal/chatops"
+package chatops
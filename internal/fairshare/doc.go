@@ -0,0 +1,10 @@
+// SPDX-License-Identifier: Apache-2.0
+
+// Package fairshare provides the ability for Vela to share
+// the workers popping items off the queue between the orgs
+// or repos that published the items.
+//
+// Usage:
+//
+//	import "github.com/go-vela/server/internal/fairshare"
+package fairshare
@@ -0,0 +1,128 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package fairshare
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	// ModeOrg shares the workers between the orgs of the repos for the items.
+	ModeOrg = "org"
+	// ModeRepo shares the workers between the repos for the items.
+	ModeRepo = "repo"
+	// Default is the owner used to set the weight and cap for owners without one.
+	Default = "*"
+)
+
+// Policy represents the configuration for sharing the workers
+// popping items off the queue between the owners of the items.
+type Policy struct {
+	// Mode is the owner of the items, either the org or the repo.
+	Mode string
+	// Weights are the share of the workers for the owners.
+	Weights map[string]int64
+	// Caps are the max number of items in-flight for the owners.
+	Caps map[string]int64
+}
+
+// New creates a policy for the mode from the weights and caps
+// provided as owner=value pairs. No policy is returned when the
+// mode is empty since the workers are not shared.
+func New(mode string, weights, caps []string) (*Policy, error) {
+	if len(mode) == 0 {
+		return nil, nil
+	}
+
+	if mode != ModeOrg && mode != ModeRepo {
+		return nil, fmt.Errorf("invalid fair share mode %s provided: must be %s or %s", mode, ModeOrg, ModeRepo)
+	}
+
+	w, err := parse(weights)
+	if err != nil {
+		return nil, fmt.Errorf("invalid fair share weights provided: %w", err)
+	}
+
+	c, err := parse(caps)
+	if err != nil {
+		return nil, fmt.Errorf("invalid fair share caps provided: %w", err)
+	}
+
+	return &Policy{
+		Mode:    mode,
+		Weights: w,
+		Caps:    c,
+	}, nil
+}
+
+// Owner returns the owner of an item for the mode of the policy.
+func (p *Policy) Owner(item []byte) string {
+	i := struct {
+		Repo *struct {
+			Org      string `json:"org"`
+			FullName string `json:"full_name"`
+		} `json:"repo"`
+	}{}
+
+	// items that are unable to be decoded share an empty owner
+	err := json.Unmarshal(item, &i)
+	if err != nil || i.Repo == nil {
+		return ""
+	}
+
+	if p.Mode == ModeRepo {
+		return i.Repo.FullName
+	}
+
+	return i.Repo.Org
+}
+
+// Weight returns the share of the workers for the owner.
+func (p *Policy) Weight(owner string) int64 {
+	if weight, ok := p.Weights[owner]; ok {
+		return weight
+	}
+
+	if weight, ok := p.Weights[Default]; ok {
+		return weight
+	}
+
+	return 1
+}
+
+// Cap returns the max number of items in-flight
+// for the owner or zero when there is no cap.
+func (p *Policy) Cap(owner string) int64 {
+	if limit, ok := p.Caps[owner]; ok {
+		return limit
+	}
+
+	return p.Caps[Default]
+}
+
+// parse is a helper function to parse a list of owner=value
+// pairs into a map of owners to positive values.
+func parse(pairs []string) (map[string]int64, error) {
+	values := make(map[string]int64)
+
+	for _, pair := range pairs {
+		owner, value, ok := strings.Cut(pair, "=")
+
+		owner = strings.TrimSpace(owner)
+		if !ok || len(owner) == 0 {
+			return nil, fmt.Errorf("%s is not an owner=value pair", pair)
+		}
+
+		v, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+		if err != nil || v < 1 {
+			return nil, fmt.Errorf("value for %s must be a positive integer", owner)
+		}
+
+		values[owner] = v
+	}
+
+	return values, nil
+}
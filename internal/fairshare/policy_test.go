@@ -0,0 +1,179 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package fairshare
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFairShare_New(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name    string
+		mode    string
+		weights []string
+		caps    []string
+		want    *Policy
+		failure bool
+	}{
+		{
+			name:    "org",
+			mode:    ModeOrg,
+			weights: []string{"github=2", " octocat = 3 "},
+			caps:    []string{"*=10"},
+			want: &Policy{
+				Mode:    ModeOrg,
+				Weights: map[string]int64{"github": 2, "octocat": 3},
+				Caps:    map[string]int64{"*": 10},
+			},
+		},
+		{
+			name:    "repo",
+			mode:    ModeRepo,
+			weights: nil,
+			caps:    []string{"github/octocat=1"},
+			want: &Policy{
+				Mode:    ModeRepo,
+				Weights: map[string]int64{},
+				Caps:    map[string]int64{"github/octocat": 1},
+			},
+		},
+		{
+			name:    "disabled",
+			mode:    "",
+			weights: []string{"github=2"},
+			want:    nil,
+		},
+		{
+			name:    "invalid mode",
+			mode:    "user",
+			failure: true,
+		},
+		{
+			name:    "missing value",
+			mode:    ModeOrg,
+			weights: []string{"github"},
+			failure: true,
+		},
+		{
+			name:    "missing owner",
+			mode:    ModeOrg,
+			caps:    []string{"=2"},
+			failure: true,
+		},
+		{
+			name:    "zero weight",
+			mode:    ModeOrg,
+			weights: []string{"github=0"},
+			failure: true,
+		},
+		{
+			name:    "invalid cap",
+			mode:    ModeOrg,
+			caps:    []string{"github=two"},
+			failure: true,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := New(test.mode, test.weights, test.caps)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("New should have returned err")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("New returned err: %v", err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("New is %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestFairShare_Policy_Owner(t *testing.T) {
+	// setup types
+	item := []byte(`{"build":{"id":1},"repo":{"org":"github","full_name":"github/octocat"}}`)
+
+	// setup tests
+	tests := []struct {
+		name string
+		mode string
+		item []byte
+		want string
+	}{
+		{
+			name: "org",
+			mode: ModeOrg,
+			item: item,
+			want: "github",
+		},
+		{
+			name: "repo",
+			mode: ModeRepo,
+			item: item,
+			want: "github/octocat",
+		},
+		{
+			name: "no repo",
+			mode: ModeOrg,
+			item: []byte(`{"build":{"id":1}}`),
+			want: "",
+		},
+		{
+			name: "invalid item",
+			mode: ModeOrg,
+			item: []byte("foo"),
+			want: "",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := (&Policy{Mode: test.mode}).Owner(test.item)
+
+			if got != test.want {
+				t.Errorf("Owner is %s, want %s", got, test.want)
+			}
+		})
+	}
+}
+
+func TestFairShare_Policy_WeightAndCap(t *testing.T) {
+	// setup types
+	p := &Policy{
+		Mode:    ModeOrg,
+		Weights: map[string]int64{"github": 3, Default: 2},
+		Caps:    map[string]int64{"github": 5},
+	}
+
+	if got := p.Weight("github"); got != 3 {
+		t.Errorf("Weight is %d, want %d", got, 3)
+	}
+
+	if got := p.Weight("octocat"); got != 2 {
+		t.Errorf("Weight is %d, want %d", got, 2)
+	}
+
+	if got := (&Policy{}).Weight("octocat"); got != 1 {
+		t.Errorf("Weight is %d, want %d", got, 1)
+	}
+
+	if got := p.Cap("github"); got != 5 {
+		t.Errorf("Cap is %d, want %d", got, 5)
+	}
+
+	if got := p.Cap("octocat"); got != 0 {
+		t.Errorf("Cap is %d, want %d", got, 0)
+	}
+}
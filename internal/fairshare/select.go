@@ -0,0 +1,37 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package fairshare
+
+// Select returns the index of the owner to pop the next item for from the
+// owners of the items waiting in the queue, in the order the items would be
+// popped without sharing, and the number of items in-flight for each owner.
+//
+// Owners at their cap are skipped. The owner with the fewest items in-flight
+// relative to its weight is selected so owners take turns popping items, and
+// ties go to the owner of the item that would be popped first. When every
+// owner is at its cap -1 is returned.
+func (p *Policy) Select(owners []string, inflight map[string]int64) int {
+	selected := -1
+
+	for i, owner := range owners {
+		limit := p.Cap(owner)
+		if limit > 0 && inflight[owner] >= limit {
+			continue
+		}
+
+		if selected < 0 {
+			selected = i
+
+			continue
+		}
+
+		// compare the ratios of in-flight items to weights
+		// without division by multiplying by the weights
+		current := owners[selected]
+		if inflight[owner]*p.Weight(current) < inflight[current]*p.Weight(owner) {
+			selected = i
+		}
+	}
+
+	return selected
+}
@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package fairshare
+
+import (
+	"testing"
+)
+
+func TestFairShare_Policy_Select(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name     string
+		policy   *Policy
+		owners   []string
+		inflight map[string]int64
+		want     int
+	}{
+		{
+			name:     "first item without in-flight items",
+			policy:   &Policy{Mode: ModeOrg},
+			owners:   []string{"github", "octocat"},
+			inflight: map[string]int64{},
+			want:     0,
+		},
+		{
+			name:     "fewest in-flight items",
+			policy:   &Policy{Mode: ModeOrg},
+			owners:   []string{"github", "octocat", "vela"},
+			inflight: map[string]int64{"github": 3, "octocat": 1, "vela": 2},
+			want:     1,
+		},
+		{
+			name:     "ties go to the first item",
+			policy:   &Policy{Mode: ModeOrg},
+			owners:   []string{"github", "octocat", "vela"},
+			inflight: map[string]int64{"github": 2, "octocat": 1, "vela": 1},
+			want:     1,
+		},
+		{
+			name:     "weighted",
+			policy:   &Policy{Mode: ModeOrg, Weights: map[string]int64{"github": 4}},
+			owners:   []string{"octocat", "github"},
+			inflight: map[string]int64{"github": 3, "octocat": 1},
+			want:     1,
+		},
+		{
+			name:     "capped",
+			policy:   &Policy{Mode: ModeOrg, Caps: map[string]int64{"octocat": 1}},
+			owners:   []string{"octocat", "github"},
+			inflight: map[string]int64{"github": 3, "octocat": 1},
+			want:     1,
+		},
+		{
+			name:     "every owner capped",
+			policy:   &Policy{Mode: ModeOrg, Caps: map[string]int64{Default: 2}},
+			owners:   []string{"octocat", "github"},
+			inflight: map[string]int64{"github": 2, "octocat": 2},
+			want:     -1,
+		},
+		{
+			name:     "no owners",
+			policy:   &Policy{Mode: ModeOrg},
+			owners:   []string{},
+			inflight: map[string]int64{},
+			want:     -1,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := test.policy.Select(test.owners, test.inflight)
+
+			if got != test.want {
+				t.Errorf("Select is %d, want %d", got, test.want)
+			}
+		})
+	}
+}
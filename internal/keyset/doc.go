@@ -0,0 +1,10 @@
+// SPDX-License-Identifier: Apache-2.0
+
+// Package keyset provides the ability for Vela to open items
+// popped from the queue with a set of public keys, so the
+// keys signing items can be rotated without draining the queue.
+//
+// Usage:
+//
+//	import "github.com/go-vela/server/internal/keyset"
+package keyset
@@ -0,0 +1,105 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package keyset
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/nacl/sign"
+)
+
+// Key represents a public key, identified by an ID,
+// for opening items signed with its private key.
+type Key struct {
+	// ID is the unique identifier for the key.
+	ID string
+	// PublicKey is the public key for opening items.
+	PublicKey *[32]byte
+}
+
+// Split splits a key provided as <id>=<base64 public key>
+// into the ID and the base64 encoded public key.
+func Split(key string) (string, string, error) {
+	id, encoded, ok := strings.Cut(key, "=")
+
+	id = strings.TrimSpace(id)
+	encoded = strings.TrimSpace(encoded)
+
+	if !ok || len(id) == 0 || len(encoded) == 0 {
+		return "", "", fmt.Errorf("invalid queue public key %s provided: must be <id>=<base64 public key>", key)
+	}
+
+	return id, encoded, nil
+}
+
+// Decode decodes a base64 encoded public key.
+func Decode(key string) (*[32]byte, error) {
+	decoded, err := base64.StdEncoding.DecodeString(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(decoded) != 32 {
+		return nil, fmt.Errorf("invalid queue public key provided: decoded key must be 32 bytes")
+	}
+
+	publicKey := new([32]byte)
+	copy(publicKey[:], decoded)
+
+	return publicKey, nil
+}
+
+// Parse parses the keys provided as <id>=<base64 public key>.
+func Parse(keys []string) ([]*Key, error) {
+	set := make([]*Key, 0, len(keys))
+	ids := make(map[string]bool, len(keys))
+
+	for _, key := range keys {
+		id, encoded, err := Split(key)
+		if err != nil {
+			return nil, err
+		}
+
+		if ids[id] {
+			return nil, fmt.Errorf("duplicate queue public key %s provided", id)
+		}
+
+		publicKey, err := Decode(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode queue public key %s: %w", id, err)
+		}
+
+		ids[id] = true
+
+		set = append(set, &Key{ID: id, PublicKey: publicKey})
+	}
+
+	return set, nil
+}
+
+// Open opens the signed item with the active public key, falling
+// back to the other public keys in the set for items signed before
+// the active key was rotated.
+//
+// https://pkg.go.dev/golang.org/x/crypto/nacl/sign#Open
+func Open(signed []byte, active *[32]byte, keys []*Key) ([]byte, bool) {
+	var out []byte
+
+	if active != nil {
+		opened, ok := sign.Open(out, signed, active)
+		if ok {
+			return opened, true
+		}
+	}
+
+	for _, key := range keys {
+		opened, ok := sign.Open(out, signed, key.PublicKey)
+		if ok {
+			return opened, true
+		}
+	}
+
+	return nil, false
+}
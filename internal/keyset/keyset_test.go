@@ -0,0 +1,200 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package keyset
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"reflect"
+	"testing"
+
+	"golang.org/x/crypto/nacl/sign"
+)
+
+func TestKeySet_Split(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name    string
+		key     string
+		id      string
+		encoded string
+		failure bool
+	}{
+		{
+			name:    "key",
+			key:     " 2026-10 = CuS+EQAzofbk3tVFS3bt5f2tIb4YiJJC4nVMFQYQElg= ",
+			id:      "2026-10",
+			encoded: "CuS+EQAzofbk3tVFS3bt5f2tIb4YiJJC4nVMFQYQElg=",
+		},
+		{
+			name:    "missing id",
+			key:     "=CuS+EQAzofbk3tVFS3bt5f2tIb4YiJJC4nVMFQYQElg=",
+			failure: true,
+		},
+		{
+			name:    "missing separator",
+			key:     "CuS+EQAzofbk3tVFS3bt5f2tIb4YiJJC4nVMFQYQElg",
+			failure: true,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			id, encoded, err := Split(test.key)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("Split should have returned err")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("Split returned err: %v", err)
+			}
+
+			if id != test.id {
+				t.Errorf("Split ID is %s, want %s", id, test.id)
+			}
+
+			if encoded != test.encoded {
+				t.Errorf("Split key is %s, want %s", encoded, test.encoded)
+			}
+		})
+	}
+}
+
+func TestKeySet_Parse(t *testing.T) {
+	// setup types
+	encoded := "CuS+EQAzofbk3tVFS3bt5f2tIb4YiJJC4nVMFQYQElg="
+
+	decoded, _ := base64.StdEncoding.DecodeString(encoded)
+
+	publicKey := new([32]byte)
+	copy(publicKey[:], decoded)
+
+	// setup tests
+	tests := []struct {
+		name    string
+		keys    []string
+		want    []*Key
+		failure bool
+	}{
+		{
+			name: "keys",
+			keys: []string{"2026-04=" + encoded},
+			want: []*Key{{ID: "2026-04", PublicKey: publicKey}},
+		},
+		{
+			name: "empty",
+			keys: nil,
+			want: []*Key{},
+		},
+		{
+			name:    "duplicate",
+			keys:    []string{"2026-04=" + encoded, "2026-04=" + encoded},
+			failure: true,
+		},
+		{
+			name:    "invalid base64",
+			keys:    []string{"2026-04=!!!"},
+			failure: true,
+		},
+		{
+			name:    "invalid length",
+			keys:    []string{"2026-04=Zm9vYmFy"},
+			failure: true,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := Parse(test.keys)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("Parse should have returned err")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("Parse returned err: %v", err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("Parse is %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestKeySet_Open(t *testing.T) {
+	// setup types
+	item := []byte("foo")
+
+	activePublic, activePrivate, err := sign.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Errorf("unable to generate active key: %v", err)
+	}
+
+	previousPublic, previousPrivate, err := sign.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Errorf("unable to generate previous key: %v", err)
+	}
+
+	_, retiredPrivate, err := sign.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Errorf("unable to generate retired key: %v", err)
+	}
+
+	keys := []*Key{{ID: "previous", PublicKey: previousPublic}}
+
+	// setup tests
+	tests := []struct {
+		name    string
+		signed  []byte
+		failure bool
+	}{
+		{
+			name:   "active key",
+			signed: sign.Sign(nil, item, activePrivate),
+		},
+		{
+			name:   "previous key",
+			signed: sign.Sign(nil, item, previousPrivate),
+		},
+		{
+			name:    "retired key",
+			signed:  sign.Sign(nil, item, retiredPrivate),
+			failure: true,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, ok := Open(test.signed, activePublic, keys)
+
+			if test.failure {
+				if ok {
+					t.Errorf("Open should not have opened item")
+				}
+
+				return
+			}
+
+			if !ok {
+				t.Errorf("Open did not open item")
+			}
+
+			if !reflect.DeepEqual(got, item) {
+				t.Errorf("Open is %s, want %s", got, item)
+			}
+		})
+	}
+}
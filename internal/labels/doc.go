@@ -0,0 +1,10 @@
+// SPDX-License-Identifier: Apache-2.0
+
+// Package labels provides the ability for Vela to route builds
+// to workers by matching the labels required by a pipeline to
+// the labels registered by workers.
+//
+// Usage:
+//
+//	import "github.com/go-vela/server/internal/labels"
+package labels
@@ -0,0 +1,102 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package labels
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Set represents a set of labels as key value pairs,
+// like the labels required by a pipeline or the labels
+// registered by a worker.
+type Set map[string]string
+
+// Parse returns the set of labels from a comma separated
+// list of key=value pairs, like "arch=arm64,gpu=false".
+func Parse(s string) (Set, error) {
+	set := Set{}
+
+	for _, label := range strings.Split(s, ",") {
+		label = strings.TrimSpace(label)
+
+		if len(label) == 0 {
+			continue
+		}
+
+		key, value, ok := strings.Cut(label, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid label %q: labels must be in key=value format", label)
+		}
+
+		key = strings.TrimSpace(key)
+		value = strings.TrimSpace(value)
+
+		existing, ok := set[key]
+		if ok && existing != value {
+			return nil, fmt.Errorf("invalid label %q: label %s is already set to %s", label, key, existing)
+		}
+
+		set[key] = value
+	}
+
+	err := set.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return set, nil
+}
+
+// Validate verifies the keys and values for the labels are valid.
+func (s Set) Validate() error {
+	for key, value := range s {
+		if len(key) == 0 {
+			return fmt.Errorf("invalid label =%s: label keys must not be empty", value)
+		}
+
+		if strings.ContainsAny(key, ",= \t\n") {
+			return fmt.Errorf("invalid label key %q: label keys must not contain commas, equal signs or spaces", key)
+		}
+
+		if strings.ContainsAny(value, ",=\n") {
+			return fmt.Errorf("invalid label value %q for %s: label values must not contain commas or equal signs", value, key)
+		}
+	}
+
+	return nil
+}
+
+// Satisfies returns true when the set contains every
+// label, with the same value, from the required labels.
+func (s Set) Satisfies(required Set) bool {
+	for key, value := range required {
+		got, ok := s[key]
+		if !ok || got != value {
+			return false
+		}
+	}
+
+	return true
+}
+
+// String returns the labels as a comma separated
+// list of key=value pairs sorted by key.
+func (s Set) String() string {
+	keys := make([]string, 0, len(s))
+
+	for key := range s {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(s))
+
+	for _, key := range keys {
+		pairs = append(pairs, fmt.Sprintf("%s=%s", key, s[key]))
+	}
+
+	return strings.Join(pairs, ",")
+}
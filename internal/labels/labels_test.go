@@ -0,0 +1,198 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package labels
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLabels_Parse(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name    string
+		labels  string
+		want    Set
+		failure bool
+	}{
+		{
+			name:   "labels",
+			labels: "arch=arm64,gpu=false,team=payments",
+			want:   Set{"arch": "arm64", "gpu": "false", "team": "payments"},
+		},
+		{
+			name:   "surrounding whitespace",
+			labels: " arch = arm64 , gpu=false,",
+			want:   Set{"arch": "arm64", "gpu": "false"},
+		},
+		{
+			name:   "empty",
+			labels: "",
+			want:   Set{},
+		},
+		{
+			name:   "empty value",
+			labels: "gpu=",
+			want:   Set{"gpu": ""},
+		},
+		{
+			name:    "missing value",
+			labels:  "arch",
+			failure: true,
+		},
+		{
+			name:    "missing key",
+			labels:  "=arm64",
+			failure: true,
+		},
+		{
+			name:    "conflicting values",
+			labels:  "arch=arm64,arch=amd64",
+			failure: true,
+		},
+		{
+			name:    "value with equal sign",
+			labels:  "arch=arm=64",
+			failure: true,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := Parse(test.labels)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("Parse should have returned err")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("Parse returned err: %v", err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("Parse is %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestLabels_Set_Validate(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name    string
+		labels  Set
+		failure bool
+	}{
+		{
+			name:   "valid",
+			labels: Set{"arch": "arm64", "team": "payments and billing"},
+		},
+		{
+			name:    "key with space",
+			labels:  Set{"cpu arch": "arm64"},
+			failure: true,
+		},
+		{
+			name:    "value with comma",
+			labels:  Set{"arch": "arm64,amd64"},
+			failure: true,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.labels.Validate()
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("Validate should have returned err")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("Validate returned err: %v", err)
+			}
+		})
+	}
+}
+
+func TestLabels_Set_Satisfies(t *testing.T) {
+	// setup types
+	worker := Set{"arch": "arm64", "gpu": "false", "team": "payments"}
+
+	// setup tests
+	tests := []struct {
+		name     string
+		required Set
+		want     bool
+	}{
+		{
+			name:     "all labels",
+			required: Set{"arch": "arm64", "gpu": "false", "team": "payments"},
+			want:     true,
+		},
+		{
+			name:     "subset of labels",
+			required: Set{"arch": "arm64"},
+			want:     true,
+		},
+		{
+			name:     "no labels",
+			required: Set{},
+			want:     true,
+		},
+		{
+			name:     "different value",
+			required: Set{"arch": "amd64"},
+			want:     false,
+		},
+		{
+			name:     "missing label",
+			required: Set{"region": "us-east-1"},
+			want:     false,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := worker.Satisfies(test.required)
+
+			if got != test.want {
+				t.Errorf("Satisfies is %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestLabels_Set_String(t *testing.T) {
+	// setup types
+	labels := Set{"team": "payments", "arch": "arm64", "gpu": "false"}
+
+	want := "arch=arm64,gpu=false,team=payments"
+
+	// run test
+	got := labels.String()
+
+	if got != want {
+		t.Errorf("String is %s, want %s", got, want)
+	}
+
+	// ensure the labels can be parsed from the string
+	parsed, err := Parse(got)
+	if err != nil {
+		t.Errorf("Parse returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(parsed, labels) {
+		t.Errorf("Parse is %v, want %v", parsed, labels)
+	}
+}
@@ -0,0 +1,73 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package labels
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Worker represents the routes a worker pops
+// builds from and the labels it registered.
+type Worker struct {
+	Routes []string
+	Labels Set
+}
+
+// Route returns the route to publish a build requiring the labels to.
+//
+// A route is only chosen when every worker popping builds from the route
+// has the required labels, so the build can't be picked up by a worker
+// without them. When multiple routes qualify, the route with the most
+// workers is chosen, using the route name to break ties.
+//
+// An error is returned when no worker has the required labels or the
+// workers with the labels only pop builds from routes shared with
+// workers without them.
+func Route(required Set, workers []*Worker) (string, error) {
+	// count the workers popping builds from each route
+	matching := make(map[string]int)
+	shared := make(map[string]bool)
+
+	for _, w := range workers {
+		ok := w.Labels.Satisfies(required)
+
+		for _, route := range w.Routes {
+			if !ok {
+				shared[route] = true
+
+				continue
+			}
+
+			matching[route]++
+		}
+	}
+
+	if len(matching) == 0 {
+		return "", fmt.Errorf("no registered worker has the labels %s", required)
+	}
+
+	routes := []string{}
+
+	for route := range matching {
+		if shared[route] {
+			continue
+		}
+
+		routes = append(routes, route)
+	}
+
+	if len(routes) == 0 {
+		return "", fmt.Errorf("workers with the labels %s only pop builds from routes shared with workers without them", required)
+	}
+
+	sort.Slice(routes, func(i, j int) bool {
+		if matching[routes[i]] != matching[routes[j]] {
+			return matching[routes[i]] > matching[routes[j]]
+		}
+
+		return routes[i] < routes[j]
+	})
+
+	return routes[0], nil
+}
@@ -0,0 +1,91 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package labels
+
+import (
+	"testing"
+)
+
+func TestLabels_Route(t *testing.T) {
+	// setup types
+	workers := []*Worker{
+		{
+			Routes: []string{"vela"},
+			Labels: Set{"arch": "amd64", "os": "linux"},
+		},
+		{
+			Routes: []string{"vela", "arm"},
+			Labels: Set{"arch": "arm64", "gpu": "false"},
+		},
+		{
+			Routes: []string{"arm", "arm:payments"},
+			Labels: Set{"arch": "arm64", "gpu": "false", "team": "payments"},
+		},
+		{
+			Routes: []string{"gpu", "gpu:large"},
+			Labels: Set{"arch": "amd64", "gpu": "true"},
+		},
+	}
+
+	// setup tests
+	tests := []struct {
+		name     string
+		required Set
+		want     string
+		failure  bool
+	}{
+		{
+			name:     "route with the most workers",
+			required: Set{"arch": "arm64"},
+			want:     "arm",
+		},
+		{
+			name:     "route with a single worker",
+			required: Set{"team": "payments"},
+			want:     "arm:payments",
+		},
+		{
+			name:     "routes with the same workers",
+			required: Set{"gpu": "true"},
+			want:     "gpu",
+		},
+		{
+			name:     "no worker with labels",
+			required: Set{"arch": "s390x"},
+			failure:  true,
+		},
+		{
+			name:     "routes without workers missing labels",
+			required: Set{"arch": "amd64"},
+			want:     "gpu",
+		},
+		{
+			name:     "routes shared with workers without labels",
+			required: Set{"os": "linux"},
+			failure:  true,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := Route(test.required, workers)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("Route should have returned err")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("Route returned err: %v", err)
+			}
+
+			if got != test.want {
+				t.Errorf("Route is %s, want %s", got, test.want)
+			}
+		})
+	}
+}
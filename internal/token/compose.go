@@ -7,6 +7,7 @@ import (
 	"net/url"
 
 	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/scm"
 	"github.com/go-vela/types"
 	"github.com/go-vela/types/constants"
 	"github.com/go-vela/types/library"
@@ -17,15 +18,20 @@ import (
 // It uses the user's hash to sign the token. to
 // guarantee the signature is unique per token. The refresh
 // token is returned to store with the user
-// in the database.
+// in the database. The tokens are scoped to the
+// scm provider selected for the request.
 func (tm *Manager) Compose(c *gin.Context, u *library.User) (string, string, error) {
 	// grab the metadata from the context to pull in provided
 	// cookie duration information
 	m := c.MustGet("metadata").(*types.Metadata)
 
+	// capture the scm provider the user belongs to
+	provider := scm.SelectedFromContext(c)
+
 	// mint token options for refresh token
 	rmto := MintTokenOpts{
 		User:          u,
+		SCMProvider:   provider,
 		TokenType:     constants.UserRefreshTokenType,
 		TokenDuration: tm.UserRefreshTokenDuration,
 	}
@@ -39,6 +45,7 @@ func (tm *Manager) Compose(c *gin.Context, u *library.User) (string, string, err
 	// mint token options for access token
 	amto := MintTokenOpts{
 		User:          u,
+		SCMProvider:   provider,
 		TokenType:     constants.UserAccessTokenType,
 		TokenDuration: tm.UserAccessTokenDuration,
 	}
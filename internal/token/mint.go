@@ -15,11 +15,12 @@ import (
 // Claims struct is an extension of the JWT standard claims. It
 // includes information about the user.
 type Claims struct {
-	BuildID   int64  `json:"build_id"`
-	IsActive  bool   `json:"is_active"`
-	IsAdmin   bool   `json:"is_admin"`
-	Repo      string `json:"repo"`
-	TokenType string `json:"token_type"`
+	BuildID     int64  `json:"build_id"`
+	IsActive    bool   `json:"is_active"`
+	IsAdmin     bool   `json:"is_admin"`
+	Repo        string `json:"repo"`
+	SCMProvider string `json:"scm_provider,omitempty"`
+	TokenType   string `json:"token_type"`
 	jwt.RegisteredClaims
 }
 
@@ -29,6 +30,7 @@ type MintTokenOpts struct {
 	BuildID       int64
 	Hostname      string
 	Repo          string
+	SCMProvider   string
 	TokenDuration time.Duration
 	TokenType     string
 	User          *library.User
@@ -48,6 +50,7 @@ func (tm *Manager) MintToken(mto *MintTokenOpts) (string, error) {
 
 		claims.IsActive = mto.User.GetActive()
 		claims.IsAdmin = mto.User.GetAdmin()
+		claims.SCMProvider = mto.SCMProvider
 		claims.Subject = mto.User.GetName()
 
 	case constants.WorkerBuildTokenType:
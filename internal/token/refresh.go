@@ -21,8 +21,8 @@ func (tm *Manager) Refresh(c *gin.Context, refreshToken string) (string, error)
 		return "", err
 	}
 
-	// look up user in database given claims subject
-	u, err := database.FromContext(c).GetUserForName(ctx, claims.Subject)
+	// look up user in database given claims scm provider and subject
+	u, err := database.FromContext(c).GetUserForProvider(ctx, claims.SCMProvider, claims.Subject)
 	if err != nil {
 		return "", fmt.Errorf("unable to retrieve user %s from database from claims subject: %w", claims.Subject, err)
 	}
@@ -30,6 +30,7 @@ func (tm *Manager) Refresh(c *gin.Context, refreshToken string) (string, error)
 	// options for user access token minting
 	amto := &MintTokenOpts{
 		User:          u,
+		SCMProvider:   claims.SCMProvider,
 		TokenType:     constants.UserAccessTokenType,
 		TokenDuration: tm.UserAccessTokenDuration,
 	}
@@ -32,7 +32,7 @@ var Flags = []cli.Flag{
 		EnvVars:  []string{"VELA_QUEUE_CLUSTER", "QUEUE_CLUSTER"},
 		FilePath: "/vela/queue/cluster",
 		Name:     "queue.cluster",
-		Usage:    "enables connecting to a queue cluster",
+		Usage:    "enables connecting to a queue cluster (not supported with queue lease, priority or fair share)",
 	},
 	&cli.StringSliceFlag{
 		EnvVars:  []string{"VELA_QUEUE_ROUTES", "QUEUE_ROUTES"},
@@ -60,4 +60,79 @@ var Flags = []cli.Flag{
 		Name:     "queue.public-key",
 		Usage:    "set value of base64 encoded queue signing public key",
 	},
+	&cli.StringFlag{
+		EnvVars:  []string{"VELA_QUEUE_KEY_ID", "QUEUE_KEY_ID"},
+		FilePath: "/vela/queue/key_id",
+		Name:     "queue.key-id",
+		Usage:    "set ID of the active queue signing key pair",
+		Value:    "default",
+	},
+	&cli.StringSliceFlag{
+		EnvVars:  []string{"VELA_QUEUE_PUBLIC_KEYS", "QUEUE_PUBLIC_KEYS"},
+		FilePath: "/vela/queue/public_keys",
+		Name:     "queue.public-keys",
+		Usage:    "list of <id>=<base64> public keys, other than the active key, still accepted for opening items (remove a key to retire it)",
+	},
+	&cli.StringFlag{
+		EnvVars:  []string{"VELA_QUEUE_ENCRYPTION_KEY", "QUEUE_ENCRYPTION_KEY"},
+		FilePath: "/vela/queue/encryption.key",
+		Name:     "queue.encryption-key",
+		Usage:    "set value of base64 encoded 32 byte secret key for encrypting items in the queue (disabled when empty)",
+	},
+	&cli.BoolFlag{
+		EnvVars:  []string{"VELA_QUEUE_ENCRYPTION_MIGRATION", "QUEUE_ENCRYPTION_MIGRATION"},
+		FilePath: "/vela/queue/encryption_migration",
+		Name:     "queue.encryption.migration",
+		Usage:    "enables accepting items that are only signed alongside encrypted items while rolling out queue encryption",
+	},
+	&cli.DurationFlag{
+		EnvVars:  []string{"VELA_QUEUE_LEASE_DURATION", "QUEUE_LEASE_DURATION"},
+		FilePath: "/vela/queue/lease_duration",
+		Name:     "queue.lease.duration",
+		Usage:    "lease for items popped off the queue before they are requeued unless acked (disabled when zero)",
+	},
+	&cli.DurationFlag{
+		EnvVars:  []string{"VELA_QUEUE_LEASE_INTERVAL", "QUEUE_LEASE_INTERVAL"},
+		FilePath: "/vela/queue/lease_interval",
+		Name:     "queue.lease.interval",
+		Usage:    "interval for requeueing items popped off the queue with an expired lease and releasing completed builds when sharing workers",
+		Value:    time.Minute,
+	},
+	&cli.BoolFlag{
+		EnvVars:  []string{"VELA_QUEUE_PRIORITY", "QUEUE_PRIORITY"},
+		FilePath: "/vela/queue/priority",
+		Name:     "queue.priority",
+		Usage:    "enables popping items off the queue by priority instead of first in, first out (drain the queue before toggling)",
+	},
+	&cli.StringFlag{
+		EnvVars:  []string{"VELA_QUEUE_FAIR_SHARE", "QUEUE_FAIR_SHARE"},
+		FilePath: "/vela/queue/fair_share",
+		Name:     "queue.fair-share",
+		Usage:    "owner (org or repo) to share workers between fairly when popping items off the queue (disabled when empty, drain the queue before toggling)",
+	},
+	&cli.StringSliceFlag{
+		EnvVars:  []string{"VELA_QUEUE_FAIR_SHARE_WEIGHTS", "QUEUE_FAIR_SHARE_WEIGHTS"},
+		FilePath: "/vela/queue/fair_share_weights",
+		Name:     "queue.fair-share.weights",
+		Usage:    "list of owner=weight pairs for sharing workers fairly (use * for the default weight)",
+	},
+	&cli.StringSliceFlag{
+		EnvVars:  []string{"VELA_QUEUE_FAIR_SHARE_CAPS", "QUEUE_FAIR_SHARE_CAPS"},
+		FilePath: "/vela/queue/fair_share_caps",
+		Name:     "queue.fair-share.caps",
+		Usage:    "list of owner=cap pairs limiting the builds popped off the queue and not complete per owner (use * for the default cap)",
+	},
+	&cli.StringSliceFlag{
+		EnvVars:  []string{"VELA_QUEUE_MAX_TIME", "QUEUE_MAX_TIME"},
+		FilePath: "/vela/queue/max_time",
+		Name:     "queue.max-time",
+		Usage:    "list of route=duration pairs for the max time builds wait in the queue before they error (use * for the default, disabled when empty)",
+	},
+	&cli.DurationFlag{
+		EnvVars:  []string{"VELA_QUEUE_MAX_TIME_INTERVAL", "QUEUE_MAX_TIME_INTERVAL"},
+		FilePath: "/vela/queue/max_time_interval",
+		Name:     "queue.max-time.interval",
+		Usage:    "interval for expiring builds waiting in the queue longer than the max time",
+		Value:    time.Minute,
+	},
 }
@@ -0,0 +1,32 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package queue
+
+import (
+	"context"
+	"fmt"
+)
+
+// Held captures the builds popped off the queue that a worker still holds,
+// either under a lease that was not acked or nacked or counted in-flight
+// until the build is done. These builds are no longer waiting in a route
+// of the queue but must not be treated as missing from the queue.
+func Held(ctx context.Context, s Service) (map[int64]bool, error) {
+	held := make(map[int64]bool)
+
+	leased, err := s.Leased(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list builds leased from queue: %w", err)
+	}
+
+	inflight, err := s.Inflight(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list builds in-flight from queue: %w", err)
+	}
+
+	for _, build := range append(leased, inflight...) {
+		held[build] = true
+	}
+
+	return held, nil
+}
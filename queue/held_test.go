@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package queue
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/go-vela/server/constants"
+	"github.com/go-vela/types/library"
+)
+
+func TestQueue_Held(t *testing.T) {
+	// setup types
+	_setup := &Setup{
+		Driver:     "memory",
+		Routes:     []string{"foo"},
+		PrivateKey: "tCIevHOBq6DdN5SSBtteXUusjjd0fOqzk2eyi0DMq04NewmShNKQeUbbp3vkvIckb4pCxc+vxUo+mYf/vzOaSg==",
+		PublicKey:  "DXsJkoTSkHlG26d75LyHJG+KQsXPr8VKPpmH/78zmko=",
+		Lease:      time.Minute,
+		FairShare:  "org",
+	}
+
+	_queue, err := _setup.Memory()
+	if err != nil {
+		t.Errorf("unable to create queue service: %v", err)
+	}
+
+	_repo := new(library.Repo)
+	_repo.SetOrg("github")
+	_repo.SetFullName("github/octocat")
+
+	for _, id := range []int64{1, 2, 3} {
+		_build := new(library.Build)
+		_build.SetID(id)
+
+		item, err := json.Marshal(ToItem(_build, _repo, new(library.User), constants.PriorityDefault))
+		if err != nil {
+			t.Errorf("unable to marshal queue item: %v", err)
+		}
+
+		err = _queue.Push(context.Background(), "foo", item)
+		if err != nil {
+			t.Errorf("Push returned err: %v", err)
+		}
+	}
+
+	// the first item stays leased
+	_, err = _queue.Pop(context.Background(), nil)
+	if err != nil {
+		t.Errorf("Pop returned err: %v", err)
+	}
+
+	// the second item is acked and stays in-flight
+	item, err := _queue.Pop(context.Background(), nil)
+	if err != nil {
+		t.Errorf("Pop returned err: %v", err)
+	}
+
+	err = _queue.Ack(context.Background(), item)
+	if err != nil {
+		t.Errorf("Ack returned err: %v", err)
+	}
+
+	want := map[int64]bool{1: true, 2: true}
+
+	// run test
+	got, err := Held(context.Background(), _queue)
+	if err != nil {
+		t.Errorf("Held returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Held is %v, want %v", got, want)
+	}
+}
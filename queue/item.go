@@ -0,0 +1,27 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package queue
+
+import (
+	"github.com/go-vela/types"
+	"github.com/go-vela/types/library"
+)
+
+// Item is the queue representation of an item to publish to the
+// queue including the priority the item is popped off the queue.
+//
+// The fields of the types.Item are embedded so workers are able to
+// decode the published item without knowledge of the priority.
+type Item struct {
+	types.Item
+	// Priority is the priority of the item in the queue.
+	Priority int64 `json:"priority"`
+}
+
+// ToItem creates a queue item from a build, repo, user and priority.
+func ToItem(b *library.Build, r *library.Repo, u *library.User, priority int64) *Item {
+	return &Item{
+		Item:     *types.ToItem(b, r, u),
+		Priority: priority,
+	}
+}
@@ -0,0 +1,65 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package queue
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/go-vela/types"
+	"github.com/go-vela/types/library"
+)
+
+func TestQueue_ToItem(t *testing.T) {
+	// setup types
+	b := new(library.Build)
+	b.SetID(1)
+
+	r := new(library.Repo)
+	r.SetFullName("github/octocat")
+
+	u := new(library.User)
+	u.SetName("octocat")
+
+	want := &Item{
+		Item: types.Item{
+			Build:       b,
+			Repo:        r,
+			User:        u,
+			ItemVersion: types.ItemVersion,
+		},
+		Priority: 8,
+	}
+
+	// run test
+	got := ToItem(b, r, u, 8)
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ToItem is %v, want %v", got, want)
+	}
+}
+
+func TestQueue_Item_Decode(t *testing.T) {
+	// setup types
+	b := new(library.Build)
+	b.SetID(1)
+
+	bytes, err := json.Marshal(ToItem(b, new(library.Repo), new(library.User), 8))
+	if err != nil {
+		t.Errorf("unable to marshal queue item: %v", err)
+	}
+
+	// run test
+	got := new(types.Item)
+
+	// workers decode the item without the priority
+	err = json.Unmarshal(bytes, got)
+	if err != nil {
+		t.Errorf("unable to unmarshal queue item: %v", err)
+	}
+
+	if got.Build.GetID() != 1 || got.ItemVersion != types.ItemVersion {
+		t.Errorf("Item is %v, want build 1 with version %d", got, types.ItemVersion)
+	}
+}
@@ -0,0 +1,76 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package queue
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// DefaultMaxTime is the route for the max time
+// applied to routes without their own max time.
+const DefaultMaxTime = "*"
+
+// MaxTime represents the maximum time an item waits in
+// a route of the queue before the build is expired.
+type MaxTime map[string]time.Duration
+
+// ParseMaxTime creates the max times from a list of
+// <route>=<duration> pairs, using * for the default.
+func ParseMaxTime(values []string) (MaxTime, error) {
+	m := MaxTime{}
+
+	for _, value := range values {
+		route, duration, ok := strings.Cut(value, "=")
+
+		route = strings.TrimSpace(route)
+		duration = strings.TrimSpace(duration)
+
+		if !ok || len(route) == 0 || len(duration) == 0 {
+			return nil, fmt.Errorf("invalid queue max time %s provided: must be <route>=<duration>", value)
+		}
+
+		d, err := time.ParseDuration(duration)
+		if err != nil {
+			return nil, fmt.Errorf("invalid queue max time %s provided: %w", value, err)
+		}
+
+		if d < time.Minute {
+			return nil, fmt.Errorf("invalid queue max time %s provided: must be at least 1m", value)
+		}
+
+		m[route] = d
+	}
+
+	return m, nil
+}
+
+// For returns the max time for the route, which is zero
+// when items wait in the route without a max time.
+func (m MaxTime) For(route string) time.Duration {
+	d, ok := m[route]
+	if ok {
+		return d
+	}
+
+	return m[DefaultMaxTime]
+}
+
+// Routes returns the sorted routes with their own max time.
+func (m MaxTime) Routes() []string {
+	routes := []string{}
+
+	for route := range m {
+		if route == DefaultMaxTime {
+			continue
+		}
+
+		routes = append(routes, route)
+	}
+
+	sort.Strings(routes)
+
+	return routes
+}
@@ -0,0 +1,107 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package queue
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestQueue_ParseMaxTime(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name    string
+		values  []string
+		want    MaxTime
+		failure bool
+	}{
+		{
+			name:   "routes",
+			values: []string{"*=2h", " vela:large = 30m "},
+			want:   MaxTime{"*": 2 * time.Hour, "vela:large": 30 * time.Minute},
+		},
+		{
+			name:   "empty",
+			values: nil,
+			want:   MaxTime{},
+		},
+		{
+			name:    "missing duration",
+			values:  []string{"vela"},
+			failure: true,
+		},
+		{
+			name:    "invalid duration",
+			values:  []string{"vela=forever"},
+			failure: true,
+		},
+		{
+			name:    "under a minute",
+			values:  []string{"vela=30s"},
+			failure: true,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := ParseMaxTime(test.values)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("ParseMaxTime should have returned err")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("ParseMaxTime returned err: %v", err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("ParseMaxTime is %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestQueue_MaxTime_For(t *testing.T) {
+	// setup types
+	m := MaxTime{"*": 2 * time.Hour, "vela:large": 30 * time.Minute}
+
+	// setup tests
+	tests := []struct {
+		maxTime MaxTime
+		route   string
+		want    time.Duration
+	}{
+		{maxTime: m, route: "vela:large", want: 30 * time.Minute},
+		{maxTime: m, route: "vela", want: 2 * time.Hour},
+		{maxTime: MaxTime{}, route: "vela", want: 0},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got := test.maxTime.For(test.route)
+
+		if got != test.want {
+			t.Errorf("For %s is %v, want %v", test.route, got, test.want)
+		}
+	}
+}
+
+func TestQueue_MaxTime_Routes(t *testing.T) {
+	// setup types
+	m := MaxTime{"*": 2 * time.Hour, "vela:small": time.Hour, "vela:large": 30 * time.Minute}
+
+	want := []string{"vela:large", "vela:small"}
+
+	// run test
+	got := m.Routes()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Routes is %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,19 @@
+// SPDX-License-Identifier: Apache-2.0
+
+// Package memory provides the ability for Vela to integrate
+// with an in-process queue for single node installations.
+//
+// Items only exist in the memory of the process so they are
+// lost when the process exits and can't be shared between
+// multiple servers.
+//
+// Workers can't reach the memory of the server, so they pop
+// and acknowledge items through the queue API of the server:
+//
+//	POST /api/v1/queue/pop
+//	POST /api/v1/queue/items/:build/{ack,nack,extend}
+//
+// Usage:
+//
+//	import "github.com/go-vela/server/queue/memory"
+package memory
@@ -0,0 +1,49 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package memory
+
+import (
+	"context"
+	"sort"
+)
+
+// Done releases the build popped off the queue so it is no
+// longer counted in-flight for its owner when sharing workers.
+func (c *client) Done(_ context.Context, build int64) error {
+	c.Logger.Tracef("releasing build %d popped from queue", build)
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if _, ok := c.inflight[build]; !ok {
+		return nil
+	}
+
+	delete(c.inflight, build)
+
+	// wake the callers waiting on owners at their cap
+	c.wake()
+
+	return nil
+}
+
+// Inflight outputs the builds popped off the queue that
+// are counted in-flight for their owner until they are done.
+func (c *client) Inflight(_ context.Context) ([]int64, error) {
+	c.Logger.Trace("listing builds in-flight from queue")
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	builds := []int64{}
+
+	for build := range c.inflight {
+		builds = append(builds, build)
+	}
+
+	sort.Slice(builds, func(i, j int) bool {
+		return builds[i] < builds[j]
+	})
+
+	return builds, nil
+}
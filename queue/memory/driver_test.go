@@ -0,0 +1,23 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package memory
+
+import (
+	"testing"
+
+	"github.com/go-vela/server/constants"
+)
+
+func TestMemory_Driver(t *testing.T) {
+	// setup types
+	want := constants.DriverMemory
+
+	_client := testMemory(t)
+
+	// run test
+	got := _client.Driver()
+
+	if got != want {
+		t.Errorf("Driver is %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package memory
+
+import (
+	"sort"
+)
+
+// share is a helper function to select the next entry from the
+// routes with the fair share policy so the owners of the entries
+// take turns popping items off the queue.
+//
+// The mutex must be held by the caller.
+func (c *client) share(routes []string) *entry {
+	// capture the entries in the order they are popped without sharing
+	entries := []*entry{}
+
+	for _, route := range routes {
+		entries = append(entries, c.routes[route]...)
+	}
+
+	if c.config.Priority {
+		sort.SliceStable(entries, func(i, j int) bool {
+			return c.before(entries[i], entries[j])
+		})
+	}
+
+	// capture the first entry for each owner
+	owners := []string{}
+	heads := []*entry{}
+	seen := make(map[string]bool)
+
+	for _, e := range entries {
+		if seen[e.owner] {
+			continue
+		}
+
+		seen[e.owner] = true
+
+		owners = append(owners, e.owner)
+		heads = append(heads, e)
+	}
+
+	// popped builds are in-flight until they are done
+	inflight := make(map[string]int64)
+
+	for _, owner := range c.inflight {
+		inflight[owner]++
+	}
+
+	i := c.config.FairShare.Select(owners, inflight)
+	if i < 0 {
+		return nil
+	}
+
+	return heads[i]
+}
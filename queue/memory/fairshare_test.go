@@ -0,0 +1,140 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package memory
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/go-vela/server/internal/fairshare"
+	"github.com/go-vela/types"
+	"github.com/go-vela/types/library"
+)
+
+// testOwnerItem is a helper function to create the
+// bytes for a queue item for a build of an org.
+func testOwnerItem(t *testing.T, id int64, org string) []byte {
+	t.Helper()
+
+	b := new(library.Build)
+	b.SetID(id)
+
+	r := new(library.Repo)
+	r.SetOrg(org)
+	r.SetFullName(org + "/octocat")
+
+	bytes, err := json.Marshal(&types.Item{Build: b, Repo: r, User: new(library.User)})
+	if err != nil {
+		t.Errorf("unable to marshal queue item: %v", err)
+	}
+
+	return bytes
+}
+
+func TestMemory_FairShare_Pop(t *testing.T) {
+	// setup types
+	_policy := &fairshare.Policy{
+		Mode: fairshare.ModeOrg,
+		Caps: map[string]int64{"github": 2},
+	}
+
+	_client := testMemory(t,
+		WithChannels("vela", "custom"),
+		WithLease(time.Minute),
+		WithTimeout(100*time.Millisecond),
+		WithFairShare(_policy),
+	)
+
+	// one org publishes most of the items before the others
+	pushes := []struct {
+		route string
+		id    int64
+		org   string
+	}{
+		{route: "vela", id: 1, org: "github"},
+		{route: "vela", id: 2, org: "github"},
+		{route: "vela", id: 3, org: "github"},
+		{route: "vela", id: 4, org: "github"},
+		{route: "custom", id: 5, org: "octocat"},
+		{route: "vela", id: 6, org: "vela"},
+		{route: "vela", id: 7, org: "octocat"},
+	}
+
+	for _, push := range pushes {
+		err := _client.Push(context.Background(), push.route, testOwnerItem(t, push.id, push.org))
+		if err != nil {
+			t.Errorf("unable to push item to queue: %v", err)
+		}
+	}
+
+	// the orgs take turns until github is at its cap
+	want := []int64{1, 6, 7, 2, 5}
+
+	popped := []*types.Item{}
+
+	for _, id := range want {
+		got, err := _client.Pop(context.Background(), nil)
+		if err != nil {
+			t.Errorf("Pop returned err: %v", err)
+		}
+
+		if got == nil || got.Build.GetID() != id {
+			t.Errorf("Pop is %v, want item for build %d", got, id)
+
+			continue
+		}
+
+		popped = append(popped, got)
+	}
+
+	// github is at its cap with items waiting
+	got, err := _client.Pop(context.Background(), nil)
+	if err != nil {
+		t.Errorf("Pop returned err: %v", err)
+	}
+
+	if got != nil {
+		t.Errorf("Pop is %v, want nil", got)
+	}
+
+	// acking an item for github does not make room since the build is running
+	err = _client.Ack(context.Background(), popped[0])
+	if err != nil {
+		t.Errorf("Ack returned err: %v", err)
+	}
+
+	got, err = _client.Pop(context.Background(), nil)
+	if err != nil {
+		t.Errorf("Pop returned err: %v", err)
+	}
+
+	if got != nil {
+		t.Errorf("Pop is %v, want nil", got)
+	}
+
+	builds, err := _client.Inflight(context.Background())
+	if err != nil {
+		t.Errorf("Inflight returned err: %v", err)
+	}
+
+	if len(builds) != len(want) {
+		t.Errorf("Inflight is %v, want %d builds", builds, len(want))
+	}
+
+	// the build for github being done makes room for another
+	err = _client.Done(context.Background(), popped[0].Build.GetID())
+	if err != nil {
+		t.Errorf("Done returned err: %v", err)
+	}
+
+	got, err = _client.Pop(context.Background(), nil)
+	if err != nil {
+		t.Errorf("Pop returned err: %v", err)
+	}
+
+	if got == nil || got.Build.GetID() != 3 {
+		t.Errorf("Pop is %v, want item for build %d", got, 3)
+	}
+}
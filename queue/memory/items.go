@@ -0,0 +1,90 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package memory
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-vela/types"
+)
+
+// List outputs the items waiting in the specified channel
+// of the queue in the order they are popped off the queue.
+func (c *client) List(_ context.Context, channel string) ([]*types.Item, error) {
+	c.Logger.Tracef("listing items in queue %s", channel)
+
+	c.mutex.Lock()
+	entries := append([]*entry{}, c.routes[channel]...)
+	c.mutex.Unlock()
+
+	items := []*types.Item{}
+
+	for _, e := range entries {
+		item, err := c.open(e.signed)
+		if err != nil {
+			c.Logger.Warnf("unable to open item in queue %s: %v", channel, err)
+
+			continue
+		}
+
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+// Move moves every item waiting in the specified channel
+// of the queue to another channel and returns the total.
+func (c *client) Move(_ context.Context, from, to string) (int64, error) {
+	c.Logger.Tracef("moving items from queue %s to queue %s", from, to)
+
+	if from == to {
+		return 0, fmt.Errorf("unable to move items from queue %s to itself", from)
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	entries := c.routes[from]
+
+	delete(c.routes, from)
+
+	// entries keep their position relative to the
+	// entries already waiting in the other route
+	for _, e := range entries {
+		e.route = to
+
+		c.insert(e)
+	}
+
+	if len(entries) > 0 {
+		// wake the callers waiting to pop the moved items
+		c.wake()
+	}
+
+	return int64(len(entries)), nil
+}
+
+// Remove removes the item for a build waiting in the specified
+// channel of the queue and returns whether it was removed.
+func (c *client) Remove(_ context.Context, channel string, build int64) (bool, error) {
+	c.Logger.Tracef("removing item for build %d from queue %s", build, channel)
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	route := c.routes[channel]
+
+	for i, e := range route {
+		if e.build != build {
+			continue
+		}
+
+		c.routes[channel] = append(route[:i], route[i+1:]...)
+
+		return true, nil
+	}
+
+	return false, nil
+}
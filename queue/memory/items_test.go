@@ -0,0 +1,131 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package memory
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/go-vela/server/constants"
+	"github.com/go-vela/types"
+)
+
+// testBuildIDs is a helper function to capture
+// the build IDs for a list of queue items.
+func testBuildIDs(items []*types.Item) []int64 {
+	ids := []int64{}
+
+	for _, item := range items {
+		ids = append(ids, item.Build.GetID())
+	}
+
+	return ids
+}
+
+func TestMemory_Items(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name     string
+		priority bool
+		list     []int64
+		moved    []int64
+	}{
+		{
+			name:     "without priority",
+			priority: false,
+			list:     []int64{1, 3, 4},
+			moved:    []int64{1, 2, 4},
+		},
+		{
+			name:     "with priority",
+			priority: true,
+			list:     []int64{4, 1, 3},
+			moved:    []int64{4, 1, 2},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_client := testMemory(t, WithChannels("vela", "custom"), WithPriority(test.priority))
+
+			pushes := []struct {
+				route    string
+				id       int64
+				priority int64
+			}{
+				{route: "vela", id: 1, priority: constants.PriorityDefault},
+				{route: "custom", id: 2, priority: constants.PriorityDefault},
+				{route: "vela", id: 3, priority: constants.PriorityLow},
+				{route: "vela", id: 4, priority: constants.PriorityHigh},
+			}
+
+			for _, push := range pushes {
+				err := _client.Push(context.Background(), push.route, testItem(t, push.id, push.priority))
+				if err != nil {
+					t.Errorf("unable to push item to queue: %v", err)
+				}
+			}
+
+			got, err := _client.List(context.Background(), "vela")
+			if err != nil {
+				t.Errorf("List returned err: %v", err)
+			}
+
+			if !reflect.DeepEqual(testBuildIDs(got), test.list) {
+				t.Errorf("List is %v, want %v", testBuildIDs(got), test.list)
+			}
+
+			removed, err := _client.Remove(context.Background(), "vela", 3)
+			if err != nil {
+				t.Errorf("Remove returned err: %v", err)
+			}
+
+			if !removed {
+				t.Errorf("Remove should have removed the item for build 3")
+			}
+
+			removed, err = _client.Remove(context.Background(), "vela", 3)
+			if err != nil {
+				t.Errorf("Remove returned err: %v", err)
+			}
+
+			if removed {
+				t.Errorf("Remove should not have removed a missing item")
+			}
+
+			_, err = _client.Move(context.Background(), "vela", "vela")
+			if err == nil {
+				t.Errorf("Move to the same route should have returned err")
+			}
+
+			moved, err := _client.Move(context.Background(), "vela", "custom")
+			if err != nil {
+				t.Errorf("Move returned err: %v", err)
+			}
+
+			if moved != 2 {
+				t.Errorf("Move is %d, want %d", moved, 2)
+			}
+
+			got, err = _client.List(context.Background(), "custom")
+			if err != nil {
+				t.Errorf("List returned err: %v", err)
+			}
+
+			if !reflect.DeepEqual(testBuildIDs(got), test.moved) {
+				t.Errorf("List after Move is %v, want %v", testBuildIDs(got), test.moved)
+			}
+
+			got, err = _client.List(context.Background(), "vela")
+			if err != nil {
+				t.Errorf("List returned err: %v", err)
+			}
+
+			if len(got) != 0 {
+				t.Errorf("List after Move is %v, want %v", testBuildIDs(got), []int64{})
+			}
+		})
+	}
+}
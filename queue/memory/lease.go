@@ -0,0 +1,145 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/go-vela/types"
+)
+
+// Ack acknowledges a leased item popped from
+// the queue so it is removed from the queue.
+func (c *client) Ack(_ context.Context, item *types.Item) error {
+	c.Logger.Tracef("acking item for build %d from queue", item.Build.GetID())
+
+	// items are not leased so there is nothing to ack
+	if c.config.Lease == 0 {
+		return nil
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	_, ok := c.leased[item.Build.GetID()]
+	if !ok {
+		return fmt.Errorf("no lease found for item for build %d", item.Build.GetID())
+	}
+
+	delete(c.leased, item.Build.GetID())
+
+	return nil
+}
+
+// Nack rejects a leased item popped from the queue so it
+// is returned to its position in the route it came from.
+func (c *client) Nack(_ context.Context, item *types.Item) error {
+	c.Logger.Tracef("nacking item for build %d from queue", item.Build.GetID())
+
+	// items are not leased so there is nothing to nack
+	if c.config.Lease == 0 {
+		return nil
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	l, ok := c.leased[item.Build.GetID()]
+	if !ok {
+		return fmt.Errorf("no lease found for item for build %d", item.Build.GetID())
+	}
+
+	delete(c.leased, item.Build.GetID())
+
+	// the requeued build is no longer in-flight for its owner
+	delete(c.inflight, item.Build.GetID())
+
+	c.insert(l.entry)
+
+	// wake the callers waiting to pop the released item
+	c.wake()
+
+	return nil
+}
+
+// Extend renews the lease for an item popped from the queue.
+func (c *client) Extend(_ context.Context, item *types.Item) error {
+	c.Logger.Tracef("extending lease of item for build %d from queue", item.Build.GetID())
+
+	// items are not leased so there is nothing to extend
+	if c.config.Lease == 0 {
+		return nil
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	l, ok := c.leased[item.Build.GetID()]
+	if !ok {
+		return fmt.Errorf("lease expired for item for build %d", item.Build.GetID())
+	}
+
+	l.expires = time.Now().Add(c.config.Lease)
+
+	return nil
+}
+
+// Leased outputs the builds popped off the queue
+// with a lease that was not acked or nacked.
+func (c *client) Leased(_ context.Context) ([]int64, error) {
+	c.Logger.Trace("listing builds leased from queue")
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	builds := []int64{}
+
+	for build := range c.leased {
+		builds = append(builds, build)
+	}
+
+	sort.Slice(builds, func(i, j int) bool {
+		return builds[i] < builds[j]
+	})
+
+	return builds, nil
+}
+
+// RequeueExpired returns items with an expired lease to
+// the route they were popped from and returns the total.
+func (c *client) RequeueExpired(_ context.Context) (int64, error) {
+	c.Logger.Trace("requeueing items with expired leases in queue")
+
+	// items are not leased so there is nothing to requeue
+	if c.config.Lease == 0 {
+		return 0, nil
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	total := int64(0)
+	now := time.Now()
+
+	for build, l := range c.leased {
+		if l.expires.After(now) {
+			continue
+		}
+
+		delete(c.leased, build)
+
+		c.insert(l.entry)
+
+		total++
+	}
+
+	if total > 0 {
+		// wake the callers waiting to pop the released items
+		c.wake()
+	}
+
+	return total, nil
+}
@@ -0,0 +1,225 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package memory
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/go-vela/server/constants"
+	"github.com/go-vela/types"
+)
+
+func TestMemory_Ack(t *testing.T) {
+	// setup types
+	_client := testMemory(t, WithLease(time.Minute))
+
+	err := _client.Push(context.Background(), "vela", testItem(t, 1, constants.PriorityDefault))
+	if err != nil {
+		t.Errorf("Push returned err: %v", err)
+	}
+
+	item, err := _client.Pop(context.Background(), nil)
+	if err != nil {
+		t.Errorf("Pop returned err: %v", err)
+	}
+
+	// run test
+	err = _client.Ack(context.Background(), item)
+	if err != nil {
+		t.Errorf("Ack returned err: %v", err)
+	}
+
+	// the item is no longer leased
+	err = _client.Ack(context.Background(), item)
+	if err == nil {
+		t.Errorf("Ack should have returned err")
+	}
+}
+
+func TestMemory_Nack(t *testing.T) {
+	// setup types
+	_client := testMemory(t, WithLease(time.Minute))
+
+	for _, id := range []int64{1, 2} {
+		err := _client.Push(context.Background(), "vela", testItem(t, id, constants.PriorityDefault))
+		if err != nil {
+			t.Errorf("Push returned err: %v", err)
+		}
+	}
+
+	item, err := _client.Pop(context.Background(), nil)
+	if err != nil {
+		t.Errorf("Pop returned err: %v", err)
+	}
+
+	// run test
+	err = _client.Nack(context.Background(), item)
+	if err != nil {
+		t.Errorf("Nack returned err: %v", err)
+	}
+
+	// the requeued item keeps its position
+	got, err := _client.Pop(context.Background(), nil)
+	if err != nil {
+		t.Errorf("Pop returned err: %v", err)
+	}
+
+	if got.Build.GetID() != 1 {
+		t.Errorf("Pop is build %d, want 1", got.Build.GetID())
+	}
+}
+
+func TestMemory_Extend(t *testing.T) {
+	// setup types
+	_client := testMemory(t, WithLease(time.Minute))
+
+	err := _client.Push(context.Background(), "vela", testItem(t, 1, constants.PriorityDefault))
+	if err != nil {
+		t.Errorf("Push returned err: %v", err)
+	}
+
+	item, err := _client.Pop(context.Background(), nil)
+	if err != nil {
+		t.Errorf("Pop returned err: %v", err)
+	}
+
+	before := _client.leased[1].expires
+
+	// run test
+	err = _client.Extend(context.Background(), item)
+	if err != nil {
+		t.Errorf("Extend returned err: %v", err)
+	}
+
+	if !_client.leased[1].expires.After(before) {
+		t.Errorf("Extend did not renew the lease")
+	}
+
+	err = _client.Ack(context.Background(), item)
+	if err != nil {
+		t.Errorf("Ack returned err: %v", err)
+	}
+
+	err = _client.Extend(context.Background(), item)
+	if err == nil {
+		t.Errorf("Extend should have returned err")
+	}
+}
+
+func TestMemory_Leased(t *testing.T) {
+	// setup types
+	_client := testMemory(t, WithLease(time.Minute))
+
+	for _, id := range []int64{1, 2} {
+		err := _client.Push(context.Background(), "vela", testItem(t, id, constants.PriorityDefault))
+		if err != nil {
+			t.Errorf("Push returned err: %v", err)
+		}
+	}
+
+	item, err := _client.Pop(context.Background(), nil)
+	if err != nil {
+		t.Errorf("Pop returned err: %v", err)
+	}
+
+	// run test
+	got, err := _client.Leased(context.Background())
+	if err != nil {
+		t.Errorf("Leased returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, []int64{item.Build.GetID()}) {
+		t.Errorf("Leased is %v, want %v", got, []int64{item.Build.GetID()})
+	}
+
+	err = _client.Nack(context.Background(), item)
+	if err != nil {
+		t.Errorf("Nack returned err: %v", err)
+	}
+
+	got, err = _client.Leased(context.Background())
+	if err != nil {
+		t.Errorf("Leased returned err: %v", err)
+	}
+
+	if len(got) != 0 {
+		t.Errorf("Leased is %v, want empty", got)
+	}
+}
+
+func TestMemory_RequeueExpired(t *testing.T) {
+	// setup types
+	_client := testMemory(t, WithLease(time.Minute))
+
+	err := _client.Push(context.Background(), "vela", testItem(t, 1, constants.PriorityDefault))
+	if err != nil {
+		t.Errorf("Push returned err: %v", err)
+	}
+
+	_, err = _client.Pop(context.Background(), nil)
+	if err != nil {
+		t.Errorf("Pop returned err: %v", err)
+	}
+
+	// lease is not expired
+	got, err := _client.RequeueExpired(context.Background())
+	if err != nil {
+		t.Errorf("RequeueExpired returned err: %v", err)
+	}
+
+	if got != 0 {
+		t.Errorf("RequeueExpired is %d, want 0", got)
+	}
+
+	// expire the lease
+	_client.leased[1].expires = time.Now().Add(-time.Second)
+
+	// run test
+	got, err = _client.RequeueExpired(context.Background())
+	if err != nil {
+		t.Errorf("RequeueExpired returned err: %v", err)
+	}
+
+	if got != 1 {
+		t.Errorf("RequeueExpired is %d, want 1", got)
+	}
+
+	length, err := _client.Length(context.Background())
+	if err != nil {
+		t.Errorf("Length returned err: %v", err)
+	}
+
+	if length != 1 {
+		t.Errorf("Length is %d, want 1", length)
+	}
+}
+
+func TestMemory_NoLease(t *testing.T) {
+	// setup types
+	_client := testMemory(t)
+
+	err := _client.Push(context.Background(), "vela", testItem(t, 1, constants.PriorityDefault))
+	if err != nil {
+		t.Errorf("Push returned err: %v", err)
+	}
+
+	item, err := _client.Pop(context.Background(), nil)
+	if err != nil {
+		t.Errorf("Pop returned err: %v", err)
+	}
+
+	// run test
+	for _, fn := range []func(context.Context, *types.Item) error{_client.Ack, _client.Nack, _client.Extend} {
+		err = fn(context.Background(), item)
+		if err != nil {
+			t.Errorf("returned err without a lease: %v", err)
+		}
+	}
+
+	if len(_client.leased) != 0 {
+		t.Errorf("leased has %d items, want 0", len(_client.leased))
+	}
+}
@@ -0,0 +1,23 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package memory
+
+import (
+	"context"
+)
+
+// Length tallies all items present in the configured channels in the queue.
+func (c *client) Length(_ context.Context) (int64, error) {
+	c.Logger.Tracef("reading length of all configured channels in queue")
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	total := int64(0)
+
+	for _, channel := range c.config.Channels {
+		total += int64(len(c.routes[channel]))
+	}
+
+	return total, nil
+}
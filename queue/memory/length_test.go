@@ -0,0 +1,54 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package memory
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-vela/server/constants"
+)
+
+func TestMemory_Length(t *testing.T) {
+	// setup types
+	_client := testMemory(t, WithChannels("vela", "custom"))
+
+	// setup tests
+	tests := []struct {
+		routes []string
+		want   int64
+	}{
+		{
+			routes: []string{"vela"},
+			want:   1,
+		},
+		{
+			routes: []string{"vela", "custom"},
+			want:   3,
+		},
+		{
+			// items in routes that are not configured are not counted
+			routes: []string{"other"},
+			want:   3,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		for _, route := range test.routes {
+			err := _client.Push(context.Background(), route, testItem(t, 1, constants.PriorityDefault))
+			if err != nil {
+				t.Errorf("Push returned err: %v", err)
+			}
+		}
+
+		got, err := _client.Length(context.Background())
+		if err != nil {
+			t.Errorf("Length returned err: %v", err)
+		}
+
+		if got != test.want {
+			t.Errorf("Length is %d, want %d", got, test.want)
+		}
+	}
+}
@@ -0,0 +1,142 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package memory
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/go-vela/server/internal/fairshare"
+	"github.com/go-vela/server/internal/keyset"
+	"github.com/sirupsen/logrus"
+)
+
+type config struct {
+	// specifies a list of channels for managing builds for the Memory client
+	Channels []string
+	// specifies the timeout to use for the Memory client
+	Timeout time.Duration
+	// key for signing items pushed to the Memory client
+	PrivateKey *[64]byte
+	// key for opening items popped from the Memory client
+	PublicKey *[32]byte
+	// keys, other than the active key, for opening items signed before a key rotation
+	PublicKeys []*keyset.Key
+	// specifies the lease for items popped from the Memory client
+	Lease time.Duration
+	// enables the Memory client to pop items by priority
+	Priority bool
+	// specifies the policy for sharing the workers between owners of items
+	FairShare *fairshare.Policy
+}
+
+type (
+	// entry represents an item stored in a route of the queue.
+	entry struct {
+		route    string
+		signed   []byte
+		build    int64
+		priority int64
+		owner    string
+		sequence uint64
+	}
+
+	// lease represents an item popped from the queue waiting to be acked.
+	lease struct {
+		entry   *entry
+		expires time.Time
+	}
+)
+
+type client struct {
+	config *config
+	// first in, first out list of items for each route
+	routes map[string][]*entry
+	// leased items popped by the client waiting to be acked
+	leased map[int64]*lease
+	// owners of the builds popped by the client that are not done
+	inflight map[int64]string
+	// number of items pushed used to order items
+	sequence uint64
+	// channel closed to wake the callers waiting to pop items
+	pushed chan struct{}
+	mutex  sync.Mutex
+	// https://pkg.go.dev/github.com/sirupsen/logrus#Entry
+	Logger *logrus.Entry
+}
+
+// New returns a Queue implementation that
+// integrates with an in-process queue.
+//
+//nolint:revive // ignore returning unexported client
+func New(opts ...ClientOpt) (*client, error) {
+	// create new Memory client
+	c := new(client)
+
+	// create new fields
+	c.config = new(config)
+	c.routes = make(map[string][]*entry)
+	c.leased = make(map[int64]*lease)
+	c.inflight = make(map[int64]string)
+	c.pushed = make(chan struct{})
+
+	// create new logger for the client
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#StandardLogger
+	logger := logrus.StandardLogger()
+
+	// create new logger for the client
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#NewEntry
+	c.Logger = logrus.NewEntry(logger).WithField("queue", c.Driver())
+
+	// apply all provided configuration options
+	for _, opt := range opts {
+		err := opt(c)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return c, nil
+}
+
+// insert is a helper function to add an entry to its route in order.
+//
+// Entries are ordered by when they were pushed, and by priority first
+// when enabled, so an entry returned to the queue keeps its position.
+//
+// The mutex must be held by the caller.
+func (c *client) insert(e *entry) {
+	route := c.routes[e.route]
+
+	i := sort.Search(len(route), func(i int) bool {
+		return c.before(e, route[i])
+	})
+
+	route = append(route, nil)
+	copy(route[i+1:], route[i:])
+	route[i] = e
+
+	c.routes[e.route] = route
+}
+
+// before is a helper function to determine
+// if an entry is popped before another entry.
+func (c *client) before(a, b *entry) bool {
+	if c.config.Priority && a.priority != b.priority {
+		return a.priority > b.priority
+	}
+
+	return a.sequence < b.sequence
+}
+
+// wake is a helper function to wake every caller waiting
+// to pop items from the queue after an item is added.
+//
+// The mutex must be held by the caller.
+func (c *client) wake() {
+	close(c.pushed)
+	c.pushed = make(chan struct{})
+}
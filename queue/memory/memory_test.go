@@ -0,0 +1,97 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package memory
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/go-vela/types"
+	"github.com/go-vela/types/library"
+)
+
+// setup global variables used for testing.
+var (
+	_signingPrivateKey = "tCIevHOBq6DdN5SSBtteXUusjjd0fOqzk2eyi0DMq04NewmShNKQeUbbp3vkvIckb4pCxc+vxUo+mYf/vzOaSg=="
+	_signingPublicKey  = "DXsJkoTSkHlG26d75LyHJG+KQsXPr8VKPpmH/78zmko="
+)
+
+func TestMemory_New(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure  bool
+		channels []string
+	}{
+		{
+			failure:  false,
+			channels: []string{"vela"},
+		},
+		{
+			failure:  true,
+			channels: []string{},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		_, err := New(
+			WithChannels(test.channels...),
+			WithPrivateKey(_signingPrivateKey),
+			WithPublicKey(_signingPublicKey),
+		)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("New should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("New returned err: %v", err)
+		}
+	}
+}
+
+// testMemory is a helper function to create a Memory queue client for testing.
+func testMemory(t *testing.T, opts ...ClientOpt) *client {
+	t.Helper()
+
+	opts = append([]ClientOpt{
+		WithChannels("vela"),
+		WithPrivateKey(_signingPrivateKey),
+		WithPublicKey(_signingPublicKey),
+	}, opts...)
+
+	_client, err := New(opts...)
+	if err != nil {
+		t.Errorf("unable to create new memory queue client: %v", err)
+	}
+
+	return _client
+}
+
+// testItem is a helper function to create the bytes
+// for a queue item for a build with a priority.
+func testItem(t *testing.T, id int64, priority int64) []byte {
+	t.Helper()
+
+	b := new(library.Build)
+	b.SetID(id)
+
+	item := struct {
+		types.Item
+		Priority int64 `json:"priority"`
+	}{
+		Item:     types.Item{Build: b, Repo: new(library.Repo), User: new(library.User)},
+		Priority: priority,
+	}
+
+	bytes, err := json.Marshal(item)
+	if err != nil {
+		t.Errorf("unable to marshal queue item: %v", err)
+	}
+
+	return bytes
+}
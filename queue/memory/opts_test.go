@@ -0,0 +1,138 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package memory
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/go-vela/server/internal/fairshare"
+	"github.com/go-vela/server/internal/keyset"
+	"github.com/sirupsen/logrus"
+)
+
+func TestMemory_ClientOpt_WithChannels(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure  bool
+		channels []string
+		want     []string
+	}{
+		{
+			failure:  false,
+			channels: []string{"foo", "bar"},
+			want:     []string{"foo", "bar"},
+		},
+		{
+			failure:  true,
+			channels: []string{},
+			want:     []string{},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		_service := &client{config: new(config), Logger: logrus.NewEntry(logrus.StandardLogger())}
+
+		err := WithChannels(test.channels...)(_service)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("WithChannels should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("WithChannels returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(_service.config.Channels, test.want) {
+			t.Errorf("WithChannels is %v, want %v", _service.config.Channels, test.want)
+		}
+	}
+}
+
+func TestMemory_ClientOpt_WithLease(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		lease   time.Duration
+		want    time.Duration
+	}{
+		{
+			failure: false,
+			lease:   time.Minute,
+			want:    time.Minute,
+		},
+		{
+			failure: true,
+			lease:   -time.Minute,
+			want:    0,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		_service := &client{config: new(config), Logger: logrus.NewEntry(logrus.StandardLogger())}
+
+		err := WithLease(test.lease)(_service)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("WithLease should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("WithLease returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(_service.config.Lease, test.want) {
+			t.Errorf("WithLease is %v, want %v", _service.config.Lease, test.want)
+		}
+	}
+}
+
+func TestMemory_ClientOpt_WithPriority(t *testing.T) {
+	// setup types
+	_service := testMemory(t, WithPriority(true))
+
+	// run test
+	if !_service.config.Priority {
+		t.Errorf("WithPriority is %v, want true", _service.config.Priority)
+	}
+}
+
+func TestMemory_ClientOpt_WithFairShare(t *testing.T) {
+	// setup types
+	_policy := &fairshare.Policy{Mode: fairshare.ModeOrg}
+
+	_service := testMemory(t, WithFairShare(_policy))
+
+	// run test
+	if _service.config.FairShare != _policy {
+		t.Errorf("WithFairShare is %v, want %v", _service.config.FairShare, _policy)
+	}
+}
+
+func TestMemory_ClientOpt_WithPublicKeys(t *testing.T) {
+	// setup types
+	_service := testMemory(t, WithPublicKeys("previous=CuS+EQAzofbk3tVFS3bt5f2tIb4YiJJC4nVMFQYQElg="))
+
+	want, _ := keyset.Parse([]string{"previous=CuS+EQAzofbk3tVFS3bt5f2tIb4YiJJC4nVMFQYQElg="})
+
+	// run test
+	if !reflect.DeepEqual(_service.config.PublicKeys, want) {
+		t.Errorf("WithPublicKeys is %v, want %v", _service.config.PublicKeys, want)
+	}
+
+	_, err := New(WithPublicKeys("previous"))
+	if err == nil {
+		t.Errorf("WithPublicKeys should have returned err")
+	}
+}
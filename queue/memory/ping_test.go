@@ -0,0 +1,19 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package memory
+
+import (
+	"context"
+	"testing"
+)
+
+func TestMemory_Ping(t *testing.T) {
+	// setup types
+	_client := testMemory(t)
+
+	// run test
+	err := _client.Ping(context.Background())
+	if err != nil {
+		t.Errorf("Ping returned err: %v", err)
+	}
+}
@@ -0,0 +1,149 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package memory
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/go-vela/server/internal/keyset"
+	"github.com/go-vela/types"
+)
+
+// Pop grabs an item from the specified channel off the queue.
+func (c *client) Pop(ctx context.Context, routes []string) (*types.Item, error) {
+	c.Logger.Tracef("popping item from queue %s", c.config.Channels)
+
+	// define channels to pop from
+	var channels []string
+
+	// if routes were supplied, use those
+	if len(routes) > 0 {
+		channels = routes
+	} else {
+		channels = c.config.Channels
+	}
+
+	// a timeout of zero blocks until an item is popped
+	var deadline <-chan time.Time
+	if c.config.Timeout > 0 {
+		deadline = time.After(c.config.Timeout)
+	}
+
+	for {
+		c.mutex.Lock()
+
+		e := c.next(channels)
+
+		// capture the wake up while holding the
+		// mutex so no pushed items are missed
+		pushed := c.pushed
+
+		c.mutex.Unlock()
+
+		if e != nil {
+			return c.open(e.signed)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-deadline:
+			return nil, nil
+		case <-pushed:
+		}
+	}
+}
+
+// next is a helper function to remove the next entry from the
+// routes and lease it when items popped from the queue are leased.
+//
+// Without priorities the entry at the front of the first non-empty
+// route is removed, otherwise the entry with the highest priority
+// at the front of any of the routes is removed. With a fair share
+// policy the entry is selected by the owners of the entries.
+//
+// The mutex must be held by the caller.
+func (c *client) next(routes []string) *entry {
+	if c.config.FairShare != nil {
+		return c.take(c.share(routes))
+	}
+
+	var next *entry
+
+	for _, route := range routes {
+		if len(c.routes[route]) == 0 {
+			continue
+		}
+
+		head := c.routes[route][0]
+
+		if next == nil || (c.config.Priority && c.before(head, next)) {
+			next = head
+		}
+
+		if !c.config.Priority {
+			break
+		}
+	}
+
+	return c.take(next)
+}
+
+// take is a helper function to remove an entry from its
+// route and lease it when items popped are leased.
+//
+// The mutex must be held by the caller.
+func (c *client) take(e *entry) *entry {
+	if e == nil {
+		return nil
+	}
+
+	route := c.routes[e.route]
+
+	for i := range route {
+		if route[i] == e {
+			c.routes[e.route] = append(route[:i], route[i+1:]...)
+
+			break
+		}
+	}
+
+	// track the build in-flight for the owner until it is done
+	if c.config.FairShare != nil {
+		c.inflight[e.build] = e.owner
+	}
+
+	// track the leased item to ack it later
+	if c.config.Lease > 0 {
+		c.leased[e.build] = &lease{
+			entry:   e,
+			expires: time.Now().Add(c.config.Lease),
+		}
+	}
+
+	return e
+}
+
+// open is a helper function to open a signed
+// item popped from the queue.
+func (c *client) open(signed []byte) (*types.Item, error) {
+	// open the item using the active public key or any
+	// other public key that has not been retired yet
+	opened, ok := keyset.Open(signed, c.config.PublicKey, c.config.PublicKeys)
+	if !ok {
+		return nil, errors.New("unable to open signed item")
+	}
+
+	// unmarshal result into queue item
+	item := new(types.Item)
+
+	err := json.Unmarshal(opened, item)
+	if err != nil {
+		return nil, err
+	}
+
+	return item, nil
+}
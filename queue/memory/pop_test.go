@@ -0,0 +1,208 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package memory
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-vela/server/constants"
+)
+
+func TestMemory_Pop(t *testing.T) {
+	// setup types
+	_client := testMemory(t, WithChannels("vela", "custom"), WithTimeout(10*time.Millisecond))
+
+	pushes := []struct {
+		route string
+		id    int64
+	}{
+		{route: "custom", id: 1},
+		{route: "vela", id: 2},
+		{route: "vela", id: 3},
+	}
+
+	for _, push := range pushes {
+		err := _client.Push(context.Background(), push.route, testItem(t, push.id, constants.PriorityDefault))
+		if err != nil {
+			t.Errorf("Push returned err: %v", err)
+		}
+	}
+
+	// the first non-empty route is popped first in, first out
+	want := []int64{2, 3, 1}
+
+	// run test
+	for _, id := range want {
+		got, err := _client.Pop(context.Background(), nil)
+		if err != nil {
+			t.Errorf("Pop returned err: %v", err)
+		}
+
+		if got.Build.GetID() != id {
+			t.Errorf("Pop is build %d, want %d", got.Build.GetID(), id)
+		}
+	}
+
+	// queue is empty so the pop times out
+	got, err := _client.Pop(context.Background(), nil)
+	if err != nil {
+		t.Errorf("Pop returned err: %v", err)
+	}
+
+	if got != nil {
+		t.Errorf("Pop is %v, want nil", got)
+	}
+}
+
+func TestMemory_Pop_Routes(t *testing.T) {
+	// setup types
+	_client := testMemory(t, WithChannels("vela", "custom"), WithTimeout(10*time.Millisecond))
+
+	err := _client.Push(context.Background(), "vela", testItem(t, 1, constants.PriorityDefault))
+	if err != nil {
+		t.Errorf("Push returned err: %v", err)
+	}
+
+	// run test
+	got, err := _client.Pop(context.Background(), []string{"custom"})
+	if err != nil {
+		t.Errorf("Pop returned err: %v", err)
+	}
+
+	if got != nil {
+		t.Errorf("Pop is %v, want nil", got)
+	}
+}
+
+func TestMemory_Pop_Priority(t *testing.T) {
+	// setup types
+	_client := testMemory(t, WithChannels("vela", "custom"), WithPriority(true))
+
+	pushes := []struct {
+		route    string
+		id       int64
+		priority int64
+	}{
+		{route: "vela", id: 1, priority: constants.PriorityLow},
+		{route: "vela", id: 2, priority: constants.PriorityDefault},
+		{route: "custom", id: 3, priority: constants.PriorityDefault},
+		{route: "custom", id: 4, priority: constants.PriorityHigh},
+	}
+
+	for _, push := range pushes {
+		err := _client.Push(context.Background(), push.route, testItem(t, push.id, push.priority))
+		if err != nil {
+			t.Errorf("Push returned err: %v", err)
+		}
+	}
+
+	// highest priority first, then the oldest across routes
+	want := []int64{4, 2, 3, 1}
+
+	// run test
+	for _, id := range want {
+		got, err := _client.Pop(context.Background(), nil)
+		if err != nil {
+			t.Errorf("Pop returned err: %v", err)
+		}
+
+		if got.Build.GetID() != id {
+			t.Errorf("Pop is build %d, want %d", got.Build.GetID(), id)
+		}
+	}
+}
+
+func TestMemory_Pop_Blocking(t *testing.T) {
+	// setup types
+	_client := testMemory(t, WithTimeout(time.Minute))
+
+	// push an item once the pop is waiting for an item
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+
+		err := _client.Push(context.Background(), "vela", testItem(t, 1, constants.PriorityDefault))
+		if err != nil {
+			t.Errorf("Push returned err: %v", err)
+		}
+	}()
+
+	// run test
+	got, err := _client.Pop(context.Background(), nil)
+	if err != nil {
+		t.Errorf("Pop returned err: %v", err)
+	}
+
+	if got.Build.GetID() != 1 {
+		t.Errorf("Pop is build %d, want 1", got.Build.GetID())
+	}
+}
+
+func TestMemory_Pop_Canceled(t *testing.T) {
+	// setup types
+	_client := testMemory(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	// run test
+	_, err := _client.Pop(ctx, nil)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Pop returned err %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestMemory_Pop_BadItem(t *testing.T) {
+	// setup types
+	_client := testMemory(t)
+
+	// sign items with a different key
+	_other := testMemory(t, WithPrivateKey("bOiFT7Y9e0jpOqaapTa3NzUkAve3VdRvyowgsY/vtlcK5L4RADOh9uTe1UVLdu3l/a0hvhiIkkLidUwVBhASWA=="))
+
+	err := _other.Push(context.Background(), "vela", testItem(t, 1, constants.PriorityDefault))
+	if err != nil {
+		t.Errorf("Push returned err: %v", err)
+	}
+
+	_client.routes = _other.routes
+
+	// run test
+	_, err = _client.Pop(context.Background(), nil)
+	if err == nil {
+		t.Errorf("Pop should have returned err")
+	}
+}
+
+func TestMemory_Pop_PublicKeys(t *testing.T) {
+	// setup types
+	_client := testMemory(t, WithPublicKeys("previous=CuS+EQAzofbk3tVFS3bt5f2tIb4YiJJC4nVMFQYQElg="))
+
+	// sign items with the key used before the rotation
+	_other := testMemory(t, WithPrivateKey("bOiFT7Y9e0jpOqaapTa3NzUkAve3VdRvyowgsY/vtlcK5L4RADOh9uTe1UVLdu3l/a0hvhiIkkLidUwVBhASWA=="))
+
+	err := _other.Push(context.Background(), "vela", testItem(t, 1, constants.PriorityDefault))
+	if err != nil {
+		t.Errorf("Push returned err: %v", err)
+	}
+
+	err = _client.Push(context.Background(), "vela", testItem(t, 2, constants.PriorityDefault))
+	if err != nil {
+		t.Errorf("Push returned err: %v", err)
+	}
+
+	_client.routes["vela"] = append(_other.routes["vela"], _client.routes["vela"]...)
+
+	// run test
+	for _, id := range []int64{1, 2} {
+		got, err := _client.Pop(context.Background(), nil)
+		if err != nil {
+			t.Errorf("Pop returned err: %v", err)
+		}
+
+		if got.Build.GetID() != id {
+			t.Errorf("Pop is build %d, want %d", got.Build.GetID(), id)
+		}
+	}
+}
@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package memory
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/go-vela/server/constants"
+	"golang.org/x/crypto/nacl/sign"
+)
+
+// Push inserts an item to the specified channel in the queue.
+func (c *client) Push(_ context.Context, channel string, item []byte) error {
+	c.Logger.Tracef("pushing item to queue %s", channel)
+
+	// ensure the item to be pushed is valid
+	if item == nil {
+		return errors.New("item is nil")
+	}
+
+	var signed []byte
+
+	var out []byte
+
+	c.Logger.Tracef("signing item for queue %s", channel)
+
+	// sign the item using the private key generated using sign
+	//
+	// https://pkg.go.dev/golang.org/x/crypto@v0.1.0/nacl/sign
+	signed = sign.Sign(out, item, c.config.PrivateKey)
+
+	build, priority := metadata(item)
+
+	// capture the owner of the item to share the workers
+	owner := ""
+	if c.config.FairShare != nil {
+		owner = c.config.FairShare.Owner(item)
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.sequence++
+
+	c.insert(&entry{
+		route:    channel,
+		signed:   signed,
+		build:    build,
+		priority: priority,
+		owner:    owner,
+		sequence: c.sequence,
+	})
+
+	// wake the callers waiting to pop items
+	c.wake()
+
+	return nil
+}
+
+// metadata is a helper function to capture the build ID and
+// priority from an item used to ack and order the item.
+func metadata(item []byte) (int64, int64) {
+	m := struct {
+		Build *struct {
+			ID int64 `json:"id"`
+		} `json:"build"`
+		Priority *int64 `json:"priority"`
+	}{}
+
+	// items without a priority, like items published
+	// before priorities existed, use the default
+	priority := constants.PriorityDefault
+
+	err := json.Unmarshal(item, &m)
+	if err != nil {
+		return 0, priority
+	}
+
+	if m.Priority != nil {
+		priority = *m.Priority
+	}
+
+	// clamp the priority between the min and max
+	if priority < constants.PriorityMin {
+		priority = constants.PriorityMin
+	}
+
+	if priority > constants.PriorityMax {
+		priority = constants.PriorityMax
+	}
+
+	if m.Build == nil {
+		return 0, priority
+	}
+
+	return m.Build.ID, priority
+}
@@ -0,0 +1,101 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package memory
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-vela/server/constants"
+)
+
+func TestMemory_Push(t *testing.T) {
+	// setup types
+	_client := testMemory(t)
+
+	// setup tests
+	tests := []struct {
+		failure bool
+		bytes   []byte
+	}{
+		{
+			failure: false,
+			bytes:   testItem(t, 1, constants.PriorityDefault),
+		},
+		{
+			failure: true,
+			bytes:   nil,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		err := _client.Push(context.Background(), "vela", test.bytes)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("Push should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("Push returned err: %v", err)
+		}
+	}
+
+	if len(_client.routes["vela"]) != 1 {
+		t.Errorf("Push route has %d items, want 1", len(_client.routes["vela"]))
+	}
+}
+
+func TestMemory_metadata(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name     string
+		item     []byte
+		build    int64
+		priority int64
+	}{
+		{
+			name:     "build and priority",
+			item:     []byte(`{"build":{"id":1},"priority":8}`),
+			build:    1,
+			priority: 8,
+		},
+		{
+			name:     "no priority",
+			item:     []byte(`{"build":{"id":2}}`),
+			build:    2,
+			priority: constants.PriorityDefault,
+		},
+		{
+			name:     "priority below min",
+			item:     []byte(`{"build":{"id":3},"priority":-1}`),
+			build:    3,
+			priority: constants.PriorityMin,
+		},
+		{
+			name:     "invalid item",
+			item:     []byte(`foo`),
+			build:    0,
+			priority: constants.PriorityDefault,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			build, priority := metadata(test.item)
+
+			if build != test.build {
+				t.Errorf("metadata build is %d, want %d", build, test.build)
+			}
+
+			if priority != test.priority {
+				t.Errorf("metadata priority is %d, want %d", priority, test.priority)
+			}
+		})
+	}
+}
@@ -0,0 +1,9 @@
+// SPDX-License-Identifier: Apache-2.0
+
+// Package postgres provides the ability for Vela to
+// integrate with a PostgreSQL server as a queue backend.
+//
+// Usage:
+//
+//	import "github.com/go-vela/server/queue/postgres"
+package postgres
@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+)
+
+const (
+	// doneQuery represents a query to delete an item held until the build is done.
+	doneQuery = `
+DELETE FROM queue_items
+WHERE build_id = ? AND lease_expires < 0
+`
+
+	// inflightBuildsQuery represents a query to capture the builds for the items held until the build is done.
+	inflightBuildsQuery = `
+SELECT build_id
+FROM queue_items
+WHERE lease_expires < 0
+ORDER BY build_id
+`
+)
+
+// Done releases the build popped off the queue so it is no
+// longer counted in-flight for its owner when sharing workers.
+func (c *client) Done(ctx context.Context, build int64) error {
+	c.Logger.Tracef("releasing build %d popped from queue", build)
+
+	// builds are only held until they are done when sharing workers
+	if c.config.FairShare == nil {
+		return nil
+	}
+
+	// send query to the database to delete the held item
+	result := c.Postgres.WithContext(ctx).Exec(doneQuery, build)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return nil
+	}
+
+	// wake the callers waiting on owners at their cap
+	return c.notify(ctx, "")
+}
+
+// Inflight outputs the builds popped off the queue that
+// are counted in-flight for their owner until they are done.
+func (c *client) Inflight(ctx context.Context) ([]int64, error) {
+	c.Logger.Trace("listing builds in-flight from queue")
+
+	builds := []int64{}
+
+	// builds are only held until they are done when sharing workers
+	if c.config.FairShare == nil {
+		return builds, nil
+	}
+
+	// send query to the database to capture the held items
+	err := c.Postgres.WithContext(ctx).Raw(inflightBuildsQuery).Scan(&builds).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return builds, nil
+}
@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-vela/server/internal/fairshare"
+	"github.com/go-vela/types"
+	"github.com/go-vela/types/library"
+)
+
+func TestPostgres_FairShare_Done(t *testing.T) {
+	// setup types
+	_build := new(library.Build)
+	_build.SetID(1)
+
+	_item := &types.Item{Build: _build}
+
+	_policy := &fairshare.Policy{Mode: fairshare.ModeOrg}
+
+	_client, _mock := testPostgres(t,
+		WithLease(time.Minute),
+		WithWorker("worker_0"),
+		WithFairShare(_policy),
+	)
+
+	// the acked item is held until the build is done
+	_mock.ExpectExec(testQuery(holdQuery, "$1", "$2")).
+		WithArgs(1, "worker_0").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	_mock.ExpectQuery(inflightBuildsQuery).
+		WillReturnRows(sqlmock.NewRows([]string{"build_id"}).AddRow(1))
+
+	_mock.ExpectExec(testQuery(doneQuery, "$1")).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	_mock.ExpectExec("SELECT pg_notify($1, $2)").
+		WithArgs(notifyChannel, "").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	// the build was already done
+	_mock.ExpectExec(testQuery(doneQuery, "$1")).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	// run test
+	err := _client.Ack(context.Background(), _item)
+	if err != nil {
+		t.Errorf("Ack returned err: %v", err)
+	}
+
+	got, err := _client.Inflight(context.Background())
+	if err != nil {
+		t.Errorf("Inflight returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, []int64{1}) {
+		t.Errorf("Inflight is %v, want %v", got, []int64{1})
+	}
+
+	err = _client.Done(context.Background(), 1)
+	if err != nil {
+		t.Errorf("Done returned err: %v", err)
+	}
+
+	err = _client.Done(context.Background(), 1)
+	if err != nil {
+		t.Errorf("Done returned err: %v", err)
+	}
+
+	err = _mock.ExpectationsWereMet()
+	if err != nil {
+		t.Errorf("Done did not run expected queries: %v", err)
+	}
+}
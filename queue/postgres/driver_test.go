@@ -0,0 +1,23 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"testing"
+
+	"github.com/go-vela/types/constants"
+)
+
+func TestPostgres_Driver(t *testing.T) {
+	// setup types
+	want := constants.DriverPostgres
+
+	_client, _ := testPostgres(t)
+
+	// run test
+	got := _client.Driver()
+
+	if got != want {
+		t.Errorf("Driver is %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,135 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+)
+
+const (
+	// headsQuery represents a query to capture the next item
+	// waiting in the routes for each owner of the items.
+	headsQuery = `
+SELECT DISTINCT ON (owner) id, owner, priority
+FROM queue_items
+WHERE route IN ? AND lease_expires = 0
+ORDER BY owner, %s
+`
+
+	// inflightQuery represents a query to count the items leased by every
+	// worker or held until the build is done for each owner.
+	inflightQuery = `
+SELECT owner, COUNT(*) AS total
+FROM queue_items
+WHERE lease_expires <> 0
+GROUP BY owner
+`
+
+	// popItemQuery represents a query to delete and return an item unless it was already popped.
+	popItemQuery = `
+DELETE FROM queue_items
+WHERE id = ? AND lease_expires = 0
+RETURNING item
+`
+
+	// leaseItemQuery represents a query to lease and return an item unless it was already popped.
+	leaseItemQuery = `
+UPDATE queue_items
+SET leased_by = ?, lease_expires = ?
+WHERE id = ? AND lease_expires = 0
+RETURNING item
+`
+)
+
+// share is a helper function to pop the next item from the routes with
+// the fair share policy so the owners of the items take turns popping
+// items off the queue. The items in-flight for each owner are the items
+// leased by every worker and the items held until the build is done.
+func (c *client) share(ctx context.Context, routes []string) ([]byte, error) {
+	// items are popped oldest first unless popped by priority
+	order := "id"
+	if c.config.Priority {
+		order = "priority DESC, id"
+	}
+
+	for {
+		heads := []*queueItem{}
+
+		// send query to the database to capture the next item for each owner
+		err := c.Postgres.WithContext(ctx).Raw(fmt.Sprintf(headsQuery, order), routes).Scan(&heads).Error
+		if err != nil {
+			return nil, err
+		}
+
+		// no items are in the routes
+		if len(heads) == 0 {
+			return nil, nil
+		}
+
+		counts := []struct {
+			Owner string
+			Total int64
+		}{}
+
+		// send query to the database to count the items in-flight for each owner
+		err = c.Postgres.WithContext(ctx).Raw(inflightQuery).Scan(&counts).Error
+		if err != nil {
+			return nil, err
+		}
+
+		inflight := make(map[string]int64)
+
+		for _, count := range counts {
+			inflight[count.Owner] = count.Total
+		}
+
+		// order the owners by the item that would be popped first
+		sort.SliceStable(heads, func(i, j int) bool {
+			if c.config.Priority && heads[i].Priority != heads[j].Priority {
+				return heads[i].Priority > heads[j].Priority
+			}
+
+			return heads[i].ID < heads[j].ID
+		})
+
+		owners := []string{}
+
+		for _, head := range heads {
+			owners = append(owners, head.Owner)
+		}
+
+		i := c.config.FairShare.Select(owners, inflight)
+		if i < 0 {
+			return nil, nil
+		}
+
+		var (
+			row    = new(queueItem)
+			result = c.Postgres.WithContext(ctx)
+		)
+
+		// check if items popped from the queue are leased
+		if c.config.Lease > 0 {
+			expiration := time.Now().Add(c.config.Lease).Unix()
+
+			// send query to the database to lease the item
+			result = result.Raw(leaseItemQuery, c.config.Worker, expiration, heads[i].ID).Scan(row)
+		} else {
+			// send query to the database to pop the item
+			result = result.Raw(popItemQuery, heads[i].ID).Scan(row)
+		}
+
+		if result.Error != nil {
+			return nil, result.Error
+		}
+
+		// the item was popped by another worker in the
+		// meantime so the next item is selected again
+		if result.RowsAffected > 0 {
+			return row.Item, nil
+		}
+	}
+}
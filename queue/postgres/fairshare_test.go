@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-vela/server/internal/fairshare"
+)
+
+func TestPostgres_FairShare_Pop(t *testing.T) {
+	// setup types
+	_policy := &fairshare.Policy{
+		Mode: fairshare.ModeOrg,
+		Caps: map[string]int64{"github": 2},
+	}
+
+	_client, _mock := testPostgres(t,
+		WithLease(time.Minute),
+		WithWorker("worker_0"),
+		WithTimeout(time.Second),
+		WithFairShare(_policy),
+	)
+
+	_heads := testQuery(fmt.Sprintf(headsQuery, "id"), "($1)")
+
+	// github has the oldest item but octocat has fewer items in-flight
+	_mock.ExpectQuery(_heads).
+		WithArgs("vela").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "owner", "priority"}).
+			AddRow(3, "github", 5).
+			AddRow(4, "octocat", 5))
+
+	_mock.ExpectQuery(inflightQuery).
+		WillReturnRows(sqlmock.NewRows([]string{"owner", "total"}).
+			AddRow("github", 1))
+
+	// the item for octocat was popped by another worker
+	_mock.ExpectQuery(testQuery(leaseItemQuery, "$1", "$2", "$3")).
+		WithArgs("worker_0", AnyArgument{}, 4).
+		WillReturnRows(sqlmock.NewRows([]string{"item"}))
+
+	_mock.ExpectQuery(_heads).
+		WithArgs("vela").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "owner", "priority"}).
+			AddRow(3, "github", 5).
+			AddRow(5, "octocat", 5))
+
+	_mock.ExpectQuery(inflightQuery).
+		WillReturnRows(sqlmock.NewRows([]string{"owner", "total"}).
+			AddRow("github", 1).
+			AddRow("octocat", 1))
+
+	// ties go to the oldest item
+	_mock.ExpectQuery(testQuery(leaseItemQuery, "$1", "$2", "$3")).
+		WithArgs("worker_0", AnyArgument{}, 3).
+		WillReturnRows(sqlmock.NewRows([]string{"item"}).AddRow(testSigned(t, 3)))
+
+	// run test
+	got, err := _client.Pop(context.Background(), []string{"vela"})
+	if err != nil {
+		t.Errorf("Pop returned err: %v", err)
+	}
+
+	if got == nil || got.Build.GetID() != 3 {
+		t.Errorf("Pop is %v, want item for build %d", got, 3)
+	}
+
+	// github is at its cap with the only item waiting
+	_mock.ExpectQuery(_heads).
+		WithArgs("vela").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "owner", "priority"}).
+			AddRow(6, "github", 5))
+
+	_mock.ExpectQuery(inflightQuery).
+		WillReturnRows(sqlmock.NewRows([]string{"owner", "total"}).
+			AddRow("github", 2))
+
+	signed, err := _client.share(context.Background(), []string{"vela"})
+	if err != nil {
+		t.Errorf("share returned err: %v", err)
+	}
+
+	if signed != nil {
+		t.Errorf("share is %v, want nil", signed)
+	}
+
+	err = _mock.ExpectationsWereMet()
+	if err != nil {
+		t.Errorf("Pop did not run expected queries: %v", err)
+	}
+}
@@ -0,0 +1,108 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-vela/types"
+)
+
+const (
+	// listQuery represents a query to capture the items
+	// waiting in a route in the order they are popped.
+	listQuery = `
+SELECT item FROM queue_items
+WHERE route = ? AND lease_expires = 0
+ORDER BY %s
+`
+
+	// moveQuery represents a query to move the items waiting in a route to another route.
+	moveQuery = `
+UPDATE queue_items
+SET route = ?
+WHERE route = ? AND lease_expires = 0
+`
+
+	// removeQuery represents a query to delete the item for a build waiting in a route.
+	removeQuery = `
+DELETE FROM queue_items
+WHERE route = ? AND build_id = ? AND lease_expires = 0
+`
+)
+
+// List outputs the items waiting in the specified channel
+// of the queue in the order they are popped off the queue.
+func (c *client) List(ctx context.Context, channel string) ([]*types.Item, error) {
+	c.Logger.Tracef("listing items in queue %s", channel)
+
+	// items are popped oldest first unless popped by priority
+	order := "id"
+	if c.config.Priority {
+		order = "priority DESC, id"
+	}
+
+	rows := []*queueItem{}
+
+	// send query to the database to capture the items waiting in the route
+	err := c.Postgres.WithContext(ctx).Raw(fmt.Sprintf(listQuery, order), channel).Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	items := []*types.Item{}
+
+	for _, row := range rows {
+		item, err := c.open(row.Item)
+		if err != nil {
+			c.Logger.Warnf("unable to open item in queue %s: %v", channel, err)
+
+			continue
+		}
+
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+// Move moves every item waiting in the specified channel
+// of the queue to another channel and returns the total.
+func (c *client) Move(ctx context.Context, from, to string) (int64, error) {
+	c.Logger.Tracef("moving items from queue %s to queue %s", from, to)
+
+	if from == to {
+		return 0, fmt.Errorf("unable to move items from queue %s to itself", from)
+	}
+
+	// send query to the database to move the items waiting in the route
+	result := c.Postgres.WithContext(ctx).Exec(moveQuery, to, from)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	if result.RowsAffected > 0 {
+		// wake the callers waiting to pop the moved items
+		err := c.notify(ctx, to)
+		if err != nil {
+			return result.RowsAffected, err
+		}
+	}
+
+	return result.RowsAffected, nil
+}
+
+// Remove removes the item for a build waiting in the specified
+// channel of the queue and returns whether it was removed.
+func (c *client) Remove(ctx context.Context, channel string, build int64) (bool, error) {
+	c.Logger.Tracef("removing item for build %d from queue %s", build, channel)
+
+	// send query to the database to delete the item waiting in the route
+	result := c.Postgres.WithContext(ctx).Exec(removeQuery, channel, build)
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
@@ -0,0 +1,134 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestPostgres_List(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name     string
+		priority bool
+		order    string
+	}{
+		{
+			name:     "without priority",
+			priority: false,
+			order:    "id",
+		},
+		{
+			name:     "with priority",
+			priority: true,
+			order:    "priority DESC, id",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_client, _mock := testPostgres(t, WithPriority(test.priority))
+
+			// ensure the mock expects the list query
+			_mock.ExpectQuery(testQuery(fmt.Sprintf(listQuery, test.order), "$1")).
+				WithArgs("vela").
+				WillReturnRows(sqlmock.NewRows([]string{"item"}).
+					AddRow(testSigned(t, 2)).
+					AddRow([]byte("invalid")).
+					AddRow(testSigned(t, 1)))
+
+			got, err := _client.List(context.Background(), "vela")
+			if err != nil {
+				t.Errorf("List returned err: %v", err)
+			}
+
+			// items that are unable to be opened are skipped
+			if len(got) != 2 || got[0].Build.GetID() != 2 || got[1].Build.GetID() != 1 {
+				t.Errorf("List is %v, want items for builds 2 and 1", got)
+			}
+
+			err = _mock.ExpectationsWereMet()
+			if err != nil {
+				t.Errorf("List did not run expected queries: %v", err)
+			}
+		})
+	}
+}
+
+func TestPostgres_Move(t *testing.T) {
+	// setup types
+	_client, _mock := testPostgres(t)
+
+	// ensure the mock expects the move queries
+	_mock.ExpectExec(testQuery(moveQuery, "$1", "$2")).
+		WithArgs("custom", "vela").
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	_mock.ExpectExec(`SELECT pg_notify($1, $2)`).
+		WithArgs(notifyChannel, "custom").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	// run test
+	got, err := _client.Move(context.Background(), "vela", "custom")
+	if err != nil {
+		t.Errorf("Move returned err: %v", err)
+	}
+
+	if got != 2 {
+		t.Errorf("Move is %d, want 2", got)
+	}
+
+	_, err = _client.Move(context.Background(), "vela", "vela")
+	if err == nil {
+		t.Errorf("Move to the same route should have returned err")
+	}
+
+	err = _mock.ExpectationsWereMet()
+	if err != nil {
+		t.Errorf("Move did not run expected queries: %v", err)
+	}
+}
+
+func TestPostgres_Remove(t *testing.T) {
+	// setup types
+	_client, _mock := testPostgres(t)
+
+	// ensure the mock expects the remove queries
+	_mock.ExpectExec(testQuery(removeQuery, "$1", "$2")).
+		WithArgs("vela", 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	_mock.ExpectExec(testQuery(removeQuery, "$1", "$2")).
+		WithArgs("vela", 1).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	// run test
+	removed, err := _client.Remove(context.Background(), "vela", 1)
+	if err != nil {
+		t.Errorf("Remove returned err: %v", err)
+	}
+
+	if !removed {
+		t.Errorf("Remove should have removed the item for build 1")
+	}
+
+	// the item is no longer in the route
+	removed, err = _client.Remove(context.Background(), "vela", 1)
+	if err != nil {
+		t.Errorf("Remove returned err: %v", err)
+	}
+
+	if removed {
+		t.Errorf("Remove should not have removed a missing item")
+	}
+
+	err = _mock.ExpectationsWereMet()
+	if err != nil {
+		t.Errorf("Remove did not run expected queries: %v", err)
+	}
+}
@@ -0,0 +1,183 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-vela/types"
+)
+
+const (
+	// ackQuery represents a query to delete an item leased by the worker.
+	ackQuery = `
+DELETE FROM queue_items
+WHERE build_id = ? AND leased_by = ? AND lease_expires > 0
+`
+
+	// nackQuery represents a query to release an item leased by the worker.
+	nackQuery = `
+UPDATE queue_items
+SET leased_by = '', lease_expires = 0
+WHERE build_id = ? AND leased_by = ? AND lease_expires > 0
+`
+
+	// extendQuery represents a query to update the expiration of an item leased by the worker.
+	extendQuery = `
+UPDATE queue_items
+SET lease_expires = ?
+WHERE build_id = ? AND leased_by = ? AND lease_expires > 0
+`
+
+	// holdQuery represents a query to keep an item leased by the worker
+	// until the build is done so it is counted in-flight for its owner.
+	holdQuery = `
+UPDATE queue_items
+SET lease_expires = -1
+WHERE build_id = ? AND leased_by = ? AND lease_expires > 0
+`
+
+	// leasedQuery represents a query to capture the builds for the items leased by every worker.
+	leasedQuery = `
+SELECT build_id
+FROM queue_items
+WHERE lease_expires > 0
+ORDER BY build_id
+`
+
+	// requeueQuery represents a query to release every item with an expired lease.
+	requeueQuery = `
+UPDATE queue_items
+SET leased_by = '', lease_expires = 0
+WHERE lease_expires > 0 AND lease_expires <= ?
+`
+)
+
+// Ack acknowledges a leased item popped from
+// the queue so it is removed from the queue.
+//
+// When sharing workers the item is kept without a lease
+// until the build is done so it is counted in-flight.
+func (c *client) Ack(ctx context.Context, item *types.Item) error {
+	c.Logger.Tracef("acking item for build %d from queue", item.Build.GetID())
+
+	// items are not leased so there is nothing to ack
+	if c.config.Lease == 0 {
+		return nil
+	}
+
+	query := ackQuery
+	if c.config.FairShare != nil {
+		query = holdQuery
+	}
+
+	// send query to the database to delete or hold the leased item
+	result := c.Postgres.WithContext(ctx).Exec(query, item.Build.GetID(), c.config.Worker)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("no lease found for item for build %d", item.Build.GetID())
+	}
+
+	return nil
+}
+
+// Nack rejects a leased item popped from the queue so it
+// is returned to its position in the route it came from.
+func (c *client) Nack(ctx context.Context, item *types.Item) error {
+	c.Logger.Tracef("nacking item for build %d from queue", item.Build.GetID())
+
+	// items are not leased so there is nothing to nack
+	if c.config.Lease == 0 {
+		return nil
+	}
+
+	// send query to the database to release the leased item
+	result := c.Postgres.WithContext(ctx).Exec(nackQuery, item.Build.GetID(), c.config.Worker)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("no lease found for item for build %d", item.Build.GetID())
+	}
+
+	// wake the callers waiting to pop the released item
+	return c.notify(ctx, "")
+}
+
+// Extend renews the lease for an item popped from the queue.
+func (c *client) Extend(ctx context.Context, item *types.Item) error {
+	c.Logger.Tracef("extending lease of item for build %d from queue", item.Build.GetID())
+
+	// items are not leased so there is nothing to extend
+	if c.config.Lease == 0 {
+		return nil
+	}
+
+	expiration := time.Now().Add(c.config.Lease).Unix()
+
+	// send query to the database to extend the lease for the item
+	result := c.Postgres.WithContext(ctx).Exec(extendQuery, expiration, item.Build.GetID(), c.config.Worker)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("lease expired for item for build %d", item.Build.GetID())
+	}
+
+	return nil
+}
+
+// Leased outputs the builds popped off the queue
+// with a lease that was not acked or nacked.
+func (c *client) Leased(ctx context.Context) ([]int64, error) {
+	c.Logger.Trace("listing builds leased from queue")
+
+	builds := []int64{}
+
+	// items are not leased so there is nothing to list
+	if c.config.Lease == 0 {
+		return builds, nil
+	}
+
+	// send query to the database to capture the leased items
+	err := c.Postgres.WithContext(ctx).Raw(leasedQuery).Scan(&builds).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return builds, nil
+}
+
+// RequeueExpired returns items with an expired lease to
+// the route they were popped from and returns the total.
+func (c *client) RequeueExpired(ctx context.Context) (int64, error) {
+	c.Logger.Trace("requeueing items with expired leases in queue")
+
+	// items are not leased so there is nothing to requeue
+	if c.config.Lease == 0 {
+		return 0, nil
+	}
+
+	// send query to the database to release the items with an expired lease
+	result := c.Postgres.WithContext(ctx).Exec(requeueQuery, time.Now().Unix())
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	if result.RowsAffected > 0 {
+		// wake the callers waiting to pop the released items
+		err := c.notify(ctx, "")
+		if err != nil {
+			return result.RowsAffected, err
+		}
+	}
+
+	return result.RowsAffected, nil
+}
@@ -0,0 +1,219 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-vela/types"
+	"github.com/go-vela/types/library"
+)
+
+func TestPostgres_Ack(t *testing.T) {
+	// setup types
+	_build := new(library.Build)
+	_build.SetID(1)
+
+	_item := &types.Item{Build: _build}
+
+	_client, _mock := testPostgres(t, WithLease(time.Minute), WithWorker("worker_0"))
+
+	// ensure the mock expects the ack queries
+	_mock.ExpectExec(testQuery(ackQuery, "$1", "$2")).
+		WithArgs(1, "worker_0").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	_mock.ExpectExec(testQuery(ackQuery, "$1", "$2")).
+		WithArgs(1, "worker_0").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	// run test
+	err := _client.Ack(context.Background(), _item)
+	if err != nil {
+		t.Errorf("Ack returned err: %v", err)
+	}
+
+	// the item is no longer leased
+	err = _client.Ack(context.Background(), _item)
+	if err == nil {
+		t.Errorf("Ack should have returned err")
+	}
+
+	err = _mock.ExpectationsWereMet()
+	if err != nil {
+		t.Errorf("Ack did not run expected queries: %v", err)
+	}
+}
+
+func TestPostgres_Nack(t *testing.T) {
+	// setup types
+	_build := new(library.Build)
+	_build.SetID(1)
+
+	_item := &types.Item{Build: _build}
+
+	_client, _mock := testPostgres(t, WithLease(time.Minute), WithWorker("worker_0"))
+
+	// ensure the mock expects the nack queries
+	_mock.ExpectExec(testQuery(nackQuery, "$1", "$2")).
+		WithArgs(1, "worker_0").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	_mock.ExpectExec(`SELECT pg_notify($1, $2)`).
+		WithArgs(notifyChannel, "").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	// run test
+	err := _client.Nack(context.Background(), _item)
+	if err != nil {
+		t.Errorf("Nack returned err: %v", err)
+	}
+
+	err = _mock.ExpectationsWereMet()
+	if err != nil {
+		t.Errorf("Nack did not run expected queries: %v", err)
+	}
+}
+
+func TestPostgres_Extend(t *testing.T) {
+	// setup types
+	_build := new(library.Build)
+	_build.SetID(1)
+
+	_item := &types.Item{Build: _build}
+
+	_client, _mock := testPostgres(t, WithLease(time.Minute), WithWorker("worker_0"))
+
+	// ensure the mock expects the extend queries
+	_mock.ExpectExec(testQuery(extendQuery, "$1", "$2", "$3")).
+		WithArgs(AnyArgument{}, 1, "worker_0").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	_mock.ExpectExec(testQuery(extendQuery, "$1", "$2", "$3")).
+		WithArgs(AnyArgument{}, 1, "worker_0").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	// run test
+	err := _client.Extend(context.Background(), _item)
+	if err != nil {
+		t.Errorf("Extend returned err: %v", err)
+	}
+
+	// the lease expired and the item was requeued
+	err = _client.Extend(context.Background(), _item)
+	if err == nil {
+		t.Errorf("Extend should have returned err")
+	}
+
+	err = _mock.ExpectationsWereMet()
+	if err != nil {
+		t.Errorf("Extend did not run expected queries: %v", err)
+	}
+}
+
+func TestPostgres_Leased(t *testing.T) {
+	// setup types
+	_client, _mock := testPostgres(t, WithLease(time.Minute), WithWorker("worker_0"))
+
+	// ensure the mock expects the leased query
+	_mock.ExpectQuery(leasedQuery).
+		WillReturnRows(sqlmock.NewRows([]string{"build_id"}).AddRow(1).AddRow(2))
+
+	// run test
+	got, err := _client.Leased(context.Background())
+	if err != nil {
+		t.Errorf("Leased returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, []int64{1, 2}) {
+		t.Errorf("Leased is %v, want %v", got, []int64{1, 2})
+	}
+
+	err = _mock.ExpectationsWereMet()
+	if err != nil {
+		t.Errorf("Leased did not run expected queries: %v", err)
+	}
+}
+
+func TestPostgres_RequeueExpired(t *testing.T) {
+	// setup types
+	_client, _mock := testPostgres(t, WithLease(time.Minute), WithWorker("worker_0"))
+
+	// ensure the mock expects the requeue queries
+	_mock.ExpectExec(testQuery(requeueQuery, "$1")).
+		WithArgs(AnyArgument{}).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	_mock.ExpectExec(`SELECT pg_notify($1, $2)`).
+		WithArgs(notifyChannel, "").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	// run test
+	got, err := _client.RequeueExpired(context.Background())
+	if err != nil {
+		t.Errorf("RequeueExpired returned err: %v", err)
+	}
+
+	if got != 2 {
+		t.Errorf("RequeueExpired is %d, want 2", got)
+	}
+
+	err = _mock.ExpectationsWereMet()
+	if err != nil {
+		t.Errorf("RequeueExpired did not run expected queries: %v", err)
+	}
+}
+
+func TestPostgres_NoLease(t *testing.T) {
+	// setup types
+	_build := new(library.Build)
+	_build.SetID(1)
+
+	_item := &types.Item{Build: _build}
+
+	_client, _mock := testPostgres(t)
+
+	// run test
+	err := _client.Ack(context.Background(), _item)
+	if err != nil {
+		t.Errorf("Ack returned err: %v", err)
+	}
+
+	err = _client.Nack(context.Background(), _item)
+	if err != nil {
+		t.Errorf("Nack returned err: %v", err)
+	}
+
+	err = _client.Extend(context.Background(), _item)
+	if err != nil {
+		t.Errorf("Extend returned err: %v", err)
+	}
+
+	got, err := _client.RequeueExpired(context.Background())
+	if err != nil {
+		t.Errorf("RequeueExpired returned err: %v", err)
+	}
+
+	if got != 0 {
+		t.Errorf("RequeueExpired is %d, want 0", got)
+	}
+
+	leased, err := _client.Leased(context.Background())
+	if err != nil {
+		t.Errorf("Leased returned err: %v", err)
+	}
+
+	if len(leased) != 0 {
+		t.Errorf("Leased is %v, want empty", leased)
+	}
+
+	// items without a lease do not send queries
+	err = _mock.ExpectationsWereMet()
+	if err != nil {
+		t.Errorf("queries were run without a lease: %v", err)
+	}
+}
@@ -0,0 +1,25 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+)
+
+// Length tallies all items present in the configured channels in the queue.
+func (c *client) Length(ctx context.Context) (int64, error) {
+	c.Logger.Tracef("reading length of all configured channels in queue")
+
+	var total int64
+
+	// send query to the database to count the items that are not leased
+	err := c.Postgres.
+		WithContext(ctx).
+		Model(&queueItem{}).
+		Where("route IN ?", c.config.Channels).
+		Where("lease_expires = 0").
+		Count(&total).
+		Error
+
+	return total, err
+}
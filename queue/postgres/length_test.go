@@ -0,0 +1,30 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestPostgres_Length(t *testing.T) {
+	// setup types
+	_client, _mock := testPostgres(t, WithChannels("vela", "custom"))
+
+	// ensure the mock expects the count query
+	_mock.ExpectQuery(`SELECT count(*) FROM "queue_items" WHERE route IN ($1,$2) AND lease_expires = 0`).
+		WithArgs("vela", "custom").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+
+	// run test
+	got, err := _client.Length(context.Background())
+	if err != nil {
+		t.Errorf("Length returned err: %v", err)
+	}
+
+	if got != 3 {
+		t.Errorf("Length is %d, want 3", got)
+	}
+}
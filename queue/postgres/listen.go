@@ -0,0 +1,97 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	// notifyChannel is the Postgres channel notified when items are pushed to the queue.
+	notifyChannel = "vela_queue"
+
+	// pollInterval is the interval for checking the queue while waiting to pop
+	// an item in case a notification is missed, like when reconnecting.
+	pollInterval = 5 * time.Second
+)
+
+// notify is a helper function to notify the callers waiting to pop
+// items from the queue that an item was pushed to the route.
+//
+// https://www.postgresql.org/docs/current/sql-notify.html
+func (c *client) notify(ctx context.Context, channel string) error {
+	return c.Postgres.WithContext(ctx).Exec("SELECT pg_notify(?, ?)", notifyChannel, channel).Error
+}
+
+// listen is a helper function to wake the callers waiting to pop items
+// when the queue is notified until the context is canceled.
+//
+// A dedicated connection is used since notifications are only
+// delivered to the connection that is listening to the channel.
+func (c *client) listen(ctx context.Context) {
+	for {
+		err := c.receive(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+
+		c.Logger.Debugf("unable to listen for Postgres queue notifications: %v. Retrying in %v", err, time.Second)
+
+		// wake the waiting callers in case a notification was missed
+		c.wake()
+
+		time.Sleep(time.Second)
+	}
+}
+
+// receive is a helper function to listen to the notify channel
+// and wake the waiting callers for every notification received.
+func (c *client) receive(ctx context.Context) error {
+	// create a dedicated connection to listen for notifications
+	//
+	// https://pkg.go.dev/github.com/jackc/pgx/v5#Connect
+	conn, err := pgx.Connect(ctx, c.config.Address)
+	if err != nil {
+		return err
+	}
+
+	//nolint:errcheck // ignore checking error
+	defer conn.Close(context.Background())
+
+	_, err = conn.Exec(ctx, "LISTEN "+notifyChannel)
+	if err != nil {
+		return err
+	}
+
+	for {
+		// https://pkg.go.dev/github.com/jackc/pgx/v5#Conn.WaitForNotification
+		_, err = conn.WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+
+		c.wake()
+	}
+}
+
+// wait is a helper function to capture the channel
+// closed when the next item is pushed to the queue.
+func (c *client) wait() <-chan struct{} {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return c.pushed
+}
+
+// wake is a helper function to wake every
+// caller waiting to pop items from the queue.
+func (c *client) wake() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	close(c.pushed)
+	c.pushed = make(chan struct{})
+}
@@ -0,0 +1,238 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/go-vela/server/internal/fairshare"
+	"github.com/go-vela/server/internal/keyset"
+	"gorm.io/gorm"
+)
+
+// ClientOpt represents a configuration option to initialize the queue client for Postgres.
+type ClientOpt func(*client) error
+
+// WithAddress sets the address in the queue client for Postgres.
+func WithAddress(address string) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring address in postgres queue client")
+
+		// check if the address provided is empty
+		if len(address) == 0 {
+			return fmt.Errorf("no Postgres queue address provided")
+		}
+
+		// set the queue address in the postgres client
+		c.config.Address = address
+
+		return nil
+	}
+}
+
+// WithClient sets the database client in the queue client for Postgres.
+func WithClient(db *gorm.DB) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring database client in postgres queue client")
+
+		// set the database client in the postgres client
+		c.Postgres = db
+
+		return nil
+	}
+}
+
+// WithChannels sets the channels in the queue client for Postgres.
+func WithChannels(channels ...string) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring channels in postgres queue client")
+
+		// check if the channels provided are empty
+		if len(channels) == 0 {
+			return fmt.Errorf("no Postgres queue channels provided")
+		}
+
+		// set the queue channels in the postgres client
+		c.config.Channels = channels
+
+		return nil
+	}
+}
+
+// WithTimeout sets the timeout in the queue client for Postgres.
+func WithTimeout(timeout time.Duration) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring timeout in postgres queue client")
+
+		// set the queue timeout in the postgres client
+		c.config.Timeout = timeout
+
+		return nil
+	}
+}
+
+// WithLease sets the lease for popped items in the queue client for Postgres.
+func WithLease(lease time.Duration) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring lease in postgres queue client")
+
+		// check if the lease provided is negative
+		if lease < 0 {
+			return fmt.Errorf("invalid Postgres queue lease provided: %s", lease)
+		}
+
+		// set the queue lease in the postgres client
+		c.config.Lease = lease
+
+		return nil
+	}
+}
+
+// WithWorker sets the worker name for leased items in the queue client for Postgres.
+func WithWorker(worker string) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring worker in postgres queue client")
+
+		// use the hostname when no worker name is provided
+		if len(worker) == 0 {
+			hostname, err := os.Hostname()
+			if err != nil {
+				return fmt.Errorf("unable to capture hostname for Postgres queue worker: %w", err)
+			}
+
+			worker = hostname
+		}
+
+		// set the queue worker in the postgres client
+		c.config.Worker = worker
+
+		return nil
+	}
+}
+
+// WithPriority sets the priority mode in the queue client for Postgres.
+func WithPriority(priority bool) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring priority mode in postgres queue client")
+
+		// set the queue priority mode in the postgres client
+		c.config.Priority = priority
+
+		return nil
+	}
+}
+
+// WithFairShare sets the fair share policy in the queue client for Postgres.
+func WithFairShare(policy *fairshare.Policy) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring fair share policy in postgres queue client")
+
+		// set the queue fair share policy in the postgres client
+		c.config.FairShare = policy
+
+		return nil
+	}
+}
+
+// WithPrivateKey sets the private key in the queue client for Postgres.
+//
+//nolint:dupl // ignore similar code
+func WithPrivateKey(key string) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring private key in postgres queue client")
+
+		if len(key) == 0 {
+			c.Logger.Warn("unable to base64 decode private key, provided key is empty. queue service will be unable to sign items")
+			return nil
+		}
+
+		decoded, err := base64.StdEncoding.DecodeString(key)
+		if err != nil {
+			return err
+		}
+
+		if len(decoded) == 0 {
+			return errors.New("unable to base64 decode private key, decoded key is empty")
+		}
+
+		c.config.PrivateKey = new([64]byte)
+		copy(c.config.PrivateKey[:], decoded)
+
+		if len(*c.config.PrivateKey) != 64 {
+			return errors.New("no valid queue signing private key provided")
+		}
+
+		if c.config.PrivateKey == nil {
+			return errors.New("unable to copy decoded queue signing private key, copied key is nil")
+		}
+
+		if len(c.config.PrivateKey) == 0 {
+			return errors.New("unable to copy decoded queue signing private key, copied key is empty")
+		}
+
+		return nil
+	}
+}
+
+// WithPublicKey sets the public key in the queue client for Postgres.
+//
+//nolint:dupl // ignore similar code
+func WithPublicKey(key string) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Tracef("configuring public key in postgres queue client")
+
+		if len(key) == 0 {
+			c.Logger.Warn("unable to base64 decode public key, provided key is empty. queue service will be unable to open items")
+			return nil
+		}
+
+		decoded, err := base64.StdEncoding.DecodeString(key)
+		if err != nil {
+			return err
+		}
+
+		if len(decoded) == 0 {
+			return errors.New("unable to base64 decode public key, decoded key is empty")
+		}
+
+		c.config.PublicKey = new([32]byte)
+		copy(c.config.PublicKey[:], decoded)
+
+		if len(*c.config.PublicKey) != 32 {
+			return errors.New("no valid queue public key provided")
+		}
+
+		if c.config.PublicKey == nil {
+			return errors.New("unable to copy decoded queue public key, copied key is nil")
+		}
+
+		if len(c.config.PublicKey) == 0 {
+			return errors.New("unable to copy decoded queue signing public key, copied key is empty")
+		}
+
+		return nil
+	}
+}
+
+// WithPublicKeys sets the keys, other than the active public key,
+// for opening items in the queue client for Postgres.
+func WithPublicKeys(keys ...string) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring public keys in postgres queue client")
+
+		// parse the provided keys as <id>=<base64 public key>
+		publicKeys, err := keyset.Parse(keys)
+		if err != nil {
+			return err
+		}
+
+		// set the queue public keys in the postgres client
+		c.config.PublicKeys = publicKeys
+
+		return nil
+	}
+}
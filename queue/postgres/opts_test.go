@@ -0,0 +1,136 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/go-vela/server/internal/fairshare"
+	"github.com/go-vela/server/internal/keyset"
+)
+
+func TestPostgres_ClientOpt_WithChannels(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure  bool
+		channels []string
+		want     []string
+	}{
+		{
+			failure:  false,
+			channels: []string{"foo", "bar"},
+			want:     []string{"foo", "bar"},
+		},
+		{
+			failure:  true,
+			channels: []string{},
+			want:     []string{},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		_service := &client{config: new(config), Logger: testPostgresLogger()}
+
+		err := WithChannels(test.channels...)(_service)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("WithChannels should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("WithChannels returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(_service.config.Channels, test.want) {
+			t.Errorf("WithChannels is %v, want %v", _service.config.Channels, test.want)
+		}
+	}
+}
+
+func TestPostgres_ClientOpt_WithLease(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		lease   time.Duration
+		want    time.Duration
+	}{
+		{
+			failure: false,
+			lease:   time.Minute,
+			want:    time.Minute,
+		},
+		{
+			failure: true,
+			lease:   -time.Minute,
+			want:    0,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		_service := &client{config: new(config), Logger: testPostgresLogger()}
+
+		err := WithLease(test.lease)(_service)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("WithLease should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("WithLease returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(_service.config.Lease, test.want) {
+			t.Errorf("WithLease is %v, want %v", _service.config.Lease, test.want)
+		}
+	}
+}
+
+func TestPostgres_ClientOpt_WithPriority(t *testing.T) {
+	// setup types
+	_service, _ := testPostgres(t, WithPriority(true), WithWorker("worker_0"))
+
+	// run test
+	if !_service.config.Priority {
+		t.Errorf("WithPriority is %v, want true", _service.config.Priority)
+	}
+
+	if _service.config.Worker != "worker_0" {
+		t.Errorf("WithWorker is %v, want worker_0", _service.config.Worker)
+	}
+}
+
+func TestPostgres_ClientOpt_WithFairShare(t *testing.T) {
+	// setup types
+	_policy := &fairshare.Policy{Mode: fairshare.ModeOrg}
+
+	_service, _ := testPostgres(t, WithFairShare(_policy))
+
+	// run test
+	if _service.config.FairShare != _policy {
+		t.Errorf("WithFairShare is %v, want %v", _service.config.FairShare, _policy)
+	}
+}
+
+func TestPostgres_ClientOpt_WithPublicKeys(t *testing.T) {
+	// setup types
+	_service, _ := testPostgres(t, WithPublicKeys("previous=CuS+EQAzofbk3tVFS3bt5f2tIb4YiJJC4nVMFQYQElg="))
+
+	want, _ := keyset.Parse([]string{"previous=CuS+EQAzofbk3tVFS3bt5f2tIb4YiJJC4nVMFQYQElg="})
+
+	// run test
+	if !reflect.DeepEqual(_service.config.PublicKeys, want) {
+		t.Errorf("WithPublicKeys is %v, want %v", _service.config.PublicKeys, want)
+	}
+}
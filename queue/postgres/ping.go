@@ -0,0 +1,26 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"fmt"
+)
+
+// Ping contacts the queue to test its connection.
+func (c *client) Ping(ctx context.Context) error {
+	// capture database/sql database from gorm.io/gorm database
+	_sql, err := c.Postgres.DB()
+	if err != nil {
+		return err
+	}
+
+	// send ping request to client
+	err = _sql.PingContext(ctx)
+	if err != nil {
+		c.Logger.Debugf("unable to ping Postgres queue.")
+		return fmt.Errorf("unable to establish connection to Postgres queue")
+	}
+
+	return nil
+}
@@ -0,0 +1,28 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"testing"
+)
+
+func TestPostgres_Ping(t *testing.T) {
+	// setup types
+	_client, _ := testPostgres(t)
+
+	// run test
+	err := _client.Ping(context.Background())
+	if err != nil {
+		t.Errorf("Ping returned err: %v", err)
+	}
+
+	// close the database connection
+	_sql, _ := _client.Postgres.DB()
+	_sql.Close()
+
+	err = _client.Ping(context.Background())
+	if err == nil {
+		t.Errorf("Ping should have returned err")
+	}
+}
@@ -0,0 +1,166 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-vela/server/internal/keyset"
+	"github.com/go-vela/types"
+)
+
+const (
+	// popQuery represents a query to delete and return the next
+	// item from the routes skipping items locked by other callers.
+	popQuery = `
+DELETE FROM queue_items
+WHERE id = (
+	SELECT id FROM queue_items
+	WHERE route IN ? AND lease_expires = 0
+	ORDER BY %s
+	LIMIT 1
+	FOR UPDATE SKIP LOCKED
+)
+RETURNING item
+`
+
+	// leaseQuery represents a query to lease and return the next
+	// item from the routes skipping items locked by other callers.
+	leaseQuery = `
+UPDATE queue_items
+SET leased_by = ?, lease_expires = ?
+WHERE id = (
+	SELECT id FROM queue_items
+	WHERE route IN ? AND lease_expires = 0
+	ORDER BY %s
+	LIMIT 1
+	FOR UPDATE SKIP LOCKED
+)
+RETURNING item
+`
+)
+
+// Pop grabs an item from the specified channel off the queue.
+func (c *client) Pop(ctx context.Context, routes []string) (*types.Item, error) {
+	c.Logger.Tracef("popping item from queue %s", c.config.Channels)
+
+	// define channels to pop from
+	var channels []string
+
+	// if routes were supplied, use those
+	if len(routes) > 0 {
+		channels = routes
+	} else {
+		channels = c.config.Channels
+	}
+
+	// a timeout of zero blocks until an item is popped
+	var deadline <-chan time.Time
+	if c.config.Timeout > 0 {
+		timeout := time.NewTimer(c.config.Timeout)
+		defer timeout.Stop()
+
+		deadline = timeout.C
+	}
+
+	// reuse a single timer to poll for items between wake ups
+	poll := time.NewTimer(pollInterval)
+	defer poll.Stop()
+
+	for {
+		// capture the wake up before checking the queue
+		// so items pushed in the meantime are not missed
+		pushed := c.wait()
+
+		signed, err := c.claim(ctx, channels)
+		if err != nil {
+			return nil, err
+		}
+
+		if signed != nil {
+			return c.open(signed)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-deadline:
+			return nil, nil
+		case <-pushed:
+			// drain the poll timer if it fired before being stopped
+			if !poll.Stop() {
+				<-poll.C
+			}
+		case <-poll.C:
+		}
+
+		poll.Reset(pollInterval)
+	}
+}
+
+// claim is a helper function to pop the next item from the routes
+// and lease it to the worker when items popped are leased.
+func (c *client) claim(ctx context.Context, routes []string) ([]byte, error) {
+	// check if items are popped with a fair share policy
+	if c.config.FairShare != nil {
+		return c.share(ctx, routes)
+	}
+
+	// items are popped oldest first unless popped by priority
+	order := "id"
+	if c.config.Priority {
+		order = "priority DESC, id"
+	}
+
+	var (
+		row    = new(queueItem)
+		result = c.Postgres.WithContext(ctx)
+	)
+
+	// check if items popped from the queue are leased
+	if c.config.Lease > 0 {
+		expiration := time.Now().Add(c.config.Lease).Unix()
+
+		// send query to the database to lease the next item
+		result = result.Raw(fmt.Sprintf(leaseQuery, order), c.config.Worker, expiration, routes).Scan(row)
+	} else {
+		// send query to the database to pop the next item
+		result = result.Raw(fmt.Sprintf(popQuery, order), routes).Scan(row)
+	}
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	// no items are in the routes
+	if result.RowsAffected == 0 {
+		return nil, nil
+	}
+
+	return row.Item, nil
+}
+
+// open is a helper function to open a signed
+// item popped from the queue.
+func (c *client) open(signed []byte) (*types.Item, error) {
+	// open the item using the active public key or any
+	// other public key that has not been retired yet
+	opened, ok := keyset.Open(signed, c.config.PublicKey, c.config.PublicKeys)
+	if !ok {
+		return nil, errors.New("unable to open signed item")
+	}
+
+	// unmarshal result into queue item
+	item := new(types.Item)
+
+	err := json.Unmarshal(opened, item)
+	if err != nil {
+		return nil, err
+	}
+
+	return item, nil
+}
@@ -0,0 +1,129 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestPostgres_Pop(t *testing.T) {
+	// setup types
+	_client, _mock := testPostgres(t, WithTimeout(10*time.Millisecond))
+
+	// ensure the mock expects the pop query
+	_mock.ExpectQuery(testQuery(fmt.Sprintf(popQuery, "id"), "($1)")).
+		WithArgs("vela").
+		WillReturnRows(sqlmock.NewRows([]string{"item"}).AddRow(testSigned(t, 1)))
+
+	// ensure the mock expects the pop query for an empty queue
+	_mock.ExpectQuery(testQuery(fmt.Sprintf(popQuery, "id"), "($1)")).
+		WithArgs("vela").
+		WillReturnRows(sqlmock.NewRows([]string{"item"}))
+
+	// run test
+	got, err := _client.Pop(context.Background(), nil)
+	if err != nil {
+		t.Errorf("Pop returned err: %v", err)
+	}
+
+	if got.Build.GetID() != 1 {
+		t.Errorf("Pop is build %d, want 1", got.Build.GetID())
+	}
+
+	// queue is empty so the pop times out
+	got, err = _client.Pop(context.Background(), nil)
+	if err != nil {
+		t.Errorf("Pop returned err: %v", err)
+	}
+
+	if got != nil {
+		t.Errorf("Pop is %v, want nil", got)
+	}
+
+	err = _mock.ExpectationsWereMet()
+	if err != nil {
+		t.Errorf("Pop did not run expected queries: %v", err)
+	}
+}
+
+func TestPostgres_Pop_Lease(t *testing.T) {
+	// setup types
+	_client, _mock := testPostgres(t,
+		WithLease(time.Minute),
+		WithWorker("worker_0"),
+		WithPriority(true),
+	)
+
+	// ensure the mock expects the lease query
+	_mock.ExpectQuery(testQuery(fmt.Sprintf(leaseQuery, "priority DESC, id"), "$1", "$2", "($3)")).
+		WithArgs("worker_0", AnyArgument{}, "custom").
+		WillReturnRows(sqlmock.NewRows([]string{"item"}).AddRow(testSigned(t, 1)))
+
+	// run test
+	got, err := _client.Pop(context.Background(), []string{"custom"})
+	if err != nil {
+		t.Errorf("Pop returned err: %v", err)
+	}
+
+	if got.Build.GetID() != 1 {
+		t.Errorf("Pop is build %d, want 1", got.Build.GetID())
+	}
+
+	err = _mock.ExpectationsWereMet()
+	if err != nil {
+		t.Errorf("Pop did not run expected queries: %v", err)
+	}
+}
+
+func TestPostgres_Pop_Wake(t *testing.T) {
+	// setup types
+	_client, _mock := testPostgres(t, WithTimeout(time.Minute))
+
+	// ensure the mock expects the pop query for an empty queue
+	_mock.ExpectQuery(testQuery(fmt.Sprintf(popQuery, "id"), "($1)")).
+		WithArgs("vela").
+		WillReturnRows(sqlmock.NewRows([]string{"item"}))
+
+	// ensure the mock expects the pop query after the wake up
+	_mock.ExpectQuery(testQuery(fmt.Sprintf(popQuery, "id"), "($1)")).
+		WithArgs("vela").
+		WillReturnRows(sqlmock.NewRows([]string{"item"}).AddRow(testSigned(t, 1)))
+
+	// wake the pop once it is waiting for an item
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+
+		_client.wake()
+	}()
+
+	// run test
+	got, err := _client.Pop(context.Background(), nil)
+	if err != nil {
+		t.Errorf("Pop returned err: %v", err)
+	}
+
+	if got.Build.GetID() != 1 {
+		t.Errorf("Pop is build %d, want 1", got.Build.GetID())
+	}
+}
+
+func TestPostgres_Pop_BadItem(t *testing.T) {
+	// setup types
+	_client, _mock := testPostgres(t)
+
+	// ensure the mock expects the pop query
+	_mock.ExpectQuery(testQuery(fmt.Sprintf(popQuery, "id"), "($1)")).
+		WithArgs("vela").
+		WillReturnRows(sqlmock.NewRows([]string{"item"}).AddRow(testItem(t, 1)))
+
+	// run test
+	_, err := _client.Pop(context.Background(), nil)
+	if err == nil {
+		t.Errorf("Pop should have returned err")
+	}
+}
@@ -0,0 +1,152 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-vela/server/internal/fairshare"
+	"github.com/go-vela/server/internal/keyset"
+	"github.com/sirupsen/logrus"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+type config struct {
+	// specifies the address to use for the Postgres client
+	Address string
+	// specifies a list of channels for managing builds for the Postgres client
+	Channels []string
+	// specifies the timeout to use for the Postgres client
+	Timeout time.Duration
+	// key for signing items pushed to the Postgres client
+	PrivateKey *[64]byte
+	// key for opening items popped from the Postgres client
+	PublicKey *[32]byte
+	// keys, other than the active key, for opening items signed before a key rotation
+	PublicKeys []*keyset.Key
+	// specifies the lease for items popped from the Postgres client
+	Lease time.Duration
+	// specifies the name of the worker leasing items popped from the Postgres client
+	Worker string
+	// enables the Postgres client to pop items by priority
+	Priority bool
+	// specifies the policy for sharing the workers between owners of items
+	FairShare *fairshare.Policy
+}
+
+type client struct {
+	config *config
+	// gorm.io/gorm database client used in queue functions
+	//
+	// https://pkg.go.dev/gorm.io/gorm#DB
+	Postgres *gorm.DB
+	// channel closed to wake the callers waiting to pop items
+	pushed chan struct{}
+	mutex  sync.Mutex
+	// https://pkg.go.dev/github.com/sirupsen/logrus#Entry
+	Logger *logrus.Entry
+}
+
+// New returns a Queue implementation that
+// integrates with a Postgres queue instance.
+//
+//nolint:revive // ignore returning unexported client
+func New(opts ...ClientOpt) (*client, error) {
+	// create new Postgres client
+	c := new(client)
+
+	// create new fields
+	c.config = new(config)
+	c.pushed = make(chan struct{})
+
+	// create new logger for the client
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#StandardLogger
+	logger := logrus.StandardLogger()
+
+	// create new logger for the client
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#NewEntry
+	c.Logger = logrus.NewEntry(logger).WithField("queue", c.Driver())
+
+	// apply all provided configuration options
+	for _, opt := range opts {
+		err := opt(c)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// check if a database client was provided
+	if c.Postgres == nil {
+		var err error
+
+		// create the new Postgres database client
+		//
+		// https://pkg.go.dev/gorm.io/gorm#Open
+		c.Postgres, err = gorm.Open(
+			postgres.Open(c.config.Address),
+			&gorm.Config{SkipDefaultTransaction: true},
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		// ping the queue
+		err = pingQueue(c)
+		if err != nil {
+			return nil, err
+		}
+
+		// listen for items pushed to the queue
+		go c.listen(context.Background())
+	}
+
+	// create the queue table
+	err := c.Postgres.Exec(CreateQueueTable).Error
+	if err != nil {
+		return nil, fmt.Errorf("unable to create %s table: %w", tableQueue, err)
+	}
+
+	// add the owner column to the queue table
+	err = c.Postgres.Exec(AddOwnerColumn).Error
+	if err != nil {
+		return nil, fmt.Errorf("unable to add owner column to %s table: %w", tableQueue, err)
+	}
+
+	// create the indexes for the queue table
+	err = c.Postgres.Exec(CreateRouteIndex).Error
+	if err != nil {
+		return nil, fmt.Errorf("unable to create indexes for %s table: %w", tableQueue, err)
+	}
+
+	return c, nil
+}
+
+// pingQueue is a helper function to send a "ping"
+// request with backoff to the database.
+//
+// This will ensure we have properly established a
+// connection to the Postgres queue instance before
+// we try to set it up.
+func pingQueue(c *client) error {
+	// attempt 10 times
+	for i := 0; i < 10; i++ {
+		// send ping request to client
+		err := c.Ping(context.Background())
+		if err != nil {
+			c.Logger.Debugf("unable to ping Postgres queue. Retrying in %v", time.Duration(i)*time.Second)
+			time.Sleep(1 * time.Second)
+
+			continue
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("unable to establish connection to Postgres queue")
+}
@@ -0,0 +1,160 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"database/sql/driver"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-vela/types"
+	"github.com/go-vela/types/library"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/crypto/nacl/sign"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// setup global variables used for testing.
+var (
+	_signingPrivateKey = "tCIevHOBq6DdN5SSBtteXUusjjd0fOqzk2eyi0DMq04NewmShNKQeUbbp3vkvIckb4pCxc+vxUo+mYf/vzOaSg=="
+	_signingPublicKey  = "DXsJkoTSkHlG26d75LyHJG+KQsXPr8VKPpmH/78zmko="
+)
+
+func TestPostgres_New(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		address string
+	}{
+		{
+			failure: true,
+			address: "",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		_, err := New(
+			WithAddress(test.address),
+			WithChannels("vela"),
+		)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("New should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("New returned err: %v", err)
+		}
+	}
+}
+
+// testPostgres is a helper function to create a Postgres queue client for testing.
+func testPostgres(t *testing.T, opts ...ClientOpt) (*client, sqlmock.Sqlmock) {
+	t.Helper()
+
+	// create the new mock sql database
+	//
+	// https://pkg.go.dev/github.com/DATA-DOG/go-sqlmock#New
+	_sql, _mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Errorf("unable to create new SQL mock: %v", err)
+	}
+
+	_mock.ExpectExec(CreateQueueTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(AddOwnerColumn).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(CreateRouteIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+
+	// create the new mock Postgres database client
+	//
+	// https://pkg.go.dev/gorm.io/gorm#Open
+	_postgres, err := gorm.Open(
+		postgres.New(postgres.Config{Conn: _sql}),
+		&gorm.Config{SkipDefaultTransaction: true},
+	)
+	if err != nil {
+		t.Errorf("unable to create new postgres database: %v", err)
+	}
+
+	opts = append([]ClientOpt{
+		WithClient(_postgres),
+		WithChannels("vela"),
+		WithPrivateKey(_signingPrivateKey),
+		WithPublicKey(_signingPublicKey),
+	}, opts...)
+
+	_client, err := New(opts...)
+	if err != nil {
+		t.Errorf("unable to create new postgres queue client: %v", err)
+	}
+
+	return _client, _mock
+}
+
+// testPostgresLogger is a helper function to create the logger for a Postgres queue client for testing.
+func testPostgresLogger() *logrus.Entry {
+	return logrus.NewEntry(logrus.StandardLogger())
+}
+
+// testItem is a helper function to create the
+// bytes for a queue item for a build.
+func testItem(t *testing.T, id int64) []byte {
+	t.Helper()
+
+	b := new(library.Build)
+	b.SetID(id)
+
+	bytes, err := json.Marshal(&types.Item{Build: b, Repo: new(library.Repo), User: new(library.User)})
+	if err != nil {
+		t.Errorf("unable to marshal queue item: %v", err)
+	}
+
+	return bytes
+}
+
+// testSigned is a helper function to sign the
+// bytes for a queue item for a build.
+func testSigned(t *testing.T, id int64) []byte {
+	t.Helper()
+
+	decoded, err := base64.StdEncoding.DecodeString(_signingPrivateKey)
+	if err != nil {
+		t.Errorf("unable to decode signing key: %v", err)
+	}
+
+	key := new([64]byte)
+	copy(key[:], decoded)
+
+	return sign.Sign(nil, testItem(t, id), key)
+}
+
+// testQuery is a helper function to replace the placeholders
+// in a query with the bind variables sent to Postgres.
+func testQuery(query string, vars ...string) string {
+	for _, v := range vars {
+		query = strings.Replace(query, "?", v, 1)
+	}
+
+	return query
+}
+
+// This will be used with the github.com/DATA-DOG/go-sqlmock
+// library to compare values that are otherwise not easily
+// compared. These typically would be values generated before
+// adding or updating them in the database.
+//
+// https://github.com/DATA-DOG/go-sqlmock#matching-arguments-like-timetime
+type AnyArgument struct{}
+
+// Match satisfies sqlmock.Argument interface.
+func (a AnyArgument) Match(_ driver.Value) bool {
+	return true
+}
@@ -0,0 +1,97 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/go-vela/server/constants"
+	"golang.org/x/crypto/nacl/sign"
+)
+
+// Push inserts an item to the specified channel in the queue.
+func (c *client) Push(ctx context.Context, channel string, item []byte) error {
+	c.Logger.Tracef("pushing item to queue %s", channel)
+
+	// ensure the item to be pushed is valid
+	if item == nil {
+		return errors.New("item is nil")
+	}
+
+	var signed []byte
+
+	var out []byte
+
+	c.Logger.Tracef("signing item for queue %s", channel)
+
+	// sign the item using the private key generated using sign
+	//
+	// https://pkg.go.dev/golang.org/x/crypto@v0.1.0/nacl/sign
+	signed = sign.Sign(out, item, c.config.PrivateKey)
+
+	buildID, priority := metadata(item)
+
+	row := &queueItem{
+		Route:    channel,
+		BuildID:  buildID,
+		Item:     signed,
+		Priority: priority,
+		Created:  time.Now().UTC().Unix(),
+	}
+
+	// capture the owner of the item to share the workers
+	if c.config.FairShare != nil {
+		row.Owner = c.config.FairShare.Owner(item)
+	}
+
+	// send query to the database to insert the item to the queue
+	err := c.Postgres.WithContext(ctx).Create(row).Error
+	if err != nil {
+		return err
+	}
+
+	// wake the callers waiting to pop items from the channel
+	return c.notify(ctx, channel)
+}
+
+// metadata is a helper function to capture the build ID and
+// priority from an item used to ack and order the item.
+func metadata(item []byte) (int64, int64) {
+	m := struct {
+		Build *struct {
+			ID int64 `json:"id"`
+		} `json:"build"`
+		Priority *int64 `json:"priority"`
+	}{}
+
+	// items without a priority, like items published
+	// before priorities existed, use the default
+	priority := constants.PriorityDefault
+
+	err := json.Unmarshal(item, &m)
+	if err != nil {
+		return 0, priority
+	}
+
+	if m.Priority != nil {
+		priority = *m.Priority
+	}
+
+	// clamp the priority between the min and max
+	if priority < constants.PriorityMin {
+		priority = constants.PriorityMin
+	}
+
+	if priority > constants.PriorityMax {
+		priority = constants.PriorityMax
+	}
+
+	if m.Build == nil {
+		return 0, priority
+	}
+
+	return m.Build.ID, priority
+}
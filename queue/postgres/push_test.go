@@ -0,0 +1,116 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-vela/server/constants"
+)
+
+func TestPostgres_Push(t *testing.T) {
+	// setup types
+	_client, _mock := testPostgres(t)
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows([]string{"id"}).AddRow(1)
+
+	// ensure the mock expects the insert query
+	_mock.ExpectQuery(`INSERT INTO "queue_items" ("route","build_id","item","priority","owner","created","leased_by","lease_expires") VALUES ($1,$2,$3,$4,$5,$6,$7,$8) RETURNING "id"`).
+		WithArgs("vela", 1, AnyArgument{}, constants.PriorityDefault, "", AnyArgument{}, "", 0).
+		WillReturnRows(_rows)
+
+	// ensure the mock expects the notify query
+	_mock.ExpectExec(`SELECT pg_notify($1, $2)`).
+		WithArgs(notifyChannel, "vela").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	// setup tests
+	tests := []struct {
+		failure bool
+		bytes   []byte
+	}{
+		{
+			failure: false,
+			bytes:   testItem(t, 1),
+		},
+		{
+			failure: true,
+			bytes:   nil,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		err := _client.Push(context.Background(), "vela", test.bytes)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("Push should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("Push returned err: %v", err)
+		}
+	}
+
+	err := _mock.ExpectationsWereMet()
+	if err != nil {
+		t.Errorf("Push did not run expected queries: %v", err)
+	}
+}
+
+func TestPostgres_metadata(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name     string
+		item     []byte
+		build    int64
+		priority int64
+	}{
+		{
+			name:     "build and priority",
+			item:     []byte(`{"build":{"id":1},"priority":8}`),
+			build:    1,
+			priority: 8,
+		},
+		{
+			name:     "no priority",
+			item:     []byte(`{"build":{"id":2}}`),
+			build:    2,
+			priority: constants.PriorityDefault,
+		},
+		{
+			name:     "priority above max",
+			item:     []byte(`{"build":{"id":3},"priority":100}`),
+			build:    3,
+			priority: constants.PriorityMax,
+		},
+		{
+			name:     "invalid item",
+			item:     []byte(`foo`),
+			build:    0,
+			priority: constants.PriorityDefault,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			build, priority := metadata(test.item)
+
+			if build != test.build {
+				t.Errorf("metadata build is %d, want %d", build, test.build)
+			}
+
+			if priority != test.priority {
+				t.Errorf("metadata priority is %d, want %d", priority, test.priority)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+const (
+	// tableQueue represents the name of the table for items in the queue.
+	tableQueue = "queue_items"
+
+	// CreateQueueTable represents a query to create the Postgres queue table.
+	CreateQueueTable = `
+CREATE TABLE
+IF NOT EXISTS
+queue_items (
+	id             BIGSERIAL PRIMARY KEY,
+	route          VARCHAR(250),
+	build_id       BIGINT,
+	item           BYTEA,
+	priority       INTEGER,
+	created        BIGINT,
+	leased_by      VARCHAR(250),
+	lease_expires  BIGINT
+);
+`
+
+	// AddOwnerColumn represents a query to add the owner column
+	// used to share the workers to an existing queue table.
+	AddOwnerColumn = `
+ALTER TABLE queue_items
+ADD COLUMN IF NOT EXISTS owner VARCHAR(250);
+`
+
+	// CreateRouteIndex represents a query to create an
+	// index on the queue table for the route, priority
+	// and id columns used to pop items from the queue.
+	CreateRouteIndex = `
+CREATE INDEX
+IF NOT EXISTS
+queue_items_route
+ON queue_items (route, priority, id);
+`
+)
+
+// queueItem is the row for an item in the queue table.
+type queueItem struct {
+	ID           int64 `gorm:"primaryKey"`
+	Route        string
+	BuildID      int64
+	Item         []byte
+	Priority     int64
+	Owner        string
+	Created      int64
+	LeasedBy     string
+	LeaseExpires int64
+}
+
+// TableName sets the name of the queue table for gorm.
+func (queueItem) TableName() string {
+	return tableQueue
+}
@@ -5,6 +5,7 @@ package queue
 import (
 	"fmt"
 
+	serverconstants "github.com/go-vela/server/constants"
 	"github.com/go-vela/types/constants"
 	"github.com/sirupsen/logrus"
 )
@@ -13,6 +14,8 @@ import (
 // integrating with the configured queue environment.
 // Currently, the following queues are supported:
 //
+// * memory
+// * postgres
 // * redis
 // .
 func New(s *Setup) (Service, error) {
@@ -32,6 +35,16 @@ func New(s *Setup) (Service, error) {
 		//
 		// https://pkg.go.dev/github.com/go-vela/server/queue?tab=doc#Setup.Kafka
 		return s.Kafka()
+	case serverconstants.DriverMemory:
+		// handle the Memory queue driver being provided
+		//
+		// https://pkg.go.dev/github.com/go-vela/server/queue?tab=doc#Setup.Memory
+		return s.Memory()
+	case constants.DriverPostgres:
+		// handle the Postgres queue driver being provided
+		//
+		// https://pkg.go.dev/github.com/go-vela/server/queue?tab=doc#Setup.Postgres
+		return s.Postgres()
 	case constants.DriverRedis:
 		// handle the Redis queue driver being provided
 		//
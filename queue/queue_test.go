@@ -36,6 +36,15 @@ func TestQueue_New(t *testing.T) {
 				PublicKey:  "CuS+EQAzofbk3tVFS3bt5f2tIb4YiJJC4nVMFQYQElg=",
 			},
 		},
+		{
+			failure: false,
+			setup: &Setup{
+				Driver:     "memory",
+				Routes:     []string{"foo"},
+				PrivateKey: "bOiFT7Y9e0jpOqaapTa3NzUkAve3VdRvyowgsY/vtlcK5L4RADOh9uTe1UVLdu3l/a0hvhiIkkLidUwVBhASWA==",
+				PublicKey:  "CuS+EQAzofbk3tVFS3bt5f2tIb4YiJJC4nVMFQYQElg=",
+			},
+		},
 		{
 			failure: true,
 			setup: &Setup{
@@ -0,0 +1,51 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"context"
+	"strconv"
+)
+
+// Done releases the build popped off the queue so it is no
+// longer counted in-flight for its owner when sharing workers.
+func (c *client) Done(ctx context.Context, build int64) error {
+	c.Logger.Tracef("releasing build %d popped from queue", build)
+
+	// builds are only counted in-flight when sharing workers
+	if c.config.FairShare == nil {
+		return nil
+	}
+
+	return doneScript.Run(ctx, c.Redis, []string{inflightKey, inflightBuildsKey}, build).Err()
+}
+
+// Inflight outputs the builds popped off the queue that
+// are counted in-flight for their owner until they are done.
+func (c *client) Inflight(ctx context.Context) ([]int64, error) {
+	c.Logger.Trace("listing builds in-flight from queue")
+
+	builds := []int64{}
+
+	// builds are only counted in-flight when sharing workers
+	if c.config.FairShare == nil {
+		return builds, nil
+	}
+
+	// https://pkg.go.dev/github.com/redis/go-redis/v9#Client.HKeys
+	keys, err := c.Redis.HKeys(ctx, inflightBuildsKey).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, key := range keys {
+		build, err := strconv.ParseInt(key, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+
+		builds = append(builds, build)
+	}
+
+	return builds, nil
+}
@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"bytes"
+	"crypto/rand"
+	"errors"
+	"io"
+
+	"golang.org/x/crypto/nacl/secretbox"
+)
+
+// encryptedPrefix is the prefix marking items in the queue
+// encrypted with the secret key, which tells them apart
+// from items that are only signed during a migration.
+var encryptedPrefix = []byte("vela:secretbox:v1:")
+
+// seal is a helper function to encrypt a signed item
+// pushed to the queue when an encryption key is set.
+//
+// https://pkg.go.dev/golang.org/x/crypto/nacl/secretbox#Seal
+func (c *client) seal(signed []byte) ([]byte, error) {
+	// items are only signed when encryption is disabled
+	if c.config.EncryptionKey == nil {
+		return signed, nil
+	}
+
+	// create a random nonce for the item
+	var nonce [24]byte
+
+	_, err := io.ReadFull(rand.Reader, nonce[:])
+	if err != nil {
+		return nil, err
+	}
+
+	// prefix the encrypted item with the nonce for opening it
+	out := append(append([]byte{}, encryptedPrefix...), nonce[:]...)
+
+	return secretbox.Seal(out, signed, &nonce, c.config.EncryptionKey), nil
+}
+
+// unseal is a helper function to decrypt an item popped from
+// the queue and return the signed item. Items that are only
+// signed are returned as is when encryption is disabled or
+// while migrating to encrypted items.
+//
+// https://pkg.go.dev/golang.org/x/crypto/nacl/secretbox#Open
+func (c *client) unseal(item []byte) ([]byte, error) {
+	if !bytes.HasPrefix(item, encryptedPrefix) {
+		// reject items that are only signed once encryption is required
+		if c.config.EncryptionKey != nil && !c.config.EncryptionMigration {
+			return nil, errors.New("unable to open unencrypted item")
+		}
+
+		return item, nil
+	}
+
+	if c.config.EncryptionKey == nil {
+		return nil, errors.New("unable to open encrypted item, no queue encryption key provided")
+	}
+
+	encrypted := item[len(encryptedPrefix):]
+
+	if len(encrypted) < 24 {
+		return nil, errors.New("unable to open encrypted item, item is too short")
+	}
+
+	// capture the nonce from the encrypted item
+	var nonce [24]byte
+
+	copy(nonce[:], encrypted[:24])
+
+	signed, ok := secretbox.Open(nil, encrypted[24:], &nonce, c.config.EncryptionKey)
+	if !ok {
+		return nil, errors.New("unable to open encrypted item")
+	}
+
+	return signed, nil
+}
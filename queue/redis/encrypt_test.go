@@ -0,0 +1,202 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/go-vela/types"
+	"golang.org/x/crypto/nacl/sign"
+)
+
+const _encryptionKey = "4zj1Q8XbWkEyYw5e1C1Y8l5n0wz3s6H0XgBq3bWJ0Jk="
+
+func TestRedis_Push_Encrypted(t *testing.T) {
+	// setup types
+	// use global variables in redis_test.go
+	_item := &types.Item{
+		Build: _build,
+		Repo:  _repo,
+		User:  _user,
+	}
+
+	// setup queue item
+	_bytes, err := json.Marshal(_item)
+	if err != nil {
+		t.Errorf("unable to marshal queue item: %v", err)
+	}
+
+	// setup redis mock
+	_redis, err := NewTest(_signingPrivateKey, _signingPublicKey, "vela")
+	if err != nil {
+		t.Errorf("unable to create queue service: %v", err)
+	}
+
+	err = WithEncryptionKey(_encryptionKey)(_redis)
+	if err != nil {
+		t.Errorf("unable to set encryption key: %v", err)
+	}
+
+	_redis.config.Timeout = time.Second
+
+	// run test
+	err = _redis.Push(context.Background(), "vela", _bytes)
+	if err != nil {
+		t.Errorf("Push returned err: %v", err)
+	}
+
+	stored, err := _redis.Redis.LIndex(context.Background(), "vela", 0).Bytes()
+	if err != nil {
+		t.Errorf("unable to read item from queue: %v", err)
+	}
+
+	if !bytes.HasPrefix(stored, encryptedPrefix) {
+		t.Errorf("Push stored item without the encrypted prefix")
+	}
+
+	if bytes.Contains(stored, []byte(_repo.GetFullName())) {
+		t.Errorf("Push stored item in clear")
+	}
+
+	got, err := _redis.Pop(context.Background(), nil)
+	if err != nil {
+		t.Errorf("Pop returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, _item) {
+		t.Errorf("Pop is %v, want %v", got, _item)
+	}
+}
+
+func TestRedis_Unseal(t *testing.T) {
+	// setup types
+	item := []byte("foo")
+
+	// setup redis mock
+	_redis, err := NewTest(_signingPrivateKey, _signingPublicKey, "vela")
+	if err != nil {
+		t.Errorf("unable to create queue service: %v", err)
+	}
+
+	signed := sign.Sign(nil, item, _redis.config.PrivateKey)
+
+	err = WithEncryptionKey(_encryptionKey)(_redis)
+	if err != nil {
+		t.Errorf("unable to set encryption key: %v", err)
+	}
+
+	encrypted, err := _redis.seal(signed)
+	if err != nil {
+		t.Errorf("seal returned err: %v", err)
+	}
+
+	// encrypt with a different key
+	other, err := NewTest(_signingPrivateKey, _signingPublicKey, "vela")
+	if err != nil {
+		t.Errorf("unable to create queue service: %v", err)
+	}
+
+	err = WithEncryptionKey("DXsJkoTSkHlG26d75LyHJG+KQsXPr8VKPpmH/78zmko=")(other)
+	if err != nil {
+		t.Errorf("unable to set encryption key: %v", err)
+	}
+
+	otherEncrypted, err := other.seal(signed)
+	if err != nil {
+		t.Errorf("seal returned err: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		name      string
+		key       string
+		migration bool
+		item      []byte
+		failure   bool
+	}{
+		{
+			name: "encrypted",
+			key:  _encryptionKey,
+			item: encrypted,
+		},
+		{
+			name:      "encrypted during migration",
+			key:       _encryptionKey,
+			migration: true,
+			item:      encrypted,
+		},
+		{
+			name:      "signed during migration",
+			key:       _encryptionKey,
+			migration: true,
+			item:      signed,
+		},
+		{
+			name: "signed without encryption",
+			item: signed,
+		},
+		{
+			name:    "signed with encryption",
+			key:     _encryptionKey,
+			item:    signed,
+			failure: true,
+		},
+		{
+			name:    "encrypted without encryption",
+			item:    encrypted,
+			failure: true,
+		},
+		{
+			name:    "encrypted with another key",
+			key:     _encryptionKey,
+			item:    otherEncrypted,
+			failure: true,
+		},
+		{
+			name:    "truncated",
+			key:     _encryptionKey,
+			item:    encrypted[:len(encryptedPrefix)+10],
+			failure: true,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_client, err := NewTest(_signingPrivateKey, _signingPublicKey, "vela")
+			if err != nil {
+				t.Errorf("unable to create queue service: %v", err)
+			}
+
+			err = WithEncryptionKey(test.key)(_client)
+			if err != nil {
+				t.Errorf("unable to set encryption key: %v", err)
+			}
+
+			_client.config.EncryptionMigration = test.migration
+
+			got, err := _client.verify(test.item)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("verify should have returned err")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("verify returned err: %v", err)
+			}
+
+			if !reflect.DeepEqual(got, item) {
+				t.Errorf("verify is %s, want %s", got, item)
+			}
+		})
+	}
+}
@@ -0,0 +1,314 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"context"
+	"sort"
+	"strconv"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// routePrefix is the prefix for the keys of the items waiting
+	// in a route for each owner when sharing the workers.
+	routePrefix = "vela:queue:route:"
+	// inflightKey is the hash of owners to the number of builds popped off the queue that are not complete.
+	inflightKey = "vela:queue:inflight"
+	// inflightBuildsKey is the hash of builds popped off the queue that are not complete to their owner.
+	inflightBuildsKey = "vela:queue:inflight:builds"
+)
+
+var (
+	// pushShareScript atomically adds an item to the sorted set for
+	// its owner in a route and adds the owner to the set of owners
+	// with items waiting in the route.
+	//
+	// KEYS: owner items, route owners
+	// ARGV: score, item, owner
+	pushShareScript = redis.NewScript(`
+redis.call('ZADD', KEYS[1], ARGV[1], ARGV[2])
+redis.call('SADD', KEYS[2], ARGV[3])
+return 1
+`)
+
+	// claimScript atomically removes an item from the sorted set for its
+	// owner in a route and, when a lease is provided, moves it to the
+	// processing list for the worker. The build for the item is counted
+	// in-flight for the owner until the build is done.
+	//
+	// KEYS: processing list, leases, lease routes, lease workers, lease scores,
+	//       lease owners, in-flight, in-flight builds, owner items, route owners
+	// ARGV: lease expiration or empty without a lease, worker, item, route, owner, build
+	claimScript = redis.NewScript(`
+local score = redis.call('ZSCORE', KEYS[9], ARGV[3])
+if not score then
+	return 0
+end
+redis.call('ZREM', KEYS[9], ARGV[3])
+if redis.call('ZCARD', KEYS[9]) == 0 then
+	redis.call('SREM', KEYS[10], ARGV[5])
+end
+if ARGV[1] ~= '' then
+	redis.call('RPUSH', KEYS[1], ARGV[3])
+	redis.call('ZADD', KEYS[2], ARGV[1], ARGV[3])
+	redis.call('HSET', KEYS[3], ARGV[3], ARGV[4])
+	redis.call('HSET', KEYS[4], ARGV[3], ARGV[2])
+	redis.call('HSET', KEYS[5], ARGV[3], score)
+	redis.call('HSET', KEYS[6], ARGV[3], ARGV[5])
+end
+if redis.call('HSETNX', KEYS[8], ARGV[6], ARGV[5]) == 1 then
+	redis.call('HINCRBY', KEYS[7], ARGV[5], 1)
+end
+return 1
+`)
+
+	// doneScript atomically removes a build from the builds in-flight
+	// and decrements the number of builds in-flight for its owner.
+	//
+	// KEYS: in-flight, in-flight builds
+	// ARGV: build
+	doneScript = redis.NewScript(`
+local owner = redis.call('HGET', KEYS[2], ARGV[1])
+if not owner then
+	return 0
+end
+redis.call('HDEL', KEYS[2], ARGV[1])
+if redis.call('HINCRBY', KEYS[1], owner, -1) <= 0 then
+	redis.call('HDEL', KEYS[1], owner)
+end
+return 1
+`)
+
+	// removeShareScript atomically removes an item from the sorted set
+	// for its owner in a route and removes the owner from the set of
+	// owners with items waiting in the route when no items are left.
+	//
+	// KEYS: owner items, route owners
+	// ARGV: item, owner
+	removeShareScript = redis.NewScript(`
+local removed = redis.call('ZREM', KEYS[1], ARGV[1])
+if redis.call('ZCARD', KEYS[1]) == 0 then
+	redis.call('SREM', KEYS[2], ARGV[2])
+end
+return removed
+`)
+
+	// moveShareScript atomically moves the sorted sets for every owner
+	// with items waiting in a route to another route. The items keep
+	// their score so they are popped in the same order from the new route.
+	//
+	// KEYS: source route owners, destination route owners
+	// ARGV: route prefix, source route, destination route
+	moveShareScript = redis.NewScript(`
+local moved = 0
+for _, owner in ipairs(redis.call('SMEMBERS', KEYS[1])) do
+	local from = ARGV[1] .. ARGV[2] .. ':owner:' .. owner
+	local to = ARGV[1] .. ARGV[3] .. ':owner:' .. owner
+	local items = redis.call('ZRANGE', from, 0, -1, 'WITHSCORES')
+	for i = 1, #items, 2 do
+		redis.call('ZADD', to, items[i + 1], items[i])
+		moved = moved + 1
+	end
+	redis.call('DEL', from)
+	redis.call('SADD', KEYS[2], owner)
+end
+redis.call('DEL', KEYS[1])
+return moved
+`)
+)
+
+// candidate represents an item in the queue
+// considered by the fair share policy.
+type candidate struct {
+	route string
+	item  string
+	score float64
+	owner string
+}
+
+// ownersKey is a helper function to create the key for
+// the set of owners with items waiting in a route.
+func ownersKey(route string) string {
+	return routePrefix + route + ":owners"
+}
+
+// ownerKey is a helper function to create the key for the
+// sorted set of items waiting in a route for an owner.
+func ownerKey(route, owner string) string {
+	return routePrefix + route + ":owner:" + owner
+}
+
+// share is a helper function to pop the next item from the routes with
+// the fair share policy so the owners of the items take turns popping
+// items off the queue. The builds in-flight for each owner are the builds
+// popped off the queue until they are done.
+//
+// Only the first item waiting for each owner and the number of builds
+// in-flight for each owner are read, so popping an item does not depend
+// on the number of items waiting in the queue.
+func (c *client) share(ctx context.Context, routes []string, expiration string) (string, error) {
+	for {
+		heads, err := c.heads(ctx, routes)
+		if err != nil {
+			return "", err
+		}
+
+		if len(heads) == 0 {
+			return "", redis.Nil
+		}
+
+		inflight, err := c.inflight(ctx)
+		if err != nil {
+			return "", err
+		}
+
+		owners := []string{}
+
+		for _, head := range heads {
+			owners = append(owners, head.owner)
+		}
+
+		i := c.config.FairShare.Select(owners, inflight)
+		if i < 0 {
+			return "", redis.Nil
+		}
+
+		head := heads[i]
+
+		// capture the build for the item to count it in-flight
+		item, err := c.open([]byte(head.item))
+		if err != nil {
+			return "", err
+		}
+
+		keys := []string{
+			c.processingKey(), leasesKey, leaseRoutesKey, leaseWorkersKey, leaseScoresKey,
+			leaseOwnersKey, inflightKey, inflightBuildsKey, ownerKey(head.route, head.owner), ownersKey(head.route),
+		}
+
+		claimed, err := claimScript.Run(ctx, c.Redis, keys,
+			expiration, c.config.Worker, head.item, head.route, head.owner, item.Build.GetID()).Int()
+		if err != nil {
+			return "", err
+		}
+
+		// the item was popped by another worker in the
+		// meantime so the next item is selected again
+		if claimed == 1 {
+			return head.item, nil
+		}
+	}
+}
+
+// heads is a helper function to capture the first item waiting in the
+// routes for each owner, ordered by the score the items were pushed with
+// so ties between owners go to the item that would be popped first.
+func (c *client) heads(ctx context.Context, routes []string) ([]*candidate, error) {
+	heads := []*candidate{}
+
+	for _, route := range routes {
+		// https://pkg.go.dev/github.com/redis/go-redis/v9#Client.SMembers
+		owners, err := c.Redis.SMembers(ctx, ownersKey(route)).Result()
+		if err != nil {
+			return nil, err
+		}
+
+		if len(owners) == 0 {
+			continue
+		}
+
+		cmds := make([]*redis.ZSliceCmd, len(owners))
+
+		// https://pkg.go.dev/github.com/redis/go-redis/v9#Client.Pipelined
+		_, err = c.Redis.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+			for i, owner := range owners {
+				cmds[i] = pipe.ZRangeWithScores(ctx, ownerKey(route, owner), 0, 0)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for i, cmd := range cmds {
+			// the owner has no items left since it was read
+			if len(cmd.Val()) == 0 {
+				continue
+			}
+
+			member, _ := cmd.Val()[0].Member.(string)
+
+			heads = append(heads, &candidate{
+				route: route,
+				item:  member,
+				score: cmd.Val()[0].Score,
+				owner: owners[i],
+			})
+		}
+	}
+
+	sort.SliceStable(heads, func(i, j int) bool {
+		return heads[i].score < heads[j].score
+	})
+
+	return heads, nil
+}
+
+// waiting is a helper function to capture the items waiting
+// in a route for every owner in the order they are popped
+// without sharing along with the owner of each item.
+func (c *client) waiting(ctx context.Context, route string) ([]*candidate, error) {
+	waiting := []*candidate{}
+
+	// https://pkg.go.dev/github.com/redis/go-redis/v9#Client.SMembers
+	owners, err := c.Redis.SMembers(ctx, ownersKey(route)).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, owner := range owners {
+		// https://pkg.go.dev/github.com/redis/go-redis/v9#Client.ZRangeWithScores
+		items, err := c.Redis.ZRangeWithScores(ctx, ownerKey(route, owner), 0, -1).Result()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range items {
+			member, _ := item.Member.(string)
+
+			waiting = append(waiting, &candidate{route: route, item: member, score: item.Score, owner: owner})
+		}
+	}
+
+	sort.SliceStable(waiting, func(i, j int) bool {
+		return waiting[i].score < waiting[j].score
+	})
+
+	return waiting, nil
+}
+
+// inflight is a helper function to capture the
+// number of builds in-flight for each owner.
+func (c *client) inflight(ctx context.Context) (map[string]int64, error) {
+	// https://pkg.go.dev/github.com/redis/go-redis/v9#Client.HGetAll
+	counts, err := c.Redis.HGetAll(ctx, inflightKey).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	inflight := make(map[string]int64, len(counts))
+
+	for owner, count := range counts {
+		total, err := strconv.ParseInt(count, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+
+		inflight[owner] = total
+	}
+
+	return inflight, nil
+}
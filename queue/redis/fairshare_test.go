@@ -0,0 +1,282 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/go-vela/server/internal/fairshare"
+	"github.com/go-vela/types"
+	"github.com/go-vela/types/library"
+)
+
+// testOwnerItem is a helper function to create the
+// bytes for a queue item for a build of an org.
+func testOwnerItem(t *testing.T, id int64, org string) []byte {
+	t.Helper()
+
+	b := new(library.Build)
+	b.SetID(id)
+
+	r := new(library.Repo)
+	r.SetOrg(org)
+	r.SetFullName(org + "/octocat")
+
+	bytes, err := json.Marshal(&types.Item{Build: b, Repo: r, User: _user})
+	if err != nil {
+		t.Errorf("unable to marshal queue item: %v", err)
+	}
+
+	return bytes
+}
+
+func TestRedis_FairShare_Pop(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name     string
+		priority bool
+	}{
+		{
+			name:     "without priority",
+			priority: false,
+		},
+		{
+			name:     "with priority",
+			priority: true,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// setup redis mock
+			_redis, err := NewTest(_signingPrivateKey, _signingPublicKey, "vela", "custom")
+			if err != nil {
+				t.Errorf("unable to create queue service: %v", err)
+			}
+
+			_redis.config.Priority = test.priority
+			_redis.config.Lease = time.Minute
+			_redis.config.Worker = "worker_0"
+			_redis.config.Timeout = 100 * time.Millisecond
+			_redis.config.FairShare = &fairshare.Policy{
+				Mode: fairshare.ModeOrg,
+				Caps: map[string]int64{"github": 2},
+			}
+
+			// one org publishes most of the items before the others
+			pushes := []struct {
+				route string
+				id    int64
+				org   string
+			}{
+				{route: "vela", id: 1, org: "github"},
+				{route: "vela", id: 2, org: "github"},
+				{route: "vela", id: 3, org: "github"},
+				{route: "vela", id: 4, org: "github"},
+				{route: "vela", id: 5, org: "octocat"},
+				{route: "custom", id: 6, org: "vela"},
+			}
+
+			for _, push := range pushes {
+				err = _redis.Push(context.Background(), push.route, testOwnerItem(t, push.id, push.org))
+				if err != nil {
+					t.Errorf("unable to push item to queue: %v", err)
+				}
+
+				// items with a priority are ordered by when they were pushed
+				time.Sleep(2 * time.Millisecond)
+			}
+
+			// the orgs take turns until github is at its cap
+			want := []int64{1, 5, 6, 2}
+
+			popped := []*types.Item{}
+
+			for _, id := range want {
+				got, err := _redis.Pop(context.Background(), nil)
+				if err != nil {
+					t.Errorf("Pop returned err: %v", err)
+				}
+
+				if got == nil || got.Build.GetID() != id {
+					t.Errorf("Pop is %v, want item for build %d", got, id)
+
+					continue
+				}
+
+				popped = append(popped, got)
+			}
+
+			// github is at its cap with items waiting
+			got, err := _redis.Pop(context.Background(), nil)
+			if err != nil {
+				t.Errorf("Pop returned err: %v", err)
+			}
+
+			if got != nil {
+				t.Errorf("Pop is %v, want nil", got)
+			}
+
+			// acking an item for github does not make room since the build is running
+			err = _redis.Ack(context.Background(), popped[0])
+			if err != nil {
+				t.Errorf("Ack returned err: %v", err)
+			}
+
+			got, err = _redis.Pop(context.Background(), nil)
+			if err != nil {
+				t.Errorf("Pop returned err: %v", err)
+			}
+
+			if got != nil {
+				t.Errorf("Pop is %v, want nil", got)
+			}
+
+			builds, err := _redis.Inflight(context.Background())
+			if err != nil {
+				t.Errorf("Inflight returned err: %v", err)
+			}
+
+			if len(builds) != len(want) {
+				t.Errorf("Inflight is %v, want %d builds", builds, len(want))
+			}
+
+			// the build for github being done makes room for another
+			err = _redis.Done(context.Background(), popped[0].Build.GetID())
+			if err != nil {
+				t.Errorf("Done returned err: %v", err)
+			}
+
+			got, err = _redis.Pop(context.Background(), nil)
+			if err != nil {
+				t.Errorf("Pop returned err: %v", err)
+			}
+
+			if got == nil || got.Build.GetID() != 3 {
+				t.Errorf("Pop is %v, want item for build %d", got, 3)
+			}
+		})
+	}
+}
+
+func TestRedis_FairShare_Items(t *testing.T) {
+	// setup redis mock
+	_redis, err := NewTest(_signingPrivateKey, _signingPublicKey, "vela", "custom")
+	if err != nil {
+		t.Errorf("unable to create queue service: %v", err)
+	}
+
+	_redis.config.Lease = time.Minute
+	_redis.config.Worker = "worker_0"
+	_redis.config.Timeout = 100 * time.Millisecond
+	_redis.config.FairShare = &fairshare.Policy{Mode: fairshare.ModeOrg}
+
+	pushes := []struct {
+		id  int64
+		org string
+	}{
+		{id: 1, org: "github"},
+		{id: 2, org: "octocat"},
+		{id: 3, org: "github"},
+	}
+
+	for _, push := range pushes {
+		err = _redis.Push(context.Background(), "vela", testOwnerItem(t, push.id, push.org))
+		if err != nil {
+			t.Errorf("unable to push item to queue: %v", err)
+		}
+
+		// items are ordered by when they were pushed
+		time.Sleep(2 * time.Millisecond)
+	}
+
+	length, err := _redis.Length(context.Background())
+	if err != nil {
+		t.Errorf("Length returned err: %v", err)
+	}
+
+	if length != 3 {
+		t.Errorf("Length is %d, want %d", length, 3)
+	}
+
+	moved, err := _redis.Move(context.Background(), "vela", "custom")
+	if err != nil {
+		t.Errorf("Move returned err: %v", err)
+	}
+
+	if moved != 3 {
+		t.Errorf("Move is %d, want %d", moved, 3)
+	}
+
+	items, err := _redis.List(context.Background(), "custom")
+	if err != nil {
+		t.Errorf("List returned err: %v", err)
+	}
+
+	got := []int64{}
+
+	for _, item := range items {
+		got = append(got, item.Build.GetID())
+	}
+
+	if !reflect.DeepEqual(got, []int64{1, 2, 3}) {
+		t.Errorf("List is %v, want %v", got, []int64{1, 2, 3})
+	}
+
+	removed, err := _redis.Remove(context.Background(), "custom", 2)
+	if err != nil {
+		t.Errorf("Remove returned err: %v", err)
+	}
+
+	if !removed {
+		t.Errorf("Remove is %v, want true", removed)
+	}
+
+	// octocat has no items left in the route
+	owners, err := _redis.Redis.SMembers(context.Background(), ownersKey("custom")).Result()
+	if err != nil {
+		t.Errorf("unable to capture owners: %v", err)
+	}
+
+	if !reflect.DeepEqual(owners, []string{"github"}) {
+		t.Errorf("owners are %v, want %v", owners, []string{"github"})
+	}
+
+	// a rejected item is requeued for its owner and no longer in-flight
+	popped, err := _redis.Pop(context.Background(), []string{"custom"})
+	if err != nil {
+		t.Errorf("Pop returned err: %v", err)
+	}
+
+	if popped == nil || popped.Build.GetID() != 1 {
+		t.Fatalf("Pop is %v, want item for build %d", popped, 1)
+	}
+
+	err = _redis.Nack(context.Background(), popped)
+	if err != nil {
+		t.Errorf("Nack returned err: %v", err)
+	}
+
+	builds, err := _redis.Inflight(context.Background())
+	if err != nil {
+		t.Errorf("Inflight returned err: %v", err)
+	}
+
+	if len(builds) != 0 {
+		t.Errorf("Inflight is %v, want no builds", builds)
+	}
+
+	popped, err = _redis.Pop(context.Background(), []string{"custom"})
+	if err != nil {
+		t.Errorf("Pop returned err: %v", err)
+	}
+
+	if popped == nil || popped.Build.GetID() != 1 {
+		t.Errorf("Pop is %v, want item for build %d", popped, 1)
+	}
+}
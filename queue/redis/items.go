@@ -0,0 +1,169 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-vela/types"
+	"github.com/redis/go-redis/v9"
+)
+
+// moveScript atomically moves every item from a route to the end of
+// another route. With priorities the items keep the score they were
+// pushed with so they are popped in the same order from the new route.
+//
+// The items in lists are moved with LPOP and RPUSH instead of LMOVE
+// so moving items works with Redis versions older than 6.2.
+//
+// KEYS: source route, destination route
+// ARGV: priority
+var moveScript = redis.NewScript(`
+local moved = 0
+if ARGV[1] == '1' then
+	local items = redis.call('ZRANGE', KEYS[1], 0, -1, 'WITHSCORES')
+	for i = 1, #items, 2 do
+		redis.call('ZADD', KEYS[2], items[i + 1], items[i])
+		moved = moved + 1
+	end
+	redis.call('DEL', KEYS[1])
+else
+	local item = redis.call('LPOP', KEYS[1])
+	while item do
+		redis.call('RPUSH', KEYS[2], item)
+		moved = moved + 1
+		item = redis.call('LPOP', KEYS[1])
+	end
+end
+return moved
+`)
+
+// List outputs the items waiting in the specified channel
+// of the queue in the order they are popped off the queue.
+func (c *client) List(ctx context.Context, channel string) ([]*types.Item, error) {
+	c.Logger.Tracef("listing items in queue %s", channel)
+
+	members, err := c.members(ctx, channel)
+	if err != nil {
+		return nil, err
+	}
+
+	items := []*types.Item{}
+
+	for _, member := range members {
+		item, err := c.open([]byte(member.item))
+		if err != nil {
+			c.Logger.Warnf("unable to open item in queue %s: %v", channel, err)
+
+			continue
+		}
+
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+// Move moves every item waiting in the specified channel
+// of the queue to another channel and returns the total.
+func (c *client) Move(ctx context.Context, from, to string) (int64, error) {
+	c.Logger.Tracef("moving items from queue %s to queue %s", from, to)
+
+	if from == to {
+		return 0, fmt.Errorf("unable to move items from queue %s to itself", from)
+	}
+
+	// items pushed for an owner are stored in a sorted set for the owner
+	if c.config.FairShare != nil {
+		return moveShareScript.Run(ctx, c.Redis, []string{ownersKey(from), ownersKey(to)}, routePrefix, from, to).Int64()
+	}
+
+	priority := "0"
+	if c.config.Priority {
+		priority = "1"
+	}
+
+	return moveScript.Run(ctx, c.Redis, []string{from, to}, priority).Int64()
+}
+
+// Remove removes the item for a build waiting in the specified
+// channel of the queue and returns whether it was removed.
+func (c *client) Remove(ctx context.Context, channel string, build int64) (bool, error) {
+	c.Logger.Tracef("removing item for build %d from queue %s", build, channel)
+
+	members, err := c.members(ctx, channel)
+	if err != nil {
+		return false, err
+	}
+
+	for _, member := range members {
+		item, err := c.open([]byte(member.item))
+		if err != nil || item.Build.GetID() != build {
+			continue
+		}
+
+		// the item may have been popped in the meantime
+		removed, err := c.remove(ctx, member)
+		if err != nil {
+			return false, err
+		}
+
+		return removed > 0, nil
+	}
+
+	return false, nil
+}
+
+// remove is a helper function to remove an item waiting in a
+// channel of the queue and return the number of items removed.
+func (c *client) remove(ctx context.Context, member *candidate) (int64, error) {
+	// items pushed for an owner are stored in a sorted set for the owner
+	if c.config.FairShare != nil {
+		keys := []string{ownerKey(member.route, member.owner), ownersKey(member.route)}
+
+		return removeShareScript.Run(ctx, c.Redis, keys, member.item, member.owner).Int64()
+	}
+
+	// items pushed with a priority are stored in a sorted set
+	if c.config.Priority {
+		// https://pkg.go.dev/github.com/redis/go-redis/v9#Client.ZRem
+		return c.Redis.ZRem(ctx, member.route, member.item).Result()
+	}
+
+	// https://pkg.go.dev/github.com/redis/go-redis/v9#Client.LRem
+	return c.Redis.LRem(ctx, member.route, 1, member.item).Result()
+}
+
+// members is a helper function to capture the signed items
+// waiting in a channel of the queue in the order they are popped.
+func (c *client) members(ctx context.Context, channel string) ([]*candidate, error) {
+	// items pushed for an owner are stored in a sorted set for the owner
+	if c.config.FairShare != nil {
+		return c.waiting(ctx, channel)
+	}
+
+	var (
+		items []string
+		err   error
+	)
+
+	// items pushed with a priority are stored in a sorted set
+	if c.config.Priority {
+		items, err = c.Redis.ZRange(ctx, channel, 0, -1).Result()
+	} else {
+		items, err = c.Redis.LRange(ctx, channel, 0, -1).Result()
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	members := []*candidate{}
+
+	for _, item := range items {
+		members = append(members, &candidate{route: channel, item: item})
+	}
+
+	return members, nil
+}
@@ -0,0 +1,137 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/go-vela/server/constants"
+	"github.com/go-vela/types"
+)
+
+// testBuildIDs is a helper function to capture
+// the build IDs for a list of queue items.
+func testBuildIDs(items []*types.Item) []int64 {
+	ids := []int64{}
+
+	for _, item := range items {
+		ids = append(ids, item.Build.GetID())
+	}
+
+	return ids
+}
+
+func TestRedis_Items(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name     string
+		priority bool
+		list     []int64
+		moved    []int64
+	}{
+		{
+			name:     "without priority",
+			priority: false,
+			list:     []int64{1, 2, 3},
+			moved:    []int64{4, 1, 3},
+		},
+		{
+			name:     "with priority",
+			priority: true,
+			list:     []int64{3, 1, 2},
+			moved:    []int64{3, 1, 4},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// setup redis mock
+			_redis, err := NewTest(_signingPrivateKey, _signingPublicKey, "vela", "custom")
+			if err != nil {
+				t.Errorf("unable to create queue service: %v", err)
+			}
+
+			_redis.config.Priority = test.priority
+
+			pushes := []struct {
+				route    string
+				id       int64
+				priority int64
+			}{
+				{route: "vela", id: 1, priority: constants.PriorityDefault},
+				{route: "vela", id: 2, priority: constants.PriorityLow},
+				{route: "vela", id: 3, priority: constants.PriorityHigh},
+				{route: "custom", id: 4, priority: constants.PriorityDefault},
+			}
+
+			for _, push := range pushes {
+				err = _redis.Push(context.Background(), push.route, testPriorityItem(t, push.id, push.priority))
+				if err != nil {
+					t.Errorf("unable to push item to queue: %v", err)
+				}
+			}
+
+			got, err := _redis.List(context.Background(), "vela")
+			if err != nil {
+				t.Errorf("List returned err: %v", err)
+			}
+
+			if !reflect.DeepEqual(testBuildIDs(got), test.list) {
+				t.Errorf("List is %v, want %v", testBuildIDs(got), test.list)
+			}
+
+			removed, err := _redis.Remove(context.Background(), "vela", 2)
+			if err != nil {
+				t.Errorf("Remove returned err: %v", err)
+			}
+
+			if !removed {
+				t.Errorf("Remove should have removed the item for build 2")
+			}
+
+			removed, err = _redis.Remove(context.Background(), "vela", 2)
+			if err != nil {
+				t.Errorf("Remove returned err: %v", err)
+			}
+
+			if removed {
+				t.Errorf("Remove should not have removed a missing item")
+			}
+
+			_, err = _redis.Move(context.Background(), "vela", "vela")
+			if err == nil {
+				t.Errorf("Move to the same route should have returned err")
+			}
+
+			moved, err := _redis.Move(context.Background(), "vela", "custom")
+			if err != nil {
+				t.Errorf("Move returned err: %v", err)
+			}
+
+			if moved != 2 {
+				t.Errorf("Move is %d, want %d", moved, 2)
+			}
+
+			got, err = _redis.List(context.Background(), "custom")
+			if err != nil {
+				t.Errorf("List returned err: %v", err)
+			}
+
+			if !reflect.DeepEqual(testBuildIDs(got), test.moved) {
+				t.Errorf("List after Move is %v, want %v", testBuildIDs(got), test.moved)
+			}
+
+			got, err = _redis.List(context.Background(), "vela")
+			if err != nil {
+				t.Errorf("List returned err: %v", err)
+			}
+
+			if len(got) != 0 {
+				t.Errorf("List after Move is %v, want %v", testBuildIDs(got), []int64{})
+			}
+		})
+	}
+}
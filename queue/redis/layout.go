@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// layout is a helper function to verify the items waiting in the routes
+// were pushed with the same priority and fair share configuration as the
+// client, since the items are stored in lists without a priority, in sorted
+// sets with a priority and in sorted sets for each owner with fair share.
+//
+// Toggling the configuration with items waiting would otherwise fail every
+// push and pop for the route with a WRONGTYPE error or leave the items
+// waiting in keys the client no longer pops from.
+func (c *client) layout(ctx context.Context, routes []string) error {
+	for _, route := range routes {
+		// https://pkg.go.dev/github.com/redis/go-redis/v9#Client.Type
+		kind, err := c.Redis.Type(ctx, route).Result()
+		if err != nil {
+			return err
+		}
+
+		// https://pkg.go.dev/github.com/redis/go-redis/v9#Client.SCard
+		owners, err := c.Redis.SCard(ctx, ownersKey(route)).Result()
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case c.config.FairShare != nil && kind != "none":
+			return fmt.Errorf("queue route %s has items pushed without queue fair share: drain the route before enabling queue fair share", route)
+		case c.config.FairShare == nil && owners > 0:
+			return fmt.Errorf("queue route %s has items pushed with queue fair share: drain the route before disabling queue fair share", route)
+		case c.config.Priority && kind == "list":
+			return fmt.Errorf("queue route %s has items pushed without queue priority: drain the route before enabling queue priority", route)
+		case !c.config.Priority && kind == "zset":
+			return fmt.Errorf("queue route %s has items pushed with queue priority: drain the route before disabling queue priority", route)
+		}
+	}
+
+	return nil
+}
+
+// wrongType is a helper function to explain the error returned
+// by Redis for a route holding items pushed with a different
+// priority configuration than the client.
+func wrongType(err error) error {
+	if err == nil || !strings.HasPrefix(err.Error(), "WRONGTYPE") {
+		return err
+	}
+
+	return fmt.Errorf("queue route has items pushed with a different queue priority configuration: %w", err)
+}
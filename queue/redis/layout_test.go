@@ -0,0 +1,128 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/go-vela/server/internal/fairshare"
+)
+
+func TestRedis_layout(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name          string
+		failure       bool
+		push          bool
+		pushPriority  bool
+		pushFairShare bool
+		priority      bool
+		fairShare     bool
+	}{
+		{
+			name:    "empty route",
+			failure: false,
+		},
+		{
+			name:    "list without priority",
+			failure: false,
+			push:    true,
+		},
+		{
+			name:     "list with priority",
+			failure:  true,
+			push:     true,
+			priority: true,
+		},
+		{
+			name:         "sorted set without priority",
+			failure:      true,
+			push:         true,
+			pushPriority: true,
+		},
+		{
+			name:      "list with fair share",
+			failure:   true,
+			push:      true,
+			fairShare: true,
+		},
+		{
+			name:          "owners without fair share",
+			failure:       true,
+			push:          true,
+			pushFairShare: true,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// setup redis mock
+			_redis, err := NewTest(_signingPrivateKey, _signingPublicKey, "vela")
+			if err != nil {
+				t.Errorf("unable to create queue service: %v", err)
+			}
+
+			// push an item with the previous configuration
+			if test.push {
+				_redis.config.Priority = test.pushPriority
+
+				if test.pushFairShare {
+					_redis.config.FairShare = &fairshare.Policy{Mode: fairshare.ModeOrg}
+				}
+
+				err = _redis.Push(context.Background(), "vela", testOwnerItem(t, 1, "github"))
+				if err != nil {
+					t.Errorf("unable to push item to queue: %v", err)
+				}
+			}
+
+			_redis.config.Priority = test.priority
+			_redis.config.FairShare = nil
+
+			if test.fairShare {
+				_redis.config.FairShare = &fairshare.Policy{Mode: fairshare.ModeOrg}
+			}
+
+			err = _redis.layout(context.Background(), []string{"vela"})
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("layout should have returned err")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("layout returned err: %v", err)
+			}
+		})
+	}
+}
+
+func TestRedis_Push_WrongType(t *testing.T) {
+	// setup redis mock
+	_redis, err := NewTest(_signingPrivateKey, _signingPublicKey, "vela")
+	if err != nil {
+		t.Errorf("unable to create queue service: %v", err)
+	}
+
+	// push an item with a priority
+	_redis.config.Priority = true
+
+	err = _redis.Push(context.Background(), "vela", testOwnerItem(t, 1, "github"))
+	if err != nil {
+		t.Errorf("unable to push item to queue: %v", err)
+	}
+
+	// run test
+	_redis.config.Priority = false
+
+	err = _redis.Push(context.Background(), "vela", testOwnerItem(t, 2, "github"))
+	if err == nil || !strings.Contains(err.Error(), "different queue priority configuration") {
+		t.Errorf("Push returned err %v, want err for the priority configuration", err)
+	}
+}
@@ -0,0 +1,260 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-vela/types"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// leasesKey is the sorted set of leased items scored by lease expiration.
+	leasesKey = "vela:queue:leases"
+	// leaseRoutesKey is the hash of leased items to the route they were popped from.
+	leaseRoutesKey = "vela:queue:lease:routes"
+	// leaseWorkersKey is the hash of leased items to the worker that popped them.
+	leaseWorkersKey = "vela:queue:lease:workers"
+	// leaseScoresKey is the hash of leased items to the priority score they were popped with.
+	leaseScoresKey = "vela:queue:lease:scores"
+	// leaseOwnersKey is the hash of leased items to the owner they were popped for when sharing the workers.
+	leaseOwnersKey = "vela:queue:lease:owners"
+	// processingPrefix is the prefix for the processing list of leased items for a worker.
+	processingPrefix = "vela:queue:processing:"
+)
+
+var (
+	// releaseScript atomically removes a leased item from the processing list
+	// for the worker and, when requested, pushes it back to its route with
+	// the priority score it was popped with or to the front of the route.
+	// Items popped for an owner are pushed back to the sorted set for
+	// the owner in the route.
+	//
+	// KEYS: processing list, leases, lease routes, lease workers, lease scores, lease owners
+	// ARGV: item, requeue, route prefix
+	releaseScript = redis.NewScript(`
+local route = redis.call('HGET', KEYS[3], ARGV[1])
+local score = redis.call('HGET', KEYS[5], ARGV[1])
+local owner = redis.call('HGET', KEYS[6], ARGV[1])
+local removed = redis.call('LREM', KEYS[1], 1, ARGV[1])
+redis.call('ZREM', KEYS[2], ARGV[1])
+redis.call('HDEL', KEYS[3], ARGV[1])
+redis.call('HDEL', KEYS[4], ARGV[1])
+redis.call('HDEL', KEYS[5], ARGV[1])
+redis.call('HDEL', KEYS[6], ARGV[1])
+if removed > 0 and ARGV[2] == '1' and route then
+	if owner then
+		redis.call('ZADD', ARGV[3] .. route .. ':owner:' .. owner, score, ARGV[1])
+		redis.call('SADD', ARGV[3] .. route .. ':owners', owner)
+	elseif score then
+		redis.call('ZADD', route, score, ARGV[1])
+	else
+		redis.call('LPUSH', route, ARGV[1])
+	end
+end
+return removed
+`)
+
+	// extendScript atomically updates the expiration for an existing lease.
+	//
+	// KEYS: leases
+	// ARGV: item, lease expiration
+	extendScript = redis.NewScript(`
+if not redis.call('ZSCORE', KEYS[1], ARGV[1]) then
+	return 0
+end
+redis.call('ZADD', KEYS[1], ARGV[2], ARGV[1])
+return 1
+`)
+
+	// requeueScript atomically moves every item with an expired lease
+	// from the processing list for its worker back to its route. Items
+	// popped for an owner are moved back to the sorted set for the owner
+	// in the route, and the build stays in-flight for the owner until it
+	// is popped again and done.
+	//
+	// KEYS: leases, lease routes, lease workers, lease scores, lease owners
+	// ARGV: now, processing prefix, route prefix
+	requeueScript = redis.NewScript(`
+local items = redis.call('ZRANGEBYSCORE', KEYS[1], '-inf', ARGV[1])
+for _, item in ipairs(items) do
+	local route = redis.call('HGET', KEYS[2], item)
+	local worker = redis.call('HGET', KEYS[3], item)
+	local score = redis.call('HGET', KEYS[4], item)
+	local owner = redis.call('HGET', KEYS[5], item)
+	if worker then
+		redis.call('LREM', ARGV[2] .. worker, 1, item)
+	end
+	if route and owner then
+		redis.call('ZADD', ARGV[3] .. route .. ':owner:' .. owner, score, item)
+		redis.call('SADD', ARGV[3] .. route .. ':owners', owner)
+	elseif route and score then
+		redis.call('ZADD', route, score, item)
+	elseif route then
+		redis.call('LPUSH', route, item)
+	end
+	redis.call('ZREM', KEYS[1], item)
+	redis.call('HDEL', KEYS[2], item)
+	redis.call('HDEL', KEYS[3], item)
+	redis.call('HDEL', KEYS[4], item)
+	redis.call('HDEL', KEYS[5], item)
+end
+return #items
+`)
+)
+
+// Ack acknowledges a leased item popped from the queue
+// so it is removed from the processing list for the worker.
+func (c *client) Ack(ctx context.Context, item *types.Item) error {
+	c.Logger.Tracef("acking item for build %d from queue", item.Build.GetID())
+
+	return c.release(ctx, item, false)
+}
+
+// Nack rejects a leased item popped from the queue so
+// it is pushed back to the front of the route it came from.
+func (c *client) Nack(ctx context.Context, item *types.Item) error {
+	c.Logger.Tracef("nacking item for build %d from queue", item.Build.GetID())
+
+	return c.release(ctx, item, true)
+}
+
+// Extend renews the lease for an item popped from the queue.
+func (c *client) Extend(ctx context.Context, item *types.Item) error {
+	c.Logger.Tracef("extending lease of item for build %d from queue", item.Build.GetID())
+
+	// items are not leased so there is nothing to extend
+	if c.config.Lease == 0 {
+		return nil
+	}
+
+	signed, ok := c.leasedItem(item, false)
+	if !ok {
+		return fmt.Errorf("no lease found for item for build %d", item.Build.GetID())
+	}
+
+	expiration := time.Now().Add(c.config.Lease).Unix()
+
+	extended, err := extendScript.Run(ctx, c.Redis, []string{leasesKey}, signed, expiration).Int()
+	if err != nil {
+		return err
+	}
+
+	if extended == 0 {
+		// the lease already expired so the item was requeued
+		c.leasedItem(item, true)
+
+		return fmt.Errorf("lease expired for item for build %d", item.Build.GetID())
+	}
+
+	return nil
+}
+
+// Leased outputs the builds popped off the queue
+// with a lease that was not acked or nacked.
+func (c *client) Leased(ctx context.Context) ([]int64, error) {
+	c.Logger.Trace("listing builds leased from queue")
+
+	builds := []int64{}
+
+	// items are not leased so there is nothing to list
+	if c.config.Lease == 0 {
+		return builds, nil
+	}
+
+	// https://pkg.go.dev/github.com/redis/go-redis/v9#Client.ZRange
+	items, err := c.Redis.ZRange(ctx, leasesKey, 0, -1).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, signed := range items {
+		item, err := c.open([]byte(signed))
+		if err != nil {
+			c.Logger.Warnf("unable to open item leased from queue: %v", err)
+
+			continue
+		}
+
+		builds = append(builds, item.Build.GetID())
+	}
+
+	return builds, nil
+}
+
+// RequeueExpired pushes items with an expired lease back to
+// the route they were popped from and returns the total.
+func (c *client) RequeueExpired(ctx context.Context) (int64, error) {
+	c.Logger.Trace("requeueing items with expired leases in queue")
+
+	// items are not leased so there is nothing to requeue
+	if c.config.Lease == 0 {
+		return 0, nil
+	}
+
+	now := time.Now().Unix()
+
+	keys := []string{leasesKey, leaseRoutesKey, leaseWorkersKey, leaseScoresKey, leaseOwnersKey}
+
+	return requeueScript.Run(ctx, c.Redis, keys, now, processingPrefix, routePrefix).Int64()
+}
+
+// release is a helper function to remove a leased item from
+// the processing list for the worker and optionally requeue it.
+func (c *client) release(ctx context.Context, item *types.Item, requeue bool) error {
+	// items are not leased so there is nothing to release
+	if c.config.Lease == 0 {
+		return nil
+	}
+
+	signed, ok := c.leasedItem(item, true)
+	if !ok {
+		return fmt.Errorf("no lease found for item for build %d", item.Build.GetID())
+	}
+
+	flag := "0"
+	if requeue {
+		flag = "1"
+	}
+
+	keys := []string{c.processingKey(), leasesKey, leaseRoutesKey, leaseWorkersKey, leaseScoresKey, leaseOwnersKey}
+
+	removed, err := releaseScript.Run(ctx, c.Redis, keys, signed, flag, routePrefix).Int()
+	if err != nil {
+		return err
+	}
+
+	if removed == 0 {
+		return fmt.Errorf("lease expired for item for build %d", item.Build.GetID())
+	}
+
+	// the requeued build is no longer in-flight for its owner
+	if requeue {
+		return c.Done(ctx, item.Build.GetID())
+	}
+
+	return nil
+}
+
+// leasedItem is a helper function to capture the signed
+// item leased by the client and optionally forget it.
+func (c *client) leasedItem(item *types.Item, forget bool) ([]byte, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	signed, ok := c.leased[item.Build.GetID()]
+	if ok && forget {
+		delete(c.leased, item.Build.GetID())
+	}
+
+	return signed, ok
+}
+
+// processingKey is a helper function to create
+// the processing list key for the worker.
+func (c *client) processingKey() string {
+	return processingPrefix + c.config.Worker
+}
@@ -0,0 +1,320 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/go-vela/types"
+	"github.com/redis/go-redis/v9"
+	"gopkg.in/square/go-jose.v2/json"
+)
+
+// testLeaseClient is a helper function to create a Redis client
+// with leases enabled and an item pushed to the vela channel.
+func testLeaseClient(t *testing.T) (*client, *types.Item) {
+	t.Helper()
+
+	// use global variables in redis_test.go
+	_item := &types.Item{
+		Build: _build,
+		Repo:  _repo,
+		User:  _user,
+	}
+
+	// setup queue item
+	bytes, err := json.Marshal(_item)
+	if err != nil {
+		t.Fatalf("unable to marshal queue item: %v", err)
+	}
+
+	// setup redis mock
+	_redis, err := NewTest(_signingPrivateKey, _signingPublicKey, "vela", "custom")
+	if err != nil {
+		t.Fatalf("unable to create queue service: %v", err)
+	}
+
+	_redis.config.Lease = time.Minute
+	_redis.config.Worker = "worker_0"
+	_redis.config.Timeout = time.Second
+
+	err = _redis.Push(context.Background(), "custom", bytes)
+	if err != nil {
+		t.Fatalf("unable to push item to queue: %v", err)
+	}
+
+	return _redis, _item
+}
+
+func TestRedis_Lease_Pop(t *testing.T) {
+	// setup types
+	_redis, _item := testLeaseClient(t)
+
+	// run test
+	got, err := _redis.Pop(context.Background(), nil)
+	if err != nil {
+		t.Errorf("Pop returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, _item) {
+		t.Errorf("Pop is %v, want %v", got, _item)
+	}
+
+	processing, err := _redis.Redis.LLen(context.Background(), "vela:queue:processing:worker_0").Result()
+	if err != nil {
+		t.Errorf("unable to get processing list length: %v", err)
+	}
+
+	if processing != 1 {
+		t.Errorf("processing list length is %d, want 1", processing)
+	}
+
+	leases, err := _redis.Redis.ZCard(context.Background(), leasesKey).Result()
+	if err != nil {
+		t.Errorf("unable to get lease count: %v", err)
+	}
+
+	if leases != 1 {
+		t.Errorf("lease count is %d, want 1", leases)
+	}
+
+	// queue is empty so the pop times out
+	got, err = _redis.Pop(context.Background(), nil)
+	if err != nil {
+		t.Errorf("Pop returned err: %v", err)
+	}
+
+	if got != nil {
+		t.Errorf("Pop is %v, want nil", got)
+	}
+}
+
+func TestRedis_Lease_Ack(t *testing.T) {
+	// setup types
+	_redis, _ := testLeaseClient(t)
+
+	item, err := _redis.Pop(context.Background(), nil)
+	if err != nil {
+		t.Errorf("Pop returned err: %v", err)
+	}
+
+	// run test
+	err = _redis.Ack(context.Background(), item)
+	if err != nil {
+		t.Errorf("Ack returned err: %v", err)
+	}
+
+	processing, _ := _redis.Redis.LLen(context.Background(), "vela:queue:processing:worker_0").Result()
+	if processing != 0 {
+		t.Errorf("processing list length is %d, want 0", processing)
+	}
+
+	length, _ := _redis.Redis.LLen(context.Background(), "custom").Result()
+	if length != 0 {
+		t.Errorf("queue length is %d, want 0", length)
+	}
+
+	// item was already acked
+	err = _redis.Ack(context.Background(), item)
+	if err == nil {
+		t.Errorf("Ack should have returned err")
+	}
+}
+
+func TestRedis_Lease_Nack(t *testing.T) {
+	// setup types
+	_redis, _item := testLeaseClient(t)
+
+	item, err := _redis.Pop(context.Background(), nil)
+	if err != nil {
+		t.Errorf("Pop returned err: %v", err)
+	}
+
+	// run test
+	err = _redis.Nack(context.Background(), item)
+	if err != nil {
+		t.Errorf("Nack returned err: %v", err)
+	}
+
+	processing, _ := _redis.Redis.LLen(context.Background(), "vela:queue:processing:worker_0").Result()
+	if processing != 0 {
+		t.Errorf("processing list length is %d, want 0", processing)
+	}
+
+	// item is available to pop again from the same route
+	got, err := _redis.Pop(context.Background(), []string{"custom"})
+	if err != nil {
+		t.Errorf("Pop returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, _item) {
+		t.Errorf("Pop is %v, want %v", got, _item)
+	}
+}
+
+func TestRedis_Lease_Extend(t *testing.T) {
+	// setup types
+	_redis, _ := testLeaseClient(t)
+
+	item, err := _redis.Pop(context.Background(), nil)
+	if err != nil {
+		t.Errorf("Pop returned err: %v", err)
+	}
+
+	before, _ := _redis.Redis.ZScore(context.Background(), leasesKey, string(_redis.leased[item.Build.GetID()])).Result()
+
+	_redis.config.Lease = time.Hour
+
+	// run test
+	err = _redis.Extend(context.Background(), item)
+	if err != nil {
+		t.Errorf("Extend returned err: %v", err)
+	}
+
+	after, _ := _redis.Redis.ZScore(context.Background(), leasesKey, string(_redis.leased[item.Build.GetID()])).Result()
+	if after <= before {
+		t.Errorf("Extend lease is %v, want after %v", after, before)
+	}
+
+	// lease expired and the item was requeued
+	_, err = _redis.Redis.ZAdd(context.Background(), leasesKey, redis.Z{Score: 0, Member: _redis.leased[item.Build.GetID()]}).Result()
+	if err != nil {
+		t.Errorf("unable to expire lease: %v", err)
+	}
+
+	_, err = _redis.RequeueExpired(context.Background())
+	if err != nil {
+		t.Errorf("RequeueExpired returned err: %v", err)
+	}
+
+	err = _redis.Extend(context.Background(), item)
+	if err == nil {
+		t.Errorf("Extend should have returned err")
+	}
+}
+
+func TestRedis_Lease_Leased(t *testing.T) {
+	// setup types
+	_redis, _item := testLeaseClient(t)
+
+	// run test
+	got, err := _redis.Leased(context.Background())
+	if err != nil {
+		t.Errorf("Leased returned err: %v", err)
+	}
+
+	if len(got) != 0 {
+		t.Errorf("Leased is %v, want empty", got)
+	}
+
+	item, err := _redis.Pop(context.Background(), nil)
+	if err != nil {
+		t.Errorf("Pop returned err: %v", err)
+	}
+
+	got, err = _redis.Leased(context.Background())
+	if err != nil {
+		t.Errorf("Leased returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, []int64{_item.Build.GetID()}) {
+		t.Errorf("Leased is %v, want %v", got, []int64{_item.Build.GetID()})
+	}
+
+	err = _redis.Ack(context.Background(), item)
+	if err != nil {
+		t.Errorf("Ack returned err: %v", err)
+	}
+
+	got, err = _redis.Leased(context.Background())
+	if err != nil {
+		t.Errorf("Leased returned err: %v", err)
+	}
+
+	if len(got) != 0 {
+		t.Errorf("Leased is %v, want empty", got)
+	}
+}
+
+func TestRedis_Lease_RequeueExpired(t *testing.T) {
+	// setup types
+	_redis, _item := testLeaseClient(t)
+
+	_redis.config.Lease = time.Millisecond
+
+	_, err := _redis.Pop(context.Background(), nil)
+	if err != nil {
+		t.Errorf("Pop returned err: %v", err)
+	}
+
+	// wait for the lease to expire
+	time.Sleep(time.Second)
+
+	// run test
+	got, err := _redis.RequeueExpired(context.Background())
+	if err != nil {
+		t.Errorf("RequeueExpired returned err: %v", err)
+	}
+
+	if got != 1 {
+		t.Errorf("RequeueExpired is %d, want 1", got)
+	}
+
+	processing, _ := _redis.Redis.LLen(context.Background(), "vela:queue:processing:worker_0").Result()
+	if processing != 0 {
+		t.Errorf("processing list length is %d, want 0", processing)
+	}
+
+	item, err := _redis.Pop(context.Background(), []string{"custom"})
+	if err != nil {
+		t.Errorf("Pop returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(item, _item) {
+		t.Errorf("Pop is %v, want %v", item, _item)
+	}
+}
+
+func TestRedis_Lease_Disabled(t *testing.T) {
+	// setup types
+	_redis, _item := testLeaseClient(t)
+
+	_redis.config.Lease = 0
+
+	item, err := _redis.Pop(context.Background(), nil)
+	if err != nil {
+		t.Errorf("Pop returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(item, _item) {
+		t.Errorf("Pop is %v, want %v", item, _item)
+	}
+
+	// run tests
+	err = _redis.Ack(context.Background(), item)
+	if err != nil {
+		t.Errorf("Ack returned err: %v", err)
+	}
+
+	err = _redis.Nack(context.Background(), item)
+	if err != nil {
+		t.Errorf("Nack returned err: %v", err)
+	}
+
+	err = _redis.Extend(context.Background(), item)
+	if err != nil {
+		t.Errorf("Extend returned err: %v", err)
+	}
+
+	total, err := _redis.RequeueExpired(context.Background())
+	if err != nil {
+		t.Errorf("RequeueExpired returned err: %v", err)
+	}
+
+	if total != 0 {
+		t.Errorf("RequeueExpired is %d, want 0", total)
+	}
+}
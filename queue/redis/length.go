@@ -13,7 +13,49 @@ func (c *client) Length(ctx context.Context) (int64, error) {
 	total := int64(0)
 
 	for _, channel := range c.config.Channels {
-		items, err := c.Redis.LLen(ctx, channel).Result()
+		// items pushed for an owner are stored in a sorted set for the owner
+		if c.config.FairShare != nil {
+			items, err := c.shareLength(ctx, channel)
+			if err != nil {
+				return 0, err
+			}
+
+			total += items
+
+			continue
+		}
+
+		// items pushed with a priority are stored in a sorted set
+		length := c.Redis.LLen
+		if c.config.Priority {
+			length = c.Redis.ZCard
+		}
+
+		items, err := length(ctx, channel).Result()
+		if err != nil {
+			return 0, err
+		}
+
+		total += items
+	}
+
+	return total, nil
+}
+
+// shareLength is a helper function to tally the
+// items waiting in a channel for every owner.
+func (c *client) shareLength(ctx context.Context, channel string) (int64, error) {
+	// https://pkg.go.dev/github.com/redis/go-redis/v9#Client.SMembers
+	owners, err := c.Redis.SMembers(ctx, ownersKey(channel)).Result()
+	if err != nil {
+		return 0, err
+	}
+
+	total := int64(0)
+
+	for _, owner := range owners {
+		// https://pkg.go.dev/github.com/redis/go-redis/v9#Client.ZCard
+		items, err := c.Redis.ZCard(ctx, ownerKey(channel, owner)).Result()
 		if err != nil {
 			return 0, err
 		}
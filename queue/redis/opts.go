@@ -6,7 +6,11 @@ import (
 	"encoding/base64"
 	"errors"
 	"fmt"
+	"os"
 	"time"
+
+	"github.com/go-vela/server/internal/fairshare"
+	"github.com/go-vela/server/internal/keyset"
 )
 
 // ClientOpt represents a configuration option to initialize the queue client for Redis.
@@ -70,6 +74,108 @@ func WithTimeout(timeout time.Duration) ClientOpt {
 	}
 }
 
+// WithEncryptionKey sets the secret key for encrypting items in the queue client for Redis.
+func WithEncryptionKey(key string) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring encryption key in redis queue client")
+
+		// items are only signed when no key is provided
+		if len(key) == 0 {
+			return nil
+		}
+
+		decoded, err := base64.StdEncoding.DecodeString(key)
+		if err != nil {
+			return err
+		}
+
+		if len(decoded) != 32 {
+			return errors.New("no valid queue encryption key provided, decoded key must be 32 bytes")
+		}
+
+		// set the queue encryption key in the redis client
+		c.config.EncryptionKey = new([32]byte)
+		copy(c.config.EncryptionKey[:], decoded)
+
+		return nil
+	}
+}
+
+// WithEncryptionMigration sets the encryption migration in the queue client for Redis.
+func WithEncryptionMigration(migration bool) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring encryption migration in redis queue client")
+
+		// set the queue encryption migration in the redis client
+		c.config.EncryptionMigration = migration
+
+		return nil
+	}
+}
+
+// WithLease sets the lease for popped items in the queue client for Redis.
+func WithLease(lease time.Duration) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring lease in redis queue client")
+
+		// check if the lease provided is negative
+		if lease < 0 {
+			return fmt.Errorf("invalid Redis queue lease provided: %s", lease)
+		}
+
+		// set the queue lease in the redis client
+		c.config.Lease = lease
+
+		return nil
+	}
+}
+
+// WithWorker sets the worker name for the processing list in the queue client for Redis.
+func WithWorker(worker string) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring worker in redis queue client")
+
+		// use the hostname when no worker name is provided
+		if len(worker) == 0 {
+			hostname, err := os.Hostname()
+			if err != nil {
+				return fmt.Errorf("unable to capture hostname for Redis queue worker: %w", err)
+			}
+
+			worker = hostname
+		}
+
+		// set the queue worker in the redis client
+		c.config.Worker = worker
+
+		return nil
+	}
+}
+
+// WithPriority sets the priority mode in the queue client for Redis.
+func WithPriority(priority bool) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring priority mode in redis queue client")
+
+		// set the queue priority mode in the redis client
+		c.config.Priority = priority
+
+		return nil
+	}
+}
+
+// WithFairShare sets the fair share policy in the queue client for Redis.
+func WithFairShare(policy *fairshare.Policy) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring fair share policy in redis queue client")
+
+		// set the queue fair share policy in the redis client
+		c.config.FairShare = policy
+
+		return nil
+	}
+}
+
 // WithPrivateKey sets the private key in the queue client for Redis.
 //
 //nolint:dupl // ignore similar code
@@ -149,3 +255,22 @@ func WithPublicKey(key string) ClientOpt {
 		return nil
 	}
 }
+
+// WithPublicKeys sets the keys, other than the active public key,
+// for opening items in the queue client for Redis.
+func WithPublicKeys(keys ...string) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring public keys in redis queue client")
+
+		// parse the provided keys as <id>=<base64 public key>
+		publicKeys, err := keyset.Parse(keys)
+		if err != nil {
+			return err
+		}
+
+		// set the queue public keys in the redis client
+		c.config.PublicKeys = publicKeys
+
+		return nil
+	}
+}
@@ -5,11 +5,15 @@ package redis
 import (
 	"encoding/base64"
 	"fmt"
+	"os"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/Bose/minisentinel"
 	"github.com/alicebob/miniredis/v2"
+	"github.com/go-vela/server/internal/fairshare"
+	"github.com/go-vela/server/internal/keyset"
 )
 
 func TestRedis_ClientOpt_WithAddress(t *testing.T) {
@@ -315,3 +319,344 @@ func TestRedis_ClientOpt_WithSigningPublicKey(t *testing.T) {
 		}
 	}
 }
+
+func TestRedis_ClientOpt_WithPublicKeys(t *testing.T) {
+	// setup tests
+	// create a local fake redis instance
+	//
+	// https://pkg.go.dev/github.com/alicebob/miniredis/v2#Run
+	_redis, err := miniredis.Run()
+	if err != nil {
+		t.Errorf("unable to create miniredis instance: %v", err)
+	}
+	defer _redis.Close()
+
+	want, _ := keyset.Parse([]string{"previous=CuS+EQAzofbk3tVFS3bt5f2tIb4YiJJC4nVMFQYQElg="})
+
+	tests := []struct {
+		failure bool
+		keys    []string
+		want    []*keyset.Key
+	}{
+		{ //valid keys input
+			failure: false,
+			keys:    []string{"previous=CuS+EQAzofbk3tVFS3bt5f2tIb4YiJJC4nVMFQYQElg="},
+			want:    want,
+		},
+		{ //empty keys input
+			failure: false,
+			keys:    nil,
+			want:    []*keyset.Key{},
+		},
+		{ //missing key id input
+			failure: true,
+			keys:    []string{"CuS+EQAzofbk3tVFS3bt5f2tIb4YiJJC4nVMFQYQElg="},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		_service, err := New(
+			WithAddress(fmt.Sprintf("redis://%s", _redis.Addr())),
+			WithPublicKeys(test.keys...),
+		)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("WithPublicKeys should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("WithPublicKeys returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(_service.config.PublicKeys, test.want) {
+			t.Errorf("WithPublicKeys is %v, want %v", _service.config.PublicKeys, test.want)
+		}
+	}
+}
+
+func TestRedis_ClientOpt_WithEncryptionKey(t *testing.T) {
+	// setup tests
+	// create a local fake redis instance
+	//
+	// https://pkg.go.dev/github.com/alicebob/miniredis/v2#Run
+	_redis, err := miniredis.Run()
+	if err != nil {
+		t.Errorf("unable to create miniredis instance: %v", err)
+	}
+	defer _redis.Close()
+
+	tests := []struct {
+		failure bool
+		key     string
+		want    string
+	}{
+		{ //valid key input
+			failure: false,
+			key:     "DXsJkoTSkHlG26d75LyHJG+KQsXPr8VKPpmH/78zmko=",
+			want:    "DXsJkoTSkHlG26d75LyHJG+KQsXPr8VKPpmH/78zmko=",
+		},
+		{ //empty key input
+			failure: false,
+			key:     "",
+			want:    "",
+		},
+		{ //invalid base64 encoded input
+			failure: true,
+			key:     "abc123",
+		},
+		{ //invalid key length input
+			failure: true,
+			key:     "Zm9vYmFy",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		_service, err := New(
+			WithAddress(fmt.Sprintf("redis://%s", _redis.Addr())),
+			WithEncryptionKey(test.key),
+		)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("WithEncryptionKey should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("WithEncryptionKey returned err: %v", err)
+		}
+
+		got := ""
+		if _service.config.EncryptionKey != nil {
+			got = base64.StdEncoding.EncodeToString(_service.config.EncryptionKey[:])
+		}
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("WithEncryptionKey is %v, want %v", got, test.want)
+		}
+	}
+}
+
+func TestRedis_ClientOpt_WithEncryptionMigration(t *testing.T) {
+	// setup tests
+	// create a local fake redis instance
+	//
+	// https://pkg.go.dev/github.com/alicebob/miniredis/v2#Run
+	_redis, err := miniredis.Run()
+	if err != nil {
+		t.Errorf("unable to create miniredis instance: %v", err)
+	}
+	defer _redis.Close()
+
+	tests := []struct {
+		migration bool
+		want      bool
+	}{
+		{
+			migration: true,
+			want:      true,
+		},
+		{
+			migration: false,
+			want:      false,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		_service, err := New(
+			WithAddress(fmt.Sprintf("redis://%s", _redis.Addr())),
+			WithEncryptionMigration(test.migration),
+		)
+
+		if err != nil {
+			t.Errorf("WithEncryptionMigration returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(_service.config.EncryptionMigration, test.want) {
+			t.Errorf("WithEncryptionMigration is %v, want %v", _service.config.EncryptionMigration, test.want)
+		}
+	}
+}
+
+func TestRedis_ClientOpt_WithLease(t *testing.T) {
+	// setup tests
+	// create a local fake redis instance
+	//
+	// https://pkg.go.dev/github.com/alicebob/miniredis/v2#Run
+	_redis, err := miniredis.Run()
+	if err != nil {
+		t.Errorf("unable to create miniredis instance: %v", err)
+	}
+	defer _redis.Close()
+
+	tests := []struct {
+		failure bool
+		lease   time.Duration
+		want    time.Duration
+	}{
+		{
+			failure: false,
+			lease:   5 * time.Minute,
+			want:    5 * time.Minute,
+		},
+		{
+			failure: false,
+			lease:   0,
+			want:    0,
+		},
+		{
+			failure: true,
+			lease:   -1 * time.Minute,
+			want:    0,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		_service, err := New(
+			WithAddress(fmt.Sprintf("redis://%s", _redis.Addr())),
+			WithLease(test.lease),
+		)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("WithLease should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("WithLease returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(_service.config.Lease, test.want) {
+			t.Errorf("WithLease is %v, want %v", _service.config.Lease, test.want)
+		}
+	}
+}
+
+func TestRedis_ClientOpt_WithWorker(t *testing.T) {
+	// setup tests
+	// create a local fake redis instance
+	//
+	// https://pkg.go.dev/github.com/alicebob/miniredis/v2#Run
+	_redis, err := miniredis.Run()
+	if err != nil {
+		t.Errorf("unable to create miniredis instance: %v", err)
+	}
+	defer _redis.Close()
+
+	hostname, err := os.Hostname()
+	if err != nil {
+		t.Errorf("unable to capture hostname: %v", err)
+	}
+
+	tests := []struct {
+		worker string
+		want   string
+	}{
+		{
+			worker: "worker_0",
+			want:   "worker_0",
+		},
+		{
+			worker: "",
+			want:   hostname,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		_service, err := New(
+			WithAddress(fmt.Sprintf("redis://%s", _redis.Addr())),
+			WithWorker(test.worker),
+		)
+
+		if err != nil {
+			t.Errorf("WithWorker returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(_service.config.Worker, test.want) {
+			t.Errorf("WithWorker is %v, want %v", _service.config.Worker, test.want)
+		}
+	}
+}
+
+func TestRedis_ClientOpt_WithPriority(t *testing.T) {
+	// setup tests
+	// create a local fake redis instance
+	//
+	// https://pkg.go.dev/github.com/alicebob/miniredis/v2#Run
+	_redis, err := miniredis.Run()
+	if err != nil {
+		t.Errorf("unable to create miniredis instance: %v", err)
+	}
+	defer _redis.Close()
+
+	tests := []struct {
+		priority bool
+		want     bool
+	}{
+		{
+			priority: true,
+			want:     true,
+		},
+		{
+			priority: false,
+			want:     false,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		_service, err := New(
+			WithAddress(fmt.Sprintf("redis://%s", _redis.Addr())),
+			WithPriority(test.priority),
+		)
+
+		if err != nil {
+			t.Errorf("WithPriority returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(_service.config.Priority, test.want) {
+			t.Errorf("WithPriority is %v, want %v", _service.config.Priority, test.want)
+		}
+	}
+}
+
+func TestRedis_ClientOpt_WithFairShare(t *testing.T) {
+	// setup tests
+	// create a local fake redis instance
+	//
+	// https://pkg.go.dev/github.com/alicebob/miniredis/v2#Run
+	_redis, err := miniredis.Run()
+	if err != nil {
+		t.Errorf("unable to create miniredis instance: %v", err)
+	}
+	defer _redis.Close()
+
+	_policy := &fairshare.Policy{Mode: fairshare.ModeOrg}
+
+	// run test
+	_service, err := New(
+		WithAddress(fmt.Sprintf("redis://%s", _redis.Addr())),
+		WithFairShare(_policy),
+	)
+	if err != nil {
+		t.Errorf("WithFairShare returned err: %v", err)
+	}
+
+	if _service.config.FairShare != _policy {
+		t.Errorf("WithFairShare is %v, want %v", _service.config.FairShare, _policy)
+	}
+}
@@ -6,10 +6,12 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"time"
 
+	"github.com/go-vela/server/internal/keyset"
 	"github.com/go-vela/types"
 	"github.com/redis/go-redis/v9"
-	"golang.org/x/crypto/nacl/sign"
 )
 
 // Pop grabs an item from the specified channel off the queue.
@@ -26,6 +28,11 @@ func (c *client) Pop(ctx context.Context, routes []string) (*types.Item, error)
 		channels = c.config.Channels
 	}
 
+	// check if items popped from the queue are leased, prioritized or shared
+	if c.config.Lease > 0 || c.config.Priority || c.config.FairShare != nil {
+		return c.poll(ctx, channels)
+	}
+
 	// build a redis queue command to pop an item from queue
 	//
 	// https://pkg.go.dev/github.com/go-redis/redis?tab=doc#Client.BLPop
@@ -41,20 +48,132 @@ func (c *client) Pop(ctx context.Context, routes []string) (*types.Item, error)
 			return nil, nil
 		}
 
-		return nil, err
+		return nil, wrongType(err)
 	}
 
 	// extract signed item from pop results
-	signed := []byte(result[1])
+	return c.open([]byte(result[1]))
+}
 
-	var opened, out []byte
+// popScript atomically pops the next item from the routes. With priorities
+// the item with the lowest score across all routes is popped, otherwise the
+// item at the front of the first non-empty route is popped. When a lease is
+// provided the item is moved to the processing list for the worker.
+//
+// KEYS: processing list, leases, lease routes, lease workers, lease scores, routes...
+// ARGV: lease expiration or empty without a lease, worker, priority
+var popScript = redis.NewScript(`
+local route, item, score
+if ARGV[3] == '1' then
+	for i = 6, #KEYS do
+		local head = redis.call('ZRANGE', KEYS[i], 0, 0, 'WITHSCORES')
+		if head[1] and (not score or tonumber(head[2]) < tonumber(score)) then
+			route, item, score = KEYS[i], head[1], head[2]
+		end
+	end
+	if item then
+		redis.call('ZREM', route, item)
+	end
+else
+	for i = 6, #KEYS do
+		item = redis.call('LPOP', KEYS[i])
+		if item then
+			route = KEYS[i]
+			break
+		end
+	end
+end
+if not item then
+	return false
+end
+if ARGV[1] ~= '' then
+	redis.call('RPUSH', KEYS[1], item)
+	redis.call('ZADD', KEYS[2], ARGV[1], item)
+	redis.call('HSET', KEYS[3], item, route)
+	redis.call('HSET', KEYS[4], item, ARGV[2])
+	if score then
+		redis.call('HSET', KEYS[5], item, score)
+	end
+end
+return item
+`)
 
-	// open the item using the public key generated using sign
-	//
-	// https://pkg.go.dev/golang.org/x/crypto@v0.1.0/nacl/sign
-	opened, ok := sign.Open(out, signed, c.config.PublicKey)
-	if !ok {
-		return nil, errors.New("unable to open signed item")
+// poll is a helper function to pop an item from the routes with
+// a script until an item is popped or the timeout is reached.
+//
+// Unlike BLPOP the routes are polled since Redis is unable to
+// block while moving items or popping across sorted sets.
+func (c *client) poll(ctx context.Context, routes []string) (*types.Item, error) {
+	keys := append([]string{c.processingKey(), leasesKey, leaseRoutesKey, leaseWorkersKey, leaseScoresKey}, routes...)
+
+	priority := "0"
+	if c.config.Priority {
+		priority = "1"
+	}
+
+	// a timeout of zero blocks until an item is popped
+	var deadline <-chan time.Time
+	if c.config.Timeout > 0 {
+		deadline = time.After(c.config.Timeout)
+	}
+
+	for {
+		// items without a lease are not moved to the processing list
+		expiration := ""
+		if c.config.Lease > 0 {
+			expiration = fmt.Sprint(time.Now().Add(c.config.Lease).Unix())
+		}
+
+		var (
+			result string
+			err    error
+		)
+
+		// check if items are popped with a fair share policy
+		if c.config.FairShare != nil {
+			result, err = c.share(ctx, routes, expiration)
+		} else {
+			result, err = popScript.Run(ctx, c.Redis, keys, expiration, c.config.Worker, priority).Text()
+		}
+
+		if err == nil {
+			signed := []byte(result)
+
+			item, err := c.open(signed)
+			if err != nil {
+				return nil, err
+			}
+
+			// track the leased item to ack it later
+			if c.config.Lease > 0 {
+				c.mutex.Lock()
+				c.leased[item.Build.GetID()] = signed
+				c.mutex.Unlock()
+			}
+
+			return item, nil
+		}
+
+		if !errors.Is(err, redis.Nil) {
+			return nil, wrongType(err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-deadline:
+			return nil, nil
+		case <-time.After(time.Second):
+		}
+	}
+}
+
+// open is a helper function to open a signed
+// item popped from the queue.
+func (c *client) open(signed []byte) (*types.Item, error) {
+	opened, err := c.verify(signed)
+	if err != nil {
+		return nil, err
 	}
 
 	// unmarshal result into queue item
@@ -67,3 +186,22 @@ func (c *client) Pop(ctx context.Context, routes []string) (*types.Item, error)
 
 	return item, nil
 }
+
+// verify is a helper function to decrypt an item in the queue
+// and verify its signature to return the signed bytes.
+func (c *client) verify(item []byte) ([]byte, error) {
+	// decrypt the item when it was encrypted
+	signed, err := c.unseal(item)
+	if err != nil {
+		return nil, err
+	}
+
+	// open the item using the active public key or any
+	// other public key that has not been retired yet
+	opened, ok := keyset.Open(signed, c.config.PublicKey, c.config.PublicKeys)
+	if !ok {
+		return nil, errors.New("unable to open signed item")
+	}
+
+	return opened, nil
+}
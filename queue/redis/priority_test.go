@@ -0,0 +1,193 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"encoding/json"
+	"github.com/go-vela/server/constants"
+	"github.com/go-vela/types"
+	"github.com/go-vela/types/library"
+)
+
+// testPriorityItem is a helper function to create the
+// bytes for a queue item for a build with a priority.
+func testPriorityItem(t *testing.T, id int64, priority int64) []byte {
+	t.Helper()
+
+	b := new(library.Build)
+	b.SetID(id)
+
+	item := struct {
+		types.Item
+		Priority int64 `json:"priority"`
+	}{
+		Item:     types.Item{Build: b, Repo: _repo, User: _user},
+		Priority: priority,
+	}
+
+	bytes, err := json.Marshal(item)
+	if err != nil {
+		t.Fatalf("unable to marshal queue item: %v", err)
+	}
+
+	return bytes
+}
+
+func TestRedis_Priority_Pop(t *testing.T) {
+	// setup redis mock
+	_redis, err := NewTest(_signingPrivateKey, _signingPublicKey, "vela", "custom")
+	if err != nil {
+		t.Errorf("unable to create queue service: %v", err)
+	}
+
+	_redis.config.Priority = true
+	_redis.config.Timeout = time.Second
+
+	// push items in order of build ID
+	pushes := []struct {
+		route    string
+		id       int64
+		priority int64
+	}{
+		{route: "vela", id: 1, priority: constants.PriorityLow},
+		{route: "vela", id: 2, priority: constants.PriorityDefault},
+		{route: "custom", id: 3, priority: constants.PriorityDefault},
+		{route: "custom", id: 4, priority: constants.PriorityHigh},
+	}
+
+	for _, push := range pushes {
+		err = _redis.Push(context.Background(), push.route, testPriorityItem(t, push.id, push.priority))
+		if err != nil {
+			t.Errorf("Push returned err: %v", err)
+		}
+
+		// ensure items with the same priority are scored by age
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	length, err := _redis.Length(context.Background())
+	if err != nil {
+		t.Errorf("Length returned err: %v", err)
+	}
+
+	if length != 4 {
+		t.Errorf("Length is %d, want 4", length)
+	}
+
+	// highest priority first, then the oldest across routes
+	want := []int64{4, 2, 3, 1}
+
+	// run test
+	for _, id := range want {
+		got, err := _redis.Pop(context.Background(), nil)
+		if err != nil {
+			t.Errorf("Pop returned err: %v", err)
+		}
+
+		if got.Build.GetID() != id {
+			t.Errorf("Pop is build %d, want %d", got.Build.GetID(), id)
+		}
+	}
+
+	// queue is empty so the pop times out
+	got, err := _redis.Pop(context.Background(), nil)
+	if err != nil {
+		t.Errorf("Pop returned err: %v", err)
+	}
+
+	if got != nil {
+		t.Errorf("Pop is %v, want nil", got)
+	}
+}
+
+func TestRedis_Priority_Nack(t *testing.T) {
+	// setup redis mock
+	_redis, err := NewTest(_signingPrivateKey, _signingPublicKey, "vela")
+	if err != nil {
+		t.Errorf("unable to create queue service: %v", err)
+	}
+
+	_redis.config.Priority = true
+	_redis.config.Lease = time.Minute
+	_redis.config.Worker = "worker_0"
+	_redis.config.Timeout = time.Second
+
+	err = _redis.Push(context.Background(), "vela", testPriorityItem(t, 1, constants.PriorityHigh))
+	if err != nil {
+		t.Errorf("Push returned err: %v", err)
+	}
+
+	err = _redis.Push(context.Background(), "vela", testPriorityItem(t, 2, constants.PriorityDefault))
+	if err != nil {
+		t.Errorf("Push returned err: %v", err)
+	}
+
+	item, err := _redis.Pop(context.Background(), nil)
+	if err != nil {
+		t.Errorf("Pop returned err: %v", err)
+	}
+
+	// run test
+	err = _redis.Nack(context.Background(), item)
+	if err != nil {
+		t.Errorf("Nack returned err: %v", err)
+	}
+
+	// the requeued item keeps its priority
+	got, err := _redis.Pop(context.Background(), nil)
+	if err != nil {
+		t.Errorf("Pop returned err: %v", err)
+	}
+
+	if got.Build.GetID() != 1 {
+		t.Errorf("Pop is build %d, want 1", got.Build.GetID())
+	}
+}
+
+func TestRedis_score(t *testing.T) {
+	// setup types
+	now := time.Now()
+
+	// setup tests
+	tests := []struct {
+		name string
+		item []byte
+		want float64
+	}{
+		{
+			name: "priority",
+			item: []byte(`{"priority":8}`),
+			want: float64(constants.PriorityMax-8)*1e13 + float64(now.UnixMilli()),
+		},
+		{
+			name: "no priority",
+			item: []byte(`{"build":{}}`),
+			want: float64(constants.PriorityMax-constants.PriorityDefault)*1e13 + float64(now.UnixMilli()),
+		},
+		{
+			name: "priority above max",
+			item: []byte(`{"priority":100}`),
+			want: float64(now.UnixMilli()),
+		},
+		{
+			name: "invalid item",
+			item: []byte(`foo`),
+			want: float64(constants.PriorityMax-constants.PriorityDefault)*1e13 + float64(now.UnixMilli()),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := score(test.item, now)
+
+			if got != test.want {
+				t.Errorf("score is %v, want %v", got, test.want)
+			}
+		})
+	}
+}
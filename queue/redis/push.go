@@ -4,8 +4,12 @@ package redis
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"time"
 
+	"github.com/go-vela/server/constants"
+	"github.com/redis/go-redis/v9"
 	"golang.org/x/crypto/nacl/sign"
 )
 
@@ -32,6 +36,36 @@ func (c *client) Push(ctx context.Context, channel string, item []byte) error {
 	// https://pkg.go.dev/golang.org/x/crypto@v0.1.0/nacl/sign
 	signed = sign.Sign(out, item, c.config.PrivateKey)
 
+	// encrypt the signed item when an encryption key is set
+	signed, err := c.seal(signed)
+	if err != nil {
+		return err
+	}
+
+	// check if items are pushed to the queue for an owner
+	if c.config.FairShare != nil {
+		// items without a priority are popped oldest first
+		s := float64(time.Now().UnixMilli())
+		if c.config.Priority {
+			s = score(item, time.Now())
+		}
+
+		owner := c.config.FairShare.Owner(item)
+
+		return pushShareScript.Run(ctx, c.Redis, []string{ownerKey(channel, owner), ownersKey(channel)}, s, signed, owner).Err()
+	}
+
+	// check if items are pushed to the queue with a priority
+	if c.config.Priority {
+		// build a redis queue command to add an item to the sorted set for the queue
+		//
+		// https://pkg.go.dev/github.com/redis/go-redis/v9#Client.ZAdd
+		return wrongType(c.Redis.ZAdd(ctx, channel, redis.Z{
+			Score:  score(item, time.Now()),
+			Member: signed,
+		}).Err())
+	}
+
 	// build a redis queue command to push an item to queue
 	//
 	// https://pkg.go.dev/github.com/go-redis/redis?tab=doc#Client.RPush
@@ -40,10 +74,41 @@ func (c *client) Push(ctx context.Context, channel string, item []byte) error {
 	// blocking call to push an item to queue and return err
 	//
 	// https://pkg.go.dev/github.com/go-redis/redis?tab=doc#IntCmd.Err
-	err := pushCmd.Err()
+	err = pushCmd.Err()
 	if err != nil {
-		return err
+		return wrongType(err)
 	}
 
 	return nil
 }
+
+// score is a helper function to create the score for an item in the
+// sorted set for the queue so items with the highest priority are popped
+// first and items with the same priority are popped oldest first.
+func score(item []byte, pushed time.Time) float64 {
+	// capture the priority from the queue item
+	p := struct {
+		Priority *int64 `json:"priority"`
+	}{}
+
+	// items without a priority, like items published
+	// before priorities existed, use the default
+	priority := constants.PriorityDefault
+
+	err := json.Unmarshal(item, &p)
+	if err == nil && p.Priority != nil {
+		priority = *p.Priority
+	}
+
+	// clamp the priority between the min and max
+	if priority < constants.PriorityMin {
+		priority = constants.PriorityMin
+	}
+
+	if priority > constants.PriorityMax {
+		priority = constants.PriorityMax
+	}
+
+	// the priority takes precedence over the time the item was pushed
+	return float64(constants.PriorityMax-priority)*1e13 + float64(pushed.UnixMilli())
+}
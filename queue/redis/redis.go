@@ -6,10 +6,12 @@ import (
 	"context"
 	"fmt"
 	"strings"
-
+	"sync"
 	"time"
 
 	"github.com/alicebob/miniredis/v2"
+	"github.com/go-vela/server/internal/fairshare"
+	"github.com/go-vela/server/internal/keyset"
 	"github.com/redis/go-redis/v9"
 	"github.com/sirupsen/logrus"
 )
@@ -27,12 +29,29 @@ type config struct {
 	PrivateKey *[64]byte
 	// key for opening items popped from the Redis client
 	PublicKey *[32]byte
+	// keys, other than the active key, for opening items signed before a key rotation
+	PublicKeys []*keyset.Key
+	// secret key for encrypting items pushed to and popped from the Redis client
+	EncryptionKey *[32]byte
+	// enables the Redis client to accept items that are only signed alongside encrypted items
+	EncryptionMigration bool
+	// specifies the lease for items popped from the Redis client
+	Lease time.Duration
+	// specifies the name of the worker for the processing list of leased items
+	Worker string
+	// enables the Redis client to pop items by priority using sorted sets
+	Priority bool
+	// specifies the policy for sharing the workers between owners of items
+	FairShare *fairshare.Policy
 }
 
 type client struct {
 	config  *config
 	Redis   *redis.Client
 	Options *redis.Options
+	// leased items popped by the client waiting to be acked
+	leased map[int64][]byte
+	mutex  sync.Mutex
 	// https://pkg.go.dev/github.com/sirupsen/logrus#Entry
 	Logger *logrus.Entry
 }
@@ -49,6 +68,7 @@ func New(opts ...ClientOpt) (*client, error) {
 	c.config = new(config)
 	c.Redis = new(redis.Client)
 	c.Options = new(redis.Options)
+	c.leased = make(map[int64][]byte)
 
 	// create new logger for the client
 	//
@@ -92,6 +112,12 @@ func New(opts ...ClientOpt) (*client, error) {
 		return nil, err
 	}
 
+	// verify the items waiting in the routes can be popped by the client
+	err = c.layout(context.Background(), c.config.Channels)
+	if err != nil {
+		return nil, err
+	}
+
 	return c, nil
 }
 
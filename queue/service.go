@@ -14,6 +14,14 @@ import (
 type Service interface {
 	// Service Interface Functions
 
+	// Ack defines a function that acknowledges
+	// a leased item popped off the queue.
+	Ack(context.Context, *types.Item) error
+
+	// Done defines a function that releases a build popped
+	// off the queue so it is no longer counted in-flight.
+	Done(context.Context, int64) error
+
 	// Driver defines a function that outputs
 	// the configured queue driver.
 	Driver() string
@@ -22,6 +30,30 @@ type Service interface {
 	// the length of a queue channel
 	Length(context.Context) (int64, error)
 
+	// Extend defines a function that renews the
+	// lease for an item popped off the queue.
+	Extend(context.Context, *types.Item) error
+
+	// Inflight defines a function that outputs the builds popped
+	// off the queue that are counted in-flight until they are done.
+	Inflight(context.Context) ([]int64, error)
+
+	// Leased defines a function that outputs the builds popped
+	// off the queue with a lease that was not acked or nacked.
+	Leased(context.Context) ([]int64, error)
+
+	// List defines a function that outputs the items
+	// waiting in a route of the queue in pop order.
+	List(context.Context, string) ([]*types.Item, error)
+
+	// Move defines a function that moves every item
+	// waiting in a route of the queue to another route.
+	Move(context.Context, string, string) (int64, error)
+
+	// Nack defines a function that rejects a leased
+	// item popped off the queue so it is requeued.
+	Nack(context.Context, *types.Item) error
+
 	// Pop defines a function that grabs an
 	// item off the queue.
 	Pop(context.Context, []string) (*types.Item, error)
@@ -34,6 +66,14 @@ type Service interface {
 	// connection to the queue.
 	Ping(context.Context) error
 
+	// Remove defines a function that removes the item
+	// for a build waiting in a route of the queue.
+	Remove(context.Context, string, int64) (bool, error)
+
+	// RequeueExpired defines a function that requeues
+	// the items popped off the queue with an expired lease.
+	RequeueExpired(context.Context) (int64, error)
+
 	// Route defines a function that decides which
 	// channel a build gets placed within the queue.
 	Route(*pipeline.Worker) (string, error)
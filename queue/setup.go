@@ -7,6 +7,11 @@ import (
 	"strings"
 	"time"
 
+	serverconstants "github.com/go-vela/server/constants"
+	"github.com/go-vela/server/internal/fairshare"
+	"github.com/go-vela/server/internal/keyset"
+	"github.com/go-vela/server/queue/memory"
+	"github.com/go-vela/server/queue/postgres"
 	"github.com/go-vela/server/queue/redis"
 	"github.com/go-vela/types/constants"
 	"github.com/sirupsen/logrus"
@@ -32,6 +37,33 @@ type Setup struct {
 	PrivateKey string
 	// public key in base64 used for opening items popped from the queue
 	PublicKey string
+	// specifies the ID of the active key pair used for signing and opening items
+	KeyID string
+	// specifies a list of <id>=<base64> public keys, other than the active key,
+	// used for opening items signed before a key rotation
+	PublicKeys []string
+	// secret key in base64 used for encrypting items in the queue, disabled when empty
+	EncryptionKey string
+	// enables the queue client to accept items that are only signed while rolling out encryption
+	EncryptionMigration bool
+	// specifies the lease for items popped from the queue, disabled when zero
+	Lease time.Duration
+	// specifies the name of the worker popping leased items from the queue
+	Worker string
+	// enables the queue client to pop items by priority
+	Priority bool
+	// specifies the owner (org or repo) to share workers between fairly, disabled when empty
+	FairShare string
+	// specifies a list of owner=weight pairs for sharing workers fairly
+	FairShareWeights []string
+	// specifies a list of owner=cap pairs limiting the builds in flight per owner
+	FairShareCaps []string
+}
+
+// policy creates and returns the fair share policy
+// for the queue, which is nil when fair share is disabled.
+func (s *Setup) policy() (*fairshare.Policy, error) {
+	return fairshare.New(s.FairShare, s.FairShareWeights, s.FairShareCaps)
 }
 
 // Redis creates and returns a Vela service capable
@@ -39,6 +71,11 @@ type Setup struct {
 func (s *Setup) Redis() (Service, error) {
 	logrus.Trace("creating redis queue client from setup")
 
+	policy, err := s.policy()
+	if err != nil {
+		return nil, err
+	}
+
 	// create new Redis queue service
 	//
 	// https://pkg.go.dev/github.com/go-vela/server/queue/redis?tab=doc#New
@@ -49,6 +86,65 @@ func (s *Setup) Redis() (Service, error) {
 		redis.WithTimeout(s.Timeout),
 		redis.WithPrivateKey(s.PrivateKey),
 		redis.WithPublicKey(s.PublicKey),
+		redis.WithPublicKeys(s.PublicKeys...),
+		redis.WithEncryptionKey(s.EncryptionKey),
+		redis.WithEncryptionMigration(s.EncryptionMigration),
+		redis.WithLease(s.Lease),
+		redis.WithWorker(s.Worker),
+		redis.WithPriority(s.Priority),
+		redis.WithFairShare(policy),
+	)
+}
+
+// Postgres creates and returns a Vela service capable
+// of integrating with a Postgres queue.
+func (s *Setup) Postgres() (Service, error) {
+	logrus.Trace("creating postgres queue client from setup")
+
+	policy, err := s.policy()
+	if err != nil {
+		return nil, err
+	}
+
+	// create new Postgres queue service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/queue/postgres?tab=doc#New
+	return postgres.New(
+		postgres.WithAddress(s.Address),
+		postgres.WithChannels(s.Routes...),
+		postgres.WithTimeout(s.Timeout),
+		postgres.WithPrivateKey(s.PrivateKey),
+		postgres.WithPublicKey(s.PublicKey),
+		postgres.WithPublicKeys(s.PublicKeys...),
+		postgres.WithLease(s.Lease),
+		postgres.WithWorker(s.Worker),
+		postgres.WithPriority(s.Priority),
+		postgres.WithFairShare(policy),
+	)
+}
+
+// Memory creates and returns a Vela service capable
+// of integrating with an in-process queue.
+func (s *Setup) Memory() (Service, error) {
+	logrus.Trace("creating memory queue client from setup")
+
+	policy, err := s.policy()
+	if err != nil {
+		return nil, err
+	}
+
+	// create new Memory queue service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/queue/memory?tab=doc#New
+	return memory.New(
+		memory.WithChannels(s.Routes...),
+		memory.WithTimeout(s.Timeout),
+		memory.WithPrivateKey(s.PrivateKey),
+		memory.WithPublicKey(s.PublicKey),
+		memory.WithPublicKeys(s.PublicKeys...),
+		memory.WithLease(s.Lease),
+		memory.WithPriority(s.Priority),
+		memory.WithFairShare(policy),
 	)
 }
 
@@ -70,13 +166,13 @@ func (s *Setup) Validate() error {
 		return fmt.Errorf("no queue driver provided")
 	}
 
-	// verify a queue address was provided
-	if len(s.Address) == 0 {
+	// verify a queue address was provided unless the queue is in memory
+	if len(s.Address) == 0 && s.Driver != serverconstants.DriverMemory {
 		return fmt.Errorf("no queue address provided")
 	}
 
 	// check if the queue address has a scheme
-	if !strings.Contains(s.Address, "://") {
+	if len(s.Address) > 0 && !strings.Contains(s.Address, "://") {
 		return fmt.Errorf("queue address must be fully qualified (<scheme>://<host>)")
 	}
 
@@ -94,6 +190,40 @@ func (s *Setup) Validate() error {
 		return fmt.Errorf("no queue public key was provided")
 	}
 
+	// verify the public keys are valid
+	keys, err := keyset.Parse(s.PublicKeys)
+	if err != nil {
+		return err
+	}
+
+	// verify the public keys do not reuse the ID of the active key
+	for _, key := range keys {
+		if key.ID == s.KeyID {
+			return fmt.Errorf("queue public key %s must not use the ID of the active key", key.ID)
+		}
+	}
+
+	// verify the queue driver supports encrypting items
+	if len(s.EncryptionKey) > 0 && s.Driver != constants.DriverRedis {
+		return fmt.Errorf("queue encryption is not supported by the %s queue driver", s.Driver)
+	}
+
+	// verify the fair share policy is valid
+	_, err = s.policy()
+	if err != nil {
+		return err
+	}
+
+	// verify a lease was provided for counting builds in flight with fair share
+	if len(s.FairShare) > 0 && s.Lease <= 0 {
+		return fmt.Errorf("queue fair share requires a queue lease duration")
+	}
+
+	// verify the features using scripts with keys built from the items are not clustered
+	if s.Cluster && (s.Lease > 0 || s.Priority || len(s.FairShare) > 0) {
+		return fmt.Errorf("queue lease, priority and fair share are not supported in cluster mode")
+	}
+
 	// setup is valid
 	return nil
 }
@@ -5,6 +5,7 @@ package queue
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/alicebob/miniredis/v2"
 )
@@ -34,6 +35,37 @@ func TestQueue_Setup_Redis(t *testing.T) {
 	}
 }
 
+func TestQueue_Setup_Postgres(t *testing.T) {
+	// setup types
+	_setup := &Setup{
+		Driver:    "postgres",
+		Address:   "postgres://localhost:1/vela",
+		Routes:    []string{"foo"},
+		Cluster:   false,
+		PublicKey: "CuS+EQAzofbk3tVFS3bt5f2tIb4YiJJC4nVMFQYQElg=",
+	}
+
+	// no postgres instance is running at the address
+	_, err := _setup.Postgres()
+	if err == nil {
+		t.Errorf("Postgres should have returned err")
+	}
+}
+
+func TestQueue_Setup_Memory(t *testing.T) {
+	// setup types
+	_setup := &Setup{
+		Driver:    "memory",
+		Routes:    []string{"foo"},
+		PublicKey: "CuS+EQAzofbk3tVFS3bt5f2tIb4YiJJC4nVMFQYQElg=",
+	}
+
+	_, err := _setup.Memory()
+	if err != nil {
+		t.Errorf("Memory returned err: %v", err)
+	}
+}
+
 func TestQueue_Setup_Kafka(t *testing.T) {
 	// setup types
 	_setup := &Setup{
@@ -79,6 +111,128 @@ func TestQueue_Setup_Validate(t *testing.T) {
 				PublicKey: "CuS+EQAzofbk3tVFS3bt5f2tIb4YiJJC4nVMFQYQElg=",
 			},
 		},
+		{
+			failure: false,
+			setup: &Setup{
+				Driver:    "memory",
+				Routes:    []string{"foo"},
+				PublicKey: "CuS+EQAzofbk3tVFS3bt5f2tIb4YiJJC4nVMFQYQElg=",
+			},
+		},
+		{
+			failure: false,
+			setup: &Setup{
+				Driver:           "memory",
+				Routes:           []string{"foo"},
+				PublicKey:        "CuS+EQAzofbk3tVFS3bt5f2tIb4YiJJC4nVMFQYQElg=",
+				Lease:            time.Minute,
+				FairShare:        "org",
+				FairShareWeights: []string{"*=1", "github=2"},
+				FairShareCaps:    []string{"github=5"},
+			},
+		},
+		{
+			failure: true,
+			setup: &Setup{
+				Driver:    "memory",
+				Routes:    []string{"foo"},
+				PublicKey: "CuS+EQAzofbk3tVFS3bt5f2tIb4YiJJC4nVMFQYQElg=",
+				FairShare: "org",
+			},
+		},
+		{
+			failure: true,
+			setup: &Setup{
+				Driver:    "memory",
+				Routes:    []string{"foo"},
+				PublicKey: "CuS+EQAzofbk3tVFS3bt5f2tIb4YiJJC4nVMFQYQElg=",
+				Lease:     time.Minute,
+				FairShare: "team",
+			},
+		},
+		{
+			failure: true,
+			setup: &Setup{
+				Driver:    "redis",
+				Address:   "redis://redis.example.com",
+				Routes:    []string{"foo"},
+				Cluster:   true,
+				PublicKey: "CuS+EQAzofbk3tVFS3bt5f2tIb4YiJJC4nVMFQYQElg=",
+				Lease:     time.Minute,
+			},
+		},
+		{
+			failure: true,
+			setup: &Setup{
+				Driver:    "redis",
+				Address:   "redis://redis.example.com",
+				Routes:    []string{"foo"},
+				Cluster:   true,
+				PublicKey: "CuS+EQAzofbk3tVFS3bt5f2tIb4YiJJC4nVMFQYQElg=",
+				Priority:  true,
+			},
+		},
+		{
+			failure: true,
+			setup: &Setup{
+				Driver:    "redis",
+				Address:   "redis://redis.example.com",
+				Routes:    []string{"foo"},
+				Cluster:   true,
+				PublicKey: "CuS+EQAzofbk3tVFS3bt5f2tIb4YiJJC4nVMFQYQElg=",
+				Lease:     time.Minute,
+				FairShare: "org",
+			},
+		},
+		{
+			failure: false,
+			setup: &Setup{
+				Driver:     "memory",
+				Routes:     []string{"foo"},
+				PublicKey:  "CuS+EQAzofbk3tVFS3bt5f2tIb4YiJJC4nVMFQYQElg=",
+				KeyID:      "2026-10",
+				PublicKeys: []string{"2026-04=DXsJkoTSkHlG26d75LyHJG+KQsXPr8VKPpmH/78zmko="},
+			},
+		},
+		{
+			failure: true,
+			setup: &Setup{
+				Driver:     "memory",
+				Routes:     []string{"foo"},
+				PublicKey:  "CuS+EQAzofbk3tVFS3bt5f2tIb4YiJJC4nVMFQYQElg=",
+				KeyID:      "2026-10",
+				PublicKeys: []string{"2026-10=DXsJkoTSkHlG26d75LyHJG+KQsXPr8VKPpmH/78zmko="},
+			},
+		},
+		{
+			failure: true,
+			setup: &Setup{
+				Driver:     "memory",
+				Routes:     []string{"foo"},
+				PublicKey:  "CuS+EQAzofbk3tVFS3bt5f2tIb4YiJJC4nVMFQYQElg=",
+				PublicKeys: []string{"DXsJkoTSkHlG26d75LyHJG+KQsXPr8VKPpmH/78zmko="},
+			},
+		},
+		{
+			failure: false,
+			setup: &Setup{
+				Driver:              "redis",
+				Address:             "redis://redis.example.com",
+				Routes:              []string{"foo"},
+				PublicKey:           "CuS+EQAzofbk3tVFS3bt5f2tIb4YiJJC4nVMFQYQElg=",
+				EncryptionKey:       "DXsJkoTSkHlG26d75LyHJG+KQsXPr8VKPpmH/78zmko=",
+				EncryptionMigration: true,
+			},
+		},
+		{
+			failure: true,
+			setup: &Setup{
+				Driver:        "memory",
+				Routes:        []string{"foo"},
+				PublicKey:     "CuS+EQAzofbk3tVFS3bt5f2tIb4YiJJC4nVMFQYQElg=",
+				EncryptionKey: "DXsJkoTSkHlG26d75LyHJG+KQsXPr8VKPpmH/78zmko=",
+			},
+		},
 		{
 			failure: true,
 			setup: &Setup{
@@ -17,6 +17,11 @@ import (
 // PUT    /api/v1/admin/clean
 // PUT    /api/v1/admin/deployment
 // PUT    /api/v1/admin/hook
+// GET    /api/v1/admin/queue/items
+// DELETE /api/v1/admin/queue/items/:build
+// PUT    /api/v1/admin/queue/move
+// POST   /api/v1/admin/queue/requeue/:build
+// GET    /api/v1/admin/queue/reconcile
 // PUT    /api/v1/admin/repo
 // PUT    /api/v1/admin/secret
 // PUT    /api/v1/admin/service
@@ -42,6 +47,13 @@ func AdminHandlers(base *gin.RouterGroup) {
 		// Admin hook endpoint
 		_admin.PUT("/hook", admin.UpdateHook)
 
+		// Admin queue endpoints
+		_admin.GET("/queue/items", admin.ListQueueItems)
+		_admin.DELETE("/queue/items/:build", admin.RemoveQueueItem)
+		_admin.PUT("/queue/move", admin.MoveQueueItems)
+		_admin.POST("/queue/requeue/:build", admin.RequeueBuild)
+		_admin.GET("/queue/reconcile", admin.ReconcileQueue)
+
 		// Admin repo endpoint
 		_admin.PUT("/repo", admin.UpdateRepo)
 
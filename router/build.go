@@ -25,6 +25,7 @@ import (
 // GET    /api/v1/repos/:org/:repo/builds/:build/logs
 // GET    /api/v1/repos/:org/:repo/builds/:build/token
 // GET    /api/v1/repos/:org/:repo/builds/:build/executable
+// GET    /api/v1/repos/:org/:repo/builds/:build/stream
 // POST   /api/v1/repos/:org/:repo/builds/:build/services
 // GET    /api/v1/repos/:org/:repo/builds/:build/services
 // GET    /api/v1/repos/:org/:repo/builds/:build/services/:service
@@ -34,6 +35,9 @@ import (
 // GET    /api/v1/repos/:org/:repo/builds/:build/services/:service/logs
 // PUT    /api/v1/repos/:org/:repo/builds/:build/services/:service/logs
 // DELETE /api/v1/repos/:org/:repo/builds/:build/services/:service/logs
+// POST   /api/v1/repos/:org/:repo/builds/:build/services/:service/logs/chunks
+// GET    /api/v1/repos/:org/:repo/builds/:build/services/:service/logs/range
+// GET    /api/v1/repos/:org/:repo/builds/:build/services/:service/logs/stream
 // POST   /api/v1/repos/:org/:repo/builds/:build/steps
 // GET    /api/v1/repos/:org/:repo/builds/:build/steps
 // GET    /api/v1/repos/:org/:repo/builds/:build/steps/:step
@@ -42,7 +46,10 @@ import (
 // POST   /api/v1/repos/:org/:repo/builds/:build/steps/:step/logs
 // GET    /api/v1/repos/:org/:repo/builds/:build/steps/:step/logs
 // PUT    /api/v1/repos/:org/:repo/builds/:build/steps/:step/logs
-// DELETE /api/v1/repos/:org/:repo/builds/:build/steps/:step/logs .
+// DELETE /api/v1/repos/:org/:repo/builds/:build/steps/:step/logs
+// POST   /api/v1/repos/:org/:repo/builds/:build/steps/:step/logs/chunks
+// GET    /api/v1/repos/:org/:repo/builds/:build/steps/:step/logs/range
+// GET    /api/v1/repos/:org/:repo/builds/:build/steps/:step/logs/stream .
 func BuildHandlers(base *gin.RouterGroup) {
 	// Builds endpoints
 	builds := base.Group("/builds")
@@ -62,6 +69,7 @@ func BuildHandlers(base *gin.RouterGroup) {
 			b.GET("/token", perm.MustWorkerAuthToken(), build.GetBuildToken)
 			b.GET("/graph", perm.MustRead(), build.GetBuildGraph)
 			b.GET("/executable", perm.MustBuildAccess(), build.GetBuildExecutable)
+			b.GET("/stream", perm.MustRead(), build.StreamBuild)
 
 			// Service endpoints
 			// * Log endpoints
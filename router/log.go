@@ -14,7 +14,10 @@ import (
 // POST   /api/v1/repos/:org/:repo/builds/:build/services/:service/logs
 // GET    /api/v1/repos/:org/:repo/builds/:build/services/:service/logs
 // PUT    /api/v1/repos/:org/:repo/builds/:build/services/:service/logs
-// DELETE /api/v1/repos/:org/:repo/builds/:build/services/:service/logs .
+// DELETE /api/v1/repos/:org/:repo/builds/:build/services/:service/logs
+// POST   /api/v1/repos/:org/:repo/builds/:build/services/:service/logs/chunks
+// GET    /api/v1/repos/:org/:repo/builds/:build/services/:service/logs/range
+// GET    /api/v1/repos/:org/:repo/builds/:build/services/:service/logs/stream .
 func LogServiceHandlers(base *gin.RouterGroup) {
 	// Logs endpoints
 	logs := base.Group("/logs")
@@ -23,6 +26,9 @@ func LogServiceHandlers(base *gin.RouterGroup) {
 		logs.GET("", perm.MustRead(), log.GetServiceLog)
 		logs.PUT("", perm.MustBuildAccess(), log.UpdateServiceLog)
 		logs.DELETE("", perm.MustPlatformAdmin(), log.DeleteServiceLog)
+		logs.POST("/chunks", perm.MustBuildAccess(), log.AppendServiceLog)
+		logs.GET("/range", perm.MustRead(), log.GetServiceLogRange)
+		logs.GET("/stream", perm.MustRead(), log.StreamServiceLog)
 	} // end of logs endpoints
 }
 
@@ -32,7 +38,10 @@ func LogServiceHandlers(base *gin.RouterGroup) {
 // POST   /api/v1/repos/:org/:repo/builds/:build/steps/:step/logs
 // GET    /api/v1/repos/:org/:repo/builds/:build/steps/:step/logs
 // PUT    /api/v1/repos/:org/:repo/builds/:build/steps/:step/logs
-// DELETE /api/v1/repos/:org/:repo/builds/:build/steps/:step/logs .
+// DELETE /api/v1/repos/:org/:repo/builds/:build/steps/:step/logs
+// POST   /api/v1/repos/:org/:repo/builds/:build/steps/:step/logs/chunks
+// GET    /api/v1/repos/:org/:repo/builds/:build/steps/:step/logs/range
+// GET    /api/v1/repos/:org/:repo/builds/:build/steps/:step/logs/stream .
 func LogStepHandlers(base *gin.RouterGroup) {
 	// Logs endpoints
 	logs := base.Group("/logs")
@@ -41,5 +50,8 @@ func LogStepHandlers(base *gin.RouterGroup) {
 		logs.GET("", perm.MustRead(), log.GetStepLog)
 		logs.PUT("", perm.MustBuildAccess(), log.UpdateStepLog)
 		logs.DELETE("", perm.MustPlatformAdmin(), log.DeleteStepLog)
+		logs.POST("/chunks", perm.MustBuildAccess(), log.AppendStepLog)
+		logs.GET("/range", perm.MustRead(), log.GetStepLogRange)
+		logs.GET("/stream", perm.MustRead(), log.StreamStepLog)
 	} // end of logs endpoints
 }
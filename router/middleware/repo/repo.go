@@ -10,6 +10,7 @@ import (
 	"github.com/go-vela/server/database"
 	"github.com/go-vela/server/router/middleware/org"
 	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/scm"
 	"github.com/go-vela/server/util"
 	"github.com/go-vela/types/library"
 	"github.com/sirupsen/logrus"
@@ -44,7 +45,8 @@ func Establish() gin.HandlerFunc {
 			"user": u.GetName(),
 		}).Debugf("reading repo %s/%s", o, rParam)
 
-		r, err := database.FromContext(c).GetRepoForOrg(ctx, o, rParam)
+		// prefer the repo that belongs to the scm provider of the user
+		r, provider, err := database.FromContext(c).GetRepoForOrgWithProvider(ctx, o, rParam, scm.SelectedFromContext(c))
 		if err != nil {
 			retErr := fmt.Errorf("unable to read repo %s/%s: %w", o, rParam, err)
 			util.HandleError(c, http.StatusNotFound, retErr)
@@ -52,6 +54,17 @@ func Establish() gin.HandlerFunc {
 			return
 		}
 
+		// route scm requests for the repo to the scm provider it belongs to
+		if scm.ProvidersFromContext(c) != nil {
+			_, err = scm.Select(c, provider)
+			if err != nil {
+				retErr := fmt.Errorf("unable to select scm provider for repo %s/%s: %w", o, rParam, err)
+				util.HandleError(c, http.StatusInternalServerError, retErr)
+
+				return
+			}
+		}
+
 		ToContext(c, r)
 		c.Next()
 	}
@@ -10,6 +10,8 @@ import (
 	"testing"
 
 	"github.com/go-vela/server/router/middleware/org"
+	"github.com/go-vela/server/scm"
+	"github.com/go-vela/server/scm/github"
 
 	"github.com/gin-gonic/gin"
 	"github.com/go-vela/server/database"
@@ -106,6 +108,79 @@ func TestRepo_Establish(t *testing.T) {
 	}
 }
 
+func TestRepo_Establish_Provider(t *testing.T) {
+	// setup types
+	r := new(library.Repo)
+	r.SetID(1)
+	r.SetUserID(1)
+	r.SetHash("baz")
+	r.SetOrg("foo")
+	r.SetName("bar")
+	r.SetFullName("foo/bar")
+	r.SetVisibility("public")
+
+	s := httptest.NewServer(http.NotFoundHandler())
+	defer s.Close()
+
+	_default, _ := github.NewTest(s.URL)
+	want, _ := github.NewTest(s.URL)
+
+	providers := scm.NewProviders("github", _default)
+
+	err := providers.Add("enterprise", want)
+	if err != nil {
+		t.Errorf("unable to add scm provider: %v", err)
+	}
+
+	var got scm.Service
+
+	// setup database
+	db, err := database.NewTest()
+	if err != nil {
+		t.Errorf("unable to create test database engine: %v", err)
+	}
+
+	defer func() {
+		_ = db.DeleteRepo(context.TODO(), r)
+		db.Close()
+	}()
+
+	_, _ = db.CreateRepo(context.TODO(), r)
+	_ = db.UpdateRepoProvider(context.TODO(), r, "enterprise")
+
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	context, engine := gin.CreateTestContext(resp)
+	context.Request, _ = http.NewRequest(http.MethodGet, "/foo/bar", nil)
+
+	// setup mock server
+	engine.Use(func(c *gin.Context) { database.ToContext(c, db) })
+	engine.Use(func(c *gin.Context) {
+		scm.ProvidersToContext(c, providers)
+		scm.ToContext(c, providers.Default())
+	})
+	engine.Use(org.Establish())
+	engine.Use(Establish())
+	engine.GET("/:org/:repo", func(c *gin.Context) {
+		got = scm.FromContext(c)
+
+		c.Status(http.StatusOK)
+	})
+
+	// run test
+	engine.ServeHTTP(resp, context.Request)
+
+	if resp.Code != http.StatusOK {
+		t.Errorf("Establish returned %v, want %v", resp.Code, http.StatusOK)
+	}
+
+	if got != want {
+		t.Errorf("Establish scm is %v, want %v", got, want)
+	}
+}
+
 func TestRepo_Establish_NoOrgParameter(t *testing.T) {
 	// setup database
 	db, err := database.NewTest()
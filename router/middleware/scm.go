@@ -15,3 +15,16 @@ func Scm(s scm.Service) gin.HandlerFunc {
 		c.Next()
 	}
 }
+
+// ScmProviders is a middleware function that initializes the scm
+// providers and attaches them to the context of every http.Request.
+//
+// The default scm provider is attached as the scm for the request
+// until the request is routed to a repo or user from another provider.
+func ScmProviders(p *scm.Providers) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		scm.ProvidersToContext(c, p)
+		scm.ToContext(c, p.Default())
+		c.Next()
+	}
+}
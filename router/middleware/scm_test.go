@@ -49,3 +49,55 @@ func TestMiddleware_Scm(t *testing.T) {
 		t.Errorf("Scm is %v, want %v", got, want)
 	}
 }
+
+func TestMiddleware_ScmProviders(t *testing.T) {
+	// setup types
+	s := httptest.NewServer(http.NotFoundHandler())
+	defer s.Close()
+
+	var (
+		got       scm.Service
+		providers *scm.Providers
+	)
+
+	want, _ := github.NewTest(s.URL)
+	other, _ := github.NewTest(s.URL)
+
+	p := scm.NewProviders("github", want)
+
+	err := p.Add("enterprise", other)
+	if err != nil {
+		t.Errorf("unable to add scm provider: %v", err)
+	}
+
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	context, engine := gin.CreateTestContext(resp)
+	context.Request, _ = http.NewRequest(http.MethodGet, "/health", nil)
+
+	// setup mock server
+	engine.Use(ScmProviders(p))
+	engine.GET("/health", func(c *gin.Context) {
+		got = scm.FromContext(c)
+		providers = scm.ProvidersFromContext(c)
+
+		c.Status(http.StatusOK)
+	})
+
+	// run test
+	engine.ServeHTTP(context.Writer, context.Request)
+
+	if resp.Code != http.StatusOK {
+		t.Errorf("ScmProviders returned %v, want %v", resp.Code, http.StatusOK)
+	}
+
+	if got != want {
+		t.Errorf("ScmProviders is %v, want %v", got, want)
+	}
+
+	if providers != p {
+		t.Errorf("ScmProviders providers is %v, want %v", providers, p)
+	}
+}
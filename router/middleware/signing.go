@@ -24,6 +24,24 @@ func QueueSigningPublicKey(key string) gin.HandlerFunc {
 	}
 }
 
+// QueueSigningKeyID is a middleware function that attaches the ID of the
+// active key pair used to sign and open items that are pushed to the queue.
+func QueueSigningKeyID(id string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set("queue-key-id", id)
+		c.Next()
+	}
+}
+
+// QueueSigningPublicKeys is a middleware function that attaches the public keys,
+// other than the active key, used to open items signed before a key rotation.
+func QueueSigningPublicKeys(keys []string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set("queue-public-keys", keys)
+		c.Next()
+	}
+}
+
 // QueueAddress is a middleware function that attaches the queue address used
 // to open the connection to the queue.
 func QueueAddress(address string) gin.HandlerFunc {
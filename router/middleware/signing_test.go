@@ -75,6 +75,70 @@ func TestMiddleware_QueueSigningPublicKey(t *testing.T) {
 	}
 }
 
+func TestMiddleware_QueueSigningKeyID(t *testing.T) {
+	// setup types
+	var got string
+	want := "2026-10"
+
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	context, engine := gin.CreateTestContext(resp)
+	context.Request, _ = http.NewRequest(http.MethodGet, "/health", nil)
+
+	// setup mock server
+	engine.Use(QueueSigningKeyID(want))
+	engine.GET("/health", func(c *gin.Context) {
+		got = c.Value("queue-key-id").(string)
+
+		c.Status(http.StatusOK)
+	})
+
+	// run test
+	engine.ServeHTTP(context.Writer, context.Request)
+
+	if resp.Code != http.StatusOK {
+		t.Errorf("QueueSigningKeyID returned %v, want %v", resp.Code, http.StatusOK)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("QueueSigningKeyID is %v, want %v", got, want)
+	}
+}
+
+func TestMiddleware_QueueSigningPublicKeys(t *testing.T) {
+	// setup types
+	var got []string
+	want := []string{"2026-04=foobar"}
+
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	context, engine := gin.CreateTestContext(resp)
+	context.Request, _ = http.NewRequest(http.MethodGet, "/health", nil)
+
+	// setup mock server
+	engine.Use(QueueSigningPublicKeys(want))
+	engine.GET("/health", func(c *gin.Context) {
+		got = c.Value("queue-public-keys").([]string)
+
+		c.Status(http.StatusOK)
+	})
+
+	// run test
+	engine.ServeHTTP(context.Writer, context.Request)
+
+	if resp.Code != http.StatusOK {
+		t.Errorf("QueueSigningPublicKeys returned %v, want %v", resp.Code, http.StatusOK)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("QueueSigningPublicKeys is %v, want %v", got, want)
+	}
+}
+
 func TestMiddleware_QueueAddress(t *testing.T) {
 	// setup types
 	got := ""
@@ -0,0 +1,17 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/stream"
+)
+
+// Stream is a middleware function that initializes the stream and
+// attaches to the context of every http.Request.
+func Stream(s stream.Service) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		stream.WithGinContext(c, s)
+		c.Next()
+	}
+}
@@ -0,0 +1,48 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/go-vela/server/stream"
+	"github.com/go-vela/server/stream/memory"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestMiddleware_Stream(t *testing.T) {
+	// setup types
+	var got stream.Service
+
+	want, _ := memory.New(memory.WithBuffer(1))
+
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	context, engine := gin.CreateTestContext(resp)
+	context.Request, _ = http.NewRequest(http.MethodGet, "/health", nil)
+
+	// setup mock server
+	engine.Use(Stream(want))
+	engine.GET("/health", func(c *gin.Context) {
+		got = stream.FromGinContext(c)
+
+		c.Status(http.StatusOK)
+	})
+
+	// run test
+	engine.ServeHTTP(context.Writer, context.Request)
+
+	if resp.Code != http.StatusOK {
+		t.Errorf("Stream returned %v, want %v", resp.Code, http.StatusOK)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Stream is %v, want %v", got, want)
+	}
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/go-vela/server/database"
 	"github.com/go-vela/server/router/middleware/claims"
+	"github.com/go-vela/server/scm"
 	"github.com/go-vela/server/util"
 
 	"github.com/go-vela/types/constants"
@@ -40,13 +41,22 @@ func Establish() gin.HandlerFunc {
 
 		logrus.Debugf("parsing user access token")
 
-		// lookup user in claims subject in the database
-		u, err := database.FromContext(c).GetUserForName(ctx, cl.Subject)
+		// lookup user in claims scm provider and subject in the database
+		u, err := database.FromContext(c).GetUserForProvider(ctx, cl.SCMProvider, cl.Subject)
 		if err != nil {
 			util.HandleError(c, http.StatusUnauthorized, err)
 			return
 		}
 
+		// route scm requests for the user to the scm provider they belong to
+		if scm.ProvidersFromContext(c) != nil {
+			_, err = scm.Select(c, cl.SCMProvider)
+			if err != nil {
+				util.HandleError(c, http.StatusInternalServerError, err)
+				return
+			}
+		}
+
 		ToContext(c, u)
 		c.Next()
 	}
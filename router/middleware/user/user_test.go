@@ -139,6 +139,122 @@ func TestUser_Establish(t *testing.T) {
 	}
 }
 
+func TestUser_Establish_Provider(t *testing.T) {
+	// setup types
+	tm := &token.Manager{
+		PrivateKey:               "123abc",
+		SignMethod:               jwt.SigningMethodHS256,
+		UserAccessTokenDuration:  time.Minute * 5,
+		UserRefreshTokenDuration: time.Minute * 30,
+	}
+
+	u := new(library.User)
+	u.SetID(1)
+	u.SetName("foo")
+	u.SetToken("bar")
+	u.SetHash("baz")
+	u.SetActive(true)
+	u.SetAdmin(false)
+	u.SetFavorites([]string{})
+
+	s := httptest.NewServer(http.NotFoundHandler())
+	defer s.Close()
+
+	_default, _ := github.NewTest(s.URL)
+	want, _ := github.NewTest(s.URL)
+
+	providers := scm.NewProviders("github", _default)
+
+	err := providers.Add("enterprise", want)
+	if err != nil {
+		t.Errorf("unable to add scm provider: %v", err)
+	}
+
+	// setup database
+	db, err := database.NewTest()
+	if err != nil {
+		t.Errorf("unable to create test database engine: %v", err)
+	}
+
+	defer func() {
+		_ = db.DeleteUser(_context.TODO(), u)
+		db.Close()
+	}()
+
+	_, _ = db.CreateUser(_context.TODO(), u)
+	_ = db.UpdateUserProvider(_context.TODO(), u, "enterprise")
+
+	// setup tests
+	tests := []struct {
+		name     string
+		provider string
+		code     int
+		want     scm.Service
+	}{
+		{
+			name:     "user provider",
+			provider: "enterprise",
+			code:     http.StatusOK,
+			want:     want,
+		},
+		{
+			name:     "default provider",
+			provider: "",
+			code:     http.StatusUnauthorized,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var got scm.Service
+
+			mto := &token.MintTokenOpts{
+				User:          u,
+				SCMProvider:   test.provider,
+				TokenDuration: tm.UserAccessTokenDuration,
+				TokenType:     constants.UserAccessTokenType,
+			}
+
+			at, _ := tm.MintToken(mto)
+
+			// setup context
+			gin.SetMode(gin.TestMode)
+
+			resp := httptest.NewRecorder()
+			context, engine := gin.CreateTestContext(resp)
+			context.Request, _ = http.NewRequest(http.MethodGet, "/users/foo", nil)
+			context.Request.Header.Add("Authorization", fmt.Sprintf("Bearer %s", at))
+
+			// setup vela mock server
+			engine.Use(func(c *gin.Context) { c.Set("token-manager", tm) })
+			engine.Use(func(c *gin.Context) { database.ToContext(c, db) })
+			engine.Use(func(c *gin.Context) {
+				scm.ProvidersToContext(c, providers)
+				scm.ToContext(c, providers.Default())
+			})
+			engine.Use(claims.Establish())
+			engine.Use(Establish())
+			engine.GET("/users/:user", func(c *gin.Context) {
+				got = scm.FromContext(c)
+
+				c.Status(http.StatusOK)
+			})
+
+			// run test
+			engine.ServeHTTP(resp, context.Request)
+
+			if resp.Code != test.code {
+				t.Errorf("Establish for %s returned %v, want %v", test.name, resp.Code, test.code)
+			}
+
+			if got != test.want {
+				t.Errorf("Establish for %s scm is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
+
 func TestUser_Establish_NoToken(t *testing.T) {
 	// setup types
 	secret := "superSecret"
@@ -11,11 +11,21 @@ import (
 // QueueHandlers is a function that extends the provided base router group
 // with the API handlers for queue registration functionality.
 //
-// POST   /api/v1/queue/register.
+// GET    /api/v1/queue/info
+// POST   /api/v1/queue/pop
+// POST   /api/v1/queue/items/:build/ack
+// POST   /api/v1/queue/items/:build/nack
+// POST   /api/v1/queue/items/:build/extend .
 func QueueHandlers(base *gin.RouterGroup) {
 	// Queue endpoints
 	_queue := base.Group("/queue")
 	{
 		_queue.GET("/info", perm.MustWorkerRegisterToken(), queue.Info)
+
+		// Memory queue endpoints
+		_queue.POST("/pop", perm.MustWorkerAuthToken(), queue.Pop)
+		_queue.POST("/items/:build/ack", perm.MustWorkerAuthToken(), queue.Ack)
+		_queue.POST("/items/:build/nack", perm.MustWorkerAuthToken(), queue.Nack)
+		_queue.POST("/items/:build/extend", perm.MustWorkerAuthToken(), queue.Extend)
 	} // end of queue endpoints
 }
@@ -33,6 +33,7 @@ import (
 // DELETE /api/v1/repos/:org/:repo/builds/:build/cancel
 // GET    /api/v1/repos/:org/:repo/builds/:build/logs
 // GET    /api/v1/repos/:org/:repo/builds/:build/token
+// GET    /api/v1/repos/:org/:repo/builds/:build/stream
 // POST   /api/v1/repos/:org/:repo/builds/:build/services
 // GET    /api/v1/repos/:org/:repo/builds/:build/services
 // GET    /api/v1/repos/:org/:repo/builds/:build/services/:service
@@ -42,6 +43,9 @@ import (
 // GET    /api/v1/repos/:org/:repo/builds/:build/services/:service/logs
 // PUT    /api/v1/repos/:org/:repo/builds/:build/services/:service/logs
 // DELETE /api/v1/repos/:org/:repo/builds/:build/services/:service/logs
+// POST   /api/v1/repos/:org/:repo/builds/:build/services/:service/logs/chunks
+// GET    /api/v1/repos/:org/:repo/builds/:build/services/:service/logs/range
+// GET    /api/v1/repos/:org/:repo/builds/:build/services/:service/logs/stream
 // POST   /api/v1/repos/:org/:repo/builds/:build/steps
 // GET    /api/v1/repos/:org/:repo/builds/:build/steps
 // GET    /api/v1/repos/:org/:repo/builds/:build/steps/:step
@@ -50,7 +54,10 @@ import (
 // POST   /api/v1/repos/:org/:repo/builds/:build/steps/:step/logs
 // GET    /api/v1/repos/:org/:repo/builds/:build/steps/:step/logs
 // PUT    /api/v1/repos/:org/:repo/builds/:build/steps/:step/logs
-// DELETE /api/v1/repos/:org/:repo/builds/:build/steps/:step/logs .
+// DELETE /api/v1/repos/:org/:repo/builds/:build/steps/:step/logs
+// POST   /api/v1/repos/:org/:repo/builds/:build/steps/:step/logs/chunks
+// GET    /api/v1/repos/:org/:repo/builds/:build/steps/:step/logs/range
+// GET    /api/v1/repos/:org/:repo/builds/:build/steps/:step/logs/stream .
 func RepoHandlers(base *gin.RouterGroup) {
 	// Repos endpoints
 	_repos := base.Group("/repos")
@@ -87,6 +87,7 @@ func Load(options ...gin.HandlerFunc) *gin.Engine {
 
 	// Webhook endpoint
 	r.POST("/webhook", webhook.PostWebhook)
+	r.POST("/webhook/:provider", webhook.PostWebhook)
 
 	// Authentication endpoints
 	authenticate := r.Group("/authenticate")
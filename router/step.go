@@ -22,7 +22,10 @@ import (
 // POST   /api/v1/repos/:org/:repo/builds/:build/steps/:step/logs
 // GET    /api/v1/repos/:org/:repo/builds/:build/steps/:step/logs
 // PUT    /api/v1/repos/:org/:repo/builds/:build/steps/:step/logs
-// DELETE /api/v1/repos/:org/:repo/builds/:build/steps/:step/logs .
+// DELETE /api/v1/repos/:org/:repo/builds/:build/steps/:step/logs
+// POST   /api/v1/repos/:org/:repo/builds/:build/steps/:step/logs/chunks
+// GET    /api/v1/repos/:org/:repo/builds/:build/steps/:step/logs/range
+// GET    /api/v1/repos/:org/:repo/builds/:build/steps/:step/logs/stream .
 func StepHandlers(base *gin.RouterGroup) {
 	// Steps endpoints
 	steps := base.Group("/steps")
@@ -15,6 +15,13 @@ type Setter interface {
 	Set(string, interface{})
 }
 
+// SetterContext defines a context that enables
+// getting and setting values.
+type SetterContext interface {
+	context.Context
+	Setter
+}
+
 // FromContext returns the scm Service
 // associated with this context.
 func FromContext(c context.Context) Service {
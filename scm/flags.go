@@ -53,7 +53,7 @@ var Flags = []cli.Flag{
 		EnvVars:  []string{"VELA_SCM_SCOPES", "SCM_SCOPES", "VELA_SOURCE_SCOPES", "SOURCE_SCOPES"},
 		FilePath: "/vela/scm/scopes",
 		Name:     "scm.scopes",
-		Usage:    "OAuth scopes to be used for the version control system",
+		Usage:    "OAuth scopes to be used for the version control system (defaults to api,read_user for gitlab and read:user,read:organization,write:repository for gitea)",
 		Value:    cli.NewStringSlice("repo", "repo:status", "user:email", "read:user", "read:org"),
 	},
 	&cli.StringFlag{
@@ -66,4 +66,51 @@ var Flags = []cli.Flag{
 			"is behind a Firewall or NAT, or when using something like ngrok to forward webhooks. " +
 			"(defaults to VELA_ADDR).",
 	},
+
+	&cli.Int64Flag{
+		EnvVars:  []string{"VELA_SCM_APP_ID", "SCM_APP_ID"},
+		FilePath: "/vela/scm/app_id",
+		Name:     "scm.app.id",
+		Usage:    "GitHub App ID used to act on repos with installation access tokens instead of the repo owner's OAuth token",
+	},
+	&cli.StringFlag{
+		EnvVars:  []string{"VELA_SCM_APP_PRIVATE_KEY", "SCM_APP_PRIVATE_KEY"},
+		FilePath: "/vela/scm/app_private_key",
+		Name:     "scm.app.private-key",
+		Usage:    "PEM encoded private key for the GitHub App",
+	},
+	&cli.StringFlag{
+		EnvVars: []string{"VELA_SCM_APP_PRIVATE_KEY_PATH", "SCM_APP_PRIVATE_KEY_PATH"},
+		Name:    "scm.app.private-key.path",
+		Usage:   "path to the PEM encoded private key for the GitHub App",
+	},
+	&cli.StringFlag{
+		EnvVars:  []string{"VELA_SCM_APP_WEBHOOK_SECRET", "SCM_APP_WEBHOOK_SECRET"},
+		FilePath: "/vela/scm/app_webhook_secret",
+		Name:     "scm.app.webhook-secret",
+		Usage:    "secret used to verify webhooks delivered to the GitHub App",
+	},
+	&cli.BoolFlag{
+		EnvVars:  []string{"VELA_SCM_CHECKS", "SCM_CHECKS"},
+		FilePath: "/vela/scm/checks",
+		Name:     "scm.checks",
+		Usage:    "report builds as GitHub check runs with per-step detail instead of commit statuses (requires a GitHub App)",
+	},
+	&cli.StringFlag{
+		EnvVars:  []string{"VELA_SCM_NAME", "SCM_NAME"},
+		FilePath: "/vela/scm/name",
+		Name:     "scm.name",
+		Usage:    "name of the default scm provider used to select it at login (defaults to the scm driver)",
+	},
+	&cli.StringFlag{
+		EnvVars: []string{"VELA_SCM_PROVIDERS_FILE", "SCM_PROVIDERS_FILE"},
+		Name:    "scm.providers.file",
+		Usage:   "path to a YAML file defining additional named scm providers served alongside the default scm provider",
+	},
+	&cli.StringFlag{
+		EnvVars:  []string{"VELA_SCM_LOCAL_STATIC_FILE", "SCM_LOCAL_STATIC_FILE"},
+		FilePath: "/vela/scm/local_static_file",
+		Name:     "scm.local.static-file",
+		Usage:    "path to a YAML file providing the users, permissions and webhook secret for the local scm driver (requires a file:// scm address)",
+	},
 }
@@ -0,0 +1,217 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package gitea
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/sirupsen/logrus"
+
+	"github.com/go-vela/types/library"
+)
+
+// OrgAccess captures the user's access level for an org.
+func (c *client) OrgAccess(ctx context.Context, u *library.User, org string) (string, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  org,
+		"user": u.GetName(),
+	}).Tracef("capturing %s access level to org %s", u.GetName(), org)
+
+	// check if user is accessing personal org
+	if strings.EqualFold(org, u.GetName()) {
+		c.Logger.WithFields(logrus.Fields{
+			"org":  org,
+			"user": u.GetName(),
+		}).Debugf("skipping access level check for user %s with org %s", u.GetName(), org)
+
+		//nolint:goconst // ignore making constant
+		return "admin", nil
+	}
+
+	// create Gitea OAuth client with user's token
+	client := c.newClientToken(ctx, u.GetToken())
+
+	// send API call to capture org access level for user
+	perms, _, err := client.GetOrgPermissions(org, u.GetName())
+	if err != nil {
+		return "", err
+	}
+
+	switch {
+	case perms.IsOwner, perms.IsAdmin:
+		return "admin", nil
+	case perms.CanRead, perms.CanWrite:
+		return "member", nil
+	default:
+		return "", nil
+	}
+}
+
+// RepoAccess captures the user's access level for a repo.
+func (c *client) RepoAccess(ctx context.Context, u *library.User, token, org, repo string) (string, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  org,
+		"repo": repo,
+		"user": u.GetName(),
+	}).Tracef("capturing %s access level to repo %s/%s", u.GetName(), org, repo)
+
+	// check if user is accessing repo in personal org
+	if strings.EqualFold(org, u.GetName()) {
+		c.Logger.WithFields(logrus.Fields{
+			"org":  org,
+			"repo": repo,
+			"user": u.GetName(),
+		}).Debugf("skipping access level check for user %s with repo %s/%s", u.GetName(), org, repo)
+
+		return "admin", nil
+	}
+
+	// create gitea oauth client with the given token
+	client := c.newClientToken(ctx, token)
+
+	// send API call to capture repo access level for user
+	perm, resp, err := client.CollaboratorPermission(org, repo, u.GetName())
+	if err != nil {
+		// a 404 is returned when the user has no access to the repo
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return "none", nil
+		}
+
+		return "", err
+	}
+
+	return toRepoPermission(perm.Permission), nil
+}
+
+// RepoInstalled checks if the scm acts on a repo
+// with an app installation instead of a user's token.
+func (c *client) RepoInstalled(ctx context.Context, org, repo string) bool {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  org,
+		"repo": repo,
+	}).Tracef("checking app installation for repo %s/%s", org, repo)
+
+	return false
+}
+
+// TeamAccess captures the user's access level for a team.
+func (c *client) TeamAccess(ctx context.Context, u *library.User, org, team string) (string, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  org,
+		"team": team,
+		"user": u.GetName(),
+	}).Tracef("capturing %s access level to team %s/%s", u.GetName(), org, team)
+
+	// check if user is accessing team in personal org
+	if strings.EqualFold(org, u.GetName()) {
+		c.Logger.WithFields(logrus.Fields{
+			"org":  org,
+			"team": team,
+			"user": u.GetName(),
+		}).Debugf("skipping access level check for user %s with team %s/%s", u.GetName(), org, team)
+
+		return "admin", nil
+	}
+
+	// send API call to list all teams for the user
+	teams, err := c.listMyTeams(ctx, u.GetToken())
+	if err != nil {
+		return "", err
+	}
+
+	// iterate through each element in the teams
+	for _, t := range teams {
+		// skip the team if does not match the team we are checking
+		if !strings.EqualFold(team, t.Name) {
+			continue
+		}
+
+		// skip the org if does not match the org we are checking
+		if t.Organization == nil || !strings.EqualFold(org, t.Organization.UserName) {
+			continue
+		}
+
+		// return admin access if the user is a part of that team
+		return "admin", nil
+	}
+
+	return "", nil
+}
+
+// ListUsersTeamsForOrg captures the user's teams for an org.
+func (c *client) ListUsersTeamsForOrg(ctx context.Context, u *library.User, org string) ([]string, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  org,
+		"user": u.GetName(),
+	}).Tracef("capturing %s team membership for org %s", u.GetName(), org)
+
+	// send API call to list all teams for the user
+	teams, err := c.listMyTeams(ctx, u.GetToken())
+	if err != nil {
+		return []string{""}, err
+	}
+
+	var userTeams []string
+
+	// iterate through each element in the teams
+	for _, t := range teams {
+		// skip the team if it does not belong to the org we are checking
+		if t.Organization == nil || !strings.EqualFold(org, t.Organization.UserName) {
+			continue
+		}
+
+		userTeams = append(userTeams, t.Name)
+	}
+
+	return userTeams, nil
+}
+
+// listMyTeams is a helper function to capture
+// all teams for the user with the provided token.
+func (c *client) listMyTeams(ctx context.Context, token string) ([]*gitea.Team, error) {
+	// create Gitea OAuth client with user's token
+	client := c.newClientToken(ctx, token)
+	teams := []*gitea.Team{}
+
+	// set the page size for the options to capture the list of teams
+	opts := &gitea.ListTeamsOptions{
+		ListOptions: gitea.ListOptions{Page: 1, PageSize: perPage},
+	}
+
+	for {
+		// send API call to list a page of teams for the user
+		t, _, err := client.ListMyTeams(opts)
+		if err != nil {
+			return nil, err
+		}
+
+		teams = append(teams, t...)
+
+		// break the loop if there is no more results to page through
+		if len(t) < opts.PageSize {
+			break
+		}
+
+		opts.Page++
+	}
+
+	return teams, nil
+}
+
+// toRepoPermission is a helper function to convert a
+// Gitea access mode to a Vela repo permission.
+func toRepoPermission(mode gitea.AccessMode) string {
+	switch mode {
+	case gitea.AccessModeOwner, gitea.AccessModeAdmin:
+		return "admin"
+	case gitea.AccessModeWrite:
+		return "write"
+	case gitea.AccessModeRead:
+		return "read"
+	default:
+		return "none"
+	}
+}
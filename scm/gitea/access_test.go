@@ -0,0 +1,284 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package gitea
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/go-vela/types/library"
+)
+
+func TestGitea_OrgAccess(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name string
+		file string
+		want string
+	}{
+		{
+			name: "owner",
+			file: "testdata/org_permissions_owner.json",
+			want: "admin",
+		},
+		{
+			name: "member",
+			file: "testdata/org_permissions_member.json",
+			want: "member",
+		},
+		{
+			name: "none",
+			file: "testdata/org_permissions_none.json",
+			want: "",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// setup context
+			gin.SetMode(gin.TestMode)
+
+			resp := httptest.NewRecorder()
+			_, engine := gin.CreateTestContext(resp)
+
+			// setup mock server
+			engine.GET("/api/v1/users/:user/orgs/:org/permissions", func(c *gin.Context) {
+				c.Header("Content-Type", "application/json")
+				c.Status(http.StatusOK)
+				c.File(test.file)
+			})
+
+			s := httptest.NewServer(engine)
+			defer s.Close()
+
+			// setup types
+			u := new(library.User)
+			u.SetName("foo")
+			u.SetToken("bar")
+
+			client, _ := NewTest(s.URL)
+
+			// run test
+			got, err := client.OrgAccess(context.TODO(), u, "github")
+
+			if err != nil {
+				t.Errorf("OrgAccess returned err: %v", err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("OrgAccess is %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestGitea_OrgAccess_Personal(t *testing.T) {
+	// setup types
+	want := "admin"
+
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	client, _ := NewTest("https://gitea.example.com")
+
+	// run test
+	got, err := client.OrgAccess(context.TODO(), u, "foo")
+
+	if err != nil {
+		t.Errorf("OrgAccess returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("OrgAccess is %v, want %v", got, want)
+	}
+}
+
+func TestGitea_RepoAccess(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name   string
+		status int
+		file   string
+		want   string
+	}{
+		{
+			name:   "admin",
+			status: http.StatusOK,
+			file:   "testdata/permission_admin.json",
+			want:   "admin",
+		},
+		{
+			name:   "write",
+			status: http.StatusOK,
+			file:   "testdata/permission_write.json",
+			want:   "write",
+		},
+		{
+			name:   "not found",
+			status: http.StatusNotFound,
+			want:   "none",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// setup context
+			gin.SetMode(gin.TestMode)
+
+			resp := httptest.NewRecorder()
+			_, engine := gin.CreateTestContext(resp)
+
+			// setup mock server
+			engine.GET("/api/v1/repos/:org/:repo/collaborators/:user/permission", func(c *gin.Context) {
+				if test.status != http.StatusOK {
+					c.Status(test.status)
+					return
+				}
+
+				c.Header("Content-Type", "application/json")
+				c.Status(http.StatusOK)
+				c.File(test.file)
+			})
+
+			s := httptest.NewServer(engine)
+			defer s.Close()
+
+			// setup types
+			u := new(library.User)
+			u.SetName("foo")
+			u.SetToken("bar")
+
+			client, _ := NewTest(s.URL)
+
+			// run test
+			got, err := client.RepoAccess(context.TODO(), u, u.GetToken(), "github", "octocat")
+
+			if err != nil {
+				t.Errorf("RepoAccess returned err: %v", err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("RepoAccess is %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestGitea_RepoInstalled(t *testing.T) {
+	client, _ := NewTest("https://gitea.example.com")
+
+	// run test
+	got := client.RepoInstalled(context.TODO(), "github", "octocat")
+
+	if got {
+		t.Errorf("RepoInstalled is %v, want false", got)
+	}
+}
+
+func TestGitea_TeamAccess(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name string
+		org  string
+		team string
+		want string
+	}{
+		{
+			name: "member",
+			org:  "github",
+			team: "octokitties",
+			want: "admin",
+		},
+		{
+			name: "not member",
+			org:  "github",
+			team: "outsiders",
+			want: "",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// setup context
+			gin.SetMode(gin.TestMode)
+
+			resp := httptest.NewRecorder()
+			_, engine := gin.CreateTestContext(resp)
+
+			// setup mock server
+			engine.GET("/api/v1/user/teams", func(c *gin.Context) {
+				c.Header("Content-Type", "application/json")
+				c.Status(http.StatusOK)
+				c.File("testdata/teams.json")
+			})
+
+			s := httptest.NewServer(engine)
+			defer s.Close()
+
+			// setup types
+			u := new(library.User)
+			u.SetName("foo")
+			u.SetToken("bar")
+
+			client, _ := NewTest(s.URL)
+
+			// run test
+			got, err := client.TeamAccess(context.TODO(), u, test.org, test.team)
+
+			if err != nil {
+				t.Errorf("TeamAccess returned err: %v", err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("TeamAccess is %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestGitea_ListUsersTeamsForOrg(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/api/v1/user/teams", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusOK)
+		c.File("testdata/teams.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	want := []string{"Justice League", "octokitties"}
+
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got, err := client.ListUsersTeamsForOrg(context.TODO(), u, "github")
+
+	if err != nil {
+		t.Errorf("ListUsersTeamsForOrg returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ListUsersTeamsForOrg is %v, want %v", got, want)
+	}
+}
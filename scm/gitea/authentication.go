@@ -0,0 +1,234 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package gitea
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/go-vela/server/random"
+	"github.com/go-vela/types/library"
+)
+
+// tokenInfo represents the response from the
+// Gitea OAuth token introspection endpoint.
+//
+// https://docs.gitea.com/development/oauth2-provider
+type tokenInfo struct {
+	Active   bool            `json:"active"`
+	Audience json.RawMessage `json:"aud"`
+}
+
+// audience is a helper function to capture the list of
+// client IDs from the audience claim for the token.
+//
+// The audience may be provided as a string or a list of strings.
+func (t *tokenInfo) audience() []string {
+	if len(t.Audience) == 0 {
+		return nil
+	}
+
+	aud := []string{}
+
+	// attempt to decode the audience as a list
+	err := json.Unmarshal(t.Audience, &aud)
+	if err == nil {
+		return aud
+	}
+
+	single := ""
+
+	// attempt to decode the audience as a string
+	err = json.Unmarshal(t.Audience, &single)
+	if err != nil {
+		return nil
+	}
+
+	return []string{single}
+}
+
+// Authorize uses the given access token to authorize the user.
+func (c *client) Authorize(ctx context.Context, token string) (string, error) {
+	c.Logger.Trace("authorizing user with token")
+
+	// create Gitea OAuth client with user's token
+	client := c.newClientToken(ctx, token)
+
+	// send API call to capture the current user making the call
+	u, _, err := client.GetMyUserInfo()
+	if err != nil {
+		return "", err
+	}
+
+	return u.UserName, nil
+}
+
+// Login begins the authentication workflow for the session.
+func (c *client) Login(ctx context.Context, w http.ResponseWriter, r *http.Request) (string, error) {
+	c.Logger.Trace("processing login request")
+
+	// generate a random string for creating the OAuth state
+	oAuthState, err := random.GenerateRandomString(32)
+	if err != nil {
+		return "", err
+	}
+
+	// embed the scm provider name so the server
+	// can route the OAuth callback to the provider
+	if len(c.config.Name) > 0 {
+		oAuthState = fmt.Sprintf("%s.%s", c.config.Name, oAuthState)
+	}
+
+	// pass through the redirect if it exists
+	redirect := r.FormValue("redirect_uri")
+	if len(redirect) > 0 {
+		c.OAuth.RedirectURL = redirect
+	}
+
+	// temporarily redirect request to Gitea to begin workflow
+	http.Redirect(w, r, c.OAuth.AuthCodeURL(oAuthState), http.StatusTemporaryRedirect)
+
+	return oAuthState, nil
+}
+
+// Authenticate completes the authentication workflow for the session
+// and returns the remote user details.
+func (c *client) Authenticate(ctx context.Context, w http.ResponseWriter, r *http.Request, oAuthState string) (*library.User, error) {
+	c.Logger.Trace("authenticating user")
+
+	// get the OAuth code
+	code := r.FormValue("code")
+	if len(code) == 0 {
+		return nil, nil
+	}
+
+	// verify the OAuth state
+	state := r.FormValue("state")
+	if state != oAuthState {
+		return nil, fmt.Errorf("unexpected oauth state: want %s but got %s", oAuthState, state)
+	}
+
+	// pass through the redirect if it exists
+	redirect := r.FormValue("redirect_uri")
+	if len(redirect) > 0 {
+		c.OAuth.RedirectURL = redirect
+	}
+
+	// exchange OAuth code for token
+	token, err := c.OAuth.Exchange(context.Background(), code)
+	if err != nil {
+		return nil, err
+	}
+
+	// authorize the user for the token
+	u, err := c.Authorize(ctx, token.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return &library.User{
+		Name:  &u,
+		Token: &token.AccessToken,
+	}, nil
+}
+
+// AuthenticateToken completes the authentication workflow
+// for the session and returns the remote user details.
+func (c *client) AuthenticateToken(ctx context.Context, r *http.Request) (*library.User, error) {
+	c.Logger.Trace("authenticating user via token")
+
+	token := r.Header.Get("Token")
+	if len(token) == 0 {
+		return nil, errors.New("no token provided")
+	}
+
+	// validate that the token was not created by vela
+	ok, err := c.ValidateOAuthToken(ctx, token)
+	if err != nil {
+		return nil, fmt.Errorf("unable to validate oauth token: %w", err)
+	}
+
+	if ok {
+		return nil, errors.New("token must not be created by vela")
+	}
+
+	u, err := c.Authorize(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	return &library.User{
+		Name:  &u,
+		Token: &token,
+	}, nil
+}
+
+// ValidateOAuthToken takes a user oauth integration token and
+// validates that it was created by the Vela OAuth application.
+// In essence, the function expects a 200 from the Gitea token
+// introspection endpoint and returns error in any other failure case.
+// Tokens that were not issued through OAuth are reported as inactive.
+func (c *client) ValidateOAuthToken(ctx context.Context, token string) (bool, error) {
+	// create the form with the token to introspect
+	form := url.Values{}
+	form.Set("token", token)
+
+	// create the request to capture the token information
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		fmt.Sprintf("%s/login/oauth/introspect", c.config.Address),
+		strings.NewReader(form.Encode()),
+	)
+	if err != nil {
+		return false, err
+	}
+
+	// the introspection endpoint requires the OAuth application credentials
+	req.SetBasicAuth(c.config.ClientID, c.config.ClientSecret)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	// send the request to capture the token information
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return false, err
+	}
+
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		break
+	// 401 is returned for tokens that can not be introspected
+	case http.StatusUnauthorized:
+		return false, nil
+	default:
+		return false, fmt.Errorf("unexpected status code %d validating oauth token", resp.StatusCode)
+	}
+
+	info := new(tokenInfo)
+
+	err = json.NewDecoder(resp.Body).Decode(info)
+	if err != nil {
+		return false, err
+	}
+
+	// tokens not issued through OAuth are always inactive
+	if !info.Active {
+		return false, nil
+	}
+
+	// verify the token was issued for the Vela OAuth application
+	for _, aud := range info.audience() {
+		if aud == c.config.ClientID {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
@@ -0,0 +1,325 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package gitea
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	_context "context"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/types/library"
+)
+
+func TestGitea_Authenticate(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	context, engine := gin.CreateTestContext(resp)
+	context.Request, _ = http.NewRequest(http.MethodGet, "/login/oauth/authorize?code=foo&state=bar", nil)
+
+	// setup mock server
+	engine.POST("/login/oauth/access_token", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusOK)
+		c.File("testdata/token.json")
+	})
+	engine.GET("/api/v1/user", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusOK)
+		c.File("testdata/user.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	want := new(library.User)
+	want.SetName("octocat")
+	want.SetToken("foo")
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got, err := client.Authenticate(_context.TODO(), context.Writer, context.Request, "bar")
+
+	if err != nil {
+		t.Errorf("Authenticate returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Authenticate is %v, want %v", got, want)
+	}
+}
+
+func TestGitea_Authenticate_NoState(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	context, engine := gin.CreateTestContext(resp)
+	context.Request, _ = http.NewRequest(http.MethodGet, "/login/oauth/authorize?code=foo", nil)
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got, err := client.Authenticate(_context.TODO(), context.Writer, context.Request, "bar")
+
+	if err == nil {
+		t.Errorf("Authenticate should have returned err")
+	}
+
+	if got != nil {
+		t.Errorf("Authenticate is %v, want nil", got)
+	}
+}
+
+func TestGitea_Authorize(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/api/v1/user", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusOK)
+		c.File("testdata/user.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	want := "octocat"
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got, err := client.Authorize(_context.TODO(), "foobar")
+
+	if err != nil {
+		t.Errorf("Authorize returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Authorize is %v, want %v", got, want)
+	}
+}
+
+func TestGitea_Authorize_NotFound(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/api/v1/user", func(c *gin.Context) {
+		c.Status(http.StatusNotFound)
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got, err := client.Authorize(_context.TODO(), "foobar")
+
+	if err == nil {
+		t.Errorf("Authorize should have returned err")
+	}
+
+	if len(got) > 0 {
+		t.Errorf("Authorize is %v, want empty", got)
+	}
+}
+
+func TestGitea_Login(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	context, engine := gin.CreateTestContext(resp)
+	context.Request, _ = http.NewRequest(http.MethodGet, "/login", nil)
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	client, _ := NewTest(s.URL)
+
+	// run test
+	_, err := client.Login(_context.TODO(), context.Writer, context.Request)
+
+	if resp.Code != http.StatusTemporaryRedirect {
+		t.Errorf("Login returned %v, want %v", resp.Code, http.StatusTemporaryRedirect)
+	}
+
+	if err != nil {
+		t.Errorf("Login returned err: %v", err)
+	}
+}
+
+func TestGitea_AuthenticateToken(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	context, engine := gin.CreateTestContext(resp)
+	context.Request, _ = http.NewRequest(http.MethodPost, "/authenticate/token", nil)
+	context.Request.Header.Set("Token", "foo")
+
+	// setup mock server
+	engine.POST("/login/oauth/introspect", func(c *gin.Context) {
+		c.Status(http.StatusUnauthorized)
+	})
+	engine.GET("/api/v1/user", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusOK)
+		c.File("testdata/user.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	want := new(library.User)
+	want.SetName("octocat")
+	want.SetToken("foo")
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got, err := client.AuthenticateToken(_context.TODO(), context.Request)
+
+	if err != nil {
+		t.Errorf("AuthenticateToken returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("AuthenticateToken is %v, want %v", got, want)
+	}
+}
+
+func TestGitea_AuthenticateToken_Vela_OAuth(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	context, engine := gin.CreateTestContext(resp)
+	context.Request, _ = http.NewRequest(http.MethodPost, "/authenticate/token", nil)
+	context.Request.Header.Set("Token", "vela")
+
+	// setup mock server
+	engine.POST("/login/oauth/introspect", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusOK)
+		c.File("testdata/token_info.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	_, err := client.AuthenticateToken(_context.TODO(), context.Request)
+
+	if err == nil {
+		t.Errorf("AuthenticateToken should have returned err")
+	}
+}
+
+func TestGitea_ValidateOAuthToken(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name    string
+		failure bool
+		status  int
+		file    string
+		want    bool
+	}{
+		{
+			name:    "valid",
+			failure: false,
+			status:  http.StatusOK,
+			file:    "testdata/token_info.json",
+			want:    true,
+		},
+		{
+			name:    "inactive",
+			failure: false,
+			status:  http.StatusOK,
+			file:    "testdata/token_info_inactive.json",
+			want:    false,
+		},
+		{
+			name:    "invalid",
+			failure: false,
+			status:  http.StatusUnauthorized,
+			want:    false,
+		},
+		{
+			name:    "error",
+			failure: true,
+			status:  http.StatusInternalServerError,
+			want:    false,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// setup context
+			gin.SetMode(gin.TestMode)
+
+			resp := httptest.NewRecorder()
+			_, engine := gin.CreateTestContext(resp)
+
+			// setup mock server
+			engine.POST("/login/oauth/introspect", func(c *gin.Context) {
+				if test.status != http.StatusOK {
+					c.Status(test.status)
+					return
+				}
+
+				c.Header("Content-Type", "application/json")
+				c.Status(http.StatusOK)
+				c.File(test.file)
+			})
+
+			s := httptest.NewServer(engine)
+			defer s.Close()
+
+			client, _ := NewTest(s.URL)
+
+			got, err := client.ValidateOAuthToken(_context.TODO(), "foobar")
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("ValidateOAuthToken should have returned err")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("ValidateOAuthToken returned err: %v", err)
+			}
+
+			if got != test.want {
+				t.Errorf("ValidateOAuthToken is %v, want %v", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package gitea
+
+import (
+	"context"
+	"fmt"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/sirupsen/logrus"
+
+	"github.com/go-vela/types/library"
+)
+
+// Changeset captures the list of files changed for a commit.
+func (c *client) Changeset(ctx context.Context, u *library.User, r *library.Repo, sha string) ([]string, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("capturing commit changeset for %s/commit/%s", r.GetFullName(), sha)
+
+	// create Gitea OAuth client with user's token
+	client := c.newClientToken(ctx, u.GetToken())
+	s := []string{}
+
+	// send API call to capture the commit
+	commit, _, err := client.GetSingleCommit(r.GetOrg(), r.GetName(), sha)
+	if err != nil {
+		return nil, fmt.Errorf("GetSingleCommit returned error: %w", err)
+	}
+
+	// iterate through each file in the commit
+	for _, f := range commit.Files {
+		s = append(s, f.Filename)
+	}
+
+	return s, nil
+}
+
+// ChangesetPR captures the list of files changed for a pull request.
+func (c *client) ChangesetPR(ctx context.Context, u *library.User, r *library.Repo, number int) ([]string, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("capturing pull request changeset for %s/pulls/%d", r.GetFullName(), number)
+
+	// create Gitea OAuth client with user's token
+	client := c.newClientToken(ctx, u.GetToken())
+	s := []string{}
+	f := []*gitea.ChangedFile{}
+
+	// set the page size for the options to capture the list of files
+	opts := gitea.ListPullRequestFilesOptions{
+		ListOptions: gitea.ListOptions{Page: 1, PageSize: perPage},
+	}
+
+	for {
+		// send API call to capture the files from the pull request
+		files, _, err := client.ListPullRequestFiles(r.GetOrg(), r.GetName(), int64(number), opts)
+		if err != nil {
+			return nil, fmt.Errorf("ListPullRequestFiles returned error: %w", err)
+		}
+
+		f = append(f, files...)
+
+		// break the loop if there is no more results to page through
+		if len(files) < opts.PageSize {
+			break
+		}
+
+		opts.Page++
+	}
+
+	// iterate through each file in the pull request
+	for _, file := range f {
+		s = append(s, file.Filename)
+	}
+
+	return s, nil
+}
+
+// CommitMessage captures the message for a commit.
+func (c *client) CommitMessage(ctx context.Context, u *library.User, r *library.Repo, sha string) (string, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("capturing commit message for %s/commit/%s", r.GetFullName(), sha)
+
+	// create Gitea OAuth client with user's token
+	client := c.newClientToken(ctx, u.GetToken())
+
+	// send API call to capture the commit
+	commit, _, err := client.GetSingleCommit(r.GetOrg(), r.GetName(), sha)
+	if err != nil {
+		return "", fmt.Errorf("GetSingleCommit returned error: %w", err)
+	}
+
+	if commit.RepoCommit == nil {
+		return "", nil
+	}
+
+	return commit.RepoCommit.Message, nil
+}
@@ -0,0 +1,141 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package gitea
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/go-vela/types/library"
+)
+
+func TestGitea_Changeset(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/api/v1/repos/:org/:repo/git/commits/:sha", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusOK)
+		c.File("testdata/commit.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	r := new(library.Repo)
+	r.SetOrg("repos")
+	r.SetName("octocat")
+
+	want := []string{"README.md"}
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got, err := client.Changeset(context.TODO(), u, r, "6dcb09b5b57875f334f61aebed695e2e4193db5e")
+
+	if err != nil {
+		t.Errorf("Changeset returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Changeset is %v, want %v", got, want)
+	}
+}
+
+func TestGitea_ChangesetPR(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/api/v1/repos/:org/:repo/pulls/:number/files", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusOK)
+		c.File("testdata/pull_request_files.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	r := new(library.Repo)
+	r.SetOrg("repos")
+	r.SetName("octocat")
+
+	want := []string{"README.md"}
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got, err := client.ChangesetPR(context.TODO(), u, r, 1)
+
+	if err != nil {
+		t.Errorf("ChangesetPR returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ChangesetPR is %v, want %v", got, want)
+	}
+}
+
+func TestGitea_CommitMessage(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/api/v1/repos/:org/:repo/git/commits/:sha", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusOK)
+		c.File("testdata/commit.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	r := new(library.Repo)
+	r.SetOrg("repos")
+	r.SetName("octocat")
+
+	want := "Update README.md"
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got, err := client.CommitMessage(context.TODO(), u, r, "6dcb09b5b57875f334f61aebed695e2e4193db5e")
+
+	if err != nil {
+		t.Errorf("CommitMessage returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("CommitMessage is %v, want %v", got, want)
+	}
+}
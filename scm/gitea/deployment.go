@@ -0,0 +1,42 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package gitea
+
+import (
+	"context"
+	"errors"
+
+	"github.com/go-vela/types/library"
+)
+
+// errDeploymentsNotSupported is returned for any deployment
+// operation since Gitea does not provide a deployments API.
+var errDeploymentsNotSupported = errors.New("deployments are not supported by the gitea scm driver")
+
+// GetDeployment gets a deployment from the Gitea repo.
+//
+// Gitea does not support deployments so an error is always returned.
+func (c *client) GetDeployment(ctx context.Context, u *library.User, r *library.Repo, id int64) (*library.Deployment, error) {
+	return nil, errDeploymentsNotSupported
+}
+
+// GetDeploymentCount counts a list of deployments from the Gitea repo.
+//
+// Gitea does not support deployments so an error is always returned.
+func (c *client) GetDeploymentCount(ctx context.Context, u *library.User, r *library.Repo) (int64, error) {
+	return 0, errDeploymentsNotSupported
+}
+
+// GetDeploymentList gets a list of deployments from the Gitea repo.
+//
+// Gitea does not support deployments so an error is always returned.
+func (c *client) GetDeploymentList(ctx context.Context, u *library.User, r *library.Repo, page, perPage int) ([]*library.Deployment, error) {
+	return nil, errDeploymentsNotSupported
+}
+
+// CreateDeployment creates a new deployment for the Gitea repo.
+//
+// Gitea does not support deployments so an error is always returned.
+func (c *client) CreateDeployment(ctx context.Context, u *library.User, r *library.Repo, d *library.Deployment) error {
+	return errDeploymentsNotSupported
+}
@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package gitea
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-vela/types/library"
+)
+
+func TestGitea_Deployments_NotSupported(t *testing.T) {
+	// setup types
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	r := new(library.Repo)
+	r.SetOrg("foo")
+	r.SetName("bar")
+	r.SetFullName("foo/bar")
+
+	client, _ := NewTest("https://gitea.example.com")
+
+	// run tests
+	_, err := client.GetDeployment(context.TODO(), u, r, 1)
+	if err == nil {
+		t.Errorf("GetDeployment should have returned err")
+	}
+
+	_, err = client.GetDeploymentCount(context.TODO(), u, r)
+	if err == nil {
+		t.Errorf("GetDeploymentCount should have returned err")
+	}
+
+	_, err = client.GetDeploymentList(context.TODO(), u, r, 1, 10)
+	if err == nil {
+		t.Errorf("GetDeploymentList should have returned err")
+	}
+
+	err = client.CreateDeployment(context.TODO(), u, r, new(library.Deployment))
+	if err == nil {
+		t.Errorf("CreateDeployment should have returned err")
+	}
+}
@@ -0,0 +1,9 @@
+// SPDX-License-Identifier: Apache-2.0
+
+// Package gitea provides the ability for Vela to
+// integrate with Gitea or Forgejo as a scm provider.
+//
+// Usage:
+//
+//	import "github.com/go-vela/server/scm/gitea"
+package gitea
@@ -0,0 +1,12 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package gitea
+
+// DriverGitea defines the driver type when integrating
+// with a Gitea or Forgejo scm system.
+const DriverGitea = "gitea"
+
+// Driver outputs the configured scm driver.
+func (c *client) Driver() string {
+	return DriverGitea
+}
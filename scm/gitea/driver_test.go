@@ -0,0 +1,33 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package gitea
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGitea_Driver(t *testing.T) {
+	// setup types
+	want := DriverGitea
+
+	_service, err := New(
+		WithAddress("https://gitea.com/"),
+		WithClientID("foo"),
+		WithClientSecret("bar"),
+		WithServerAddress("https://vela-server.example.com"),
+		WithStatusContext("continuous-integration/vela"),
+		WithWebUIAddress("https://vela.example.com"),
+		WithScopes([]string{"read:user", "read:organization", "write:repository"}),
+	)
+	if err != nil {
+		t.Errorf("unable to create scm service: %v", err)
+	}
+
+	// run test
+	got := _service.Driver()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Driver is %v, want %v", got, want)
+	}
+}
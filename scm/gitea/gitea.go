@@ -0,0 +1,161 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package gitea
+
+import (
+	"context"
+	"fmt"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/sirupsen/logrus"
+
+	"golang.org/x/oauth2"
+)
+
+const (
+	defaultURL = "https://gitea.com" // Default Gitea URL
+
+	// number of results to capture per page when
+	// paging through lists from the Gitea API.
+	perPage = 50
+
+	// events for repo webhooks.
+	eventPush               = "push"
+	eventPullRequest        = "pull_request"
+	eventPullRequestSync    = "pull_request_sync"
+	eventIssueComment       = "issue_comment"
+	eventPullRequestComment = "pull_request_comment"
+	eventRepository         = "repository"
+	eventInitialize         = "initialize"
+
+	// actions for pull request webhooks.
+	actionSynchronized = "synchronized"
+)
+
+type config struct {
+	// specifies the name of the scm provider for the Gitea client
+	Name string
+	// specifies the address to use for the Gitea client
+	Address string
+	// specifies the OAuth client ID from Gitea to use for the Gitea client
+	ClientID string
+	// specifies the OAuth client secret from Gitea to use for the Gitea client
+	ClientSecret string
+	// specifies the Vela server address to use for the Gitea client
+	ServerAddress string
+	// specifies the Vela server address that the scm provider should use to send Vela webhooks
+	ServerWebhookAddress string
+	// specifies the context for the commit status to use for the Gitea client
+	StatusContext string
+	// specifies the Vela web UI address to use for the Gitea client
+	WebUIAddress string
+	// specifies the OAuth scopes to use for the Gitea client
+	Scopes []string
+}
+
+type client struct {
+	config *config
+	OAuth  *oauth2.Config
+	// https://pkg.go.dev/github.com/sirupsen/logrus#Entry
+	Logger *logrus.Entry
+}
+
+// New returns a SCM implementation that integrates with
+// a Gitea or a Forgejo instance.
+//
+//nolint:revive // ignore returning unexported client
+func New(opts ...ClientOpt) (*client, error) {
+	// create new Gitea client
+	c := new(client)
+
+	// create new fields
+	c.config = new(config)
+	c.OAuth = new(oauth2.Config)
+
+	// create new logger for the client
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#StandardLogger
+	logger := logrus.StandardLogger()
+
+	// create new logger for the client
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#NewEntry
+	c.Logger = logrus.NewEntry(logger).WithField("scm", c.Driver())
+
+	// apply all provided configuration options
+	for _, opt := range opts {
+		err := opt(c)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// create the Gitea OAuth config object
+	c.OAuth = &oauth2.Config{
+		ClientID:     c.config.ClientID,
+		ClientSecret: c.config.ClientSecret,
+		Scopes:       c.config.Scopes,
+		Endpoint: oauth2.Endpoint{
+			AuthURL:  fmt.Sprintf("%s/login/oauth/authorize", c.config.Address),
+			TokenURL: fmt.Sprintf("%s/login/oauth/access_token", c.config.Address),
+		},
+	}
+
+	return c, nil
+}
+
+// NewTest returns a SCM implementation that integrates with the provided
+// mock server. Only the url from the mock server is required.
+//
+// This function is intended for running tests only.
+//
+//nolint:revive // ignore returning unexported client
+func NewTest(urls ...string) (*client, error) {
+	address := urls[0]
+	server := address
+
+	// check if multiple URLs were provided
+	if len(urls) > 1 {
+		server = urls[1]
+	}
+
+	return New(
+		WithAddress(address),
+		WithClientID("foo"),
+		WithClientSecret("bar"),
+		WithServerAddress(server),
+		WithServerWebhookAddress(""),
+		WithStatusContext("continuous-integration/vela"),
+		WithWebUIAddress(address),
+		WithScopes([]string{"read:user", "read:organization", "write:repository"}),
+	)
+}
+
+// helper function to return the Gitea OAuth client.
+func (c *client) newClientToken(ctx context.Context, token string) *gitea.Client {
+	// create the Gitea client from the OAuth token
+	//
+	// the server version check is skipped to avoid an extra API
+	// call for every client which means no error is returned
+	client, _ := gitea.NewClient(
+		c.config.Address,
+		gitea.SetToken(token),
+		gitea.SetContext(ctx),
+		gitea.SetGiteaVersion(""),
+	)
+
+	return client
+}
+
+// webhookURL returns the Vela server address that
+// Gitea should use to send repository webhooks.
+//
+// Named scm providers receive webhooks on their own
+// path so the server can route them to the provider.
+func (c *client) webhookURL() string {
+	if len(c.config.Name) > 0 {
+		return fmt.Sprintf("%s/webhook/%s", c.config.ServerWebhookAddress, c.config.Name)
+	}
+
+	return fmt.Sprintf("%s/webhook", c.config.ServerWebhookAddress)
+}
@@ -0,0 +1,94 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package gitea
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestGitea_New(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		id      string
+	}{
+		{
+			failure: false,
+			id:      "foo",
+		},
+		{
+			failure: true,
+			id:      "",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		_, err := New(
+			WithAddress("https://gitea.com/"),
+			WithClientID(test.id),
+			WithClientSecret("bar"),
+			WithServerAddress("https://vela-server.example.com"),
+			WithStatusContext("continuous-integration/vela"),
+			WithWebUIAddress("https://vela.example.com"),
+			WithScopes([]string{"read:user", "read:organization", "write:repository"}),
+		)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("New should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("New returned err: %v", err)
+		}
+	}
+}
+
+func TestGitea_newClientToken(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/api/v1/user", func(c *gin.Context) {
+		if c.GetHeader("Authorization") != "token foobar" {
+			c.Status(http.StatusUnauthorized)
+			return
+		}
+
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusOK)
+		c.File("testdata/user.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup client
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got := client.newClientToken(context.TODO(), "foobar")
+
+	if got == nil {
+		t.Errorf("newClientToken is nil")
+
+		return
+	}
+
+	_, _, err := got.GetMyUserInfo()
+	if err != nil {
+		t.Errorf("newClientToken client returned err: %v", err)
+	}
+}
@@ -0,0 +1,163 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package gitea
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// ClientOpt represents a configuration option to initialize the scm client for Gitea.
+type ClientOpt func(*client) error
+
+// WithAddress sets the Gitea address in the scm client for Gitea.
+func WithAddress(address string) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring address in gitea scm client")
+
+		// set a default address for the client
+		c.config.Address = defaultURL
+
+		// check if an address was provided
+		if len(address) > 0 {
+			// check if the address is a valid url
+			_, err := url.ParseRequestURI(address)
+			if err != nil {
+				return fmt.Errorf("invalid Gitea address provided: %w", err)
+			}
+
+			// set the address for the client
+			c.config.Address = strings.TrimSuffix(address, "/")
+		}
+
+		return nil
+	}
+}
+
+// WithClientID sets the OAuth client ID in the scm client for Gitea.
+func WithClientID(id string) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring OAuth client ID in gitea scm client")
+
+		// check if the OAuth client ID provided is empty
+		if len(id) == 0 {
+			return fmt.Errorf("no Gitea OAuth client ID provided")
+		}
+
+		// set the OAuth client ID in the gitea client
+		c.config.ClientID = id
+
+		return nil
+	}
+}
+
+// WithClientSecret sets the OAuth client secret in the scm client for Gitea.
+func WithClientSecret(secret string) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring OAuth client secret in gitea scm client")
+
+		// check if the OAuth client secret provided is empty
+		if len(secret) == 0 {
+			return fmt.Errorf("no Gitea OAuth client secret provided")
+		}
+
+		// set the OAuth client secret in the gitea client
+		c.config.ClientSecret = secret
+
+		return nil
+	}
+}
+
+// WithServerAddress sets the Vela server address in the scm client for Gitea.
+func WithServerAddress(address string) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring Vela server address in gitea scm client")
+
+		// check if the Vela server address provided is empty
+		if len(address) == 0 {
+			return fmt.Errorf("no Vela server address provided")
+		}
+
+		// set the Vela server address in the gitea client
+		c.config.ServerAddress = address
+
+		return nil
+	}
+}
+
+// WithServerWebhookAddress sets the Vela server webhook address in the scm client for Gitea.
+func WithServerWebhookAddress(address string) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring Vela server webhook address in gitea scm client")
+
+		// fallback to Vela server address if the provided Vela server webhook address is empty
+		if len(address) == 0 {
+			c.config.ServerWebhookAddress = c.config.ServerAddress
+			return nil
+		}
+
+		// set the Vela server webhook address in the gitea client
+		c.config.ServerWebhookAddress = address
+
+		return nil
+	}
+}
+
+// WithStatusContext sets the context for commit statuses in the scm client for Gitea.
+func WithStatusContext(context string) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring context for commit statuses in gitea scm client")
+
+		// check if the context for the commit statuses provided is empty
+		if len(context) == 0 {
+			return fmt.Errorf("no Gitea context for commit statuses provided")
+		}
+
+		// set the context for the commit status in the gitea client
+		c.config.StatusContext = context
+
+		return nil
+	}
+}
+
+// WithWebUIAddress sets the Vela web UI address in the scm client for Gitea.
+func WithWebUIAddress(address string) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring Vela web UI address in gitea scm client")
+
+		// set the Vela web UI address in the gitea client
+		c.config.WebUIAddress = address
+
+		return nil
+	}
+}
+
+// WithScopes sets the OAuth scopes in the scm client for Gitea.
+func WithScopes(scopes []string) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring oauth scopes in gitea scm client")
+
+		// check if the scopes provided is empty
+		if len(scopes) == 0 {
+			return fmt.Errorf("no Gitea OAuth scopes provided")
+		}
+
+		// set the scopes in the gitea client
+		c.config.Scopes = scopes
+
+		return nil
+	}
+}
+
+// WithName sets the name of the scm provider in the scm client for Gitea.
+func WithName(name string) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring scm provider name in gitea scm client")
+
+		// set the scm provider name in the gitea client
+		c.config.Name = name
+
+		return nil
+	}
+}
@@ -0,0 +1,383 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package gitea
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGitea_ClientOpt_WithAddress(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		address string
+		want    config
+	}{
+		{
+			failure: false,
+			address: "https://git.example.com/",
+			want: config{
+				Address: "https://git.example.com",
+			},
+		},
+		{
+			failure: false,
+			address: "",
+			want: config{
+				Address: defaultURL,
+			},
+		},
+		{
+			failure: true,
+			address: "git.example.com",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		_service, err := New(
+			WithAddress(test.address),
+		)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("WithAddress should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("WithAddress returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(_service.config.Address, test.want.Address) {
+			t.Errorf("WithAddress is %v, want %v", _service.config.Address, test.want.Address)
+		}
+	}
+}
+
+func TestGitea_ClientOpt_WithClientID(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		id      string
+		want    string
+	}{
+		{
+			failure: false,
+			id:      "foo",
+			want:    "foo",
+		},
+		{
+			failure: true,
+			id:      "",
+			want:    "",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		_service, err := New(
+			WithClientID(test.id),
+		)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("WithClientID should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("WithClientID returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(_service.config.ClientID, test.want) {
+			t.Errorf("WithClientID is %v, want %v", _service.config.ClientID, test.want)
+		}
+	}
+}
+
+func TestGitea_ClientOpt_WithClientSecret(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		secret  string
+		want    string
+	}{
+		{
+			failure: false,
+			secret:  "bar",
+			want:    "bar",
+		},
+		{
+			failure: true,
+			secret:  "",
+			want:    "",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		_service, err := New(
+			WithClientSecret(test.secret),
+		)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("WithClientSecret should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("WithClientSecret returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(_service.config.ClientSecret, test.want) {
+			t.Errorf("WithClientSecret is %v, want %v", _service.config.ClientSecret, test.want)
+		}
+	}
+}
+
+func TestGitea_ClientOpt_WithServerAddress(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		address string
+		want    string
+	}{
+		{
+			failure: false,
+			address: "https://vela.example.com",
+			want:    "https://vela.example.com",
+		},
+		{
+			failure: true,
+			address: "",
+			want:    "",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		_service, err := New(
+			WithServerAddress(test.address),
+		)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("WithServerAddress should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("WithServerAddress returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(_service.config.ServerAddress, test.want) {
+			t.Errorf("WithServerAddress is %v, want %v", _service.config.ServerAddress, test.want)
+		}
+	}
+}
+
+func TestGitea_ClientOpt_WithServerWebhookAddress(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		address        string
+		webhookAddress string
+		want           string
+	}{
+		{
+			address:        "https://vela.example.com",
+			webhookAddress: "https://vela.example.com",
+			want:           "https://vela.example.com",
+		},
+		{
+			address:        "https://vela.example.com",
+			webhookAddress: "",
+			want:           "https://vela.example.com",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		_service, err := New(
+			WithServerAddress(test.address),
+			WithServerWebhookAddress(test.webhookAddress),
+		)
+
+		if err != nil {
+			t.Errorf("WithServerWebhookAddress returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(_service.config.ServerWebhookAddress, test.want) {
+			t.Errorf("WithServerWebhookAddress is %v, want %v", _service.config.ServerWebhookAddress, test.want)
+		}
+	}
+}
+
+func TestGitea_ClientOpt_WithStatusContext(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		context string
+		want    string
+	}{
+		{
+			failure: false,
+			context: "continuous-integration/vela",
+			want:    "continuous-integration/vela",
+		},
+		{
+			failure: true,
+			context: "",
+			want:    "",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		_service, err := New(
+			WithStatusContext(test.context),
+		)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("WithStatusContext should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("WithStatusContext returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(_service.config.StatusContext, test.want) {
+			t.Errorf("WithStatusContext is %v, want %v", _service.config.StatusContext, test.want)
+		}
+	}
+}
+
+func TestGitea_ClientOpt_WithWebUIAddress(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		address string
+		want    string
+	}{
+		{
+			address: "https://vela.example.com",
+			want:    "https://vela.example.com",
+		},
+		{
+			address: "",
+			want:    "",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		_service, err := New(
+			WithWebUIAddress(test.address),
+		)
+
+		if err != nil {
+			t.Errorf("WithWebUIAddress returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(_service.config.WebUIAddress, test.want) {
+			t.Errorf("WithWebUIAddress is %v, want %v", _service.config.WebUIAddress, test.want)
+		}
+	}
+}
+
+func TestGitea_ClientOpt_WithScopes(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		scopes  []string
+		want    []string
+	}{
+		{
+			failure: false,
+			scopes:  []string{"read:user", "read:organization", "write:repository"},
+			want:    []string{"read:user", "read:organization", "write:repository"},
+		},
+		{
+			failure: true,
+			scopes:  []string{},
+			want:    []string{},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		_service, err := New(
+			WithScopes(test.scopes),
+		)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("WithScopes should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("WithScopes returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(_service.config.Scopes, test.want) {
+			t.Errorf("WithScopes is %v, want %v", _service.config.Scopes, test.want)
+		}
+	}
+}
+
+func TestGitea_ClientOpt_WithName(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name        string
+		want        string
+		wantWebhook string
+	}{
+		{
+			name:        "",
+			want:        "",
+			wantWebhook: "https://vela.example.com/webhook",
+		},
+		{
+			name:        "enterprise",
+			want:        "enterprise",
+			wantWebhook: "https://vela.example.com/webhook/enterprise",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		_service, err := New(
+			WithServerAddress("https://vela.example.com"),
+			WithServerWebhookAddress(""),
+			WithName(test.name),
+		)
+
+		if err != nil {
+			t.Errorf("WithName returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(_service.config.Name, test.want) {
+			t.Errorf("WithName is %v, want %v", _service.config.Name, test.want)
+		}
+
+		if _service.webhookURL() != test.wantWebhook {
+			t.Errorf("webhookURL is %v, want %v", _service.webhookURL(), test.wantWebhook)
+		}
+	}
+}
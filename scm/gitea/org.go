@@ -0,0 +1,40 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package gitea
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/go-vela/types/library"
+)
+
+// GetOrgName gets org name from Gitea.
+func (c *client) GetOrgName(ctx context.Context, u *library.User, o string) (string, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  o,
+		"user": u.GetName(),
+	}).Tracef("retrieving org information for %s", o)
+
+	// create Gitea OAuth client with user's token
+	client := c.newClientToken(ctx, u.GetToken())
+
+	// send an API call to get the org info
+	org, resp, err := client.GetOrg(o)
+
+	// if org is not found, return the personal org
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		user, _, err := client.GetMyUserInfo()
+		if err != nil {
+			return "", err
+		}
+
+		return user.UserName, nil
+	} else if err != nil {
+		return "", err
+	}
+
+	return org.UserName, nil
+}
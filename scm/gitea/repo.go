@@ -0,0 +1,572 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package gitea
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/sirupsen/logrus"
+
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+)
+
+// ConfigBackoff is a wrapper for Config that will retry five times if the function
+// fails to retrieve the yaml/yml file.
+func (c *client) ConfigBackoff(ctx context.Context, u *library.User, r *library.Repo, ref string) (data []byte, err error) {
+	// number of times to retry
+	retryLimit := 5
+
+	for i := 0; i < retryLimit; i++ {
+		logrus.Debugf("Fetching config file - Attempt %d", i+1)
+		// attempt to fetch the config
+		data, err = c.Config(ctx, u, r, ref)
+
+		// return err if the last attempt returns error
+		if err != nil && i == retryLimit-1 {
+			return
+		}
+
+		// if data is valid break the retry loop
+		if data != nil {
+			break
+		}
+
+		// sleep in between retries
+		sleep := time.Duration(i+1) * time.Second
+		time.Sleep(sleep)
+	}
+
+	return
+}
+
+// Config gets the pipeline configuration from the Gitea repo.
+func (c *client) Config(ctx context.Context, u *library.User, r *library.Repo, ref string) ([]byte, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("capturing configuration file for %s/commit/%s", r.GetFullName(), ref)
+
+	// create Gitea OAuth client with user's token
+	client := c.newClientToken(ctx, u.GetToken())
+
+	files := []string{".vela.yml", ".vela.yaml"}
+
+	if strings.EqualFold(r.GetPipelineType(), constants.PipelineTypeStarlark) {
+		files = append(files, ".vela.star", ".vela.py")
+	}
+
+	for _, file := range files {
+		// send API call to capture the .vela.yml pipeline configuration
+		data, resp, err := client.GetFile(r.GetOrg(), r.GetName(), ref, file)
+		if err != nil {
+			if resp == nil || resp.StatusCode != http.StatusNotFound {
+				return nil, err
+			}
+
+			continue
+		}
+
+		return data, nil
+	}
+
+	return nil, fmt.Errorf("no valid pipeline configuration file (%s) found", strings.Join(files, ","))
+}
+
+// Disable deactivates a repo by deleting the webhook.
+func (c *client) Disable(ctx context.Context, u *library.User, org, name string) error {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  org,
+		"repo": name,
+		"user": u.GetName(),
+	}).Tracef("deleting repository webhooks for %s/%s", org, name)
+
+	// create Gitea OAuth client with user's token
+	client := c.newClientToken(ctx, u.GetToken())
+	hooks := []*gitea.Hook{}
+
+	// set the page size for the options to capture the list of hooks
+	opts := gitea.ListHooksOptions{
+		ListOptions: gitea.ListOptions{Page: 1, PageSize: perPage},
+	}
+
+	for {
+		// send API call to capture a page of hooks for the repo
+		h, _, err := client.ListRepoHooks(org, name, opts)
+		if err != nil {
+			return err
+		}
+
+		hooks = append(hooks, h...)
+
+		// break the loop if there is no more results to page through
+		if len(h) < opts.PageSize {
+			break
+		}
+
+		opts.Page++
+	}
+
+	// accounting for situations in which multiple hooks have been
+	// associated with this vela instance, which causes some
+	// disable, repair, enable operations to act in undesirable ways
+	var ids []int64
+
+	// iterate through each element in the hooks
+	for _, hook := range hooks {
+		// skip if the hook has no ID
+		if hook.ID == 0 {
+			continue
+		}
+
+		// capture hook ID if the hook url matches
+		if hook.Config["url"] == c.webhookURL() {
+			ids = append(ids, hook.ID)
+		}
+	}
+
+	// skip if we have no hook IDs
+	if len(ids) == 0 {
+		c.Logger.WithFields(logrus.Fields{
+			"org":  org,
+			"repo": name,
+			"user": u.GetName(),
+		}).Warnf("no repository webhooks matching %s found for %s/%s", c.webhookURL(), org, name)
+
+		return nil
+	}
+
+	var err error
+
+	// go through all found hook IDs and delete them
+	for _, id := range ids {
+		// send API call to delete the webhook
+		_, err = client.DeleteRepoHook(org, name, id)
+	}
+
+	return err
+}
+
+// Enable activates a repo by creating the webhook.
+func (c *client) Enable(ctx context.Context, u *library.User, r *library.Repo, h *library.Hook) (*library.Hook, string, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("creating repository webhook for %s/%s", r.GetOrg(), r.GetName())
+
+	// create Gitea OAuth client with user's token
+	client := c.newClientToken(ctx, u.GetToken())
+
+	// create the hook object to make the API call
+	hook := gitea.CreateHookOption{
+		Type:   gitea.HookTypeGitea,
+		Config: c.hookConfig(r),
+		Events: hookEvents(r),
+		Active: true,
+	}
+
+	// send API call to create the webhook
+	hookInfo, resp, err := client.CreateRepoHook(r.GetOrg(), r.GetName(), hook)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return nil, "", fmt.Errorf("repo not found")
+		}
+
+		return nil, "", err
+	}
+
+	// create the first hook for the repo and record its ID from Gitea
+	webhook := new(library.Hook)
+	webhook.SetWebhookID(hookInfo.ID)
+	webhook.SetSourceID(r.GetName() + "-" + eventInitialize)
+	webhook.SetCreated(hookInfo.Created.Unix())
+	webhook.SetEvent(eventInitialize)
+	webhook.SetNumber(h.GetNumber() + 1)
+	webhook.SetStatus(constants.StatusSuccess)
+
+	// create the URL for the repo
+	url := fmt.Sprintf("%s/%s/%s", c.config.Address, r.GetOrg(), r.GetName())
+
+	return webhook, url, nil
+}
+
+// Update edits a repo webhook.
+func (c *client) Update(ctx context.Context, u *library.User, r *library.Repo, hookID int64) (bool, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("updating repository webhook for %s/%s", r.GetOrg(), r.GetName())
+
+	// create Gitea OAuth client with user's token
+	client := c.newClientToken(ctx, u.GetToken())
+
+	active := true
+
+	// create the hook object to make the API call
+	hook := gitea.EditHookOption{
+		Config: c.hookConfig(r),
+		Events: hookEvents(r),
+		Active: &active,
+	}
+
+	// send API call to update the webhook
+	resp, err := client.EditRepoHook(r.GetOrg(), r.GetName(), hookID, hook)
+
+	// track if webhook exists in Gitea; a missing webhook
+	// indicates the webhook has been manually deleted from Gitea
+	return resp == nil || resp.StatusCode != http.StatusNotFound, err
+}
+
+// hookConfig is a helper function to create
+// the webhook configuration for the repo.
+func (c *client) hookConfig(r *library.Repo) map[string]string {
+	return map[string]string{
+		"url":          c.webhookURL(),
+		"content_type": "json",
+		"secret":       r.GetHash(),
+	}
+}
+
+// hookEvents is a helper function to create the list
+// of webhook events to subscribe to for the repo.
+//
+// Gitea sends tags as push events so the create event is
+// never subscribed to in order to avoid duplicate builds.
+func hookEvents(r *library.Repo) []string {
+	events := []string{}
+
+	if r.GetAllowPush() || r.GetAllowTag() {
+		events = append(events, eventPush)
+	}
+
+	if r.GetAllowPull() {
+		events = append(events, eventPullRequest, eventPullRequestSync)
+	}
+
+	if r.GetAllowComment() {
+		events = append(events, eventIssueComment, eventPullRequestComment)
+	}
+
+	// always subscribe to repository events to track renames and transfers
+	events = append(events, eventRepository)
+
+	return events
+}
+
+// Status sends the commit status for the given SHA from the Gitea repo.
+func (c *client) Status(ctx context.Context, u *library.User, b *library.Build, org, name string) error {
+	c.Logger.WithFields(logrus.Fields{
+		"build": b.GetNumber(),
+		"org":   org,
+		"repo":  name,
+		"user":  u.GetName(),
+	}).Tracef("setting commit status for %s/%s/%d @ %s", org, name, b.GetNumber(), b.GetCommit())
+
+	// create Gitea OAuth client with user's token
+	client := c.newClientToken(ctx, u.GetToken())
+
+	context := fmt.Sprintf("%s/%s", c.config.StatusContext, b.GetEvent())
+	url := fmt.Sprintf("%s/%s/%s/%d", c.config.WebUIAddress, org, name, b.GetNumber())
+
+	var (
+		state       gitea.StatusState
+		description string
+	)
+
+	// set the state and description for the status context
+	// depending on what the status of the build is
+	switch b.GetStatus() {
+	case constants.StatusRunning, constants.StatusPending:
+		state = gitea.StatusPending
+		description = fmt.Sprintf("the build is %s", b.GetStatus())
+	case constants.StatusSuccess:
+		state = gitea.StatusSuccess
+		description = "the build was successful"
+	case constants.StatusFailure:
+		state = gitea.StatusFailure
+		description = "the build has failed"
+	case constants.StatusCanceled:
+		state = gitea.StatusFailure
+		description = "the build was canceled"
+	case constants.StatusKilled:
+		state = gitea.StatusFailure
+		description = "the build was killed"
+	case constants.StatusSkipped:
+		state = gitea.StatusSuccess
+		description = "build was skipped as no steps/stages found"
+	default:
+		state = gitea.StatusError
+		description = "there was an error"
+	}
+
+	// create the status object to make the API call
+	status := gitea.CreateStatusOption{
+		State:       state,
+		Description: description,
+		Context:     context,
+	}
+
+	// provide "Details" link in Gitea UI if server was configured with it
+	if len(c.config.WebUIAddress) > 0 && b.GetStatus() != constants.StatusSkipped {
+		status.TargetURL = url
+	}
+
+	// send API call to create the status context for the commit
+	_, _, err := client.CreateStatus(org, name, b.GetCommit(), status)
+
+	return err
+}
+
+// CheckRun sets the check run with per-step detail for the build from the Gitea repo.
+//
+// Gitea does not support check runs so the build is reported with the commit status.
+func (c *client) CheckRun(ctx context.Context, u *library.User, b *library.Build, steps []*library.Step, org, name string) error {
+	return c.Status(ctx, u, b, org, name)
+}
+
+// GetRepo gets repo information from Gitea.
+func (c *client) GetRepo(ctx context.Context, u *library.User, r *library.Repo) (*library.Repo, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("retrieving repository information for %s", r.GetFullName())
+
+	// create Gitea OAuth client with user's token
+	client := c.newClientToken(ctx, u.GetToken())
+
+	// send an API call to get the repo info
+	repo, _, err := client.GetRepo(r.GetOrg(), r.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	return toLibraryRepo(repo), nil
+}
+
+// GetOrgAndRepoName returns the name of the org and the repository in the SCM.
+func (c *client) GetOrgAndRepoName(ctx context.Context, u *library.User, o string, r string) (string, string, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  o,
+		"repo": r,
+		"user": u.GetName(),
+	}).Tracef("retrieving repository information for %s/%s", o, r)
+
+	// create Gitea OAuth client with user's token
+	client := c.newClientToken(ctx, u.GetToken())
+
+	// send an API call to get the repo info
+	repo, _, err := client.GetRepo(o, r)
+	if err != nil {
+		return "", "", err
+	}
+
+	return repo.Owner.UserName, repo.Name, nil
+}
+
+// ListUserRepos returns a list of all repos the user has access to.
+func (c *client) ListUserRepos(ctx context.Context, u *library.User) ([]*library.Repo, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"user": u.GetName(),
+	}).Tracef("listing source repositories for %s", u.GetName())
+
+	// create Gitea OAuth client with user's token
+	client := c.newClientToken(ctx, u.GetToken())
+
+	r := []*gitea.Repository{}
+	f := []*library.Repo{}
+
+	// set the page size for the options to capture the list of repos
+	opts := gitea.ListReposOptions{
+		ListOptions: gitea.ListOptions{Page: 1, PageSize: perPage},
+	}
+
+	// loop to capture *ALL* the repos
+	for {
+		// send API call to capture the user's repos
+		repos, _, err := client.ListMyRepos(opts)
+		if err != nil {
+			return nil, fmt.Errorf("unable to list user repos: %w", err)
+		}
+
+		r = append(r, repos...)
+
+		// break the loop if there is no more results to page through
+		if len(repos) < opts.PageSize {
+			break
+		}
+
+		opts.Page++
+	}
+
+	// iterate through each repo for the user
+	for _, repo := range r {
+		// skip if the repo is void
+		if repo == nil {
+			continue
+		}
+
+		// skip if the repo is archived
+		if repo.Archived {
+			continue
+		}
+
+		f = append(f, toLibraryRepo(repo))
+	}
+
+	return f, nil
+}
+
+// toLibraryRepo does a partial conversion of a gitea repository to a library repo.
+func toLibraryRepo(gr *gitea.Repository) *library.Repo {
+	// setting the visbility to match the SCM visbility
+	visibility := constants.VisibilityPublic
+	if gr.Private {
+		visibility = constants.VisibilityPrivate
+	}
+
+	r := new(library.Repo)
+	r.SetName(gr.Name)
+	r.SetFullName(gr.FullName)
+	r.SetLink(gr.HTMLURL)
+	r.SetClone(gr.CloneURL)
+	r.SetBranch(gr.DefaultBranch)
+	r.SetTopics([]string{})
+	r.SetPrivate(gr.Private)
+	r.SetVisibility(visibility)
+
+	if gr.Owner != nil {
+		r.SetOrg(gr.Owner.UserName)
+	}
+
+	return r
+}
+
+// GetPullRequest defines a function that retrieves
+// a pull request for a repo.
+func (c *client) GetPullRequest(ctx context.Context, u *library.User, r *library.Repo, number int) (string, string, string, string, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("retrieving pull request %d for repo %s", number, r.GetFullName())
+
+	// create Gitea OAuth client with user's token
+	client := c.newClientToken(ctx, u.GetToken())
+
+	pull, _, err := client.GetPullRequest(r.GetOrg(), r.GetName(), int64(number))
+	if err != nil {
+		return "", "", "", "", err
+	}
+
+	if pull.Head == nil || pull.Base == nil {
+		return "", "", "", "", fmt.Errorf("unable to capture branches for pull request %d", number)
+	}
+
+	commit := pull.Head.Sha
+	branch := pull.Base.Ref
+	baseref := pull.Base.Ref
+	headref := pull.Head.Ref
+
+	return commit, branch, baseref, headref, nil
+}
+
+// GetHTMLURL retrieves the html_url from repository contents from the Gitea repo.
+func (c *client) GetHTMLURL(ctx context.Context, u *library.User, org, repo, name, ref string) (string, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  org,
+		"repo": repo,
+		"user": u.GetName(),
+	}).Tracef("capturing html_url for %s/%s/%s@%s", org, repo, name, ref)
+
+	// create Gitea OAuth client with user's token
+	client := c.newClientToken(ctx, u.GetToken())
+
+	// send API call to capture the repository contents for org/repo/name at the ref provided
+	data, _, err := client.GetContents(org, repo, ref, name)
+	if err != nil {
+		return "", err
+	}
+
+	// data is not nil if the file exists
+	if data != nil && data.HTMLURL != nil {
+		return *data.HTMLURL, nil
+	}
+
+	return "", fmt.Errorf("no valid repository contents found")
+}
+
+// GetBranch defines a function that retrieves a branch for a repo.
+func (c *client) GetBranch(ctx context.Context, u *library.User, r *library.Repo, branch string) (string, string, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("retrieving branch %s for repo %s", branch, r.GetFullName())
+
+	// create Gitea OAuth client with user's token
+	client := c.newClientToken(ctx, u.GetToken())
+
+	data, _, err := client.GetRepoBranch(r.GetOrg(), r.GetName(), branch)
+	if err != nil {
+		return "", "", err
+	}
+
+	if data.Commit == nil {
+		return data.Name, "", nil
+	}
+
+	return data.Name, data.Commit.ID, nil
+}
+
+// GetTag defines a function that retrieves the commit for a tag in a repo.
+func (c *client) GetTag(ctx context.Context, u *library.User, r *library.Repo, tag string) (string, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("retrieving tag %s for repo %s", tag, r.GetFullName())
+
+	// create Gitea OAuth client with user's token
+	client := c.newClientToken(ctx, u.GetToken())
+
+	data, _, err := client.GetTag(r.GetOrg(), r.GetName(), tag)
+	if err != nil {
+		return "", err
+	}
+
+	if data.Commit == nil {
+		return "", fmt.Errorf("no commit found for tag %s in repo %s", tag, r.GetFullName())
+	}
+
+	return data.Commit.SHA, nil
+}
+
+// CreateComment creates a comment on a pull request for the Gitea repo.
+func (c *client) CreateComment(ctx context.Context, u *library.User, r *library.Repo, number int, body string) error {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("creating comment on pull request %d for repo %s", number, r.GetFullName())
+
+	// create Gitea OAuth client with user's token
+	client := c.newClientToken(ctx, u.GetToken())
+
+	opts := gitea.CreateIssueCommentOption{
+		Body: body,
+	}
+
+	// pull requests share the issue comment API in Gitea
+	_, _, err := client.CreateIssueComment(r.GetOrg(), r.GetName(), int64(number), opts)
+
+	return err
+}
@@ -0,0 +1,902 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package gitea
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+)
+
+func TestGitea_Config_YML(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/api/v1/repos/:org/:repo/raw/*path", func(c *gin.Context) {
+		if c.Param("path") != "/.vela.yml" || c.Query("ref") != "main" {
+			c.Status(http.StatusNotFound)
+			return
+		}
+
+		c.Header("Content-Type", "text/plain")
+		c.Status(http.StatusOK)
+		c.File("testdata/pipeline.yml")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	r := new(library.Repo)
+	r.SetOrg("foo")
+	r.SetName("bar")
+	r.SetFullName("foo/bar")
+
+	want, err := os.ReadFile("testdata/pipeline.yml")
+	if err != nil {
+		t.Errorf("unable to read file: %v", err)
+	}
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got, err := client.Config(context.TODO(), u, r, "main")
+
+	if err != nil {
+		t.Errorf("Config returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Config is %v, want %v", got, want)
+	}
+}
+
+func TestGitea_Config_YAML(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/api/v1/repos/:org/:repo/raw/*path", func(c *gin.Context) {
+		if c.Param("path") != "/.vela.yaml" {
+			c.Status(http.StatusNotFound)
+			return
+		}
+
+		c.Header("Content-Type", "text/plain")
+		c.Status(http.StatusOK)
+		c.File("testdata/pipeline.yml")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	r := new(library.Repo)
+	r.SetOrg("foo")
+	r.SetName("bar")
+	r.SetFullName("foo/bar")
+
+	want, err := os.ReadFile("testdata/pipeline.yml")
+	if err != nil {
+		t.Errorf("unable to read file: %v", err)
+	}
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got, err := client.Config(context.TODO(), u, r, "main")
+
+	if err != nil {
+		t.Errorf("Config returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Config is %v, want %v", got, want)
+	}
+}
+
+func TestGitea_Config_NotFound(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/api/v1/repos/:org/:repo/raw/*path", func(c *gin.Context) {
+		c.Status(http.StatusNotFound)
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	r := new(library.Repo)
+	r.SetOrg("foo")
+	r.SetName("bar")
+	r.SetFullName("foo/bar")
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got, err := client.Config(context.TODO(), u, r, "main")
+
+	if err == nil {
+		t.Errorf("Config should have returned err")
+	}
+
+	if got != nil {
+		t.Errorf("Config is %v, want nil", got)
+	}
+}
+
+func TestGitea_Disable(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	deleted := []string{}
+
+	// setup mock server
+	engine.GET("/api/v1/repos/:org/:repo/hooks", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusOK)
+		c.File("testdata/hooks.json")
+	})
+	engine.DELETE("/api/v1/repos/:org/:repo/hooks/:hook_id", func(c *gin.Context) {
+		deleted = append(deleted, c.Param("hook_id"))
+
+		c.Status(http.StatusNoContent)
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	client, _ := New(
+		WithAddress(s.URL),
+		WithClientID("foo"),
+		WithClientSecret("bar"),
+		WithServerAddress("https://vela-server.example.com"),
+		WithServerWebhookAddress(""),
+		WithStatusContext("continuous-integration/vela"),
+		WithScopes([]string{"read:user", "read:organization", "write:repository"}),
+	)
+
+	// run test
+	err := client.Disable(context.TODO(), u, "foo", "bar")
+
+	if err != nil {
+		t.Errorf("Disable returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(deleted, []string{"1"}) {
+		t.Errorf("Disable deleted hooks %v, want %v", deleted, []string{"1"})
+	}
+}
+
+func TestGitea_Enable(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	body := struct {
+		Type   string            `json:"type"`
+		Config map[string]string `json:"config"`
+		Events []string          `json:"events"`
+		Active bool              `json:"active"`
+	}{}
+
+	// setup mock server
+	engine.POST("/api/v1/repos/:org/:repo/hooks", func(c *gin.Context) {
+		_ = json.NewDecoder(c.Request.Body).Decode(&body)
+
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusCreated)
+		c.File("testdata/hook.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	r := new(library.Repo)
+	r.SetOrg("foo")
+	r.SetName("bar")
+	r.SetHash("secret")
+	r.SetAllowPush(true)
+	r.SetAllowPull(true)
+	r.SetAllowTag(true)
+
+	h := new(library.Hook)
+	h.SetNumber(1)
+
+	wantHook := new(library.Hook)
+	wantHook.SetWebhookID(1)
+	wantHook.SetSourceID("bar-initialize")
+	wantHook.SetCreated(1698840000)
+	wantHook.SetEvent("initialize")
+	wantHook.SetNumber(2)
+	wantHook.SetStatus(constants.StatusSuccess)
+
+	wantEvents := []string{"push", "pull_request", "pull_request_sync", "repository"}
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got, url, err := client.Enable(context.TODO(), u, r, h)
+
+	if err != nil {
+		t.Errorf("Enable returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, wantHook) {
+		t.Errorf("Enable returned hook %v, want %v", got, wantHook)
+	}
+
+	if url != fmt.Sprintf("%s/foo/bar", s.URL) {
+		t.Errorf("Enable returned url %v, want %v", url, fmt.Sprintf("%s/foo/bar", s.URL))
+	}
+
+	if body.Type != "gitea" || !body.Active {
+		t.Errorf("Enable hook is %v", body)
+	}
+
+	if body.Config["secret"] != "secret" || body.Config["content_type"] != "json" {
+		t.Errorf("Enable hook config is %v", body.Config)
+	}
+
+	if !reflect.DeepEqual(body.Events, wantEvents) {
+		t.Errorf("Enable hook events are %v, want %v", body.Events, wantEvents)
+	}
+}
+
+func TestGitea_Update(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.PATCH("/api/v1/repos/:org/:repo/hooks/:hook_id", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusOK)
+		c.File("testdata/hook.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	r := new(library.Repo)
+	r.SetOrg("foo")
+	r.SetName("bar")
+	r.SetHash("secret")
+	r.SetAllowPush(true)
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got, err := client.Update(context.TODO(), u, r, 1)
+
+	if err != nil {
+		t.Errorf("Update returned err: %v", err)
+	}
+
+	if !got {
+		t.Errorf("Update is %v, want true", got)
+	}
+}
+
+func TestGitea_Update_HookDeleted(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.PATCH("/api/v1/repos/:org/:repo/hooks/:hook_id", func(c *gin.Context) {
+		c.JSON(http.StatusNotFound, gin.H{"message": "Not Found"})
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	r := new(library.Repo)
+	r.SetOrg("foo")
+	r.SetName("bar")
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got, err := client.Update(context.TODO(), u, r, 1)
+
+	if err == nil {
+		t.Errorf("Update should have returned err")
+	}
+
+	if got {
+		t.Errorf("Update is %v, want false", got)
+	}
+}
+
+func TestGitea_Status(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		status string
+		want   map[string]interface{}
+	}{
+		{
+			status: constants.StatusRunning,
+			want: map[string]interface{}{
+				"context":     "continuous-integration/vela/push",
+				"description": "the build is running",
+				"state":       "pending",
+				"target_url":  "/foo/bar/1",
+			},
+		},
+		{
+			status: constants.StatusSuccess,
+			want: map[string]interface{}{
+				"context":     "continuous-integration/vela/push",
+				"description": "the build was successful",
+				"state":       "success",
+				"target_url":  "/foo/bar/1",
+			},
+		},
+		{
+			status: constants.StatusCanceled,
+			want: map[string]interface{}{
+				"context":     "continuous-integration/vela/push",
+				"description": "the build was canceled",
+				"state":       "failure",
+				"target_url":  "/foo/bar/1",
+			},
+		},
+		{
+			status: constants.StatusSkipped,
+			want: map[string]interface{}{
+				"context":     "continuous-integration/vela/push",
+				"description": "build was skipped as no steps/stages found",
+				"state":       "success",
+				"target_url":  "",
+			},
+		},
+		{
+			status: constants.StatusError,
+			want: map[string]interface{}{
+				"context":     "continuous-integration/vela/push",
+				"description": "there was an error",
+				"state":       "error",
+				"target_url":  "/foo/bar/1",
+			},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.status, func(t *testing.T) {
+			// setup context
+			gin.SetMode(gin.TestMode)
+
+			resp := httptest.NewRecorder()
+			_, engine := gin.CreateTestContext(resp)
+
+			body := make(map[string]interface{})
+
+			// setup mock server
+			engine.POST("/api/v1/repos/:org/:repo/statuses/:sha", func(c *gin.Context) {
+				_ = json.NewDecoder(c.Request.Body).Decode(&body)
+
+				c.Header("Content-Type", "application/json")
+				c.Status(http.StatusCreated)
+				c.File("testdata/status.json")
+			})
+
+			s := httptest.NewServer(engine)
+			defer s.Close()
+
+			// setup types
+			u := new(library.User)
+			u.SetName("foo")
+			u.SetToken("bar")
+
+			b := new(library.Build)
+			b.SetNumber(1)
+			b.SetEvent(constants.EventPush)
+			b.SetStatus(test.status)
+			b.SetCommit("a76aded1ad1c5a5d5a8b8e9b7b5e3d1c7f2a4b6c")
+
+			client, _ := NewTest(s.URL)
+
+			// run test
+			err := client.Status(context.TODO(), u, b, "foo", "bar")
+
+			if err != nil {
+				t.Errorf("Status returned err: %v", err)
+			}
+
+			// the web UI address is the mock server
+			if len(test.want["target_url"].(string)) > 0 {
+				test.want["target_url"] = s.URL + test.want["target_url"].(string)
+			}
+
+			if !reflect.DeepEqual(body, test.want) {
+				t.Errorf("Status is %v, want %v", body, test.want)
+			}
+		})
+	}
+}
+
+func TestGitea_GetRepo(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/api/v1/repos/:org/:repo", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusOK)
+		c.File("testdata/repo.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	r := new(library.Repo)
+	r.SetOrg("octocat")
+	r.SetName("Hello-World")
+
+	want := new(library.Repo)
+	want.SetOrg("octocat")
+	want.SetName("Hello-World")
+	want.SetFullName("octocat/Hello-World")
+	want.SetLink("https://gitea.com/octocat/Hello-World")
+	want.SetClone("https://gitea.com/octocat/Hello-World.git")
+	want.SetBranch("main")
+	want.SetTopics([]string{})
+	want.SetPrivate(false)
+	want.SetVisibility("public")
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got, err := client.GetRepo(context.TODO(), u, r)
+
+	if err != nil {
+		t.Errorf("GetRepo returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("GetRepo is %v, want %v", got, want)
+	}
+}
+
+func TestGitea_GetOrgAndRepoName(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/api/v1/repos/:org/:repo", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusOK)
+		c.File("testdata/repo.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	org, name, err := client.GetOrgAndRepoName(context.TODO(), u, "Octocat", "hello-world")
+
+	if err != nil {
+		t.Errorf("GetOrgAndRepoName returned err: %v", err)
+	}
+
+	if org != "octocat" || name != "Hello-World" {
+		t.Errorf("GetOrgAndRepoName is %s/%s, want %s", org, name, "octocat/Hello-World")
+	}
+}
+
+func TestGitea_ListUserRepos(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/api/v1/user/repos", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusOK)
+		c.File("testdata/repos.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	r := new(library.Repo)
+	r.SetOrg("octocat")
+	r.SetName("Hello-World")
+	r.SetFullName("octocat/Hello-World")
+	r.SetLink("https://gitea.com/octocat/Hello-World")
+	r.SetClone("https://gitea.com/octocat/Hello-World.git")
+	r.SetBranch("main")
+	r.SetTopics([]string{})
+	r.SetPrivate(false)
+	r.SetVisibility("public")
+
+	want := []*library.Repo{r}
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got, err := client.ListUserRepos(context.TODO(), u)
+
+	if err != nil {
+		t.Errorf("ListUserRepos returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ListUserRepos is %v, want %v", got, want)
+	}
+}
+
+func TestGitea_GetPullRequest(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/api/v1/repos/:org/:repo/pulls/:number", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusOK)
+		c.File("testdata/pull_request.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	r := new(library.Repo)
+	r.SetOrg("octocat")
+	r.SetName("Hello-World")
+
+	wantCommit := "6dcb09b5b57875f334f61aebed695e2e4193db5e"
+	wantBranch := "main"
+	wantBaseRef := "main"
+	wantHeadRef := "changes"
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	gotCommit, gotBranch, gotBaseRef, gotHeadRef, err := client.GetPullRequest(context.TODO(), u, r, 1)
+
+	if err != nil {
+		t.Errorf("GetPullRequest returned err: %v", err)
+	}
+
+	if gotCommit != wantCommit {
+		t.Errorf("Commit is %v, want %v", gotCommit, wantCommit)
+	}
+
+	if gotBranch != wantBranch {
+		t.Errorf("Branch is %v, want %v", gotBranch, wantBranch)
+	}
+
+	if gotBaseRef != wantBaseRef {
+		t.Errorf("BaseRef is %v, want %v", gotBaseRef, wantBaseRef)
+	}
+
+	if gotHeadRef != wantHeadRef {
+		t.Errorf("HeadRef is %v, want %v", gotHeadRef, wantHeadRef)
+	}
+}
+
+func TestGitea_GetHTMLURL(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/api/v1/repos/:org/:repo/contents/*path", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusOK)
+		c.File("testdata/contents.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	want := "https://gitea.com/octocat/Hello-World/src/branch/main/README.md"
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got, err := client.GetHTMLURL(context.TODO(), u, "octocat", "Hello-World", "README.md", "main")
+
+	if err != nil {
+		t.Errorf("GetHTMLURL returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("GetHTMLURL is %v, want %v", got, want)
+	}
+}
+
+func TestGitea_GetBranch(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/api/v1/repos/:org/:repo/branches/:branch", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusOK)
+		c.File("testdata/branch.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	r := new(library.Repo)
+	r.SetOrg("octocat")
+	r.SetName("Hello-World")
+
+	wantBranch := "main"
+	wantCommit := "7fd1a60b01f91b314f59955a4e4d4e80d8edf11d"
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	gotBranch, gotCommit, err := client.GetBranch(context.TODO(), u, r, "main")
+
+	if err != nil {
+		t.Errorf("GetBranch returned err: %v", err)
+	}
+
+	if gotBranch != wantBranch {
+		t.Errorf("Branch is %v, want %v", gotBranch, wantBranch)
+	}
+
+	if gotCommit != wantCommit {
+		t.Errorf("Commit is %v, want %v", gotCommit, wantCommit)
+	}
+}
+
+func TestGitea_GetTag(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/api/v1/repos/:org/:repo/tags/:tag", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusOK)
+		c.File("testdata/tag.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	r := new(library.Repo)
+	r.SetOrg("octocat")
+	r.SetName("Hello-World")
+
+	want := "7fd1a60b01f91b314f59955a4e4d4e80d8edf11d"
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got, err := client.GetTag(context.TODO(), u, r, "v0.1.0")
+
+	if err != nil {
+		t.Errorf("GetTag returned err: %v", err)
+	}
+
+	if got != want {
+		t.Errorf("GetTag is %v, want %v", got, want)
+	}
+}
+
+func TestGitea_CheckRun(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	created := false
+
+	// setup mock server
+	engine.POST("/api/v1/repos/:org/:repo/statuses/:sha", func(c *gin.Context) {
+		created = true
+
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusCreated)
+		c.File("testdata/status.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	b := new(library.Build)
+	b.SetNumber(1)
+	b.SetEvent(constants.EventPush)
+	b.SetStatus(constants.StatusSuccess)
+	b.SetCommit("abcd1234")
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	err := client.CheckRun(context.TODO(), u, b, nil, "foo", "bar")
+
+	if err != nil {
+		t.Errorf("CheckRun returned err: %v", err)
+	}
+
+	// the build is reported with the commit status instead
+	if !created {
+		t.Errorf("CheckRun did not create commit status")
+	}
+}
+
+func TestGitea_CreateComment(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.POST("/api/v1/repos/:org/:repo/issues/:index/comments", func(c *gin.Context) {
+		body := struct {
+			Body string `json:"body"`
+		}{}
+
+		err := c.BindJSON(&body)
+		if err != nil || c.Param("index") != "1" || body.Body != "Me too" {
+			c.Status(http.StatusBadRequest)
+
+			return
+		}
+
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusCreated)
+		c.File("testdata/comment.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	r := new(library.Repo)
+	r.SetOrg("octocat")
+	r.SetName("Hello-World")
+	r.SetFullName("octocat/Hello-World")
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	err := client.CreateComment(context.TODO(), u, r, 1, "Me too")
+
+	if err != nil {
+		t.Errorf("CreateComment returned err: %v", err)
+	}
+
+	err = client.CreateComment(context.TODO(), u, r, 2, "Me too")
+
+	if err == nil {
+		t.Errorf("CreateComment should have returned err")
+	}
+}
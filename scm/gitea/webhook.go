@@ -0,0 +1,461 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package gitea
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/sirupsen/logrus"
+
+	"github.com/go-vela/types"
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+)
+
+// pushPayload represents the payload sent by Gitea for push events.
+//
+// https://docs.gitea.com/usage/webhooks#event-information
+type pushPayload struct {
+	Ref        string            `json:"ref"`
+	Before     string            `json:"before"`
+	After      string            `json:"after"`
+	CompareURL string            `json:"compare_url"`
+	Commits    []*payloadCommit  `json:"commits"`
+	HeadCommit *payloadCommit    `json:"head_commit"`
+	Repo       *gitea.Repository `json:"repository"`
+	Pusher     *gitea.User       `json:"pusher"`
+	Sender     *gitea.User       `json:"sender"`
+}
+
+// payloadCommit represents a commit from the payload sent by Gitea for push events.
+type payloadCommit struct {
+	ID      string `json:"id"`
+	Message string `json:"message"`
+	URL     string `json:"url"`
+	Author  struct {
+		Name     string `json:"name"`
+		Email    string `json:"email"`
+		UserName string `json:"username"`
+	} `json:"author"`
+}
+
+// pullRequestPayload represents the payload sent by Gitea for pull request events.
+type pullRequestPayload struct {
+	Action      string             `json:"action"`
+	Index       int64              `json:"number"`
+	PullRequest *gitea.PullRequest `json:"pull_request"`
+	Repository  *gitea.Repository  `json:"repository"`
+	Sender      *gitea.User        `json:"sender"`
+}
+
+// issueCommentPayload represents the payload sent by Gitea for issue comment events.
+type issueCommentPayload struct {
+	Action     string            `json:"action"`
+	Issue      *gitea.Issue      `json:"issue"`
+	Comment    *gitea.Comment    `json:"comment"`
+	Repository *gitea.Repository `json:"repository"`
+	Sender     *gitea.User       `json:"sender"`
+	IsPull     bool              `json:"is_pull"`
+}
+
+// repositoryPayload represents the payload sent by Gitea for repository events.
+type repositoryPayload struct {
+	Action     string            `json:"action"`
+	Repository *gitea.Repository `json:"repository"`
+	Sender     *gitea.User       `json:"sender"`
+}
+
+// ProcessWebhook parses the webhook from a repo.
+//
+// Forgejo sends the same X-Gitea-* headers and payloads so it
+// is handled the same way as a Gitea webhook.
+//
+//nolint:nilerr // ignore webhook returning nil
+func (c *client) ProcessWebhook(ctx context.Context, request *http.Request) (*types.Webhook, error) {
+	c.Logger.Tracef("processing Gitea webhook")
+
+	// create our own record of the hook and populate its fields
+	h := new(library.Hook)
+	h.SetNumber(1)
+	h.SetSourceID(request.Header.Get("X-Gitea-Delivery"))
+	h.SetCreated(time.Now().UTC().Unix())
+	h.SetHost(hostname(c.config.Address))
+	h.SetEvent(request.Header.Get("X-Gitea-Event"))
+	h.SetStatus(constants.StatusSuccess)
+
+	payload, err := io.ReadAll(request.Body)
+	if err != nil {
+		return &types.Webhook{Hook: h}, nil
+	}
+
+	// process the event from the webhook
+	switch request.Header.Get("X-Gitea-Event") {
+	case eventPush:
+		event := new(pushPayload)
+
+		err = json.Unmarshal(payload, event)
+		if err != nil || event.Repo == nil {
+			return &types.Webhook{Hook: h}, nil
+		}
+
+		return c.processPushEvent(h, event)
+	case eventPullRequest:
+		event := new(pullRequestPayload)
+
+		err = json.Unmarshal(payload, event)
+		if err != nil || event.Repository == nil || event.PullRequest == nil {
+			return &types.Webhook{Hook: h}, nil
+		}
+
+		return c.processPREvent(h, event)
+	case eventIssueComment:
+		event := new(issueCommentPayload)
+
+		err = json.Unmarshal(payload, event)
+		if err != nil || event.Repository == nil || event.Issue == nil || event.Comment == nil {
+			return &types.Webhook{Hook: h}, nil
+		}
+
+		return c.processIssueCommentEvent(h, event)
+	case eventRepository:
+		event := new(repositoryPayload)
+
+		err = json.Unmarshal(payload, event)
+		if err != nil || event.Repository == nil {
+			return &types.Webhook{Hook: h}, nil
+		}
+
+		return c.processRepositoryEvent(h, event)
+	}
+
+	return &types.Webhook{Hook: h}, nil
+}
+
+// VerifyWebhook verifies the webhook from a repo.
+//
+// Gitea signs the payload for webhooks with an HMAC-SHA256
+// digest using the secret provided when creating the webhook.
+func (c *client) VerifyWebhook(ctx context.Context, request *http.Request, r *library.Repo) error {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+	}).Tracef("verifying Gitea webhook for %s", r.GetFullName())
+
+	signature := request.Header.Get("X-Gitea-Signature")
+	if len(signature) == 0 {
+		return errors.New("no X-Gitea-Signature header provided")
+	}
+
+	payload, err := io.ReadAll(request.Body)
+	if err != nil {
+		return err
+	}
+
+	ok, err := gitea.VerifyWebhookSignature(r.GetHash(), signature, payload)
+	if err != nil {
+		return fmt.Errorf("unable to verify X-Gitea-Signature header: %w", err)
+	}
+
+	if !ok {
+		return errors.New("invalid X-Gitea-Signature header provided")
+	}
+
+	return nil
+}
+
+// RedeliverWebhook redelivers webhooks from Gitea.
+func (c *client) RedeliverWebhook(ctx context.Context, u *library.User, r *library.Repo, h *library.Hook) error {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("redelivering Gitea webhook %s for %s", h.GetSourceID(), r.GetFullName())
+
+	return fmt.Errorf("redelivering webhooks is not supported for %s", c.Driver())
+}
+
+// processPushEvent is a helper function to process the push event.
+func (c *client) processPushEvent(h *library.Hook, payload *pushPayload) (*types.Webhook, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"repo": payload.Repo.FullName,
+	}).Tracef("processing push Gitea webhook for %s", payload.Repo.FullName)
+
+	repo := payload.Repo
+
+	// convert payload to library repo
+	r := toWebhookRepo(repo)
+
+	// update the hook object
+	h.SetBranch(strings.TrimPrefix(payload.Ref, "refs/heads/"))
+	h.SetEvent(constants.EventPush)
+	h.SetLink(hookLink(c.config.Address, r.GetFullName()))
+
+	// skip if the branch or tag was deleted
+	if len(strings.Trim(payload.After, "0")) == 0 {
+		return &types.Webhook{Hook: h}, nil
+	}
+
+	// convert payload to library build
+	b := new(library.Build)
+	b.SetEvent(constants.EventPush)
+	b.SetClone(repo.CloneURL)
+	b.SetSource(payload.CompareURL)
+	b.SetTitle(fmt.Sprintf("%s received from %s", constants.EventPush, repo.HTMLURL))
+	b.SetCommit(payload.After)
+	b.SetBranch(strings.TrimPrefix(payload.Ref, "refs/heads/"))
+	b.SetRef(payload.Ref)
+
+	if payload.Sender != nil {
+		b.SetSender(payload.Sender.UserName)
+	}
+
+	if payload.Pusher != nil {
+		b.SetAuthor(payload.Pusher.UserName)
+		b.SetEmail(payload.Pusher.Email)
+	}
+
+	// capture the head commit from the payload
+	commit := payload.HeadCommit
+
+	// fall back to the commit matching the push from the list of commits
+	if commit == nil {
+		for _, cm := range payload.Commits {
+			if cm != nil && cm.ID == payload.After {
+				commit = cm
+			}
+		}
+	}
+
+	if commit != nil {
+		b.SetMessage(commit.Message)
+
+		// ensure the build source is set
+		if len(b.GetSource()) == 0 {
+			b.SetSource(commit.URL)
+		}
+
+		// ensure the build author is set
+		if len(b.GetAuthor()) == 0 {
+			b.SetAuthor(commit.Author.UserName)
+		}
+
+		// ensure the build email is set
+		if len(b.GetEmail()) == 0 {
+			b.SetEmail(commit.Author.Email)
+		}
+	}
+
+	// handle when push event is a tag
+	if strings.HasPrefix(b.GetRef(), "refs/tags/") {
+		// set the proper event for the hook
+		h.SetEvent(constants.EventTag)
+		// set the proper event for the build
+		b.SetEvent(constants.EventTag)
+		// set the proper branch for the build
+		b.SetBranch(strings.TrimPrefix(b.GetRef(), "refs/tags/"))
+		// set the proper branch for the hook
+		h.SetBranch(b.GetBranch())
+	}
+
+	return &types.Webhook{
+		Comment: "",
+		Hook:    h,
+		Repo:    r,
+		Build:   b,
+	}, nil
+}
+
+// processPREvent is a helper function to process the pull request event.
+func (c *client) processPREvent(h *library.Hook, payload *pullRequestPayload) (*types.Webhook, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"repo": payload.Repository.FullName,
+	}).Tracef("processing pull request Gitea webhook for %s", payload.Repository.FullName)
+
+	repo := payload.Repository
+	pull := payload.PullRequest
+
+	// update the hook object
+	h.SetEvent(constants.EventPull)
+	h.SetLink(hookLink(c.config.Address, repo.FullName))
+
+	if pull.Base != nil {
+		h.SetBranch(pull.Base.Ref)
+	}
+
+	// convert the pull request action to the matching Vela action
+	var action string
+
+	switch payload.Action {
+	case constants.ActionOpened, constants.ActionReopened:
+		action = payload.Action
+	case actionSynchronized:
+		action = constants.ActionSynchronize
+	default:
+		return &types.Webhook{Hook: h}, nil
+	}
+
+	// skip if the pull request is missing branch information
+	if pull.Base == nil || pull.Head == nil {
+		return &types.Webhook{Hook: h}, nil
+	}
+
+	// convert payload to library repo
+	r := toWebhookRepo(repo)
+
+	// convert payload to library build
+	b := new(library.Build)
+	b.SetEvent(constants.EventPull)
+	b.SetEventAction(action)
+	b.SetClone(repo.CloneURL)
+	b.SetSource(pull.HTMLURL)
+	b.SetTitle(fmt.Sprintf("%s received from %s", constants.EventPull, repo.HTMLURL))
+	b.SetMessage(pull.Title)
+	b.SetCommit(pull.Head.Sha)
+	b.SetBranch(pull.Base.Ref)
+	b.SetRef(fmt.Sprintf("refs/pull/%d/head", pull.Index))
+	b.SetBaseRef(pull.Base.Ref)
+	b.SetHeadRef(pull.Head.Ref)
+
+	if payload.Sender != nil {
+		b.SetSender(payload.Sender.UserName)
+	}
+
+	if pull.Poster != nil {
+		b.SetAuthor(pull.Poster.UserName)
+		b.SetEmail(pull.Poster.Email)
+	}
+
+	return &types.Webhook{
+		Comment:  "",
+		PRNumber: int(pull.Index),
+		Hook:     h,
+		Repo:     r,
+		Build:    b,
+	}, nil
+}
+
+// processIssueCommentEvent is a helper function to process the issue comment event.
+func (c *client) processIssueCommentEvent(h *library.Hook, payload *issueCommentPayload) (*types.Webhook, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"repo": payload.Repository.FullName,
+	}).Tracef("processing issue comment Gitea webhook for %s", payload.Repository.FullName)
+
+	repo := payload.Repository
+	issue := payload.Issue
+
+	// update the hook object
+	h.SetEvent(constants.EventComment)
+	h.SetEventAction(payload.Action)
+	h.SetLink(hookLink(c.config.Address, repo.FullName))
+
+	// skip if the comment was not created or edited
+	if payload.Action != constants.ActionCreated && payload.Action != constants.ActionEdited {
+		return &types.Webhook{Hook: h}, nil
+	}
+
+	// convert payload to library repo
+	r := toWebhookRepo(repo)
+
+	// convert payload to library build
+	b := new(library.Build)
+	b.SetEvent(constants.EventComment)
+	b.SetEventAction(payload.Action)
+	b.SetClone(repo.CloneURL)
+	b.SetSource(issue.HTMLURL)
+	b.SetTitle(fmt.Sprintf("%s received from %s", constants.EventComment, repo.HTMLURL))
+	b.SetMessage(issue.Title)
+	// comments on issues are treated as a comment
+	// on the default branch for the repo
+	b.SetRef(fmt.Sprintf("refs/heads/%s", r.GetBranch()))
+
+	if payload.Sender != nil {
+		b.SetSender(payload.Sender.UserName)
+		b.SetAuthor(payload.Sender.UserName)
+		b.SetEmail(payload.Sender.Email)
+	}
+
+	prNumber := 0
+
+	// handle when the comment is on a pull request
+	if payload.IsPull || issue.PullRequest != nil {
+		prNumber = int(issue.Index)
+
+		b.SetRef(fmt.Sprintf("refs/pull/%d/head", issue.Index))
+	}
+
+	return &types.Webhook{
+		Comment:  payload.Comment.Body,
+		PRNumber: prNumber,
+		Hook:     h,
+		Repo:     r,
+		Build:    b,
+	}, nil
+}
+
+// processRepositoryEvent is a helper function to process the repository event.
+func (c *client) processRepositoryEvent(h *library.Hook, payload *repositoryPayload) (*types.Webhook, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"repo": payload.Repository.FullName,
+	}).Tracef("processing repository event Gitea webhook for %s", payload.Repository.FullName)
+
+	repo := payload.Repository
+
+	// convert payload to library repo
+	r := toWebhookRepo(repo)
+	r.SetActive(!repo.Archived)
+
+	h.SetEvent(constants.EventRepository)
+	h.SetEventAction(payload.Action)
+	h.SetBranch(r.GetBranch())
+	h.SetLink(hookLink(c.config.Address, r.GetFullName()))
+
+	return &types.Webhook{
+		Comment: "",
+		Hook:    h,
+		Repo:    r,
+	}, nil
+}
+
+// toWebhookRepo is a helper function to convert
+// the repository from a webhook to a library repo.
+func toWebhookRepo(repo *gitea.Repository) *library.Repo {
+	r := new(library.Repo)
+	r.SetName(repo.Name)
+	r.SetFullName(repo.FullName)
+	r.SetLink(repo.HTMLURL)
+	r.SetClone(repo.CloneURL)
+	r.SetBranch(repo.DefaultBranch)
+	r.SetPrivate(repo.Private)
+
+	if repo.Owner != nil {
+		r.SetOrg(repo.Owner.UserName)
+	}
+
+	return r
+}
+
+// hookLink is a helper function to create the
+// link to the webhook settings for the Gitea repo.
+func hookLink(address, fullName string) string {
+	return fmt.Sprintf("%s/%s/settings/hooks", address, fullName)
+}
+
+// hostname is a helper function to capture
+// the host from the provided Gitea address.
+func hostname(address string) string {
+	u, err := url.Parse(address)
+	if err != nil || len(u.Host) == 0 {
+		return address
+	}
+
+	return u.Host
+}
@@ -0,0 +1,688 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package gitea
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/go-vela/types"
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+)
+
+func TestGitea_ProcessWebhook_Push(t *testing.T) {
+	// setup router
+	s := httptest.NewServer(http.NotFoundHandler())
+	defer s.Close()
+
+	// setup request
+	body, err := os.Open("testdata/hooks/push.json")
+	if err != nil {
+		t.Errorf("unable to open file: %v", err)
+	}
+
+	defer body.Close()
+
+	request, _ := http.NewRequestWithContext(context.Background(), http.MethodPost, "/test", body)
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("X-Gitea-Event", "push")
+	request.Header.Set("X-Gitea-Delivery", "7bd477e4-4415-11e9-9359-0d41fdf9567e")
+
+	// setup client
+	client, _ := NewTest(s.URL)
+
+	// run test
+	wantHook := new(library.Hook)
+	wantHook.SetNumber(1)
+	wantHook.SetSourceID("7bd477e4-4415-11e9-9359-0d41fdf9567e")
+	wantHook.SetCreated(time.Now().UTC().Unix())
+	wantHook.SetHost(hostname(s.URL))
+	wantHook.SetEvent("push")
+	wantHook.SetBranch("main")
+	wantHook.SetStatus(constants.StatusSuccess)
+	wantHook.SetLink(fmt.Sprintf("%s/octocat/Hello-World/settings/hooks", s.URL))
+
+	wantRepo := new(library.Repo)
+	wantRepo.SetOrg("octocat")
+	wantRepo.SetName("Hello-World")
+	wantRepo.SetFullName("octocat/Hello-World")
+	wantRepo.SetLink("https://gitea.com/octocat/Hello-World")
+	wantRepo.SetClone("https://gitea.com/octocat/Hello-World.git")
+	wantRepo.SetBranch("main")
+	wantRepo.SetPrivate(false)
+
+	wantBuild := new(library.Build)
+	wantBuild.SetEvent("push")
+	wantBuild.SetClone("https://gitea.com/octocat/Hello-World.git")
+	wantBuild.SetSource("https://gitea.com/octocat/Hello-World/compare/553c2077f0edc3d5dc5d17262f6aa498e69d6f8e...a76aded1ad1c5a5d5a8b8e9b7b5e3d1c7f2a4b6c")
+	wantBuild.SetTitle("push received from https://gitea.com/octocat/Hello-World")
+	wantBuild.SetMessage("Update README.md\n")
+	wantBuild.SetCommit("a76aded1ad1c5a5d5a8b8e9b7b5e3d1c7f2a4b6c")
+	wantBuild.SetSender("octocat")
+	wantBuild.SetAuthor("octocat")
+	wantBuild.SetEmail("octocat@github.com")
+	wantBuild.SetBranch("main")
+	wantBuild.SetRef("refs/heads/main")
+
+	want := &types.Webhook{
+		Comment: "",
+		Hook:    wantHook,
+		Repo:    wantRepo,
+		Build:   wantBuild,
+	}
+
+	got, err := client.ProcessWebhook(context.TODO(), request)
+
+	if err != nil {
+		t.Errorf("ProcessWebhook returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ProcessWebhook is %v, want %v", got, want)
+	}
+}
+
+func TestGitea_ProcessWebhook_Push_Tag(t *testing.T) {
+	// setup router
+	s := httptest.NewServer(http.NotFoundHandler())
+	defer s.Close()
+
+	// setup request
+	body, err := os.Open("testdata/hooks/push_tag.json")
+	if err != nil {
+		t.Errorf("unable to open file: %v", err)
+	}
+
+	defer body.Close()
+
+	request, _ := http.NewRequestWithContext(context.Background(), http.MethodPost, "/test", body)
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("X-Gitea-Event", "push")
+	request.Header.Set("X-Gitea-Delivery", "7bd477e4-4415-11e9-9359-0d41fdf9567e")
+
+	// setup client
+	client, _ := NewTest(s.URL)
+
+	// run test
+	wantHook := new(library.Hook)
+	wantHook.SetNumber(1)
+	wantHook.SetSourceID("7bd477e4-4415-11e9-9359-0d41fdf9567e")
+	wantHook.SetCreated(time.Now().UTC().Unix())
+	wantHook.SetHost(hostname(s.URL))
+	wantHook.SetEvent("tag")
+	wantHook.SetBranch("v0.1")
+	wantHook.SetStatus(constants.StatusSuccess)
+	wantHook.SetLink(fmt.Sprintf("%s/octocat/Hello-World/settings/hooks", s.URL))
+
+	wantRepo := new(library.Repo)
+	wantRepo.SetOrg("octocat")
+	wantRepo.SetName("Hello-World")
+	wantRepo.SetFullName("octocat/Hello-World")
+	wantRepo.SetLink("https://gitea.com/octocat/Hello-World")
+	wantRepo.SetClone("https://gitea.com/octocat/Hello-World.git")
+	wantRepo.SetBranch("main")
+	wantRepo.SetPrivate(false)
+
+	wantBuild := new(library.Build)
+	wantBuild.SetEvent("tag")
+	wantBuild.SetClone("https://gitea.com/octocat/Hello-World.git")
+	wantBuild.SetSource("https://gitea.com/octocat/Hello-World/commit/a76aded1ad1c5a5d5a8b8e9b7b5e3d1c7f2a4b6c")
+	wantBuild.SetTitle("push received from https://gitea.com/octocat/Hello-World")
+	wantBuild.SetMessage("Update README.md\n")
+	wantBuild.SetCommit("a76aded1ad1c5a5d5a8b8e9b7b5e3d1c7f2a4b6c")
+	wantBuild.SetSender("octocat")
+	wantBuild.SetAuthor("octocat")
+	wantBuild.SetEmail("octocat@github.com")
+	wantBuild.SetBranch("v0.1")
+	wantBuild.SetRef("refs/tags/v0.1")
+
+	want := &types.Webhook{
+		Comment: "",
+		Hook:    wantHook,
+		Repo:    wantRepo,
+		Build:   wantBuild,
+	}
+
+	got, err := client.ProcessWebhook(context.TODO(), request)
+
+	if err != nil {
+		t.Errorf("ProcessWebhook returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ProcessWebhook is %v, want %v", got, want)
+	}
+}
+
+func TestGitea_ProcessWebhook_Push_Deleted(t *testing.T) {
+	// setup router
+	s := httptest.NewServer(http.NotFoundHandler())
+	defer s.Close()
+
+	// setup request
+	body, err := os.Open("testdata/hooks/push_delete.json")
+	if err != nil {
+		t.Errorf("unable to open file: %v", err)
+	}
+
+	defer body.Close()
+
+	request, _ := http.NewRequestWithContext(context.Background(), http.MethodPost, "/test", body)
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("X-Gitea-Event", "push")
+	request.Header.Set("X-Gitea-Delivery", "7bd477e4-4415-11e9-9359-0d41fdf9567e")
+
+	// setup client
+	client, _ := NewTest(s.URL)
+
+	// run test
+	wantHook := new(library.Hook)
+	wantHook.SetNumber(1)
+	wantHook.SetSourceID("7bd477e4-4415-11e9-9359-0d41fdf9567e")
+	wantHook.SetCreated(time.Now().UTC().Unix())
+	wantHook.SetHost(hostname(s.URL))
+	wantHook.SetEvent("push")
+	wantHook.SetBranch("feature")
+	wantHook.SetStatus(constants.StatusSuccess)
+	wantHook.SetLink(fmt.Sprintf("%s/octocat/Hello-World/settings/hooks", s.URL))
+
+	want := &types.Webhook{Hook: wantHook}
+
+	got, err := client.ProcessWebhook(context.TODO(), request)
+
+	if err != nil {
+		t.Errorf("ProcessWebhook returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ProcessWebhook is %v, want %v", got, want)
+	}
+}
+
+func TestGitea_ProcessWebhook_PullRequest(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		file   string
+		action string
+	}{
+		{
+			file:   "testdata/hooks/pull_request.json",
+			action: constants.ActionOpened,
+		},
+		{
+			file:   "testdata/hooks/pull_request_synchronized.json",
+			action: constants.ActionSynchronize,
+		},
+	}
+
+	// setup router
+	s := httptest.NewServer(http.NotFoundHandler())
+	defer s.Close()
+
+	// setup client
+	client, _ := NewTest(s.URL)
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.action, func(t *testing.T) {
+			// setup request
+			body, err := os.Open(test.file)
+			if err != nil {
+				t.Errorf("unable to open file: %v", err)
+			}
+
+			defer body.Close()
+
+			request, _ := http.NewRequestWithContext(context.Background(), http.MethodPost, "/test", body)
+			request.Header.Set("Content-Type", "application/json")
+			request.Header.Set("X-Gitea-Event", "pull_request")
+			request.Header.Set("X-Gitea-Delivery", "7bd477e4-4415-11e9-9359-0d41fdf9567e")
+
+			wantHook := new(library.Hook)
+			wantHook.SetNumber(1)
+			wantHook.SetSourceID("7bd477e4-4415-11e9-9359-0d41fdf9567e")
+			wantHook.SetCreated(time.Now().UTC().Unix())
+			wantHook.SetHost(hostname(s.URL))
+			wantHook.SetEvent("pull_request")
+			wantHook.SetBranch("main")
+			wantHook.SetStatus(constants.StatusSuccess)
+			wantHook.SetLink(fmt.Sprintf("%s/octocat/Hello-World/settings/hooks", s.URL))
+
+			wantRepo := new(library.Repo)
+			wantRepo.SetOrg("octocat")
+			wantRepo.SetName("Hello-World")
+			wantRepo.SetFullName("octocat/Hello-World")
+			wantRepo.SetLink("https://gitea.com/octocat/Hello-World")
+			wantRepo.SetClone("https://gitea.com/octocat/Hello-World.git")
+			wantRepo.SetBranch("main")
+			wantRepo.SetPrivate(false)
+
+			wantBuild := new(library.Build)
+			wantBuild.SetEvent("pull_request")
+			wantBuild.SetEventAction(test.action)
+			wantBuild.SetClone("https://gitea.com/octocat/Hello-World.git")
+			wantBuild.SetSource("https://gitea.com/octocat/Hello-World/pulls/1")
+			wantBuild.SetTitle("pull_request received from https://gitea.com/octocat/Hello-World")
+			wantBuild.SetMessage("Update the README with new information")
+			wantBuild.SetCommit("34c5c7793cb3b279e22454cb6750c80560547b3a")
+			wantBuild.SetSender("octocat")
+			wantBuild.SetAuthor("octocat")
+			wantBuild.SetEmail("octocat@github.com")
+			wantBuild.SetBranch("main")
+			wantBuild.SetRef("refs/pull/1/head")
+			wantBuild.SetBaseRef("main")
+			wantBuild.SetHeadRef("changes")
+
+			want := &types.Webhook{
+				Comment:  "",
+				PRNumber: 1,
+				Hook:     wantHook,
+				Repo:     wantRepo,
+				Build:    wantBuild,
+			}
+
+			got, err := client.ProcessWebhook(context.TODO(), request)
+
+			if err != nil {
+				t.Errorf("ProcessWebhook returned err: %v", err)
+			}
+
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("ProcessWebhook is %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestGitea_ProcessWebhook_PullRequest_Closed(t *testing.T) {
+	// setup router
+	s := httptest.NewServer(http.NotFoundHandler())
+	defer s.Close()
+
+	// setup request
+	body, err := os.Open("testdata/hooks/pull_request_closed.json")
+	if err != nil {
+		t.Errorf("unable to open file: %v", err)
+	}
+
+	defer body.Close()
+
+	request, _ := http.NewRequestWithContext(context.Background(), http.MethodPost, "/test", body)
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("X-Gitea-Event", "pull_request")
+	request.Header.Set("X-Gitea-Delivery", "7bd477e4-4415-11e9-9359-0d41fdf9567e")
+
+	// setup client
+	client, _ := NewTest(s.URL)
+
+	// run test
+	wantHook := new(library.Hook)
+	wantHook.SetNumber(1)
+	wantHook.SetSourceID("7bd477e4-4415-11e9-9359-0d41fdf9567e")
+	wantHook.SetCreated(time.Now().UTC().Unix())
+	wantHook.SetHost(hostname(s.URL))
+	wantHook.SetEvent("pull_request")
+	wantHook.SetBranch("main")
+	wantHook.SetStatus(constants.StatusSuccess)
+	wantHook.SetLink(fmt.Sprintf("%s/octocat/Hello-World/settings/hooks", s.URL))
+
+	want := &types.Webhook{Hook: wantHook}
+
+	got, err := client.ProcessWebhook(context.TODO(), request)
+
+	if err != nil {
+		t.Errorf("ProcessWebhook returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ProcessWebhook is %v, want %v", got, want)
+	}
+}
+
+func TestGitea_ProcessWebhook_IssueComment_PullRequest(t *testing.T) {
+	// setup router
+	s := httptest.NewServer(http.NotFoundHandler())
+	defer s.Close()
+
+	// setup request
+	body, err := os.Open("testdata/hooks/issue_comment_pr.json")
+	if err != nil {
+		t.Errorf("unable to open file: %v", err)
+	}
+
+	defer body.Close()
+
+	request, _ := http.NewRequestWithContext(context.Background(), http.MethodPost, "/test", body)
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("X-Gitea-Event", "issue_comment")
+	request.Header.Set("X-Gitea-Delivery", "7bd477e4-4415-11e9-9359-0d41fdf9567e")
+
+	// setup client
+	client, _ := NewTest(s.URL)
+
+	// run test
+	wantHook := new(library.Hook)
+	wantHook.SetNumber(1)
+	wantHook.SetSourceID("7bd477e4-4415-11e9-9359-0d41fdf9567e")
+	wantHook.SetCreated(time.Now().UTC().Unix())
+	wantHook.SetHost(hostname(s.URL))
+	wantHook.SetEvent("comment")
+	wantHook.SetEventAction("created")
+	wantHook.SetStatus(constants.StatusSuccess)
+	wantHook.SetLink(fmt.Sprintf("%s/octocat/Hello-World/settings/hooks", s.URL))
+
+	wantRepo := new(library.Repo)
+	wantRepo.SetOrg("octocat")
+	wantRepo.SetName("Hello-World")
+	wantRepo.SetFullName("octocat/Hello-World")
+	wantRepo.SetLink("https://gitea.com/octocat/Hello-World")
+	wantRepo.SetClone("https://gitea.com/octocat/Hello-World.git")
+	wantRepo.SetBranch("main")
+	wantRepo.SetPrivate(false)
+
+	wantBuild := new(library.Build)
+	wantBuild.SetEvent("comment")
+	wantBuild.SetEventAction("created")
+	wantBuild.SetClone("https://gitea.com/octocat/Hello-World.git")
+	wantBuild.SetSource("https://gitea.com/octocat/Hello-World/pulls/1")
+	wantBuild.SetTitle("comment received from https://gitea.com/octocat/Hello-World")
+	wantBuild.SetMessage("Update the README with new information")
+	wantBuild.SetSender("octocat")
+	wantBuild.SetAuthor("octocat")
+	wantBuild.SetEmail("octocat@github.com")
+	wantBuild.SetRef("refs/pull/1/head")
+
+	want := &types.Webhook{
+		Comment:  "ok to test",
+		PRNumber: 1,
+		Hook:     wantHook,
+		Repo:     wantRepo,
+		Build:    wantBuild,
+	}
+
+	got, err := client.ProcessWebhook(context.TODO(), request)
+
+	if err != nil {
+		t.Errorf("ProcessWebhook returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ProcessWebhook is %v, want %v", got, want)
+	}
+}
+
+func TestGitea_ProcessWebhook_IssueComment(t *testing.T) {
+	// setup router
+	s := httptest.NewServer(http.NotFoundHandler())
+	defer s.Close()
+
+	// setup request
+	body, err := os.Open("testdata/hooks/issue_comment.json")
+	if err != nil {
+		t.Errorf("unable to open file: %v", err)
+	}
+
+	defer body.Close()
+
+	request, _ := http.NewRequestWithContext(context.Background(), http.MethodPost, "/test", body)
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("X-Gitea-Event", "issue_comment")
+	request.Header.Set("X-Gitea-Delivery", "7bd477e4-4415-11e9-9359-0d41fdf9567e")
+
+	// setup client
+	client, _ := NewTest(s.URL)
+
+	// run test
+	wantHook := new(library.Hook)
+	wantHook.SetNumber(1)
+	wantHook.SetSourceID("7bd477e4-4415-11e9-9359-0d41fdf9567e")
+	wantHook.SetCreated(time.Now().UTC().Unix())
+	wantHook.SetHost(hostname(s.URL))
+	wantHook.SetEvent("comment")
+	wantHook.SetEventAction("created")
+	wantHook.SetStatus(constants.StatusSuccess)
+	wantHook.SetLink(fmt.Sprintf("%s/octocat/Hello-World/settings/hooks", s.URL))
+
+	wantRepo := new(library.Repo)
+	wantRepo.SetOrg("octocat")
+	wantRepo.SetName("Hello-World")
+	wantRepo.SetFullName("octocat/Hello-World")
+	wantRepo.SetLink("https://gitea.com/octocat/Hello-World")
+	wantRepo.SetClone("https://gitea.com/octocat/Hello-World.git")
+	wantRepo.SetBranch("main")
+	wantRepo.SetPrivate(false)
+
+	wantBuild := new(library.Build)
+	wantBuild.SetEvent("comment")
+	wantBuild.SetEventAction("created")
+	wantBuild.SetClone("https://gitea.com/octocat/Hello-World.git")
+	wantBuild.SetSource("https://gitea.com/octocat/Hello-World/issues/2")
+	wantBuild.SetTitle("comment received from https://gitea.com/octocat/Hello-World")
+	wantBuild.SetMessage("Found a bug")
+	wantBuild.SetSender("octocat")
+	wantBuild.SetAuthor("octocat")
+	wantBuild.SetEmail("octocat@github.com")
+	wantBuild.SetRef("refs/heads/main")
+
+	want := &types.Webhook{
+		Comment: "ok to test",
+		Hook:    wantHook,
+		Repo:    wantRepo,
+		Build:   wantBuild,
+	}
+
+	got, err := client.ProcessWebhook(context.TODO(), request)
+
+	if err != nil {
+		t.Errorf("ProcessWebhook returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ProcessWebhook is %v, want %v", got, want)
+	}
+}
+
+func TestGitea_ProcessWebhook_Repository(t *testing.T) {
+	// setup router
+	s := httptest.NewServer(http.NotFoundHandler())
+	defer s.Close()
+
+	// setup request
+	body, err := os.Open("testdata/hooks/repository.json")
+	if err != nil {
+		t.Errorf("unable to open file: %v", err)
+	}
+
+	defer body.Close()
+
+	request, _ := http.NewRequestWithContext(context.Background(), http.MethodPost, "/test", body)
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("X-Gitea-Event", "repository")
+	request.Header.Set("X-Gitea-Delivery", "7bd477e4-4415-11e9-9359-0d41fdf9567e")
+
+	// setup client
+	client, _ := NewTest(s.URL)
+
+	// run test
+	wantHook := new(library.Hook)
+	wantHook.SetNumber(1)
+	wantHook.SetSourceID("7bd477e4-4415-11e9-9359-0d41fdf9567e")
+	wantHook.SetCreated(time.Now().UTC().Unix())
+	wantHook.SetHost(hostname(s.URL))
+	wantHook.SetEvent("repository")
+	wantHook.SetEventAction("created")
+	wantHook.SetBranch("main")
+	wantHook.SetStatus(constants.StatusSuccess)
+	wantHook.SetLink(fmt.Sprintf("%s/octocat/Hello-World/settings/hooks", s.URL))
+
+	wantRepo := new(library.Repo)
+	wantRepo.SetOrg("octocat")
+	wantRepo.SetName("Hello-World")
+	wantRepo.SetFullName("octocat/Hello-World")
+	wantRepo.SetLink("https://gitea.com/octocat/Hello-World")
+	wantRepo.SetClone("https://gitea.com/octocat/Hello-World.git")
+	wantRepo.SetBranch("main")
+	wantRepo.SetPrivate(false)
+	wantRepo.SetActive(true)
+
+	want := &types.Webhook{
+		Comment: "",
+		Hook:    wantHook,
+		Repo:    wantRepo,
+	}
+
+	got, err := client.ProcessWebhook(context.TODO(), request)
+
+	if err != nil {
+		t.Errorf("ProcessWebhook returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ProcessWebhook is %v, want %v", got, want)
+	}
+}
+
+func TestGitea_ProcessWebhook_BadGiteaEvent(t *testing.T) {
+	// setup router
+	s := httptest.NewServer(http.NotFoundHandler())
+	defer s.Close()
+
+	// setup request
+	body, err := os.Open("testdata/hooks/push.json")
+	if err != nil {
+		t.Errorf("unable to open file: %v", err)
+	}
+
+	defer body.Close()
+
+	request, _ := http.NewRequestWithContext(context.Background(), http.MethodPost, "/test", body)
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("X-Gitea-Event", "create")
+	request.Header.Set("X-Gitea-Delivery", "7bd477e4-4415-11e9-9359-0d41fdf9567e")
+
+	// setup client
+	client, _ := NewTest(s.URL)
+
+	// run test
+	wantHook := new(library.Hook)
+	wantHook.SetNumber(1)
+	wantHook.SetSourceID("7bd477e4-4415-11e9-9359-0d41fdf9567e")
+	wantHook.SetCreated(time.Now().UTC().Unix())
+	wantHook.SetHost(hostname(s.URL))
+	wantHook.SetEvent("create")
+	wantHook.SetStatus(constants.StatusSuccess)
+
+	want := &types.Webhook{Hook: wantHook}
+
+	got, err := client.ProcessWebhook(context.TODO(), request)
+
+	if err != nil {
+		t.Errorf("ProcessWebhook returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ProcessWebhook is %v, want %v", got, want)
+	}
+}
+
+func TestGitea_VerifyWebhook(t *testing.T) {
+	// setup payload
+	payload, err := os.ReadFile("testdata/hooks/push.json")
+	if err != nil {
+		t.Errorf("unable to read file: %v", err)
+	}
+
+	// setup signature
+	mac := hmac.New(sha256.New, []byte("secret"))
+	mac.Write(payload)
+
+	signature := hex.EncodeToString(mac.Sum(nil))
+
+	// setup tests
+	tests := []struct {
+		name      string
+		failure   bool
+		signature string
+	}{
+		{
+			name:      "valid",
+			failure:   false,
+			signature: signature,
+		},
+		{
+			name:      "invalid",
+			failure:   true,
+			signature: "5e9c8f6a4f2f0c3f0e3b7f3c2b1a09876543210fedcba9876543210fedcba98",
+		},
+		{
+			name:      "missing",
+			failure:   true,
+			signature: "",
+		},
+	}
+
+	// setup types
+	r := new(library.Repo)
+	r.SetOrg("octocat")
+	r.SetName("Hello-World")
+	r.SetFullName("octocat/Hello-World")
+	r.SetHash("secret")
+
+	client, _ := NewTest("https://gitea.example.com")
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			request, _ := http.NewRequestWithContext(context.Background(), http.MethodPost, "/test", bytes.NewReader(payload))
+			request.Header.Set("X-Gitea-Signature", test.signature)
+
+			err := client.VerifyWebhook(context.TODO(), request, r)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("VerifyWebhook should have returned err")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("VerifyWebhook returned err: %v", err)
+			}
+		})
+	}
+}
+
+func TestGitea_RedeliverWebhook(t *testing.T) {
+	// setup types
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	r := new(library.Repo)
+	r.SetOrg("octocat")
+	r.SetName("Hello-World")
+
+	h := new(library.Hook)
+	h.SetSourceID("7bd477e4-4415-11e9-9359-0d41fdf9567e")
+
+	client, _ := NewTest("https://gitea.example.com")
+
+	// run test
+	err := client.RedeliverWebhook(context.TODO(), u, r, h)
+
+	if err == nil {
+		t.Errorf("RedeliverWebhook should have returned err")
+	}
+}
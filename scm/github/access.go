@@ -78,6 +78,33 @@ func (c *client) RepoAccess(ctx context.Context, u *library.User, token, org, re
 	return perm.GetPermission(), nil
 }
 
+// RepoInstalled checks if the scm acts on a repo
+// with an app installation instead of a user's token.
+func (c *client) RepoInstalled(ctx context.Context, org, repo string) bool {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  org,
+		"repo": repo,
+	}).Tracef("checking github app installation for repo %s/%s", org, repo)
+
+	// the user's token is used if the client is not a GitHub App
+	if !c.isApp() {
+		return false
+	}
+
+	// capture an installation access token for the repo
+	_, err := c.installationToken(ctx, org, repo)
+	if err != nil {
+		c.Logger.WithFields(logrus.Fields{
+			"org":  org,
+			"repo": repo,
+		}).Debugf("unable to capture github app installation token for %s/%s: %v", org, repo, err)
+
+		return false
+	}
+
+	return true
+}
+
 // TeamAccess captures the user's access level for a team.
 func (c *client) TeamAccess(ctx context.Context, u *library.User, org, team string) (string, error) {
 	c.Logger.WithFields(logrus.Fields{
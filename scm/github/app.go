@@ -0,0 +1,256 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/go-github/v56/github"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sync/singleflight"
+
+	"github.com/go-vela/types/library"
+)
+
+const (
+	// appTokenDuration defines how long a signed GitHub App JWT is valid.
+	//
+	// GitHub allows a maximum of 10 minutes.
+	appTokenDuration = 9 * time.Minute
+
+	// appTokenClockDrift defines how far in the past a signed GitHub App JWT
+	// is issued to protect against clock drift with GitHub.
+	appTokenClockDrift = 60 * time.Second
+
+	// installationTokenBuffer defines how long before expiration
+	// a cached installation access token is minted again.
+	installationTokenBuffer = 5 * time.Minute
+
+	// repoInstallationDuration defines how long the GitHub App installation
+	// captured for a repo is cached before it is captured again, so repos
+	// added to or removed from an installation are picked up.
+	repoInstallationDuration = 5 * time.Minute
+)
+
+// installationTokens represents a cache of GitHub App installation access
+// tokens keyed by the installation ID and of the installation ID for each
+// repo, since an installation may only have access to selected repos.
+type installationTokens struct {
+	sync.Mutex
+
+	repos  map[string]*repoInstallation
+	tokens map[int64]*github.InstallationToken
+	group  singleflight.Group
+}
+
+// repoInstallation represents the GitHub App
+// installation captured for a repo.
+type repoInstallation struct {
+	id      int64
+	expires time.Time
+}
+
+// newClientForRepo returns a GitHub client for acting on the provided repo.
+//
+// When the client is configured as a GitHub App, an installation access token
+// for the owner of the repo is used. Otherwise, or when the GitHub App is not
+// installed for the owner of the repo, the provided user's OAuth token is used.
+func (c *client) newClientForRepo(ctx context.Context, u *library.User, org, repo string) *github.Client {
+	// use the user's OAuth token if the client is not a GitHub App
+	if !c.isApp() {
+		return c.newClientToken(u.GetToken())
+	}
+
+	// capture an installation access token for the repo
+	token, err := c.installationToken(ctx, org, repo)
+	if err != nil {
+		c.Logger.WithFields(logrus.Fields{
+			"org":  org,
+			"repo": repo,
+			"user": u.GetName(),
+		}).Warnf("unable to capture github app installation token for %s/%s, falling back to user token: %v", org, repo, err)
+
+		return c.newClientToken(u.GetToken())
+	}
+
+	return c.newClientToken(token)
+}
+
+// isApp returns true if the client is configured as a GitHub App.
+func (c *client) isApp() bool {
+	return c.config.AppID > 0 && c.config.AppPrivateKey != nil
+}
+
+// installationToken returns an installation access token for the GitHub App
+// installation that has access to the provided repo. Tokens are cached per
+// installation and minted again shortly before they expire.
+//
+// The cache is not locked while capturing the installation or minting a token
+// so requests for other repos are not blocked, and concurrent requests for the
+// same repo or installation share one API call.
+func (c *client) installationToken(ctx context.Context, org, repo string) (string, error) {
+	id, err := c.repoInstallationID(ctx, org, repo)
+	if err != nil {
+		return "", err
+	}
+
+	// return the cached token if it is not close to expiring
+	if token, ok := c.cachedInstallationToken(id); ok {
+		return token, nil
+	}
+
+	// https://pkg.go.dev/golang.org/x/sync/singleflight#Group.Do
+	token, err, _ := c.installations.group.Do(fmt.Sprintf("token/%d", id), func() (interface{}, error) {
+		// the token may have been minted while waiting
+		if token, ok := c.cachedInstallationToken(id); ok {
+			return token, nil
+		}
+
+		t, err := c.mintInstallationToken(ctx, id)
+		if err != nil {
+			return "", fmt.Errorf("unable to create github app installation token for %s/%s: %w", org, repo, err)
+		}
+
+		c.installations.Lock()
+		defer c.installations.Unlock()
+
+		if c.installations.tokens == nil {
+			c.installations.tokens = make(map[int64]*github.InstallationToken)
+		}
+
+		c.installations.tokens[id] = t
+
+		return t.GetToken(), nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return token.(string), nil
+}
+
+// cachedInstallationToken returns the cached installation access
+// token for an installation if it is not close to expiring.
+func (c *client) cachedInstallationToken(id int64) (string, bool) {
+	c.installations.Lock()
+	defer c.installations.Unlock()
+
+	t, ok := c.installations.tokens[id]
+	if !ok || time.Until(t.GetExpiresAt().Time) <= installationTokenBuffer {
+		return "", false
+	}
+
+	return t.GetToken(), true
+}
+
+// repoInstallationID returns the ID of the GitHub App installation
+// that has access to the provided repo. The installation is cached
+// per repo so a repo outside of an installation never reuses the
+// token of another repo from the same owner.
+func (c *client) repoInstallationID(ctx context.Context, org, repo string) (int64, error) {
+	key := strings.ToLower(fmt.Sprintf("%s/%s", org, repo))
+
+	// return the cached installation if it has not expired
+	if id, ok := c.cachedRepoInstallation(key); ok {
+		return id, nil
+	}
+
+	// https://pkg.go.dev/golang.org/x/sync/singleflight#Group.Do
+	id, err, _ := c.installations.group.Do("repo/"+key, func() (interface{}, error) {
+		// the installation may have been captured while waiting
+		if id, ok := c.cachedRepoInstallation(key); ok {
+			return id, nil
+		}
+
+		// create GitHub client authenticated as the GitHub App
+		client, err := c.newClientApp()
+		if err != nil {
+			return int64(0), err
+		}
+
+		// send API call to capture the installation for the repo
+		installation, _, err := client.Apps.FindRepositoryInstallation(ctx, org, repo)
+		if err != nil {
+			return int64(0), fmt.Errorf("unable to find github app installation for %s/%s: %w", org, repo, err)
+		}
+
+		c.installations.Lock()
+		defer c.installations.Unlock()
+
+		if c.installations.repos == nil {
+			c.installations.repos = make(map[string]*repoInstallation)
+		}
+
+		c.installations.repos[key] = &repoInstallation{
+			id:      installation.GetID(),
+			expires: time.Now().Add(repoInstallationDuration),
+		}
+
+		return installation.GetID(), nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return id.(int64), nil
+}
+
+// cachedRepoInstallation returns the cached ID of the GitHub
+// App installation for a repo if it has not expired.
+func (c *client) cachedRepoInstallation(key string) (int64, bool) {
+	c.installations.Lock()
+	defer c.installations.Unlock()
+
+	i, ok := c.installations.repos[key]
+	if !ok || time.Now().After(i.expires) {
+		return 0, false
+	}
+
+	return i.id, true
+}
+
+// mintInstallationToken creates an installation
+// access token for the GitHub App installation.
+func (c *client) mintInstallationToken(ctx context.Context, id int64) (*github.InstallationToken, error) {
+	// create GitHub client authenticated as the GitHub App
+	client, err := c.newClientApp()
+	if err != nil {
+		return nil, err
+	}
+
+	// send API call to mint an installation access token
+	t, _, err := client.Apps.CreateInstallationToken(ctx, id, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// newClientApp returns a GitHub client authenticated as the GitHub App.
+//
+// https://docs.github.com/en/apps/creating-github-apps/authenticating-with-a-github-app/generating-a-json-web-token-jwt-for-a-github-app
+func (c *client) newClientApp() (*github.Client, error) {
+	now := time.Now()
+
+	// create the claims for the GitHub App token
+	claims := jwt.RegisteredClaims{
+		IssuedAt:  jwt.NewNumericDate(now.Add(-appTokenClockDrift)),
+		ExpiresAt: jwt.NewNumericDate(now.Add(appTokenDuration)),
+		Issuer:    strconv.FormatInt(c.config.AppID, 10),
+	}
+
+	// sign the token with the GitHub App private key
+	token, err := jwt.NewWithClaims(jwt.SigningMethodRS256, claims).SignedString(c.config.AppPrivateKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to sign github app token: %w", err)
+	}
+
+	return c.newClientToken(token), nil
+}
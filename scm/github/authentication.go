@@ -41,6 +41,12 @@ func (c *client) Login(ctx context.Context, w http.ResponseWriter, r *http.Reque
 		return "", err
 	}
 
+	// embed the scm provider name so the server
+	// can route the OAuth callback to the provider
+	if len(c.config.Name) > 0 {
+		oAuthState = fmt.Sprintf("%s.%s", c.config.Name, oAuthState)
+	}
+
 	// pass through the redirect if it exists
 	redirect := r.FormValue("redirect_uri")
 	if len(redirect) > 0 {
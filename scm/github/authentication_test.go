@@ -5,7 +5,9 @@ package github
 import (
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"reflect"
+	"strings"
 	"testing"
 
 	_context "context"
@@ -307,6 +309,42 @@ func TestGithub_Login(t *testing.T) {
 	}
 }
 
+func TestGithub_Login_Name(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	context, engine := gin.CreateTestContext(resp)
+	context.Request, _ = http.NewRequest(http.MethodGet, "/login", nil)
+
+	// setup mock server
+	engine.Any("/", func(c *gin.Context) {
+		c.Status(http.StatusNotFound)
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	client, _ := NewTest(s.URL)
+	client.config.Name = "enterprise"
+
+	// run test
+	got, err := client.Login(_context.TODO(), context.Writer, context.Request)
+
+	if err != nil {
+		t.Errorf("Login returned err: %v", err)
+	}
+
+	if !strings.HasPrefix(got, "enterprise.") {
+		t.Errorf("Login state is %v, want prefix %v", got, "enterprise.")
+	}
+
+	if !strings.Contains(resp.Header().Get("Location"), url.QueryEscape(got)) {
+		t.Errorf("Login redirect is %v, want state %v", resp.Header().Get("Location"), got)
+	}
+}
+
 func TestGithub_AuthenticateToken(t *testing.T) {
 	// setup context
 	gin.SetMode(gin.TestMode)
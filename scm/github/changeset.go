@@ -20,8 +20,8 @@ func (c *client) Changeset(ctx context.Context, u *library.User, r *library.Repo
 		"user": u.GetName(),
 	}).Tracef("capturing commit changeset for %s/commit/%s", r.GetFullName(), sha)
 
-	// create GitHub OAuth client with user's token
-	client := c.newClientToken(u.GetToken())
+	// create GitHub client for the repo
+	client := c.newClientForRepo(ctx, u, r.GetOrg(), r.GetName())
 	s := []string{}
 
 	// set the max per page for the options to capture the commit
@@ -49,8 +49,8 @@ func (c *client) ChangesetPR(ctx context.Context, u *library.User, r *library.Re
 		"user": u.GetName(),
 	}).Tracef("capturing pull request changeset for %s/pull/%d", r.GetFullName(), number)
 
-	// create GitHub OAuth client with user's token
-	client := c.newClientToken(u.GetToken())
+	// create GitHub client for the repo
+	client := c.newClientForRepo(ctx, u, r.GetOrg(), r.GetName())
 	s := []string{}
 	f := []*github.CommitFile{}
 
@@ -81,3 +81,23 @@ func (c *client) ChangesetPR(ctx context.Context, u *library.User, r *library.Re
 
 	return s, nil
 }
+
+// CommitMessage captures the message for a commit.
+func (c *client) CommitMessage(ctx context.Context, u *library.User, r *library.Repo, sha string) (string, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("capturing commit message for %s/commit/%s", r.GetFullName(), sha)
+
+	// create GitHub client for the repo
+	client := c.newClientForRepo(ctx, u, r.GetOrg(), r.GetName())
+
+	// send API call to capture the commit
+	commit, _, err := client.Git.GetCommit(ctx, r.GetOrg(), r.GetName(), sha)
+	if err != nil {
+		return "", fmt.Errorf("Git.GetCommit returned error: %w", err)
+	}
+
+	return commit.GetMessage(), nil
+}
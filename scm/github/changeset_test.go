@@ -105,3 +105,45 @@ func TestGithub_ChangesetPR(t *testing.T) {
 		t.Errorf("ChangesetPR is %v, want %v", got, want)
 	}
 }
+
+func TestGithub_CommitMessage(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/api/v3/repos/:org/:repo/git/commits/:sha", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusOK)
+		c.File("testdata/git_commit.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	want := "update docs\n\n[skip ci]"
+
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	r := new(library.Repo)
+	r.SetOrg("repos")
+	r.SetName("octocat")
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got, err := client.CommitMessage(context.TODO(), u, r, "7638417db6d59f3c431d3e1f261cc637155684cd")
+
+	if err != nil {
+		t.Errorf("CommitMessage returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("CommitMessage is %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,285 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+	"github.com/google/go-github/v56/github"
+	"github.com/sirupsen/logrus"
+)
+
+// check run statuses and conclusions.
+//
+// https://docs.github.com/en/rest/checks/runs#create-a-check-run
+const (
+	checkStatusQueued     = "queued"
+	checkStatusInProgress = "in_progress"
+	checkStatusCompleted  = "completed"
+
+	checkConclusionSuccess   = "success"
+	checkConclusionFailure   = "failure"
+	checkConclusionCancelled = "cancelled"
+	checkConclusionSkipped   = "skipped"
+)
+
+// CheckRun sets the check run with per-step detail for the build from the GitHub repo.
+//
+// The commit status is sent instead when builds are not reported as check runs.
+func (c *client) CheckRun(ctx context.Context, u *library.User, b *library.Build, steps []*library.Step, org, name string) error {
+	c.Logger.WithFields(logrus.Fields{
+		"build": b.GetNumber(),
+		"org":   org,
+		"repo":  name,
+		"user":  u.GetName(),
+	}).Tracef("setting check run for %s/%s/%d @ %s", org, name, b.GetNumber(), b.GetCommit())
+
+	return c.report(ctx, u, b, steps, org, name)
+}
+
+// report is a helper function to set the check run with the provided steps
+// for the build when builds are reported as check runs, or to send the commit
+// status for the build otherwise, so the build is written to the repo once.
+func (c *client) report(ctx context.Context, u *library.User, b *library.Build, steps []*library.Step, org, name string) error {
+	// check if the build should be reported as a check run
+	if c.checksEnabled() && !strings.EqualFold(b.GetEvent(), constants.EventDeploy) {
+		// capture an installation access token for the repo
+		//
+		// check runs can only be written by a GitHub App
+		token, err := c.installationToken(ctx, org, name)
+		if err == nil {
+			return c.setCheckRun(ctx, c.newClientToken(token), b, steps, org, name)
+		}
+
+		c.Logger.WithFields(logrus.Fields{
+			"build": b.GetNumber(),
+			"org":   org,
+			"repo":  name,
+		}).Warnf("unable to capture github app installation token for %s/%s, falling back to commit status: %v", org, name, err)
+	}
+
+	return c.commitStatus(ctx, u, b, org, name)
+}
+
+// checksEnabled returns true if the client reports builds as check runs.
+func (c *client) checksEnabled() bool {
+	return c.config.Checks && c.isApp()
+}
+
+// setCheckRun creates or updates the check run for the build.
+func (c *client) setCheckRun(ctx context.Context, client *github.Client, b *library.Build, steps []*library.Step, org, name string) error {
+	checkName := c.statusContext(b)
+	externalID := strconv.Itoa(b.GetNumber())
+	url := fmt.Sprintf("%s/%s/%s/%d", c.config.WebUIAddress, org, name, b.GetNumber())
+
+	status, conclusion, description := toCheckRunState(b.GetStatus())
+
+	output := &github.CheckRunOutput{
+		Title:   github.String(fmt.Sprintf("the build %s", description)),
+		Summary: github.String(fmt.Sprintf("Vela build #%d %s.", b.GetNumber(), description)),
+	}
+
+	// provide a link to the build if server was configured with a web UI
+	if len(c.config.WebUIAddress) > 0 {
+		output.Summary = github.String(fmt.Sprintf("Vela build [#%d](%s) %s.", b.GetNumber(), url, description))
+	}
+
+	// provide the per-step detail if steps were provided
+	if len(steps) > 0 {
+		output.Text = github.String(stepsTable(steps, url, len(c.config.WebUIAddress) > 0))
+	}
+
+	var completedAt *github.Timestamp
+
+	if status == checkStatusCompleted {
+		finished := time.Now()
+		if b.GetFinished() > 0 {
+			finished = time.Unix(b.GetFinished(), 0)
+		}
+
+		completedAt = &github.Timestamp{Time: finished}
+	}
+
+	// send API call to capture the existing check run for the build
+	id, err := c.findCheckRun(ctx, client, org, name, b.GetCommit(), checkName, externalID)
+	if err != nil {
+		return err
+	}
+
+	// create the check run if one does not exist for the build
+	if id == 0 {
+		opts := github.CreateCheckRunOptions{
+			Name:        checkName,
+			HeadSHA:     b.GetCommit(),
+			ExternalID:  github.String(externalID),
+			Status:      github.String(status),
+			CompletedAt: completedAt,
+			Output:      output,
+		}
+
+		if len(conclusion) > 0 {
+			opts.Conclusion = github.String(conclusion)
+		}
+
+		if b.GetStarted() > 0 {
+			opts.StartedAt = &github.Timestamp{Time: time.Unix(b.GetStarted(), 0)}
+		}
+
+		// provide "Details" link in GitHub UI if server was configured with it
+		if len(c.config.WebUIAddress) > 0 {
+			opts.DetailsURL = github.String(url)
+		}
+
+		// send API call to create the check run for the commit
+		_, _, err = client.Checks.CreateCheckRun(ctx, org, name, opts)
+
+		return err
+	}
+
+	opts := github.UpdateCheckRunOptions{
+		Name:        checkName,
+		ExternalID:  github.String(externalID),
+		Status:      github.String(status),
+		CompletedAt: completedAt,
+		Output:      output,
+	}
+
+	if len(conclusion) > 0 {
+		opts.Conclusion = github.String(conclusion)
+	}
+
+	// provide "Details" link in GitHub UI if server was configured with it
+	if len(c.config.WebUIAddress) > 0 {
+		opts.DetailsURL = github.String(url)
+	}
+
+	// send API call to update the check run for the commit
+	_, _, err = client.Checks.UpdateCheckRun(ctx, org, name, id, opts)
+
+	return err
+}
+
+// findCheckRun returns the ID of the check run created by the GitHub App
+// for the build or zero if no check run exists for the build.
+func (c *client) findCheckRun(ctx context.Context, client *github.Client, org, name, commit, checkName, externalID string) (int64, error) {
+	opts := &github.ListCheckRunsOptions{
+		CheckName: github.String(checkName),
+		Filter:    github.String("all"),
+		AppID:     github.Int64(c.config.AppID),
+		ListOptions: github.ListOptions{
+			PerPage: 100,
+		},
+	}
+
+	for {
+		// send API call to capture the check runs for the commit
+		results, resp, err := client.Checks.ListCheckRunsForRef(ctx, org, name, commit, opts)
+		if err != nil {
+			return 0, fmt.Errorf("unable to list check runs for %s/%s @ %s: %w", org, name, commit, err)
+		}
+
+		for _, run := range results.CheckRuns {
+			if run.GetExternalID() == externalID {
+				return run.GetID(), nil
+			}
+		}
+
+		// break the loop if there is no more results to page through
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opts.Page = resp.NextPage
+	}
+
+	return 0, nil
+}
+
+// toCheckRunState is a helper function to convert a Vela
+// build status to a check run status, conclusion and description.
+func toCheckRunState(status string) (string, string, string) {
+	switch status {
+	case constants.StatusPending:
+		return checkStatusQueued, "", "is pending"
+	case constants.StatusRunning:
+		return checkStatusInProgress, "", "is running"
+	case constants.StatusSuccess:
+		return checkStatusCompleted, checkConclusionSuccess, "was successful"
+	case constants.StatusFailure:
+		return checkStatusCompleted, checkConclusionFailure, "has failed"
+	case constants.StatusCanceled:
+		return checkStatusCompleted, checkConclusionCancelled, "was canceled"
+	case constants.StatusKilled:
+		return checkStatusCompleted, checkConclusionCancelled, "was killed"
+	case constants.StatusSkipped:
+		return checkStatusCompleted, checkConclusionSkipped, "was skipped"
+	default:
+		return checkStatusCompleted, checkConclusionFailure, "has errored"
+	}
+}
+
+// stepsTable is a helper function to render a markdown
+// table with the status and duration of each step.
+func stepsTable(steps []*library.Step, url string, link bool) string {
+	// sort the steps in the order they were planned
+	sorted := make([]*library.Step, len(steps))
+	copy(sorted, steps)
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].GetNumber() < sorted[j].GetNumber()
+	})
+
+	// check if the steps belong to stages
+	stages := false
+
+	for _, s := range sorted {
+		if len(s.GetStage()) > 0 {
+			stages = true
+
+			break
+		}
+	}
+
+	var sb strings.Builder
+
+	if stages {
+		sb.WriteString("| Stage | Step | Status | Duration |\n")
+		sb.WriteString("| --- | --- | --- | --- |\n")
+	} else {
+		sb.WriteString("| Step | Status | Duration |\n")
+		sb.WriteString("| --- | --- | --- |\n")
+	}
+
+	for _, s := range sorted {
+		step := s.GetName()
+
+		// provide a link to the step if server was configured with a web UI
+		if link {
+			step = fmt.Sprintf("[%s](%s#step:%d)", s.GetName(), url, s.GetNumber())
+		}
+
+		if stages {
+			fmt.Fprintf(&sb, "| %s ", s.GetStage())
+		}
+
+		fmt.Fprintf(&sb, "| %s | %s | %s |\n", step, s.GetStatus(), stepDuration(s))
+	}
+
+	return sb.String()
+}
+
+// stepDuration is a helper function to render the duration of a step.
+func stepDuration(s *library.Step) string {
+	if s.GetStarted() == 0 || s.GetFinished() < s.GetStarted() {
+		return "-"
+	}
+
+	return (time.Duration(s.GetFinished()-s.GetStarted()) * time.Second).String()
+}
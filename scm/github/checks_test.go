@@ -0,0 +1,347 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package github
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+	"github.com/google/go-github/v56/github"
+)
+
+func TestGithub_CheckRun_Create(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Errorf("unable to generate private key: %v", err)
+	}
+
+	var got github.CreateCheckRunOptions
+
+	// setup mock server
+	engine.GET("/api/v3/repos/:org/:repo/installation", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusOK)
+		c.File("testdata/installation.json")
+	})
+	engine.POST("/api/v3/app/installations/:id/access_tokens", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusCreated)
+		c.File("testdata/installation_token.json")
+	})
+	engine.GET("/api/v3/repos/foo/bar/commits/:sha/check-runs", func(c *gin.Context) {
+		c.String(http.StatusOK, `{"total_count": 0, "check_runs": []}`)
+	})
+	engine.POST("/api/v3/repos/foo/bar/check-runs", func(c *gin.Context) {
+		err := c.BindJSON(&got)
+		if err != nil {
+			c.Status(http.StatusBadRequest)
+			return
+		}
+
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusCreated)
+		c.File("testdata/check_run.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	b := new(library.Build)
+	b.SetID(1)
+	b.SetRepoID(1)
+	b.SetNumber(1)
+	b.SetEvent(constants.EventPush)
+	b.SetStatus(constants.StatusFailure)
+	b.SetCommit("7fd1a60b01f91b314f59955a4e4d4e80d8edf11d")
+	b.SetStarted(1563474077)
+	b.SetFinished(1563474097)
+
+	_init := new(library.Step)
+	_init.SetNumber(1)
+	_init.SetName("init")
+	_init.SetStage("init")
+	_init.SetStatus(constants.StatusSuccess)
+	_init.SetStarted(1563474077)
+	_init.SetFinished(1563474078)
+
+	_test := new(library.Step)
+	_test.SetNumber(2)
+	_test.SetName("test")
+	_test.SetStage("build")
+	_test.SetStatus(constants.StatusFailure)
+	_test.SetStarted(1563474078)
+	_test.SetFinished(1563474097)
+
+	client, _ := NewTest(s.URL)
+	client.config.AppID = 1
+	client.config.AppPrivateKey = key
+	client.config.Checks = true
+
+	// run test
+	err = client.CheckRun(context.TODO(), u, b, []*library.Step{_test, _init}, "foo", "bar")
+
+	if err != nil {
+		t.Errorf("CheckRun returned err: %v", err)
+	}
+
+	if got.Name != "continuous-integration/vela/push" {
+		t.Errorf("CheckRun name is %v, want %v", got.Name, "continuous-integration/vela/push")
+	}
+
+	if got.GetExternalID() != "1" {
+		t.Errorf("CheckRun external id is %v, want %v", got.GetExternalID(), "1")
+	}
+
+	if got.GetStatus() != checkStatusCompleted || got.GetConclusion() != checkConclusionFailure {
+		t.Errorf("CheckRun state is %s/%s, want %s/%s", got.GetStatus(), got.GetConclusion(), checkStatusCompleted, checkConclusionFailure)
+	}
+
+	wantText := "| Stage | Step | Status | Duration |\n" +
+		"| --- | --- | --- | --- |\n" +
+		"| init | [init](" + s.URL + "/foo/bar/1#step:1) | success | 1s |\n" +
+		"| build | [test](" + s.URL + "/foo/bar/1#step:2) | failure | 19s |\n"
+
+	if got.GetOutput().GetText() != wantText {
+		t.Errorf("CheckRun text is %v, want %v", got.GetOutput().GetText(), wantText)
+	}
+}
+
+func TestGithub_CheckRun_Update(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Errorf("unable to generate private key: %v", err)
+	}
+
+	updated := ""
+
+	// setup mock server
+	engine.GET("/api/v3/repos/:org/:repo/installation", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusOK)
+		c.File("testdata/installation.json")
+	})
+	engine.POST("/api/v3/app/installations/:id/access_tokens", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusCreated)
+		c.File("testdata/installation_token.json")
+	})
+	engine.GET("/api/v3/repos/foo/bar/commits/:sha/check-runs", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusOK)
+		c.File("testdata/check_runs.json")
+	})
+	engine.POST("/api/v3/repos/foo/bar/check-runs", func(c *gin.Context) {
+		c.Status(http.StatusConflict)
+	})
+	engine.PATCH("/api/v3/repos/foo/bar/check-runs/:id", func(c *gin.Context) {
+		updated = c.Param("id")
+
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusOK)
+		c.File("testdata/check_run.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	b := new(library.Build)
+	b.SetID(1)
+	b.SetRepoID(1)
+	b.SetNumber(1)
+	b.SetEvent(constants.EventPush)
+	b.SetStatus(constants.StatusSuccess)
+	b.SetCommit("7fd1a60b01f91b314f59955a4e4d4e80d8edf11d")
+
+	client, _ := NewTest(s.URL)
+	client.config.AppID = 1
+	client.config.AppPrivateKey = key
+	client.config.Checks = true
+
+	// run test
+	err = client.CheckRun(context.TODO(), u, b, nil, "foo", "bar")
+
+	if err != nil {
+		t.Errorf("CheckRun returned err: %v", err)
+	}
+
+	if updated != "4" {
+		t.Errorf("CheckRun updated check run %v, want %v", updated, "4")
+	}
+}
+
+func TestGithub_CheckRun_Disabled(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	created := false
+
+	// setup mock server
+	engine.POST("/api/v3/repos/:org/:repo/statuses/:sha", func(c *gin.Context) {
+		created = true
+
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusCreated)
+		c.File("testdata/status.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	b := new(library.Build)
+	b.SetNumber(1)
+	b.SetEvent(constants.EventPush)
+	b.SetStatus(constants.StatusSuccess)
+	b.SetCommit("7fd1a60b01f91b314f59955a4e4d4e80d8edf11d")
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	err := client.CheckRun(context.TODO(), u, b, nil, "foo", "bar")
+
+	if err != nil {
+		t.Errorf("CheckRun returned err: %v", err)
+	}
+
+	// the build is reported with the commit status instead
+	if !created {
+		t.Errorf("CheckRun did not create commit status")
+	}
+}
+
+func TestGithub_Status_CheckRun(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Errorf("unable to generate private key: %v", err)
+	}
+
+	created := false
+
+	// setup mock server
+	engine.GET("/api/v3/repos/:org/:repo/installation", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusOK)
+		c.File("testdata/installation.json")
+	})
+	engine.POST("/api/v3/app/installations/:id/access_tokens", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusCreated)
+		c.File("testdata/installation_token.json")
+	})
+	engine.GET("/api/v3/repos/foo/bar/commits/:sha/check-runs", func(c *gin.Context) {
+		c.String(http.StatusOK, `{"total_count": 0, "check_runs": []}`)
+	})
+	engine.POST("/api/v3/repos/foo/bar/check-runs", func(c *gin.Context) {
+		created = true
+
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusCreated)
+		c.File("testdata/check_run.json")
+	})
+	engine.POST("/api/v3/repos/:org/:repo/statuses/:sha", func(c *gin.Context) {
+		c.Status(http.StatusConflict)
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	b := new(library.Build)
+	b.SetID(1)
+	b.SetRepoID(1)
+	b.SetNumber(1)
+	b.SetEvent(constants.EventPush)
+	b.SetStatus(constants.StatusPending)
+	b.SetCommit("7fd1a60b01f91b314f59955a4e4d4e80d8edf11d")
+
+	client, _ := NewTest(s.URL)
+	client.config.AppID = 1
+	client.config.AppPrivateKey = key
+	client.config.Checks = true
+
+	// run test
+	err = client.Status(context.TODO(), u, b, "foo", "bar")
+
+	if err != nil {
+		t.Errorf("Status returned err: %v", err)
+	}
+
+	if !created {
+		t.Errorf("Status did not create check run")
+	}
+}
+
+func TestGithub_stepsTable(t *testing.T) {
+	// setup types
+	_clone := new(library.Step)
+	_clone.SetNumber(1)
+	_clone.SetName("clone")
+	_clone.SetStatus(constants.StatusSuccess)
+	_clone.SetStarted(1563474077)
+	_clone.SetFinished(1563474079)
+
+	_test := new(library.Step)
+	_test.SetNumber(2)
+	_test.SetName("test")
+	_test.SetStatus(constants.StatusRunning)
+	_test.SetStarted(1563474079)
+
+	want := "| Step | Status | Duration |\n" +
+		"| --- | --- | --- |\n" +
+		"| clone | success | 2s |\n" +
+		"| test | running | - |\n"
+
+	// run test
+	got := stepsTable([]*library.Step{_test, _clone}, "", false)
+
+	if !strings.EqualFold(got, want) {
+		t.Errorf("stepsTable is %v, want %v", got, want)
+	}
+}
@@ -21,8 +21,8 @@ func (c *client) GetDeployment(ctx context.Context, u *library.User, r *library.
 		"user": u.GetName(),
 	}).Tracef("capturing deployment %d for repo %s", id, r.GetFullName())
 
-	// create GitHub OAuth client with user's token
-	client := c.newClientToken(*u.Token)
+	// create GitHub client for the repo
+	client := c.newClientForRepo(ctx, u, r.GetOrg(), r.GetName())
 
 	// send API call to capture the deployment
 	deployment, _, err := client.Repositories.GetDeployment(ctx, r.GetOrg(), r.GetName(), id)
@@ -59,8 +59,8 @@ func (c *client) GetDeploymentCount(ctx context.Context, u *library.User, r *lib
 		"user": u.GetName(),
 	}).Tracef("counting deployments for repo %s", r.GetFullName())
 
-	// create GitHub OAuth client with user's token
-	client := c.newClientToken(*u.Token)
+	// create GitHub client for the repo
+	client := c.newClientForRepo(ctx, u, r.GetOrg(), r.GetName())
 	// create variable to track the deployments
 	deployments := []*github.Deployment{}
 
@@ -101,8 +101,8 @@ func (c *client) GetDeploymentList(ctx context.Context, u *library.User, r *libr
 		"user": u.GetName(),
 	}).Tracef("listing deployments for repo %s", r.GetFullName())
 
-	// create GitHub OAuth client with user's token
-	client := c.newClientToken(*u.Token)
+	// create GitHub client for the repo
+	client := c.newClientForRepo(ctx, u, r.GetOrg(), r.GetName())
 
 	// set pagination options for listing deployments
 	opts := &github.DeploymentsListOptions{
@@ -155,8 +155,8 @@ func (c *client) CreateDeployment(ctx context.Context, u *library.User, r *libra
 		"user": u.GetName(),
 	}).Tracef("creating deployment for repo %s", r.GetFullName())
 
-	// create GitHub OAuth client with user's token
-	client := c.newClientToken(*u.Token)
+	// create GitHub client for the repo
+	client := c.newClientForRepo(ctx, u, r.GetOrg(), r.GetName())
 
 	var payload interface{}
 	if d.Payload == nil {
@@ -4,6 +4,7 @@ package github
 
 import (
 	"context"
+	"crypto/rsa"
 	"fmt"
 	"net/url"
 
@@ -18,15 +19,20 @@ const (
 	defaultAPI = "https://api.github.com/" // Default GitHub API URL
 
 	// events for repo webhooks.
-	eventPush         = "push"
-	eventPullRequest  = "pull_request"
-	eventDeployment   = "deployment"
-	eventIssueComment = "issue_comment"
-	eventRepository   = "repository"
-	eventInitialize   = "initialize"
+	eventPush              = "push"
+	eventPullRequest       = "pull_request"
+	eventPullRequestReview = "pull_request_review"
+	eventDeployment        = "deployment"
+	eventIssueComment      = "issue_comment"
+	eventRepository        = "repository"
+	eventMergeGroup        = "merge_group"
+	eventRelease           = "release"
+	eventInitialize        = "initialize"
 )
 
 type config struct {
+	// specifies the name of the scm provider for the GitHub client
+	Name string
 	// specifies the address to use for the GitHub client
 	Address string
 	// specifies the API endpoint to use for the GitHub client
@@ -45,6 +51,14 @@ type config struct {
 	WebUIAddress string
 	// specifies the OAuth scopes to use for the GitHub client
 	Scopes []string
+	// specifies the GitHub App ID to use for the GitHub client
+	AppID int64
+	// specifies the GitHub App private key to use for the GitHub client
+	AppPrivateKey *rsa.PrivateKey
+	// specifies the GitHub App webhook secret to use for the GitHub client
+	AppWebhookSecret string
+	// specifies whether to report builds as check runs for the GitHub client
+	Checks bool
 }
 
 type client struct {
@@ -53,6 +67,9 @@ type client struct {
 	AuthReq *github.AuthorizationRequest
 	// https://pkg.go.dev/github.com/sirupsen/logrus#Entry
 	Logger *logrus.Entry
+
+	// cache of GitHub App installation access tokens
+	installations *installationTokens
 }
 
 // New returns a SCM implementation that integrates with
@@ -67,6 +84,7 @@ func New(opts ...ClientOpt) (*client, error) {
 	c.config = new(config)
 	c.OAuth = new(oauth2.Config)
 	c.AuthReq = new(github.AuthorizationRequest)
+	c.installations = new(installationTokens)
 
 	// create new logger for the client
 	//
@@ -164,3 +182,16 @@ func (c *client) newClientToken(token string) *github.Client {
 
 	return github
 }
+
+// webhookURL returns the Vela server address that
+// GitHub should use to send repository webhooks.
+//
+// Named scm providers receive webhooks on their own
+// path so the server can route them to the provider.
+func (c *client) webhookURL() string {
+	if len(c.config.Name) > 0 {
+		return fmt.Sprintf("%s/webhook/%s", c.config.ServerWebhookAddress, c.config.Name)
+	}
+
+	return fmt.Sprintf("%s/webhook", c.config.ServerWebhookAddress)
+}
@@ -5,6 +5,8 @@ package github
 import (
 	"fmt"
 	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
 )
 
 // ClientOpt represents a configuration option to initialize the scm client for GitHub.
@@ -149,3 +151,79 @@ func WithScopes(scopes []string) ClientOpt {
 		return nil
 	}
 }
+
+// WithGithubAppID sets the GitHub App ID in the scm client for GitHub.
+func WithGithubAppID(id int64) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring github app id in github scm client")
+
+		// check if the GitHub App ID provided is invalid
+		if id < 0 {
+			return fmt.Errorf("invalid GitHub App ID provided: %d", id)
+		}
+
+		// set the GitHub App ID in the github client
+		c.config.AppID = id
+
+		return nil
+	}
+}
+
+// WithGithubPrivateKey sets the GitHub App private key in the scm client for GitHub.
+func WithGithubPrivateKey(key string) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring github app private key in github scm client")
+
+		// skip if no GitHub App private key was provided
+		if len(key) == 0 {
+			return nil
+		}
+
+		// parse the PEM encoded GitHub App private key
+		privateKey, err := jwt.ParseRSAPrivateKeyFromPEM([]byte(key))
+		if err != nil {
+			return fmt.Errorf("invalid GitHub App private key provided: %w", err)
+		}
+
+		// set the GitHub App private key in the github client
+		c.config.AppPrivateKey = privateKey
+
+		return nil
+	}
+}
+
+// WithGithubAppWebhookSecret sets the GitHub App webhook secret in the scm client for GitHub.
+func WithGithubAppWebhookSecret(secret string) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring github app webhook secret in github scm client")
+
+		// set the GitHub App webhook secret in the github client
+		c.config.AppWebhookSecret = secret
+
+		return nil
+	}
+}
+
+// WithChecks sets whether builds are reported as check runs in the scm client for GitHub.
+func WithChecks(checks bool) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring check runs in github scm client")
+
+		// set the check runs mode in the github client
+		c.config.Checks = checks
+
+		return nil
+	}
+}
+
+// WithName sets the name of the scm provider in the scm client for GitHub.
+func WithName(name string) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring scm provider name in github scm client")
+
+		// set the scm provider name in the github client
+		c.config.Name = name
+
+		return nil
+	}
+}
@@ -3,6 +3,10 @@
 package github
 
 import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
 	"reflect"
 	"testing"
 )
@@ -348,3 +352,223 @@ func TestGithub_ClientOpt_WithScopes(t *testing.T) {
 		}
 	}
 }
+
+func TestGithub_ClientOpt_WithGithubAppID(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		id      int64
+		want    int64
+	}{
+		{
+			failure: false,
+			id:      123,
+			want:    123,
+		},
+		{
+			failure: false,
+			id:      0,
+			want:    0,
+		},
+		{
+			failure: true,
+			id:      -1,
+			want:    0,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		_service, err := New(
+			WithGithubAppID(test.id),
+		)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("WithGithubAppID should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("WithGithubAppID returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(_service.config.AppID, test.want) {
+			t.Errorf("WithGithubAppID is %v, want %v", _service.config.AppID, test.want)
+		}
+	}
+}
+
+func TestGithub_ClientOpt_WithGithubPrivateKey(t *testing.T) {
+	// setup types
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Errorf("unable to generate private key: %v", err)
+	}
+
+	_pem := pem.EncodeToMemory(&pem.Block{
+		Type:  "RSA PRIVATE KEY",
+		Bytes: x509.MarshalPKCS1PrivateKey(key),
+	})
+
+	// setup tests
+	tests := []struct {
+		failure bool
+		key     string
+		want    *rsa.PrivateKey
+	}{
+		{
+			failure: false,
+			key:     string(_pem),
+			want:    key,
+		},
+		{
+			failure: false,
+			key:     "",
+			want:    nil,
+		},
+		{
+			failure: true,
+			key:     "foobar",
+			want:    nil,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		_service, err := New(
+			WithGithubPrivateKey(test.key),
+		)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("WithGithubPrivateKey should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("WithGithubPrivateKey returned err: %v", err)
+		}
+
+		if test.want == nil {
+			if _service.config.AppPrivateKey != nil {
+				t.Errorf("WithGithubPrivateKey is %v, want nil", _service.config.AppPrivateKey)
+			}
+
+			continue
+		}
+
+		if !test.want.Equal(_service.config.AppPrivateKey) {
+			t.Errorf("WithGithubPrivateKey is %v, want %v", _service.config.AppPrivateKey, test.want)
+		}
+	}
+}
+
+func TestGithub_ClientOpt_WithGithubAppWebhookSecret(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		secret string
+		want   string
+	}{
+		{
+			secret: "foo",
+			want:   "foo",
+		},
+		{
+			secret: "",
+			want:   "",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		_service, err := New(
+			WithGithubAppWebhookSecret(test.secret),
+		)
+
+		if err != nil {
+			t.Errorf("WithGithubAppWebhookSecret returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(_service.config.AppWebhookSecret, test.want) {
+			t.Errorf("WithGithubAppWebhookSecret is %v, want %v", _service.config.AppWebhookSecret, test.want)
+		}
+	}
+}
+
+func TestGithub_ClientOpt_WithChecks(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		checks bool
+		want   bool
+	}{
+		{
+			checks: true,
+			want:   true,
+		},
+		{
+			checks: false,
+			want:   false,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		_service, err := New(
+			WithChecks(test.checks),
+		)
+
+		if err != nil {
+			t.Errorf("WithChecks returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(_service.config.Checks, test.want) {
+			t.Errorf("WithChecks is %v, want %v", _service.config.Checks, test.want)
+		}
+	}
+}
+
+func TestGithub_ClientOpt_WithName(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name        string
+		want        string
+		wantWebhook string
+	}{
+		{
+			name:        "",
+			want:        "",
+			wantWebhook: "https://vela.example.com/webhook",
+		},
+		{
+			name:        "enterprise",
+			want:        "enterprise",
+			wantWebhook: "https://vela.example.com/webhook/enterprise",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		_service, err := New(
+			WithServerAddress("https://vela.example.com"),
+			WithServerWebhookAddress(""),
+			WithName(test.name),
+		)
+
+		if err != nil {
+			t.Errorf("WithName returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(_service.config.Name, test.want) {
+			t.Errorf("WithName is %v, want %v", _service.config.Name, test.want)
+		}
+
+		if _service.webhookURL() != test.wantWebhook {
+			t.Errorf("webhookURL is %v, want %v", _service.webhookURL(), test.wantWebhook)
+		}
+	}
+}
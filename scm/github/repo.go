@@ -12,6 +12,7 @@ import (
 
 	"github.com/sirupsen/logrus"
 
+	serverconstants "github.com/go-vela/server/constants"
 	"github.com/go-vela/types/constants"
 	"github.com/go-vela/types/library"
 	"github.com/google/go-github/v56/github"
@@ -54,8 +55,8 @@ func (c *client) Config(ctx context.Context, u *library.User, r *library.Repo, r
 		"user": u.GetName(),
 	}).Tracef("capturing configuration file for %s/commit/%s", r.GetFullName(), ref)
 
-	// create GitHub OAuth client with user's token
-	client := c.newClientToken(*u.Token)
+	// create GitHub client for the repo
+	client := c.newClientForRepo(ctx, u, r.GetOrg(), r.GetName())
 
 	files := []string{".vela.yml", ".vela.yaml"}
 
@@ -99,8 +100,8 @@ func (c *client) Disable(ctx context.Context, u *library.User, org, name string)
 		"user": u.GetName(),
 	}).Tracef("deleting repository webhooks for %s/%s", org, name)
 
-	// create GitHub OAuth client with user's token
-	client := c.newClientToken(*u.Token)
+	// create GitHub client for the repo
+	client := c.newClientForRepo(ctx, u, org, name)
 
 	// send API call to capture the hooks for the repo
 	hooks, _, err := client.Repositories.ListHooks(ctx, org, name, nil)
@@ -124,7 +125,7 @@ func (c *client) Disable(ctx context.Context, u *library.User, org, name string)
 		hookURL := hook.Config["url"].(string)
 
 		// capture hook ID if the hook url matches
-		if hookURL == fmt.Sprintf("%s/webhook", c.config.ServerWebhookAddress) {
+		if hookURL == c.webhookURL() {
 			ids = append(ids, hook.GetID())
 		}
 	}
@@ -135,7 +136,7 @@ func (c *client) Disable(ctx context.Context, u *library.User, org, name string)
 			"org":  org,
 			"repo": name,
 			"user": u.GetName(),
-		}).Warnf("no repository webhooks matching %s/webhook found for %s/%s", c.config.ServerWebhookAddress, org, name)
+		}).Warnf("no repository webhooks matching %s found for %s/%s", c.webhookURL(), org, name)
 
 		return nil
 	}
@@ -157,8 +158,8 @@ func (c *client) Enable(ctx context.Context, u *library.User, r *library.Repo, h
 		"user": u.GetName(),
 	}).Tracef("creating repository webhook for %s/%s", r.GetOrg(), r.GetName())
 
-	// create GitHub OAuth client with user's token
-	client := c.newClientToken(*u.Token)
+	// create GitHub client for the repo
+	client := c.newClientForRepo(ctx, u, r.GetOrg(), r.GetName())
 
 	// always listen to repository events in case of repo name change
 	events := []string{eventRepository}
@@ -171,19 +172,26 @@ func (c *client) Enable(ctx context.Context, u *library.User, r *library.Repo, h
 		events = append(events, eventDeployment)
 	}
 
+	// merge groups are built for the pull requests added to the merge queue
+	// and reviews are built for the pull requests they are submitted on
 	if r.GetAllowPull() {
-		events = append(events, eventPullRequest)
+		events = append(events, eventPullRequest, eventPullRequestReview, eventMergeGroup)
 	}
 
 	if r.GetAllowPush() || r.GetAllowTag() {
 		events = append(events, eventPush)
 	}
 
+	// releases are built for the tags they are published on
+	if r.GetAllowTag() {
+		events = append(events, eventRelease)
+	}
+
 	// create the hook object to make the API call
 	hook := &github.Hook{
 		Events: events,
 		Config: map[string]interface{}{
-			"url":          fmt.Sprintf("%s/webhook", c.config.ServerWebhookAddress),
+			"url":          c.webhookURL(),
 			"content_type": "form",
 			"secret":       r.GetHash(),
 		},
@@ -223,8 +231,8 @@ func (c *client) Update(ctx context.Context, u *library.User, r *library.Repo, h
 		"user": u.GetName(),
 	}).Tracef("updating repository webhook for %s/%s", r.GetOrg(), r.GetName())
 
-	// create GitHub OAuth client with user's token
-	client := c.newClientToken(*u.Token)
+	// create GitHub client for the repo
+	client := c.newClientForRepo(ctx, u, r.GetOrg(), r.GetName())
 
 	// always listen to repository events in case of repo name change
 	events := []string{eventRepository}
@@ -237,19 +245,26 @@ func (c *client) Update(ctx context.Context, u *library.User, r *library.Repo, h
 		events = append(events, eventDeployment)
 	}
 
+	// merge groups are built for the pull requests added to the merge queue
+	// and reviews are built for the pull requests they are submitted on
 	if r.GetAllowPull() {
-		events = append(events, eventPullRequest)
+		events = append(events, eventPullRequest, eventPullRequestReview, eventMergeGroup)
 	}
 
 	if r.GetAllowPush() || r.GetAllowTag() {
 		events = append(events, eventPush)
 	}
 
+	// releases are built for the tags they are published on
+	if r.GetAllowTag() {
+		events = append(events, eventRelease)
+	}
+
 	// create the hook object to make the API call
 	hook := &github.Hook{
 		Events: events,
 		Config: map[string]interface{}{
-			"url":          fmt.Sprintf("%s/webhook", c.config.ServerWebhookAddress),
+			"url":          c.webhookURL(),
 			"content_type": "form",
 			"secret":       r.GetHash(),
 		},
@@ -273,10 +288,16 @@ func (c *client) Status(ctx context.Context, u *library.User, b *library.Build,
 		"user":  u.GetName(),
 	}).Tracef("setting commit status for %s/%s/%d @ %s", org, name, b.GetNumber(), b.GetCommit())
 
-	// create GitHub OAuth client with user's token
-	client := c.newClientToken(*u.Token)
+	return c.report(ctx, u, b, nil, org, name)
+}
 
-	context := fmt.Sprintf("%s/%s", c.config.StatusContext, b.GetEvent())
+// commitStatus sends the commit status, or the deployment
+// status for deployments, for the build to the GitHub repo.
+func (c *client) commitStatus(ctx context.Context, u *library.User, b *library.Build, org, name string) error {
+	// create GitHub client for the repo
+	client := c.newClientForRepo(ctx, u, org, name)
+
+	context := c.statusContext(b)
 	url := fmt.Sprintf("%s/%s/%s/%d", c.config.WebUIAddress, org, name, b.GetNumber())
 
 	var (
@@ -374,8 +395,8 @@ func (c *client) GetRepo(ctx context.Context, u *library.User, r *library.Repo)
 		"user": u.GetName(),
 	}).Tracef("retrieving repository information for %s", r.GetFullName())
 
-	// create GitHub OAuth client with user's token
-	client := c.newClientToken(u.GetToken())
+	// create GitHub client for the repo
+	client := c.newClientForRepo(ctx, u, r.GetOrg(), r.GetName())
 
 	// send an API call to get the repo info
 	repo, _, err := client.Repositories.Get(ctx, r.GetOrg(), r.GetName())
@@ -394,8 +415,8 @@ func (c *client) GetOrgAndRepoName(ctx context.Context, u *library.User, o strin
 		"user": u.GetName(),
 	}).Tracef("retrieving repository information for %s/%s", o, r)
 
-	// create GitHub OAuth client with user's token
-	client := c.newClientToken(u.GetToken())
+	// create GitHub client for the repo
+	client := c.newClientForRepo(ctx, u, o, r)
 
 	// send an API call to get the repo info
 	repo, _, err := client.Repositories.Get(ctx, o, r)
@@ -413,6 +434,9 @@ func (c *client) ListUserRepos(ctx context.Context, u *library.User) ([]*library
 	}).Tracef("listing source repositories for %s", u.GetName())
 
 	// create GitHub OAuth client with user's token
+	//
+	// installation access tokens are not used since they
+	// list the repos for the installation instead of the user
 	client := c.newClientToken(u.GetToken())
 
 	r := []*github.Repository{}
@@ -492,8 +516,8 @@ func (c *client) GetPullRequest(ctx context.Context, u *library.User, r *library
 		"user": u.GetName(),
 	}).Tracef("retrieving pull request %d for repo %s", number, r.GetFullName())
 
-	// create GitHub OAuth client with user's token
-	client := c.newClientToken(u.GetToken())
+	// create GitHub client for the repo
+	client := c.newClientForRepo(ctx, u, r.GetOrg(), r.GetName())
 
 	pull, _, err := client.PullRequests.Get(ctx, r.GetOrg(), r.GetName(), number)
 	if err != nil {
@@ -516,8 +540,8 @@ func (c *client) GetHTMLURL(ctx context.Context, u *library.User, org, repo, nam
 		"user": u.GetName(),
 	}).Tracef("capturing html_url for %s/%s/%s@%s", org, repo, name, ref)
 
-	// create GitHub OAuth client with user's token
-	client := c.newClientToken(*u.Token)
+	// create GitHub client for the repo
+	client := c.newClientForRepo(ctx, u, org, repo)
 
 	// set the reference for the options to capture the repository contents
 	opts := &github.RepositoryContentGetOptions{
@@ -552,8 +576,8 @@ func (c *client) GetBranch(ctx context.Context, u *library.User, r *library.Repo
 		"user": u.GetName(),
 	}).Tracef("retrieving branch %s for repo %s", branch, r.GetFullName())
 
-	// create GitHub OAuth client with user's token
-	client := c.newClientToken(u.GetToken())
+	// create GitHub client for the repo
+	client := c.newClientForRepo(ctx, u, r.GetOrg(), r.GetName())
 
 	maxRedirects := 3
 	data, _, err := client.Repositories.GetBranch(ctx, r.GetOrg(), r.GetName(), branch, maxRedirects)
@@ -563,3 +587,60 @@ func (c *client) GetBranch(ctx context.Context, u *library.User, r *library.Repo
 
 	return data.GetName(), data.GetCommit().GetSHA(), nil
 }
+
+// GetTag defines a function that retrieves the commit for a tag in a repo.
+func (c *client) GetTag(ctx context.Context, u *library.User, r *library.Repo, tag string) (string, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("retrieving tag %s for repo %s", tag, r.GetFullName())
+
+	// create GitHub client for the repo
+	client := c.newClientForRepo(ctx, u, r.GetOrg(), r.GetName())
+
+	// resolve the commit for the tag which peels annotated tags
+	commit, _, err := client.Repositories.GetCommitSHA1(ctx, r.GetOrg(), r.GetName(), fmt.Sprintf("refs/tags/%s", tag), "")
+	if err != nil {
+		return "", err
+	}
+
+	return commit, nil
+}
+
+// statusContext is a helper function to create the context
+// for the commit status or check run of a build.
+//
+// Merge group builds report with the pull_request context
+// so the checks required for a pull request to be added to
+// the merge queue are also satisfied for the merge group.
+func (c *client) statusContext(b *library.Build) string {
+	event := b.GetEvent()
+
+	if strings.EqualFold(event, serverconstants.EventMergeGroup) {
+		event = constants.EventPull
+	}
+
+	return fmt.Sprintf("%s/%s", c.config.StatusContext, event)
+}
+
+// CreateComment creates a comment on a pull request for the GitHub repo.
+func (c *client) CreateComment(ctx context.Context, u *library.User, r *library.Repo, number int, body string) error {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("creating comment on pull request %d for repo %s", number, r.GetFullName())
+
+	// create GitHub client for the repo
+	client := c.newClientForRepo(ctx, u, r.GetOrg(), r.GetName())
+
+	comment := &github.IssueComment{
+		Body: github.String(body),
+	}
+
+	// pull requests share the issue comment API in GitHub
+	_, _, err := client.Issues.CreateComment(ctx, r.GetOrg(), r.GetName(), number, comment)
+
+	return err
+}
@@ -14,6 +14,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 
+	serverconstants "github.com/go-vela/server/constants"
 	"github.com/go-vela/types/constants"
 	"github.com/go-vela/types/library"
 )
@@ -1426,3 +1427,134 @@ func TestGithub_GetBranch(t *testing.T) {
 		t.Errorf("Commit is %v, want %v", gotCommit, wantCommit)
 	}
 }
+
+func TestGithub_GetTag(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/api/v3/repos/:owner/:repo/commits/*ref", func(c *gin.Context) {
+		if c.Param("ref") != "/refs/tags/v0.1.0" {
+			c.Status(http.StatusNotFound)
+
+			return
+		}
+
+		c.String(http.StatusOK, "7fd1a60b01f91b314f59955a4e4d4e80d8edf11d")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	r := new(library.Repo)
+	r.SetOrg("octocat")
+	r.SetName("Hello-World")
+	r.SetFullName("octocat/Hello-World")
+
+	want := "7fd1a60b01f91b314f59955a4e4d4e80d8edf11d"
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got, err := client.GetTag(context.TODO(), u, r, "v0.1.0")
+
+	if err != nil {
+		t.Errorf("GetTag returned err: %v", err)
+	}
+
+	if got != want {
+		t.Errorf("GetTag is %v, want %v", got, want)
+	}
+}
+
+func TestGithub_statusContext(t *testing.T) {
+	// setup types
+	s := httptest.NewServer(http.NotFoundHandler())
+	defer s.Close()
+
+	client, _ := NewTest(s.URL)
+
+	// setup tests
+	tests := []struct {
+		event string
+		want  string
+	}{
+		{event: constants.EventPush, want: "continuous-integration/vela/push"},
+		{event: constants.EventPull, want: "continuous-integration/vela/pull_request"},
+		{event: serverconstants.EventMergeGroup, want: "continuous-integration/vela/pull_request"},
+	}
+
+	// run tests
+	for _, test := range tests {
+		b := new(library.Build)
+		b.SetEvent(test.event)
+
+		got := client.statusContext(b)
+
+		if got != test.want {
+			t.Errorf("statusContext for %s is %v, want %v", test.event, got, test.want)
+		}
+	}
+}
+
+func TestGithub_CreateComment(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.POST("/api/v3/repos/:owner/:repo/issues/:number/comments", func(c *gin.Context) {
+		body := struct {
+			Body string `json:"body"`
+		}{}
+
+		err := c.BindJSON(&body)
+		if err != nil || c.Param("number") != "1" || body.Body != "Me too" {
+			c.Status(http.StatusBadRequest)
+
+			return
+		}
+
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusCreated)
+		c.File("testdata/comment.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	r := new(library.Repo)
+	r.SetOrg("octocat")
+	r.SetName("Hello-World")
+	r.SetFullName("octocat/Hello-World")
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	err := client.CreateComment(context.TODO(), u, r, 1, "Me too")
+
+	if err != nil {
+		t.Errorf("CreateComment returned err: %v", err)
+	}
+
+	err = client.CreateComment(context.TODO(), u, r, 2, "Me too")
+
+	if err == nil {
+		t.Errorf("CreateComment should have returned err")
+	}
+}
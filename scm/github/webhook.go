@@ -15,6 +15,7 @@ import (
 
 	"github.com/sirupsen/logrus"
 
+	serverconstants "github.com/go-vela/server/constants"
 	"github.com/go-vela/types"
 	"github.com/go-vela/types/constants"
 	"github.com/go-vela/types/library"
@@ -77,6 +78,14 @@ func (c *client) ProcessWebhook(ctx context.Context, request *http.Request) (*ty
 		return c.processIssueCommentEvent(h, event)
 	case *github.RepositoryEvent:
 		return c.processRepositoryEvent(h, event)
+	case *github.CheckRunEvent:
+		return c.processCheckRunEvent(h, event)
+	case *github.MergeGroupEvent:
+		return c.processMergeGroupEvent(h, event)
+	case *github.ReleaseEvent:
+		return c.processReleaseEvent(h, event)
+	case *github.PullRequestReviewEvent:
+		return c.processReviewEvent(h, event)
 	}
 
 	return &types.Webhook{Hook: h}, nil
@@ -89,7 +98,15 @@ func (c *client) VerifyWebhook(ctx context.Context, request *http.Request, r *li
 		"repo": r.GetName(),
 	}).Tracef("verifying GitHub webhook for %s", r.GetFullName())
 
-	_, err := github.ValidatePayload(request, []byte(r.GetHash()))
+	secret := r.GetHash()
+
+	// use the GitHub App webhook secret for webhooks delivered to the GitHub App
+	if strings.EqualFold(request.Header.Get("X-GitHub-Hook-Installation-Target-Type"), "integration") &&
+		len(c.config.AppWebhookSecret) > 0 {
+		secret = c.config.AppWebhookSecret
+	}
+
+	_, err := github.ValidatePayload(request, []byte(secret))
 	if err != nil {
 		return err
 	}
@@ -99,9 +116,8 @@ func (c *client) VerifyWebhook(ctx context.Context, request *http.Request, r *li
 
 // RedeliverWebhook redelivers webhooks from GitHub.
 func (c *client) RedeliverWebhook(ctx context.Context, u *library.User, r *library.Repo, h *library.Hook) error {
-	// create GitHub OAuth client with user's token
-	//nolint:contextcheck // do not need to pass context in this instance
-	client := c.newClientToken(*u.Token)
+	// create GitHub client for the repo
+	client := c.newClientForRepo(ctx, u, r.GetOrg(), r.GetName())
 
 	// capture the delivery ID of the hook using GitHub API
 	deliveryID, err := c.getDeliveryID(ctx, client, r, h)
@@ -478,6 +494,292 @@ func (c *client) processRepositoryEvent(h *library.Hook, payload *github.Reposit
 	}, nil
 }
 
+// processCheckRunEvent is a helper function to process the check run event.
+func (c *client) processCheckRunEvent(h *library.Hook, payload *github.CheckRunEvent) (*types.Webhook, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  payload.GetRepo().GetOwner().GetLogin(),
+		"repo": payload.GetRepo().GetName(),
+	}).Tracef("processing check run GitHub webhook for %s", payload.GetRepo().GetFullName())
+
+	h.SetEvent(serverconstants.EventCheckRun)
+	h.SetEventAction(payload.GetAction())
+
+	// skip if the check run was not re-requested
+	if !strings.EqualFold(payload.GetAction(), serverconstants.ActionRerequested) {
+		return &types.Webhook{Hook: h}, nil
+	}
+
+	// skip if the check run was not created by the GitHub App
+	if !c.checksEnabled() || payload.GetCheckRun().GetApp().GetID() != c.config.AppID {
+		return &types.Webhook{Hook: h}, nil
+	}
+
+	// capture the build number from the check run
+	number, err := strconv.Atoi(payload.GetCheckRun().GetExternalID())
+	if err != nil {
+		return &types.Webhook{Hook: h}, nil
+	}
+
+	repo := payload.GetRepo()
+
+	// convert payload to library repo
+	r := new(library.Repo)
+	r.SetOrg(repo.GetOwner().GetLogin())
+	r.SetName(repo.GetName())
+	r.SetFullName(repo.GetFullName())
+	r.SetLink(repo.GetHTMLURL())
+	r.SetClone(repo.GetCloneURL())
+	r.SetBranch(repo.GetDefaultBranch())
+	r.SetPrivate(repo.GetPrivate())
+	r.SetTopics(repo.Topics)
+
+	// convert payload to library build
+	//
+	// only the number of the build to restart is captured
+	b := new(library.Build)
+	b.SetNumber(number)
+	b.SetCommit(payload.GetCheckRun().GetHeadSHA())
+	b.SetSender(payload.GetSender().GetLogin())
+
+	h.SetBranch(r.GetBranch())
+	h.SetLink(
+		fmt.Sprintf("https://%s/%s/settings/hooks", h.GetHost(), r.GetFullName()),
+	)
+
+	return &types.Webhook{
+		Hook:  h,
+		Repo:  r,
+		Build: b,
+	}, nil
+}
+
+// processMergeGroupEvent is a helper function to process the merge group event.
+func (c *client) processMergeGroupEvent(h *library.Hook, payload *github.MergeGroupEvent) (*types.Webhook, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  payload.GetRepo().GetOwner().GetLogin(),
+		"repo": payload.GetRepo().GetName(),
+	}).Tracef("processing merge group GitHub webhook for %s", payload.GetRepo().GetFullName())
+
+	group := payload.GetMergeGroup()
+
+	// update the hook object
+	h.SetBranch(strings.TrimPrefix(group.GetBaseRef(), "refs/heads/"))
+	h.SetEvent(serverconstants.EventMergeGroup)
+	h.SetEventAction(payload.GetAction())
+	h.SetLink(
+		fmt.Sprintf("https://%s/%s/settings/hooks", h.GetHost(), payload.GetRepo().GetFullName()),
+	)
+
+	// skip if the merge group action is not checks_requested or destroyed
+	if !strings.EqualFold(payload.GetAction(), serverconstants.ActionChecksRequested) &&
+		!strings.EqualFold(payload.GetAction(), serverconstants.ActionDestroyed) {
+		return &types.Webhook{Hook: h}, nil
+	}
+
+	repo := payload.GetRepo()
+
+	// convert payload to library repo
+	r := new(library.Repo)
+	r.SetOrg(repo.GetOwner().GetLogin())
+	r.SetName(repo.GetName())
+	r.SetFullName(repo.GetFullName())
+	r.SetLink(repo.GetHTMLURL())
+	r.SetClone(repo.GetCloneURL())
+	r.SetBranch(repo.GetDefaultBranch())
+	r.SetPrivate(repo.GetPrivate())
+	r.SetTopics(repo.Topics)
+
+	// convert payload to library build
+	b := new(library.Build)
+	b.SetEvent(serverconstants.EventMergeGroup)
+	b.SetEventAction(payload.GetAction())
+	b.SetClone(repo.GetCloneURL())
+	b.SetSource(fmt.Sprintf("%s/commit/%s", repo.GetHTMLURL(), group.GetHeadSHA()))
+	b.SetTitle(fmt.Sprintf("%s received from %s", serverconstants.EventMergeGroup, repo.GetHTMLURL()))
+	b.SetMessage(group.GetHeadCommit().GetMessage())
+	b.SetCommit(group.GetHeadSHA())
+	b.SetSender(payload.GetSender().GetLogin())
+	b.SetAuthor(group.GetHeadCommit().GetAuthor().GetLogin())
+	b.SetEmail(group.GetHeadCommit().GetAuthor().GetEmail())
+	b.SetBranch(strings.TrimPrefix(group.GetBaseRef(), "refs/heads/"))
+	b.SetRef(group.GetHeadRef())
+	b.SetBaseRef(strings.TrimPrefix(group.GetBaseRef(), "refs/heads/"))
+	b.SetHeadRef(group.GetHeadRef())
+
+	// ensure the build author is set
+	if len(b.GetAuthor()) == 0 {
+		b.SetAuthor(group.GetHeadCommit().GetAuthor().GetName())
+	}
+
+	// fall back to the sender for the build author
+	if len(b.GetAuthor()) == 0 {
+		b.SetAuthor(payload.GetSender().GetLogin())
+	}
+
+	return &types.Webhook{
+		Hook:  h,
+		Repo:  r,
+		Build: b,
+	}, nil
+}
+
+// processReleaseEvent is a helper function to process the release event.
+//
+// GitHub delivers both the published and prereleased actions when
+// a pre-release is published so builds are only created for the
+// published action and the prereleased action is set on the build
+// for pre-releases instead.
+func (c *client) processReleaseEvent(h *library.Hook, payload *github.ReleaseEvent) (*types.Webhook, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  payload.GetRepo().GetOwner().GetLogin(),
+		"repo": payload.GetRepo().GetName(),
+	}).Tracef("processing release GitHub webhook for %s", payload.GetRepo().GetFullName())
+
+	release := payload.GetRelease()
+
+	// update the hook object
+	h.SetBranch(release.GetTargetCommitish())
+	h.SetEvent(serverconstants.EventRelease)
+	h.SetEventAction(payload.GetAction())
+	h.SetLink(
+		fmt.Sprintf("https://%s/%s/settings/hooks", h.GetHost(), payload.GetRepo().GetFullName()),
+	)
+
+	// skip if the release action is not published or the release is a draft
+	if !strings.EqualFold(payload.GetAction(), serverconstants.ActionPublished) || release.GetDraft() {
+		return &types.Webhook{Hook: h}, nil
+	}
+
+	repo := payload.GetRepo()
+
+	// convert payload to library repo
+	r := new(library.Repo)
+	r.SetOrg(repo.GetOwner().GetLogin())
+	r.SetName(repo.GetName())
+	r.SetFullName(repo.GetFullName())
+	r.SetLink(repo.GetHTMLURL())
+	r.SetClone(repo.GetCloneURL())
+	r.SetBranch(repo.GetDefaultBranch())
+	r.SetPrivate(repo.GetPrivate())
+	r.SetTopics(repo.Topics)
+
+	// convert payload to library build
+	//
+	// the release payload does not provide the commit for
+	// the tag so it is resolved when the webhook is handled
+	b := new(library.Build)
+	b.SetEvent(serverconstants.EventRelease)
+	b.SetEventAction(serverconstants.ActionPublished)
+	b.SetClone(repo.GetCloneURL())
+	b.SetSource(release.GetHTMLURL())
+	b.SetTitle(fmt.Sprintf("%s received from %s", serverconstants.EventRelease, repo.GetHTMLURL()))
+	b.SetMessage(release.GetName())
+	b.SetSender(payload.GetSender().GetLogin())
+	b.SetAuthor(release.GetAuthor().GetLogin())
+	b.SetEmail(release.GetAuthor().GetEmail())
+	b.SetBranch(release.GetTagName())
+	b.SetRef(fmt.Sprintf("refs/tags/%s", release.GetTagName()))
+	b.SetBaseRef(release.GetTargetCommitish())
+
+	// set the prereleased action for pre-releases
+	if release.GetPrerelease() {
+		b.SetEventAction(serverconstants.ActionPrereleased)
+	}
+
+	// ensure the build message is set
+	if len(b.GetMessage()) == 0 {
+		b.SetMessage(release.GetTagName())
+	}
+
+	// ensure the build author is set
+	if len(b.GetAuthor()) == 0 {
+		b.SetAuthor(payload.GetSender().GetLogin())
+	}
+
+	return &types.Webhook{
+		Hook:  h,
+		Repo:  r,
+		Build: b,
+	}, nil
+}
+
+// processReviewEvent is a helper function to process the pull request review event.
+//
+// Builds are only created for submitted reviews with the
+// state of the review (i.e. approved) set as the build action.
+func (c *client) processReviewEvent(h *library.Hook, payload *github.PullRequestReviewEvent) (*types.Webhook, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  payload.GetRepo().GetOwner().GetLogin(),
+		"repo": payload.GetRepo().GetName(),
+	}).Tracef("processing pull_request_review GitHub webhook for %s", payload.GetRepo().GetFullName())
+
+	pr := payload.GetPullRequest()
+	state := strings.ToLower(payload.GetReview().GetState())
+
+	// update the hook object
+	h.SetBranch(pr.GetBase().GetRef())
+	h.SetEvent(serverconstants.EventReview)
+	h.SetEventAction(payload.GetAction())
+	h.SetLink(
+		fmt.Sprintf("https://%s/%s/settings/hooks", h.GetHost(), payload.GetRepo().GetFullName()),
+	)
+
+	// skip if the review action is not submitted or the pull request isn't open
+	if !strings.EqualFold(payload.GetAction(), serverconstants.ActionSubmitted) ||
+		!strings.EqualFold(pr.GetState(), "open") {
+		return &types.Webhook{Hook: h}, nil
+	}
+
+	// skip if the review state is not approved, changes_requested or commented
+	if state != serverconstants.ActionApproved &&
+		state != serverconstants.ActionChangesRequested &&
+		state != serverconstants.ActionCommented {
+		return &types.Webhook{Hook: h}, nil
+	}
+
+	repo := payload.GetRepo()
+
+	// convert payload to library repo
+	r := new(library.Repo)
+	r.SetOrg(repo.GetOwner().GetLogin())
+	r.SetName(repo.GetName())
+	r.SetFullName(repo.GetFullName())
+	r.SetLink(repo.GetHTMLURL())
+	r.SetClone(repo.GetCloneURL())
+	r.SetBranch(repo.GetDefaultBranch())
+	r.SetPrivate(repo.GetPrivate())
+	r.SetTopics(repo.Topics)
+
+	// convert payload to library build
+	b := new(library.Build)
+	b.SetEvent(serverconstants.EventReview)
+	b.SetEventAction(state)
+	b.SetClone(repo.GetCloneURL())
+	b.SetSource(payload.GetReview().GetHTMLURL())
+	b.SetTitle(fmt.Sprintf("%s received from %s", serverconstants.EventReview, repo.GetHTMLURL()))
+	b.SetMessage(pr.GetTitle())
+	b.SetCommit(pr.GetHead().GetSHA())
+	b.SetSender(payload.GetSender().GetLogin())
+	b.SetAuthor(pr.GetUser().GetLogin())
+	b.SetEmail(pr.GetUser().GetEmail())
+	b.SetBranch(pr.GetBase().GetRef())
+	b.SetRef(fmt.Sprintf("refs/pull/%d/head", pr.GetNumber()))
+	b.SetBaseRef(pr.GetBase().GetRef())
+	b.SetHeadRef(pr.GetHead().GetRef())
+
+	// ensure the build sender is set
+	if len(b.GetSender()) == 0 {
+		b.SetSender(payload.GetReview().GetUser().GetLogin())
+	}
+
+	return &types.Webhook{
+		PRNumber: pr.GetNumber(),
+		Hook:     h,
+		Repo:     r,
+		Build:    b,
+	}, nil
+}
+
 // getDeliveryID gets the last 100 webhook deliveries for a repo and
 // finds the matching delivery id with the source id in the hook.
 func (c *client) getDeliveryID(ctx context.Context, ghClient *github.Client, r *library.Repo, h *library.Hook) (int64, error) {
@@ -3,7 +3,13 @@
 package github
 
 import (
+	"bytes"
 	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -13,6 +19,7 @@ import (
 	"time"
 
 	"github.com/gin-gonic/gin"
+	serverconstants "github.com/go-vela/server/constants"
 	"github.com/go-vela/types/raw"
 	"github.com/google/go-cmp/cmp"
 
@@ -1356,3 +1363,526 @@ func TestGithub_GetDeliveryID(t *testing.T) {
 		t.Errorf("getDeliveryID returned: %v; want: %v", got, want)
 	}
 }
+
+func TestGithub_ProcessWebhook_CheckRun_Rerequested(t *testing.T) {
+	// setup router
+	s := httptest.NewServer(http.NotFoundHandler())
+	defer s.Close()
+
+	// setup request
+	body, err := os.Open("testdata/hooks/check_run_rerequested.json")
+	if err != nil {
+		t.Errorf("unable to open file: %v", err)
+	}
+
+	defer body.Close()
+
+	request, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, "/test", body)
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("User-Agent", "GitHub-Hookshot/a22606a")
+	request.Header.Set("X-GitHub-Delivery", "7bd477e4-4415-11e9-9359-0d41fdf9567e")
+	request.Header.Set("X-GitHub-Hook-ID", "123456")
+	request.Header.Set("X-GitHub-Event", "check_run")
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Errorf("unable to generate private key: %v", err)
+	}
+
+	// setup client
+	client, _ := NewTest(s.URL)
+	client.config.AppID = 1
+	client.config.AppPrivateKey = key
+	client.config.Checks = true
+
+	// run test
+	wantHook := new(library.Hook)
+	wantHook.SetNumber(1)
+	wantHook.SetSourceID("7bd477e4-4415-11e9-9359-0d41fdf9567e")
+	wantHook.SetWebhookID(123456)
+	wantHook.SetCreated(time.Now().UTC().Unix())
+	wantHook.SetHost("github.com")
+	wantHook.SetEvent(serverconstants.EventCheckRun)
+	wantHook.SetEventAction(serverconstants.ActionRerequested)
+	wantHook.SetBranch("main")
+	wantHook.SetStatus(constants.StatusSuccess)
+	wantHook.SetLink("https://github.com/Codertocat/Hello-World/settings/hooks")
+
+	wantRepo := new(library.Repo)
+	wantRepo.SetOrg("Codertocat")
+	wantRepo.SetName("Hello-World")
+	wantRepo.SetFullName("Codertocat/Hello-World")
+	wantRepo.SetLink("https://github.com/Codertocat/Hello-World")
+	wantRepo.SetClone("https://github.com/Codertocat/Hello-World.git")
+	wantRepo.SetBranch("main")
+	wantRepo.SetPrivate(false)
+	wantRepo.SetTopics(nil)
+
+	wantBuild := new(library.Build)
+	wantBuild.SetNumber(1)
+	wantBuild.SetCommit("9c93babf58917cd6f6f6772b5df2b098f507ff95")
+	wantBuild.SetSender("Octocat")
+
+	want := &types.Webhook{
+		Hook:  wantHook,
+		Repo:  wantRepo,
+		Build: wantBuild,
+	}
+
+	got, err := client.ProcessWebhook(context.TODO(), request)
+
+	if err != nil {
+		t.Errorf("ProcessWebhook returned err: %v", err)
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("ProcessWebhook mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestGithub_ProcessWebhook_MergeGroup(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name   string
+		file   string
+		action string
+	}{
+		{
+			name:   "checks requested",
+			file:   "testdata/hooks/merge_group_checks_requested.json",
+			action: serverconstants.ActionChecksRequested,
+		},
+		{
+			name:   "destroyed",
+			file:   "testdata/hooks/merge_group_destroyed.json",
+			action: serverconstants.ActionDestroyed,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// setup router
+			s := httptest.NewServer(http.NotFoundHandler())
+			defer s.Close()
+
+			// setup request
+			body, err := os.Open(test.file)
+			if err != nil {
+				t.Errorf("unable to open file: %v", err)
+			}
+
+			defer body.Close()
+
+			request, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, "/test", body)
+			request.Header.Set("Content-Type", "application/json")
+			request.Header.Set("User-Agent", "GitHub-Hookshot/a22606a")
+			request.Header.Set("X-GitHub-Delivery", "7bd477e4-4415-11e9-9359-0d41fdf9567e")
+			request.Header.Set("X-GitHub-Hook-ID", "123456")
+			request.Header.Set("X-GitHub-Event", "merge_group")
+
+			// setup client
+			client, _ := NewTest(s.URL)
+
+			// run test
+			wantHook := new(library.Hook)
+			wantHook.SetNumber(1)
+			wantHook.SetSourceID("7bd477e4-4415-11e9-9359-0d41fdf9567e")
+			wantHook.SetWebhookID(123456)
+			wantHook.SetCreated(time.Now().UTC().Unix())
+			wantHook.SetHost("github.com")
+			wantHook.SetEvent(serverconstants.EventMergeGroup)
+			wantHook.SetEventAction(test.action)
+			wantHook.SetBranch("main")
+			wantHook.SetStatus(constants.StatusSuccess)
+			wantHook.SetLink("https://github.com/Codertocat/Hello-World/settings/hooks")
+
+			wantRepo := new(library.Repo)
+			wantRepo.SetOrg("Codertocat")
+			wantRepo.SetName("Hello-World")
+			wantRepo.SetFullName("Codertocat/Hello-World")
+			wantRepo.SetLink("https://github.com/Codertocat/Hello-World")
+			wantRepo.SetClone("https://github.com/Codertocat/Hello-World.git")
+			wantRepo.SetBranch("main")
+			wantRepo.SetPrivate(false)
+			wantRepo.SetTopics(nil)
+
+			wantBuild := new(library.Build)
+			wantBuild.SetEvent(serverconstants.EventMergeGroup)
+			wantBuild.SetEventAction(test.action)
+			wantBuild.SetClone("https://github.com/Codertocat/Hello-World.git")
+			wantBuild.SetSource("https://github.com/Codertocat/Hello-World/commit/ec26c3e57ca3a959ca5aad62de7213c562f8c821")
+			wantBuild.SetTitle("merge_group received from https://github.com/Codertocat/Hello-World")
+			wantBuild.SetMessage("Merge pull request #2 from Codertocat/patch-1\n\nUpdate README.md")
+			wantBuild.SetCommit("ec26c3e57ca3a959ca5aad62de7213c562f8c821")
+			wantBuild.SetSender("Octocat")
+			wantBuild.SetAuthor("Codertocat")
+			wantBuild.SetEmail("21031067+Codertocat@users.noreply.github.com")
+			wantBuild.SetBranch("main")
+			wantBuild.SetRef("refs/heads/gh-readonly-queue/main/pr-2-6113728f27ae82c7b1a177c8d03f9e96e0adf246")
+			wantBuild.SetBaseRef("main")
+			wantBuild.SetHeadRef("refs/heads/gh-readonly-queue/main/pr-2-6113728f27ae82c7b1a177c8d03f9e96e0adf246")
+
+			want := &types.Webhook{
+				Hook:  wantHook,
+				Repo:  wantRepo,
+				Build: wantBuild,
+			}
+
+			got, err := client.ProcessWebhook(context.TODO(), request)
+
+			if err != nil {
+				t.Errorf("ProcessWebhook returned err: %v", err)
+			}
+
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Errorf("ProcessWebhook mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGithub_ProcessWebhook_Release(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name   string
+		file   string
+		action string
+		build  bool
+	}{
+		{
+			name:   "published",
+			file:   "testdata/hooks/release_published.json",
+			action: serverconstants.ActionPublished,
+			build:  true,
+		},
+		{
+			name:   "published prerelease",
+			file:   "testdata/hooks/release_prerelease.json",
+			action: serverconstants.ActionPrereleased,
+			build:  true,
+		},
+		{
+			name:   "prereleased",
+			file:   "testdata/hooks/release_prereleased.json",
+			action: serverconstants.ActionPrereleased,
+			build:  false,
+		},
+		{
+			name:   "created",
+			file:   "testdata/hooks/release_created.json",
+			action: "created",
+			build:  false,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// setup router
+			s := httptest.NewServer(http.NotFoundHandler())
+			defer s.Close()
+
+			// setup request
+			body, err := os.Open(test.file)
+			if err != nil {
+				t.Errorf("unable to open file: %v", err)
+			}
+
+			defer body.Close()
+
+			request, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, "/test", body)
+			request.Header.Set("Content-Type", "application/json")
+			request.Header.Set("User-Agent", "GitHub-Hookshot/a22606a")
+			request.Header.Set("X-GitHub-Delivery", "7bd477e4-4415-11e9-9359-0d41fdf9567e")
+			request.Header.Set("X-GitHub-Hook-ID", "123456")
+			request.Header.Set("X-GitHub-Event", "release")
+
+			// setup client
+			client, _ := NewTest(s.URL)
+
+			// run test
+			wantHook := new(library.Hook)
+			wantHook.SetNumber(1)
+			wantHook.SetSourceID("7bd477e4-4415-11e9-9359-0d41fdf9567e")
+			wantHook.SetWebhookID(123456)
+			wantHook.SetCreated(time.Now().UTC().Unix())
+			wantHook.SetHost("github.com")
+			wantHook.SetEvent(serverconstants.EventRelease)
+			wantHook.SetBranch("main")
+			wantHook.SetStatus(constants.StatusSuccess)
+			wantHook.SetLink("https://github.com/Codertocat/Hello-World/settings/hooks")
+
+			want := &types.Webhook{Hook: wantHook}
+
+			if !test.build {
+				wantHook.SetEventAction(test.action)
+			} else {
+				wantHook.SetEventAction(serverconstants.ActionPublished)
+
+				wantRepo := new(library.Repo)
+				wantRepo.SetOrg("Codertocat")
+				wantRepo.SetName("Hello-World")
+				wantRepo.SetFullName("Codertocat/Hello-World")
+				wantRepo.SetLink("https://github.com/Codertocat/Hello-World")
+				wantRepo.SetClone("https://github.com/Codertocat/Hello-World.git")
+				wantRepo.SetBranch("main")
+				wantRepo.SetPrivate(false)
+				wantRepo.SetTopics(nil)
+
+				wantBuild := new(library.Build)
+				wantBuild.SetEvent(serverconstants.EventRelease)
+				wantBuild.SetEventAction(test.action)
+				wantBuild.SetClone("https://github.com/Codertocat/Hello-World.git")
+				wantBuild.SetSource("https://github.com/Codertocat/Hello-World/releases/tag/v0.0.1")
+				wantBuild.SetTitle("release received from https://github.com/Codertocat/Hello-World")
+				wantBuild.SetMessage("Hello World v0.0.1")
+				wantBuild.SetSender("Codertocat")
+				wantBuild.SetAuthor("Codertocat")
+				wantBuild.SetEmail("")
+				wantBuild.SetBranch("v0.0.1")
+				wantBuild.SetRef("refs/tags/v0.0.1")
+				wantBuild.SetBaseRef("main")
+
+				want.Repo = wantRepo
+				want.Build = wantBuild
+			}
+
+			got, err := client.ProcessWebhook(context.TODO(), request)
+
+			if err != nil {
+				t.Errorf("ProcessWebhook returned err: %v", err)
+			}
+
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Errorf("ProcessWebhook mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGithub_ProcessWebhook_Review(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name   string
+		file   string
+		action string
+		state  string
+		build  bool
+	}{
+		{
+			name:   "approved",
+			file:   "testdata/hooks/pull_request_review_approved.json",
+			action: serverconstants.ActionSubmitted,
+			state:  serverconstants.ActionApproved,
+			build:  true,
+		},
+		{
+			name:   "commented",
+			file:   "testdata/hooks/pull_request_review_commented.json",
+			action: serverconstants.ActionSubmitted,
+			state:  serverconstants.ActionCommented,
+			build:  true,
+		},
+		{
+			name:   "dismissed",
+			file:   "testdata/hooks/pull_request_review_dismissed.json",
+			action: "dismissed",
+			build:  false,
+		},
+		{
+			name:   "closed pull request",
+			file:   "testdata/hooks/pull_request_review_closed.json",
+			action: serverconstants.ActionSubmitted,
+			build:  false,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// setup router
+			s := httptest.NewServer(http.NotFoundHandler())
+			defer s.Close()
+
+			// setup request
+			body, err := os.Open(test.file)
+			if err != nil {
+				t.Errorf("unable to open file: %v", err)
+			}
+
+			defer body.Close()
+
+			request, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, "/test", body)
+			request.Header.Set("Content-Type", "application/json")
+			request.Header.Set("User-Agent", "GitHub-Hookshot/a22606a")
+			request.Header.Set("X-GitHub-Delivery", "7bd477e4-4415-11e9-9359-0d41fdf9567e")
+			request.Header.Set("X-GitHub-Hook-ID", "123456")
+			request.Header.Set("X-GitHub-Event", "pull_request_review")
+
+			// setup client
+			client, _ := NewTest(s.URL)
+
+			// run test
+			wantHook := new(library.Hook)
+			wantHook.SetNumber(1)
+			wantHook.SetSourceID("7bd477e4-4415-11e9-9359-0d41fdf9567e")
+			wantHook.SetWebhookID(123456)
+			wantHook.SetCreated(time.Now().UTC().Unix())
+			wantHook.SetHost("github.com")
+			wantHook.SetEvent(serverconstants.EventReview)
+			wantHook.SetEventAction(test.action)
+			wantHook.SetBranch("main")
+			wantHook.SetStatus(constants.StatusSuccess)
+			wantHook.SetLink("https://github.com/Codertocat/Hello-World/settings/hooks")
+
+			want := &types.Webhook{Hook: wantHook}
+
+			if test.build {
+				wantRepo := new(library.Repo)
+				wantRepo.SetOrg("Codertocat")
+				wantRepo.SetName("Hello-World")
+				wantRepo.SetFullName("Codertocat/Hello-World")
+				wantRepo.SetLink("https://github.com/Codertocat/Hello-World")
+				wantRepo.SetClone("https://github.com/Codertocat/Hello-World.git")
+				wantRepo.SetBranch("main")
+				wantRepo.SetPrivate(false)
+				wantRepo.SetTopics(nil)
+
+				wantBuild := new(library.Build)
+				wantBuild.SetEvent(serverconstants.EventReview)
+				wantBuild.SetEventAction(test.state)
+				wantBuild.SetClone("https://github.com/Codertocat/Hello-World.git")
+				wantBuild.SetSource("https://github.com/Codertocat/Hello-World/pull/2#pullrequestreview-237895671")
+				wantBuild.SetTitle("pull_request_review received from https://github.com/Codertocat/Hello-World")
+				wantBuild.SetMessage("Update the README with new information.")
+				wantBuild.SetCommit("ec26c3e57ca3a959ca5aad62de7213c562f8c821")
+				wantBuild.SetSender("Octocat")
+				wantBuild.SetAuthor("Codertocat")
+				wantBuild.SetEmail("")
+				wantBuild.SetBranch("main")
+				wantBuild.SetRef("refs/pull/2/head")
+				wantBuild.SetBaseRef("main")
+				wantBuild.SetHeadRef("changes")
+
+				want.PRNumber = 2
+				want.Repo = wantRepo
+				want.Build = wantBuild
+			}
+
+			got, err := client.ProcessWebhook(context.TODO(), request)
+
+			if err != nil {
+				t.Errorf("ProcessWebhook returned err: %v", err)
+			}
+
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Errorf("ProcessWebhook mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGithub_ProcessWebhook_CheckRun_Skip(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name   string
+		file   string
+		checks bool
+	}{
+		{
+			name:   "completed action",
+			file:   "testdata/hooks/check_run_completed.json",
+			checks: true,
+		},
+		{
+			name:   "checks disabled",
+			file:   "testdata/hooks/check_run_rerequested.json",
+			checks: false,
+		},
+	}
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Errorf("unable to generate private key: %v", err)
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// setup router
+			s := httptest.NewServer(http.NotFoundHandler())
+			defer s.Close()
+
+			// setup request
+			body, err := os.Open(test.file)
+			if err != nil {
+				t.Errorf("unable to open file: %v", err)
+			}
+
+			defer body.Close()
+
+			request, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, "/test", body)
+			request.Header.Set("Content-Type", "application/json")
+			request.Header.Set("User-Agent", "GitHub-Hookshot/a22606a")
+			request.Header.Set("X-GitHub-Delivery", "7bd477e4-4415-11e9-9359-0d41fdf9567e")
+			request.Header.Set("X-GitHub-Hook-ID", "123456")
+			request.Header.Set("X-GitHub-Event", "check_run")
+
+			// setup client
+			client, _ := NewTest(s.URL)
+			client.config.AppID = 1
+			client.config.AppPrivateKey = key
+			client.config.Checks = test.checks
+
+			got, err := client.ProcessWebhook(context.TODO(), request)
+
+			if err != nil {
+				t.Errorf("ProcessWebhook returned err: %v", err)
+			}
+
+			if got.Build != nil {
+				t.Errorf("ProcessWebhook build is %v, want nil", got.Build)
+			}
+		})
+	}
+}
+
+func TestGithub_VerifyWebhook_App(t *testing.T) {
+	// setup router
+	s := httptest.NewServer(http.NotFoundHandler())
+	defer s.Close()
+
+	r := new(library.Repo)
+	r.SetOrg("Codertocat")
+	r.SetName("Hello-World")
+	r.SetFullName("Codertocat/Hello-World")
+	r.SetHash("foo")
+
+	// setup request
+	payload, err := os.ReadFile("testdata/hooks/check_run_rerequested.json")
+	if err != nil {
+		t.Errorf("unable to read file: %v", err)
+	}
+
+	mac := hmac.New(sha256.New, []byte("bar"))
+	mac.Write(payload)
+
+	request, _ := http.NewRequestWithContext(context.Background(), http.MethodPost, "/test", bytes.NewReader(payload))
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("X-GitHub-Delivery", "7bd477e4-4415-11e9-9359-0d41fdf9567e")
+	request.Header.Set("X-GitHub-Hook-ID", "123456")
+	request.Header.Set("X-GitHub-Event", "check_run")
+	request.Header.Set("X-GitHub-Hook-Installation-Target-Type", "integration")
+	request.Header.Set("X-Hub-Signature-256", "sha256="+hex.EncodeToString(mac.Sum(nil)))
+
+	// setup client
+	client, _ := NewTest(s.URL)
+	client.config.AppWebhookSecret = "bar"
+
+	// run test
+	err = client.VerifyWebhook(context.TODO(), request, r)
+	if err != nil {
+		t.Errorf("VerifyWebhook returned err: %v", err)
+	}
+}
@@ -0,0 +1,275 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/go-vela/types/library"
+	"github.com/xanzy/go-gitlab"
+)
+
+// OrgAccess captures the user's access level for an org.
+func (c *client) OrgAccess(ctx context.Context, u *library.User, org string) (string, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  org,
+		"user": u.GetName(),
+	}).Tracef("capturing %s access level to org %s", u.GetName(), org)
+
+	// check if user is accessing personal org
+	if strings.EqualFold(org, u.GetName()) {
+		c.Logger.WithFields(logrus.Fields{
+			"org":  org,
+			"user": u.GetName(),
+		}).Debugf("skipping access level check for user %s with org %s", u.GetName(), org)
+
+		//nolint:goconst // ignore making constant
+		return "admin", nil
+	}
+
+	// create GitLab OAuth client with user's token
+	client := c.newClientToken(u.GetToken())
+
+	// send API call to capture the user's ID
+	id, err := c.userID(ctx, client, u.GetName())
+	if err != nil {
+		return "", err
+	}
+
+	// send API call to capture org access level for user
+	member, _, err := c.groupMember(ctx, client, org, id)
+	if err != nil {
+		return "", err
+	}
+
+	// return their access level if they are an active user
+	if member.State == "active" {
+		return toOrgPermission(member.AccessLevel), nil
+	}
+
+	return "", nil
+}
+
+// RepoAccess captures the user's access level for a repo.
+func (c *client) RepoAccess(ctx context.Context, u *library.User, token, org, repo string) (string, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  org,
+		"repo": repo,
+		"user": u.GetName(),
+	}).Tracef("capturing %s access level to repo %s/%s", u.GetName(), org, repo)
+
+	// check if user is accessing repo in personal org
+	if strings.EqualFold(org, u.GetName()) {
+		c.Logger.WithFields(logrus.Fields{
+			"org":  org,
+			"repo": repo,
+			"user": u.GetName(),
+		}).Debugf("skipping access level check for user %s with repo %s/%s", u.GetName(), org, repo)
+
+		return "admin", nil
+	}
+
+	// create gitlab oauth client with the given token
+	client := c.newClientToken(token)
+
+	// send API call to capture the user's ID
+	id, err := c.userID(ctx, client, u.GetName())
+	if err != nil {
+		return "", err
+	}
+
+	// send API call to capture repo access level for user
+	//
+	// this includes access inherited from any parent groups
+	member, resp, err := client.ProjectMembers.GetInheritedProjectMember(projectID(org, repo), id, gitlab.WithContext(ctx))
+	if err != nil {
+		// a 404 is returned when the user is not a member of the repo
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return "none", nil
+		}
+
+		return "", err
+	}
+
+	return toRepoPermission(member.AccessLevel), nil
+}
+
+// RepoInstalled checks if the scm acts on a repo
+// with an app installation instead of a user's token.
+func (c *client) RepoInstalled(ctx context.Context, org, repo string) bool {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  org,
+		"repo": repo,
+	}).Tracef("checking app installation for repo %s/%s", org, repo)
+
+	return false
+}
+
+// TeamAccess captures the user's access level for a team.
+//
+// GitLab has no concept of teams so subgroups of the org are used instead.
+func (c *client) TeamAccess(ctx context.Context, u *library.User, org, team string) (string, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  org,
+		"team": team,
+		"user": u.GetName(),
+	}).Tracef("capturing %s access level to team %s/%s", u.GetName(), org, team)
+
+	// check if user is accessing team in personal org
+	if strings.EqualFold(org, u.GetName()) {
+		c.Logger.WithFields(logrus.Fields{
+			"org":  org,
+			"team": team,
+			"user": u.GetName(),
+		}).Debugf("skipping access level check for user %s with team %s/%s", u.GetName(), org, team)
+
+		return "admin", nil
+	}
+
+	// create GitLab OAuth client with user's token
+	client := c.newClientToken(u.GetToken())
+
+	// send API call to capture the user's ID
+	id, err := c.userID(ctx, client, u.GetName())
+	if err != nil {
+		return "", err
+	}
+
+	// send API call to capture the subgroup membership for the user
+	member, resp, err := c.groupMember(ctx, client, fmt.Sprintf("%s/%s", org, team), id)
+	if err != nil {
+		// a 404 is returned when the user is not a member of the subgroup
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return "", nil
+		}
+
+		return "", err
+	}
+
+	// return admin access if the user is an active part of that subgroup
+	if member.State == "active" {
+		return "admin", nil
+	}
+
+	return "", nil
+}
+
+// ListUsersTeamsForOrg captures the user's teams for an org.
+//
+// GitLab has no concept of teams so subgroups of the org are used instead.
+func (c *client) ListUsersTeamsForOrg(ctx context.Context, u *library.User, org string) ([]string, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  org,
+		"user": u.GetName(),
+	}).Tracef("capturing %s team membership for org %s", u.GetName(), org)
+
+	// create GitLab OAuth client with user's token
+	client := c.newClientToken(u.GetToken())
+	groups := []*gitlab.Group{}
+
+	// set the max per page for the options to capture the list of subgroups
+	opts := &gitlab.ListSubGroupsOptions{
+		ListOptions:    gitlab.ListOptions{PerPage: 100}, // 100 is max
+		MinAccessLevel: gitlab.AccessLevel(gitlab.GuestPermissions),
+	}
+
+	for {
+		// send API call to list all subgroups for the org the user is a member of
+		subgroups, resp, err := client.Groups.ListSubGroups(org, opts, gitlab.WithContext(ctx))
+		if err != nil {
+			return []string{""}, err
+		}
+
+		groups = append(groups, subgroups...)
+
+		// break the loop if there is no more results to page through
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opts.Page = resp.NextPage
+	}
+
+	var userTeams []string
+
+	// iterate through each element in the subgroups
+	for _, g := range groups {
+		userTeams = append(userTeams, g.Path)
+	}
+
+	return userTeams, nil
+}
+
+// userID is a helper function to capture the
+// GitLab ID for the user with the provided name.
+func (c *client) userID(ctx context.Context, client *gitlab.Client, name string) (int, error) {
+	// send API call to capture the users matching the name
+	users, _, err := client.Users.ListUsers(&gitlab.ListUsersOptions{Username: gitlab.String(name)}, gitlab.WithContext(ctx))
+	if err != nil {
+		return 0, err
+	}
+
+	// iterate through each user to find the exact match
+	for _, user := range users {
+		if strings.EqualFold(user.Username, name) {
+			return user.ID, nil
+		}
+	}
+
+	return 0, fmt.Errorf("unable to find GitLab user %s", name)
+}
+
+// groupMember is a helper function to capture the membership for a user
+// in a group including any access inherited from parent groups.
+//
+// https://docs.gitlab.com/ee/api/members.html#get-a-member-of-a-group-or-project-including-inherited-and-invited-members
+func (c *client) groupMember(ctx context.Context, client *gitlab.Client, group string, user int) (*gitlab.GroupMember, *gitlab.Response, error) {
+	path := fmt.Sprintf("groups/%s/members/all/%d", gitlab.PathEscape(group), user)
+
+	req, err := client.NewRequest(http.MethodGet, path, nil, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	member := new(gitlab.GroupMember)
+
+	resp, err := client.Do(req, member)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return member, resp, nil
+}
+
+// toOrgPermission is a helper function to convert a
+// GitLab access level to a Vela org permission.
+func toOrgPermission(level gitlab.AccessLevelValue) string {
+	switch {
+	case level >= gitlab.MaintainerPermissions:
+		return "admin"
+	case level > gitlab.NoPermissions:
+		return "member"
+	default:
+		return ""
+	}
+}
+
+// toRepoPermission is a helper function to convert a
+// GitLab access level to a Vela repo permission.
+func toRepoPermission(level gitlab.AccessLevelValue) string {
+	switch {
+	case level >= gitlab.MaintainerPermissions:
+		return "admin"
+	case level >= gitlab.DeveloperPermissions:
+		return "write"
+	case level >= gitlab.GuestPermissions:
+		return "read"
+	default:
+		return "none"
+	}
+}
@@ -0,0 +1,446 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package gitlab
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/go-vela/types/library"
+)
+
+func TestGitlab_OrgAccess_Admin(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/api/v4/users", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusOK)
+		c.File("testdata/users.json")
+	})
+	engine.GET("/api/v4/groups/:group/members/all/:id", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusOK)
+		c.File("testdata/member_maintainer.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	want := "admin"
+
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got, err := client.OrgAccess(context.TODO(), u, "github")
+
+	if err != nil {
+		t.Errorf("OrgAccess returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("OrgAccess is %v, want %v", got, want)
+	}
+}
+
+func TestGitlab_OrgAccess_Member(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/api/v4/users", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusOK)
+		c.File("testdata/users.json")
+	})
+	engine.GET("/api/v4/groups/:group/members/all/:id", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusOK)
+		c.File("testdata/member_developer.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	want := "member"
+
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got, err := client.OrgAccess(context.TODO(), u, "github")
+
+	if err != nil {
+		t.Errorf("OrgAccess returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("OrgAccess is %v, want %v", got, want)
+	}
+}
+
+func TestGitlab_OrgAccess_Blocked(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/api/v4/users", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusOK)
+		c.File("testdata/users.json")
+	})
+	engine.GET("/api/v4/groups/:group/members/all/:id", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusOK)
+		c.File("testdata/member_blocked.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	want := ""
+
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got, err := client.OrgAccess(context.TODO(), u, "github")
+
+	if err != nil {
+		t.Errorf("OrgAccess returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("OrgAccess is %v, want %v", got, want)
+	}
+}
+
+func TestGitlab_OrgAccess_Personal(t *testing.T) {
+	// setup types
+	want := "admin"
+
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	client, _ := NewTest("https://gitlab.example.com")
+
+	// run test
+	got, err := client.OrgAccess(context.TODO(), u, "foo")
+
+	if err != nil {
+		t.Errorf("OrgAccess returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("OrgAccess is %v, want %v", got, want)
+	}
+}
+
+func TestGitlab_RepoAccess_Admin(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	engine.UseRawPath = true
+
+	// setup mock server
+	engine.GET("/api/v4/users", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusOK)
+		c.File("testdata/users.json")
+	})
+	engine.GET("/api/v4/projects/:project/members/all/:id", func(c *gin.Context) {
+		if c.Param("project") != "github/octocat" {
+			c.AbortWithStatus(http.StatusNotFound)
+			return
+		}
+
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusOK)
+		c.File("testdata/member_maintainer.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	want := "admin"
+
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got, err := client.RepoAccess(context.TODO(), u, u.GetToken(), "github", "octocat")
+
+	if err != nil {
+		t.Errorf("RepoAccess returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("RepoAccess is %v, want %v", got, want)
+	}
+}
+
+func TestGitlab_RepoAccess_Write(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	engine.UseRawPath = true
+
+	// setup mock server
+	engine.GET("/api/v4/users", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusOK)
+		c.File("testdata/users.json")
+	})
+	engine.GET("/api/v4/projects/:project/members/all/:id", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusOK)
+		c.File("testdata/member_developer.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	want := "write"
+
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got, err := client.RepoAccess(context.TODO(), u, u.GetToken(), "github", "octocat")
+
+	if err != nil {
+		t.Errorf("RepoAccess returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("RepoAccess is %v, want %v", got, want)
+	}
+}
+
+func TestGitlab_RepoAccess_NotMember(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	engine.UseRawPath = true
+
+	// setup mock server
+	engine.GET("/api/v4/users", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusOK)
+		c.File("testdata/users.json")
+	})
+	engine.GET("/api/v4/projects/:project/members/all/:id", func(c *gin.Context) {
+		c.JSON(http.StatusNotFound, gin.H{"message": "404 Not found"})
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	want := "none"
+
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got, err := client.RepoAccess(context.TODO(), u, u.GetToken(), "github", "octocat")
+
+	if err != nil {
+		t.Errorf("RepoAccess returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("RepoAccess is %v, want %v", got, want)
+	}
+}
+
+func TestGitlab_RepoInstalled(t *testing.T) {
+	client, _ := NewTest("https://gitlab.example.com")
+
+	// run test
+	got := client.RepoInstalled(context.TODO(), "foo", "bar")
+
+	if got {
+		t.Errorf("RepoInstalled is %v, want false", got)
+	}
+}
+
+func TestGitlab_TeamAccess_Admin(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	engine.UseRawPath = true
+
+	// setup mock server
+	engine.GET("/api/v4/users", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusOK)
+		c.File("testdata/users.json")
+	})
+	engine.GET("/api/v4/groups/:group/members/all/:id", func(c *gin.Context) {
+		if c.Param("group") != "github/octokitties" {
+			c.AbortWithStatus(http.StatusNotFound)
+			return
+		}
+
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusOK)
+		c.File("testdata/member_developer.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	want := "admin"
+
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got, err := client.TeamAccess(context.TODO(), u, "github", "octokitties")
+
+	if err != nil {
+		t.Errorf("TeamAccess returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("TeamAccess is %v, want %v", got, want)
+	}
+}
+
+func TestGitlab_TeamAccess_NoAccess(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	engine.UseRawPath = true
+
+	// setup mock server
+	engine.GET("/api/v4/users", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusOK)
+		c.File("testdata/users.json")
+	})
+	engine.GET("/api/v4/groups/:group/members/all/:id", func(c *gin.Context) {
+		c.JSON(http.StatusNotFound, gin.H{"message": "404 Not found"})
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	want := ""
+
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got, err := client.TeamAccess(context.TODO(), u, "github", "baz")
+
+	if err != nil {
+		t.Errorf("TeamAccess returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("TeamAccess is %v, want %v", got, want)
+	}
+}
+
+func TestGitlab_ListUsersTeamsForOrg(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/api/v4/groups/:group/subgroups", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusOK)
+		c.File("testdata/subgroups.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	want := []string{"justice-league", "avengers"}
+
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got, err := client.ListUsersTeamsForOrg(context.TODO(), u, "github")
+
+	if err != nil {
+		t.Errorf("ListUsersTeamsForOrg returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ListUsersTeamsForOrg is %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,183 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/go-vela/server/random"
+	"github.com/go-vela/types/library"
+	"github.com/xanzy/go-gitlab"
+)
+
+// tokenInfo represents the response from the
+// GitLab OAuth token information endpoint.
+//
+// https://docs.gitlab.com/ee/api/oauth2.html#retrieve-the-token-information
+type tokenInfo struct {
+	Application struct {
+		UID string `json:"uid"`
+	} `json:"application"`
+}
+
+// Authorize uses the given access token to authorize the user.
+func (c *client) Authorize(ctx context.Context, token string) (string, error) {
+	c.Logger.Trace("authorizing user with token")
+
+	// create GitLab OAuth client with user's token
+	client := c.newClientToken(token)
+
+	// send API call to capture the current user making the call
+	u, _, err := client.Users.CurrentUser(gitlab.WithContext(ctx))
+	if err != nil {
+		return "", err
+	}
+
+	return u.Username, nil
+}
+
+// Login begins the authentication workflow for the session.
+func (c *client) Login(ctx context.Context, w http.ResponseWriter, r *http.Request) (string, error) {
+	c.Logger.Trace("processing login request")
+
+	// generate a random string for creating the OAuth state
+	oAuthState, err := random.GenerateRandomString(32)
+	if err != nil {
+		return "", err
+	}
+
+	// embed the scm provider name so the server
+	// can route the OAuth callback to the provider
+	if len(c.config.Name) > 0 {
+		oAuthState = fmt.Sprintf("%s.%s", c.config.Name, oAuthState)
+	}
+
+	// pass through the redirect if it exists
+	redirect := r.FormValue("redirect_uri")
+	if len(redirect) > 0 {
+		c.OAuth.RedirectURL = redirect
+	}
+
+	// temporarily redirect request to GitLab to begin workflow
+	http.Redirect(w, r, c.OAuth.AuthCodeURL(oAuthState), http.StatusTemporaryRedirect)
+
+	return oAuthState, nil
+}
+
+// Authenticate completes the authentication workflow for the session
+// and returns the remote user details.
+func (c *client) Authenticate(ctx context.Context, w http.ResponseWriter, r *http.Request, oAuthState string) (*library.User, error) {
+	c.Logger.Trace("authenticating user")
+
+	// get the OAuth code
+	code := r.FormValue("code")
+	if len(code) == 0 {
+		return nil, nil
+	}
+
+	// verify the OAuth state
+	state := r.FormValue("state")
+	if state != oAuthState {
+		return nil, fmt.Errorf("unexpected oauth state: want %s but got %s", oAuthState, state)
+	}
+
+	// pass through the redirect if it exists
+	redirect := r.FormValue("redirect_uri")
+	if len(redirect) > 0 {
+		c.OAuth.RedirectURL = redirect
+	}
+
+	// exchange OAuth code for token
+	token, err := c.OAuth.Exchange(context.Background(), code)
+	if err != nil {
+		return nil, err
+	}
+
+	// authorize the user for the token
+	u, err := c.Authorize(ctx, token.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return &library.User{
+		Name:  &u,
+		Token: &token.AccessToken,
+	}, nil
+}
+
+// AuthenticateToken completes the authentication workflow
+// for the session and returns the remote user details.
+func (c *client) AuthenticateToken(ctx context.Context, r *http.Request) (*library.User, error) {
+	c.Logger.Trace("authenticating user via token")
+
+	token := r.Header.Get("Token")
+	if len(token) == 0 {
+		return nil, errors.New("no token provided")
+	}
+
+	// validate that the token was not created by vela
+	ok, err := c.ValidateOAuthToken(ctx, token)
+	if err != nil {
+		return nil, fmt.Errorf("unable to validate oauth token: %w", err)
+	}
+
+	if ok {
+		return nil, errors.New("token must not be created by vela")
+	}
+
+	u, err := c.Authorize(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	return &library.User{
+		Name:  &u,
+		Token: &token,
+	}, nil
+}
+
+// ValidateOAuthToken takes a user oauth integration token and
+// validates that it was created by the Vela OAuth application.
+// In essence, the function expects either a 200 or 401 from the
+// GitLab token information endpoint and returns error in any
+// other failure case.
+func (c *client) ValidateOAuthToken(ctx context.Context, token string) (bool, error) {
+	// create the request to capture the token information
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/oauth/token/info", c.config.Address), nil)
+	if err != nil {
+		return false, err
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+
+	// send the request to capture the token information
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return false, err
+	}
+
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		break
+	// 401 is expected when a personal access token is used
+	case http.StatusUnauthorized:
+		return false, nil
+	default:
+		return false, fmt.Errorf("unexpected status code %d validating oauth token", resp.StatusCode)
+	}
+
+	info := new(tokenInfo)
+
+	err = json.NewDecoder(resp.Body).Decode(info)
+	if err != nil {
+		return false, err
+	}
+
+	return info.Application.UID == c.config.ClientID, nil
+}
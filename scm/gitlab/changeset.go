@@ -0,0 +1,115 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package gitlab
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/go-vela/types/library"
+	"github.com/xanzy/go-gitlab"
+)
+
+// Changeset captures the list of files changed for a commit.
+func (c *client) Changeset(ctx context.Context, u *library.User, r *library.Repo, sha string) ([]string, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("capturing commit changeset for %s/commit/%s", r.GetFullName(), sha)
+
+	// create GitLab OAuth client with user's token
+	client := c.newClientToken(u.GetToken())
+	s := []string{}
+	d := []*gitlab.Diff{}
+
+	// set the max per page for the options to capture the commit diff
+	opts := &gitlab.GetCommitDiffOptions{PerPage: 100} // 100 is max
+
+	for {
+		// send API call to capture the diff for the commit
+		diffs, resp, err := client.Commits.GetCommitDiff(projectID(r.GetOrg(), r.GetName()), sha, opts, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, fmt.Errorf("Commits.GetCommitDiff returned error: %w", err)
+		}
+
+		d = append(d, diffs...)
+
+		// break the loop if there is no more results to page through
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opts.Page = resp.NextPage
+	}
+
+	// iterate through each file in the commit
+	for _, diff := range d {
+		s = append(s, diff.NewPath)
+	}
+
+	return s, nil
+}
+
+// ChangesetPR captures the list of files changed for a pull request.
+func (c *client) ChangesetPR(ctx context.Context, u *library.User, r *library.Repo, number int) ([]string, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("capturing merge request changeset for %s/-/merge_requests/%d", r.GetFullName(), number)
+
+	// create GitLab OAuth client with user's token
+	client := c.newClientToken(u.GetToken())
+	s := []string{}
+	d := []*gitlab.MergeRequestDiff{}
+
+	// set the max per page for the options to capture the merge request diffs
+	opts := &gitlab.ListMergeRequestDiffsOptions{PerPage: 100} // 100 is max
+
+	for {
+		// send API call to capture the files from the merge request
+		diffs, resp, err := client.MergeRequests.ListMergeRequestDiffs(projectID(r.GetOrg(), r.GetName()), number, opts, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, fmt.Errorf("MergeRequests.ListMergeRequestDiffs returned error: %w", err)
+		}
+
+		d = append(d, diffs...)
+
+		// break the loop if there is no more results to page through
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opts.Page = resp.NextPage
+	}
+
+	// iterate through each file in the merge request
+	for _, diff := range d {
+		s = append(s, diff.NewPath)
+	}
+
+	return s, nil
+}
+
+// CommitMessage captures the message for a commit.
+func (c *client) CommitMessage(ctx context.Context, u *library.User, r *library.Repo, sha string) (string, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("capturing commit message for %s/commit/%s", r.GetFullName(), sha)
+
+	// create GitLab OAuth client with user's token
+	client := c.newClientToken(u.GetToken())
+
+	// send API call to capture the commit
+	commit, _, err := client.Commits.GetCommit(projectID(r.GetOrg(), r.GetName()), sha, gitlab.WithContext(ctx))
+	if err != nil {
+		return "", fmt.Errorf("Commits.GetCommit returned error: %w", err)
+	}
+
+	return commit.Message, nil
+}
@@ -0,0 +1,147 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package gitlab
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/go-vela/types/library"
+)
+
+func TestGitlab_Changeset(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	engine.UseRawPath = true
+
+	// setup mock server
+	engine.GET("/api/v4/projects/:project/repository/commits/:sha/diff", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusOK)
+		c.File("testdata/commit_diff.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	r := new(library.Repo)
+	r.SetOrg("repos")
+	r.SetName("octocat")
+
+	want := []string{"README.md"}
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got, err := client.Changeset(context.TODO(), u, r, "6dcb09b5b57875f334f61aebed695e2e4193db5e")
+
+	if err != nil {
+		t.Errorf("Changeset returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Changeset is %v, want %v", got, want)
+	}
+}
+
+func TestGitlab_ChangesetPR(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	engine.UseRawPath = true
+
+	// setup mock server
+	engine.GET("/api/v4/projects/:project/merge_requests/:number/diffs", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusOK)
+		c.File("testdata/merge_request_diffs.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	r := new(library.Repo)
+	r.SetOrg("repos")
+	r.SetName("octocat")
+
+	want := []string{"README.md"}
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got, err := client.ChangesetPR(context.TODO(), u, r, 1)
+
+	if err != nil {
+		t.Errorf("ChangesetPR returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ChangesetPR is %v, want %v", got, want)
+	}
+}
+
+func TestGitlab_CommitMessage(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	engine.UseRawPath = true
+
+	// setup mock server
+	engine.GET("/api/v4/projects/:project/repository/commits/:sha", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusOK)
+		c.File("testdata/commit.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	r := new(library.Repo)
+	r.SetOrg("repos")
+	r.SetName("octocat")
+
+	want := "Update README.md"
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got, err := client.CommitMessage(context.TODO(), u, r, "6dcb09b5b57875f334f61aebed695e2e4193db5e")
+
+	if err != nil {
+		t.Errorf("CommitMessage returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("CommitMessage is %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,201 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/go-vela/types/library"
+	"github.com/xanzy/go-gitlab"
+)
+
+// GetDeployment gets a deployment from the GitLab repo.
+func (c *client) GetDeployment(ctx context.Context, u *library.User, r *library.Repo, id int64) (*library.Deployment, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("capturing deployment %d for repo %s", id, r.GetFullName())
+
+	// create GitLab OAuth client with user's token
+	client := c.newClientToken(u.GetToken())
+
+	// send API call to capture the deployment
+	deployment, _, err := client.Deployments.GetProjectDeployment(projectID(r.GetOrg(), r.GetName()), int(id), gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return c.toLibraryDeployment(r, deployment), nil
+}
+
+// GetDeploymentCount counts a list of deployments from the GitLab repo.
+func (c *client) GetDeploymentCount(ctx context.Context, u *library.User, r *library.Repo) (int64, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("counting deployments for repo %s", r.GetFullName())
+
+	// create GitLab OAuth client with user's token
+	client := c.newClientToken(u.GetToken())
+	// create variable to track the deployments
+	deployments := []*gitlab.Deployment{}
+
+	// set pagination options for listing deployments
+	opts := &gitlab.ListProjectDeploymentsOptions{
+		// set the max per page for the options
+		// to capture the list of deployments
+		ListOptions: gitlab.ListOptions{
+			PerPage: 100, // 100 is max
+		},
+	}
+
+	for {
+		// send API call to capture the list of deployments
+		d, resp, err := client.Deployments.ListProjectDeployments(projectID(r.GetOrg(), r.GetName()), opts, gitlab.WithContext(ctx))
+		if err != nil {
+			return 0, err
+		}
+
+		deployments = append(deployments, d...)
+
+		// break the loop if there is no more results to page through
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opts.Page = resp.NextPage
+	}
+
+	return int64(len(deployments)), nil
+}
+
+// GetDeploymentList gets a list of deployments from the GitLab repo.
+func (c *client) GetDeploymentList(ctx context.Context, u *library.User, r *library.Repo, page, perPage int) ([]*library.Deployment, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("listing deployments for repo %s", r.GetFullName())
+
+	// create GitLab OAuth client with user's token
+	client := c.newClientToken(u.GetToken())
+
+	// set pagination options for listing deployments
+	opts := &gitlab.ListProjectDeploymentsOptions{
+		ListOptions: gitlab.ListOptions{
+			Page:    page,
+			PerPage: perPage,
+		},
+		OrderBy: gitlab.String("id"),
+		Sort:    gitlab.String("desc"),
+	}
+
+	// send API call to capture the list of deployments
+	d, _, err := client.Deployments.ListProjectDeployments(projectID(r.GetOrg(), r.GetName()), opts, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	// variable we want to return
+	deployments := []*library.Deployment{}
+
+	// iterate through all API results
+	for _, deployment := range d {
+		// convert query result to library type
+		deployments = append(deployments, c.toLibraryDeployment(r, deployment))
+	}
+
+	return deployments, nil
+}
+
+// CreateDeployment creates a new deployment for the GitLab repo.
+//
+// GitLab deployments do not support a task, description or
+// payload so those fields are ignored when creating them.
+func (c *client) CreateDeployment(ctx context.Context, u *library.User, r *library.Repo, d *library.Deployment) error {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("creating deployment for repo %s", r.GetFullName())
+
+	// create GitLab OAuth client with user's token
+	client := c.newClientToken(u.GetToken())
+
+	// GitLab requires the commit for a deployment
+	// so we resolve the provided reference first
+	commit, _, err := client.Commits.GetCommit(projectID(r.GetOrg(), r.GetName()), d.GetRef(), gitlab.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	// GitLab expects the short name of the branch or tag
+	ref := strings.TrimPrefix(d.GetRef(), "refs/heads/")
+	tag := strings.HasPrefix(ref, "refs/tags/")
+	ref = strings.TrimPrefix(ref, "refs/tags/")
+
+	// create the deployment object to make the API call
+	//
+	// a running deployment triggers the deployment webhook
+	deployment := &gitlab.CreateProjectDeploymentOptions{
+		Environment: gitlab.String(d.GetTarget()),
+		Ref:         gitlab.String(ref),
+		SHA:         gitlab.String(commit.ID),
+		Tag:         gitlab.Bool(tag),
+		Status:      gitlab.DeploymentStatus(gitlab.DeploymentStatusRunning),
+	}
+
+	// send API call to create the deployment
+	deploy, _, err := client.Deployments.CreateProjectDeployment(projectID(r.GetOrg(), r.GetName()), deployment, gitlab.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	d.SetID(int64(deploy.ID))
+	d.SetRepoID(r.GetID())
+	d.SetURL(c.deploymentURL(r.GetOrg(), r.GetName(), deploy.ID))
+	d.SetCommit(deploy.SHA)
+	d.SetRef(deploy.Ref)
+
+	if deploy.User != nil {
+		d.SetUser(deploy.User.Username)
+	}
+
+	if deploy.Environment != nil {
+		d.SetTarget(deploy.Environment.Name)
+	}
+
+	return nil
+}
+
+// deploymentURL is a helper function to create the
+// API address for a deployment in the GitLab repo.
+func (c *client) deploymentURL(org, repo string, id int) string {
+	return fmt.Sprintf("%sprojects/%s/deployments/%d", c.config.API, gitlab.PathEscape(projectID(org, repo)), id)
+}
+
+// toLibraryDeployment does a partial conversion of a gitlab deployment to a library deployment.
+func (c *client) toLibraryDeployment(r *library.Repo, d *gitlab.Deployment) *library.Deployment {
+	deployment := new(library.Deployment)
+	deployment.SetID(int64(d.ID))
+	deployment.SetRepoID(r.GetID())
+	deployment.SetURL(c.deploymentURL(r.GetOrg(), r.GetName(), d.ID))
+	deployment.SetCommit(d.SHA)
+	deployment.SetRef(d.Ref)
+
+	if d.User != nil {
+		deployment.SetUser(d.User.Username)
+	}
+
+	if d.Environment != nil {
+		deployment.SetTarget(d.Environment.Name)
+	}
+
+	return deployment
+}
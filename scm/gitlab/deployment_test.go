@@ -0,0 +1,240 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/go-vela/types/library"
+)
+
+func TestGitlab_GetDeployment(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	engine.UseRawPath = true
+
+	// setup mock server
+	engine.GET("/api/v4/projects/:project/deployments/:deployment", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusOK)
+		c.File("testdata/deployment.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	r := new(library.Repo)
+	r.SetID(1)
+	r.SetOrg("foo")
+	r.SetName("bar")
+	r.SetFullName("foo/bar")
+
+	want := new(library.Deployment)
+	want.SetID(1)
+	want.SetRepoID(1)
+	want.SetURL(fmt.Sprintf("%s/api/v4/projects/foo%%2Fbar/deployments/1", s.URL))
+	want.SetUser("octocat")
+	want.SetCommit("a76aded1ad1c5a5d5a8b8e9b7b5e3d1c7f2a4b6c")
+	want.SetRef("main")
+	want.SetTarget("production")
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got, err := client.GetDeployment(context.TODO(), u, r, 1)
+
+	if err != nil {
+		t.Errorf("GetDeployment returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("GetDeployment is %v, want %v", got, want)
+	}
+}
+
+func TestGitlab_GetDeploymentCount(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	engine.UseRawPath = true
+
+	// setup mock server
+	engine.GET("/api/v4/projects/:project/deployments", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusOK)
+		c.File("testdata/deployments.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	r := new(library.Repo)
+	r.SetOrg("foo")
+	r.SetName("bar")
+	r.SetFullName("foo/bar")
+
+	want := int64(2)
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got, err := client.GetDeploymentCount(context.TODO(), u, r)
+
+	if err != nil {
+		t.Errorf("GetDeploymentCount returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("GetDeploymentCount is %v, want %v", got, want)
+	}
+}
+
+func TestGitlab_GetDeploymentList(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	engine.UseRawPath = true
+
+	// setup mock server
+	engine.GET("/api/v4/projects/:project/deployments", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusOK)
+		c.File("testdata/deployments.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	r := new(library.Repo)
+	r.SetID(1)
+	r.SetOrg("foo")
+	r.SetName("bar")
+	r.SetFullName("foo/bar")
+
+	want2 := new(library.Deployment)
+	want2.SetID(2)
+	want2.SetRepoID(1)
+	want2.SetURL(fmt.Sprintf("%s/api/v4/projects/foo%%2Fbar/deployments/2", s.URL))
+	want2.SetUser("octocat")
+	want2.SetCommit("a76aded1ad1c5a5d5a8b8e9b7b5e3d1c7f2a4b6c")
+	want2.SetRef("main")
+	want2.SetTarget("production")
+
+	want1 := new(library.Deployment)
+	want1.SetID(1)
+	want1.SetRepoID(1)
+	want1.SetURL(fmt.Sprintf("%s/api/v4/projects/foo%%2Fbar/deployments/1", s.URL))
+	want1.SetUser("octocat")
+	want1.SetCommit("a76aded1ad1c5a5d5a8b8e9b7b5e3d1c7f2a4b6c")
+	want1.SetRef("main")
+	want1.SetTarget("production")
+
+	want := []*library.Deployment{want2, want1}
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got, err := client.GetDeploymentList(context.TODO(), u, r, 1, 100)
+
+	if err != nil {
+		t.Errorf("GetDeploymentList returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("GetDeploymentList is %v, want %v", got, want)
+	}
+}
+
+func TestGitlab_CreateDeployment(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	engine.UseRawPath = true
+
+	// setup mock server
+	engine.GET("/api/v4/projects/:project/repository/commits/:sha", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusOK)
+		c.File("testdata/commit.json")
+	})
+	engine.POST("/api/v4/projects/:project/deployments", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusCreated)
+		c.File("testdata/deployment.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	r := new(library.Repo)
+	r.SetID(1)
+	r.SetOrg("foo")
+	r.SetName("bar")
+	r.SetFullName("foo/bar")
+
+	d := new(library.Deployment)
+	d.SetRef("refs/heads/main")
+	d.SetTarget("production")
+
+	want := new(library.Deployment)
+	want.SetID(1)
+	want.SetRepoID(1)
+	want.SetURL(fmt.Sprintf("%s/api/v4/projects/foo%%2Fbar/deployments/1", s.URL))
+	want.SetUser("octocat")
+	want.SetCommit("a76aded1ad1c5a5d5a8b8e9b7b5e3d1c7f2a4b6c")
+	want.SetRef("main")
+	want.SetTarget("production")
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	err := client.CreateDeployment(context.TODO(), u, r, d)
+
+	if err != nil {
+		t.Errorf("CreateDeployment returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(d, want) {
+		t.Errorf("CreateDeployment is %v, want %v", d, want)
+	}
+}
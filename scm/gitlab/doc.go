@@ -0,0 +1,10 @@
+// SPDX-License-Identifier: Apache-2.0
+
+// Package gitlab provides the ability for Vela to
+// integrate with GitLab or a self-managed GitLab instance
+// as a scm provider.
+//
+// Usage:
+//
+//	import "github.com/go-vela/server/scm/gitlab"
+package gitlab
@@ -0,0 +1,35 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package gitlab
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/go-vela/types/constants"
+)
+
+func TestGitlab_Driver(t *testing.T) {
+	// setup types
+	want := constants.DriverGitlab
+
+	_service, err := New(
+		WithAddress("https://gitlab.com/"),
+		WithClientID("foo"),
+		WithClientSecret("bar"),
+		WithServerAddress("https://vela-server.example.com"),
+		WithStatusContext("continuous-integration/vela"),
+		WithWebUIAddress("https://vela.example.com"),
+		WithScopes([]string{"api", "read_user"}),
+	)
+	if err != nil {
+		t.Errorf("unable to create scm service: %v", err)
+	}
+
+	// run test
+	got := _service.Driver()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Driver is %v, want %v", got, want)
+	}
+}
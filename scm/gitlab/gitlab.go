@@ -0,0 +1,174 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package gitlab
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"github.com/xanzy/go-gitlab"
+
+	"golang.org/x/oauth2"
+)
+
+const (
+	defaultURL = "https://gitlab.com"         // Default GitLab URL
+	defaultAPI = "https://gitlab.com/api/v4/" // Default GitLab API URL
+
+	// events for repo webhooks.
+	eventInitialize = "initialize"
+
+	// actions for merge request webhooks.
+	actionOpen   = "open"
+	actionReopen = "reopen"
+	actionUpdate = "update"
+
+	// states for merge requests.
+	stateOpened = "opened"
+)
+
+type config struct {
+	// specifies the name of the scm provider for the GitLab client
+	Name string
+	// specifies the address to use for the GitLab client
+	Address string
+	// specifies the API endpoint to use for the GitLab client
+	API string
+	// specifies the OAuth client ID from GitLab to use for the GitLab client
+	ClientID string
+	// specifies the OAuth client secret from GitLab to use for the GitLab client
+	ClientSecret string
+	// specifies the Vela server address to use for the GitLab client
+	ServerAddress string
+	// specifies the Vela server address that the scm provider should use to send Vela webhooks
+	ServerWebhookAddress string
+	// specifies the context for the commit status to use for the GitLab client
+	StatusContext string
+	// specifies the Vela web UI address to use for the GitLab client
+	WebUIAddress string
+	// specifies the OAuth scopes to use for the GitLab client
+	Scopes []string
+}
+
+type client struct {
+	config *config
+	OAuth  *oauth2.Config
+	// https://pkg.go.dev/github.com/sirupsen/logrus#Entry
+	Logger *logrus.Entry
+}
+
+// New returns a SCM implementation that integrates with
+// a GitLab or a self-managed GitLab instance.
+//
+//nolint:revive // ignore returning unexported client
+func New(opts ...ClientOpt) (*client, error) {
+	// create new GitLab client
+	c := new(client)
+
+	// create new fields
+	c.config = new(config)
+	c.OAuth = new(oauth2.Config)
+
+	// create new logger for the client
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#StandardLogger
+	logger := logrus.StandardLogger()
+
+	// create new logger for the client
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#NewEntry
+	c.Logger = logrus.NewEntry(logger).WithField("scm", c.Driver())
+
+	// apply all provided configuration options
+	for _, opt := range opts {
+		err := opt(c)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// create the GitLab OAuth config object
+	c.OAuth = &oauth2.Config{
+		ClientID:     c.config.ClientID,
+		ClientSecret: c.config.ClientSecret,
+		Scopes:       c.config.Scopes,
+		Endpoint: oauth2.Endpoint{
+			AuthURL:  fmt.Sprintf("%s/oauth/authorize", c.config.Address),
+			TokenURL: fmt.Sprintf("%s/oauth/token", c.config.Address),
+		},
+	}
+
+	return c, nil
+}
+
+// NewTest returns a SCM implementation that integrates with the provided
+// mock server. Only the url from the mock server is required.
+//
+// This function is intended for running tests only.
+//
+//nolint:revive // ignore returning unexported client
+func NewTest(urls ...string) (*client, error) {
+	address := urls[0]
+	server := address
+
+	// check if multiple URLs were provided
+	if len(urls) > 1 {
+		server = urls[1]
+	}
+
+	return New(
+		WithAddress(address),
+		WithClientID("foo"),
+		WithClientSecret("bar"),
+		WithServerAddress(server),
+		WithServerWebhookAddress(""),
+		WithStatusContext("continuous-integration/vela"),
+		WithWebUIAddress(address),
+		WithScopes([]string{"api", "read_user"}),
+	)
+}
+
+// helper function to return the GitLab OAuth client.
+func (c *client) newClientToken(token string) *gitlab.Client {
+	// create the GitLab client from the OAuth token
+	//
+	// the error is ignored because the only possible failure
+	// is an invalid base URL which is validated in WithAddress
+	gitlab, _ := gitlab.NewOAuthClient(
+		token,
+		gitlab.WithBaseURL(c.config.API),
+	)
+
+	return gitlab
+}
+
+// helper function to return the GitLab project ID
+// used in API calls for the provided org and repo.
+func projectID(org, repo string) string {
+	return fmt.Sprintf("%s/%s", org, repo)
+}
+
+// helper function to split the full path for a GitLab
+// project into the org (namespace) and repo (path).
+func splitPath(fullPath string) (string, string) {
+	idx := strings.LastIndex(fullPath, "/")
+	if idx < 0 {
+		return "", fullPath
+	}
+
+	return fullPath[:idx], fullPath[idx+1:]
+}
+
+// webhookURL returns the Vela server address that
+// GitLab should use to send repository webhooks.
+//
+// Named scm providers receive webhooks on their own
+// path so the server can route them to the provider.
+func (c *client) webhookURL() string {
+	if len(c.config.Name) > 0 {
+		return fmt.Sprintf("%s/webhook/%s", c.config.ServerWebhookAddress, c.config.Name)
+	}
+
+	return fmt.Sprintf("%s/webhook", c.config.ServerWebhookAddress)
+}
@@ -0,0 +1,113 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package gitlab
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGitlab_New(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		id      string
+	}{
+		{
+			failure: false,
+			id:      "foo",
+		},
+		{
+			failure: true,
+			id:      "",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		_, err := New(
+			WithAddress("https://gitlab.com/"),
+			WithClientID(test.id),
+			WithClientSecret("bar"),
+			WithServerAddress("https://vela-server.example.com"),
+			WithStatusContext("continuous-integration/vela"),
+			WithWebUIAddress("https://vela.example.com"),
+			WithScopes([]string{"api", "read_user"}),
+		)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("New should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("New returned err: %v", err)
+		}
+	}
+}
+
+func TestGitlab_newClientToken(t *testing.T) {
+	// setup router
+	s := httptest.NewServer(http.NotFoundHandler())
+	defer s.Close()
+
+	want := s.URL + "/api/v4/"
+
+	// setup client
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got := client.newClientToken("foobar")
+
+	if got == nil {
+		t.Errorf("newClientToken is nil, want %v", want)
+
+		return
+	}
+
+	if got.BaseURL().String() != want {
+		t.Errorf("newClientToken BaseURL is %v, want %v", got.BaseURL(), want)
+	}
+}
+
+func TestGitlab_splitPath(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		path string
+		org  string
+		repo string
+	}{
+		{
+			path: "octocat/Hello-World",
+			org:  "octocat",
+			repo: "Hello-World",
+		},
+		{
+			path: "octocat/subgroup/Hello-World",
+			org:  "octocat/subgroup",
+			repo: "Hello-World",
+		},
+		{
+			path: "Hello-World",
+			org:  "",
+			repo: "Hello-World",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		org, repo := splitPath(test.path)
+
+		if org != test.org {
+			t.Errorf("splitPath org is %v, want %v", org, test.org)
+		}
+
+		if repo != test.repo {
+			t.Errorf("splitPath repo is %v, want %v", repo, test.repo)
+		}
+	}
+}
@@ -0,0 +1,41 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package gitlab
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/go-vela/types/library"
+	"github.com/xanzy/go-gitlab"
+)
+
+// GetOrgName gets org name from GitLab.
+func (c *client) GetOrgName(ctx context.Context, u *library.User, o string) (string, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  o,
+		"user": u.GetName(),
+	}).Tracef("retrieving org information for %s", o)
+
+	// create GitLab OAuth client with user's token
+	client := c.newClientToken(u.GetToken())
+
+	// send an API call to get the group info
+	group, resp, err := client.Groups.GetGroup(o, nil, gitlab.WithContext(ctx))
+
+	// if group is not found, return the personal org
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		user, _, err := client.Users.CurrentUser(gitlab.WithContext(ctx))
+		if err != nil {
+			return "", err
+		}
+
+		return user.Username, nil
+	} else if err != nil {
+		return "", err
+	}
+
+	return group.FullPath, nil
+}
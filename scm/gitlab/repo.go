@@ -0,0 +1,582 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+	"github.com/xanzy/go-gitlab"
+)
+
+// ConfigBackoff is a wrapper for Config that will retry five times if the function
+// fails to retrieve the yaml/yml file.
+func (c *client) ConfigBackoff(ctx context.Context, u *library.User, r *library.Repo, ref string) (data []byte, err error) {
+	// number of times to retry
+	retryLimit := 5
+
+	for i := 0; i < retryLimit; i++ {
+		logrus.Debugf("Fetching config file - Attempt %d", i+1)
+		// attempt to fetch the config
+		data, err = c.Config(ctx, u, r, ref)
+
+		// return err if the last attempt returns error
+		if err != nil && i == retryLimit-1 {
+			return
+		}
+
+		// if data is valid break the retry loop
+		if data != nil {
+			break
+		}
+
+		// sleep in between retries
+		sleep := time.Duration(i+1) * time.Second
+		time.Sleep(sleep)
+	}
+
+	return
+}
+
+// Config gets the pipeline configuration from the GitLab repo.
+func (c *client) Config(ctx context.Context, u *library.User, r *library.Repo, ref string) ([]byte, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("capturing configuration file for %s/commit/%s", r.GetFullName(), ref)
+
+	// create GitLab OAuth client with user's token
+	client := c.newClientToken(u.GetToken())
+
+	files := []string{".vela.yml", ".vela.yaml"}
+
+	if strings.EqualFold(r.GetPipelineType(), constants.PipelineTypeStarlark) {
+		files = append(files, ".vela.star", ".vela.py")
+	}
+
+	// set the reference for the options to capture the pipeline configuration
+	opts := &gitlab.GetRawFileOptions{
+		Ref: gitlab.String(ref),
+	}
+
+	for _, file := range files {
+		// send API call to capture the .vela.yml pipeline configuration
+		data, resp, err := client.RepositoryFiles.GetRawFile(projectID(r.GetOrg(), r.GetName()), file, opts, gitlab.WithContext(ctx))
+		if err != nil {
+			if resp == nil || resp.StatusCode != http.StatusNotFound {
+				return nil, err
+			}
+
+			continue
+		}
+
+		return data, nil
+	}
+
+	return nil, fmt.Errorf("no valid pipeline configuration file (%s) found", strings.Join(files, ","))
+}
+
+// Disable deactivates a repo by deleting the webhook.
+func (c *client) Disable(ctx context.Context, u *library.User, org, name string) error {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  org,
+		"repo": name,
+		"user": u.GetName(),
+	}).Tracef("deleting repository webhooks for %s/%s", org, name)
+
+	// create GitLab OAuth client with user's token
+	client := c.newClientToken(u.GetToken())
+
+	// send API call to capture the hooks for the repo
+	hooks, _, err := client.Projects.ListProjectHooks(projectID(org, name), nil, gitlab.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	// accounting for situations in which multiple hooks have been
+	// associated with this vela instance, which causes some
+	// disable, repair, enable operations to act in undesirable ways
+	var ids []int
+
+	// iterate through each element in the hooks
+	for _, hook := range hooks {
+		// skip if the hook has no ID
+		if hook.ID == 0 {
+			continue
+		}
+
+		// capture hook ID if the hook url matches
+		if hook.URL == c.webhookURL() {
+			ids = append(ids, hook.ID)
+		}
+	}
+
+	// skip if we have no hook IDs
+	if len(ids) == 0 {
+		c.Logger.WithFields(logrus.Fields{
+			"org":  org,
+			"repo": name,
+			"user": u.GetName(),
+		}).Warnf("no repository webhooks matching %s found for %s/%s", c.webhookURL(), org, name)
+
+		return nil
+	}
+
+	// go through all found hook IDs and delete them
+	for _, id := range ids {
+		// send API call to delete the webhook
+		_, err = client.Projects.DeleteProjectHook(projectID(org, name), id, gitlab.WithContext(ctx))
+	}
+
+	return err
+}
+
+// Enable activates a repo by creating the webhook.
+func (c *client) Enable(ctx context.Context, u *library.User, r *library.Repo, h *library.Hook) (*library.Hook, string, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("creating repository webhook for %s/%s", r.GetOrg(), r.GetName())
+
+	// create GitLab OAuth client with user's token
+	client := c.newClientToken(u.GetToken())
+
+	// create the hook object to make the API call
+	hook := &gitlab.AddProjectHookOptions{
+		URL:                   gitlab.String(c.webhookURL()),
+		Token:                 gitlab.String(r.GetHash()),
+		EnableSSLVerification: gitlab.Bool(true),
+		PushEvents:            gitlab.Bool(r.GetAllowPush()),
+		TagPushEvents:         gitlab.Bool(r.GetAllowTag()),
+		MergeRequestsEvents:   gitlab.Bool(r.GetAllowPull()),
+		NoteEvents:            gitlab.Bool(r.GetAllowComment()),
+		DeploymentEvents:      gitlab.Bool(r.GetAllowDeploy()),
+	}
+
+	// send API call to create the webhook
+	hookInfo, resp, err := client.Projects.AddProjectHook(projectID(r.GetOrg(), r.GetName()), hook, gitlab.WithContext(ctx))
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return nil, "", fmt.Errorf("repo not found")
+		}
+
+		return nil, "", err
+	}
+
+	// create the first hook for the repo and record its ID from GitLab
+	webhook := new(library.Hook)
+	webhook.SetWebhookID(int64(hookInfo.ID))
+	webhook.SetSourceID(r.GetName() + "-" + eventInitialize)
+	webhook.SetCreated(time.Now().UTC().Unix())
+	webhook.SetEvent(eventInitialize)
+	webhook.SetNumber(h.GetNumber() + 1)
+	webhook.SetStatus(constants.StatusSuccess)
+
+	if hookInfo.CreatedAt != nil {
+		webhook.SetCreated(hookInfo.CreatedAt.Unix())
+	}
+
+	// create the URL for the repo
+	url := fmt.Sprintf("%s/%s/%s", c.config.Address, r.GetOrg(), r.GetName())
+
+	return webhook, url, nil
+}
+
+// Update edits a repo webhook.
+func (c *client) Update(ctx context.Context, u *library.User, r *library.Repo, hookID int64) (bool, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("updating repository webhook for %s/%s", r.GetOrg(), r.GetName())
+
+	// create GitLab OAuth client with user's token
+	client := c.newClientToken(u.GetToken())
+
+	// create the hook object to make the API call
+	hook := &gitlab.EditProjectHookOptions{
+		URL:                   gitlab.String(c.webhookURL()),
+		Token:                 gitlab.String(r.GetHash()),
+		EnableSSLVerification: gitlab.Bool(true),
+		PushEvents:            gitlab.Bool(r.GetAllowPush()),
+		TagPushEvents:         gitlab.Bool(r.GetAllowTag()),
+		MergeRequestsEvents:   gitlab.Bool(r.GetAllowPull()),
+		NoteEvents:            gitlab.Bool(r.GetAllowComment()),
+		DeploymentEvents:      gitlab.Bool(r.GetAllowDeploy()),
+	}
+
+	// send API call to update the webhook
+	_, resp, err := client.Projects.EditProjectHook(projectID(r.GetOrg(), r.GetName()), int(hookID), hook, gitlab.WithContext(ctx))
+
+	// track if webhook exists in GitLab; a missing webhook
+	// indicates the webhook has been manually deleted from GitLab
+	return resp == nil || resp.StatusCode != http.StatusNotFound, err
+}
+
+// Status sends the commit status for the given SHA from the GitLab repo.
+func (c *client) Status(ctx context.Context, u *library.User, b *library.Build, org, name string) error {
+	c.Logger.WithFields(logrus.Fields{
+		"build": b.GetNumber(),
+		"org":   org,
+		"repo":  name,
+		"user":  u.GetName(),
+	}).Tracef("setting commit status for %s/%s/%d @ %s", org, name, b.GetNumber(), b.GetCommit())
+
+	// create GitLab OAuth client with user's token
+	client := c.newClientToken(u.GetToken())
+
+	context := fmt.Sprintf("%s/%s", c.config.StatusContext, b.GetEvent())
+	url := fmt.Sprintf("%s/%s/%s/%d", c.config.WebUIAddress, org, name, b.GetNumber())
+
+	var (
+		state       gitlab.BuildStateValue
+		deployState gitlab.DeploymentStatusValue
+		description string
+	)
+
+	// set the state and description for the status context
+	// depending on what the status of the build is
+	switch b.GetStatus() {
+	case constants.StatusRunning:
+		state = gitlab.Running
+		deployState = gitlab.DeploymentStatusRunning
+		description = fmt.Sprintf("the build is %s", b.GetStatus())
+	case constants.StatusPending:
+		state = gitlab.Pending
+		deployState = gitlab.DeploymentStatusRunning
+		description = fmt.Sprintf("the build is %s", b.GetStatus())
+	case constants.StatusSuccess:
+		state = gitlab.Success
+		deployState = gitlab.DeploymentStatusSuccess
+		description = "the build was successful"
+	case constants.StatusFailure:
+		state = gitlab.Failed
+		deployState = gitlab.DeploymentStatusFailed
+		description = "the build has failed"
+	case constants.StatusCanceled:
+		state = gitlab.Canceled
+		deployState = gitlab.DeploymentStatusCanceled
+		description = "the build was canceled"
+	case constants.StatusKilled:
+		state = gitlab.Canceled
+		deployState = gitlab.DeploymentStatusCanceled
+		description = "the build was killed"
+	case constants.StatusSkipped:
+		state = gitlab.Success
+		deployState = gitlab.DeploymentStatusSuccess
+		description = "build was skipped as no steps/stages found"
+	default:
+		state = gitlab.Failed
+		deployState = gitlab.DeploymentStatusFailed
+		description = "there was an error"
+	}
+
+	// check if the build event is deployment
+	if strings.EqualFold(b.GetEvent(), constants.EventDeploy) {
+		// deployments are created in a running state
+		// so there is nothing to update until they finish
+		if deployState == gitlab.DeploymentStatusRunning {
+			return nil
+		}
+
+		// parse out deployment number from build source URL
+		//
+		// pattern: <api>/projects/<project>/deployments/<deployment_id>
+		parts := strings.Split(b.GetSource(), "/deployments/")
+		if len(parts) != 2 {
+			return fmt.Errorf("unable to parse deployment from source %s", b.GetSource())
+		}
+
+		// capture number by converting from string
+		number, err := strconv.Atoi(parts[1])
+		if err != nil {
+			return err
+		}
+
+		// create the status object to make the API call
+		status := &gitlab.UpdateProjectDeploymentOptions{
+			Status: gitlab.DeploymentStatus(deployState),
+		}
+
+		_, _, err = client.Deployments.UpdateProjectDeployment(projectID(org, name), number, status, gitlab.WithContext(ctx))
+
+		return err
+	}
+
+	// create the status object to make the API call
+	status := &gitlab.SetCommitStatusOptions{
+		Name:        gitlab.String(context),
+		Description: gitlab.String(description),
+		State:       state,
+	}
+
+	// provide "Details" link in GitLab UI if server was configured with it
+	if len(c.config.WebUIAddress) > 0 && b.GetStatus() != constants.StatusSkipped {
+		status.TargetURL = gitlab.String(url)
+	}
+
+	// send API call to create the status context for the commit
+	_, _, err := client.Commits.SetCommitStatus(projectID(org, name), b.GetCommit(), status, gitlab.WithContext(ctx))
+
+	return err
+}
+
+// CheckRun sets the check run with per-step detail for the build from the GitLab repo.
+//
+// GitLab does not support check runs so the build is reported with the commit status.
+func (c *client) CheckRun(ctx context.Context, u *library.User, b *library.Build, steps []*library.Step, org, name string) error {
+	return c.Status(ctx, u, b, org, name)
+}
+
+// GetRepo gets repo information from GitLab.
+func (c *client) GetRepo(ctx context.Context, u *library.User, r *library.Repo) (*library.Repo, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("retrieving repository information for %s", r.GetFullName())
+
+	// create GitLab OAuth client with user's token
+	client := c.newClientToken(u.GetToken())
+
+	// send an API call to get the repo info
+	repo, _, err := client.Projects.GetProject(projectID(r.GetOrg(), r.GetName()), nil, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return toLibraryRepo(repo), nil
+}
+
+// GetOrgAndRepoName returns the name of the org and the repository in the SCM.
+func (c *client) GetOrgAndRepoName(ctx context.Context, u *library.User, o string, r string) (string, string, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  o,
+		"repo": r,
+		"user": u.GetName(),
+	}).Tracef("retrieving repository information for %s/%s", o, r)
+
+	// create GitLab OAuth client with user's token
+	client := c.newClientToken(u.GetToken())
+
+	// send an API call to get the repo info
+	repo, _, err := client.Projects.GetProject(projectID(o, r), nil, gitlab.WithContext(ctx))
+	if err != nil {
+		return "", "", err
+	}
+
+	org, name := splitPath(repo.PathWithNamespace)
+
+	return org, name, nil
+}
+
+// ListUserRepos returns a list of all repos the user has access to.
+func (c *client) ListUserRepos(ctx context.Context, u *library.User) ([]*library.Repo, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"user": u.GetName(),
+	}).Tracef("listing source repositories for %s", u.GetName())
+
+	// create GitLab OAuth client with user's token
+	client := c.newClientToken(u.GetToken())
+
+	r := []*gitlab.Project{}
+	f := []*library.Repo{}
+
+	// set the max per page for the options to capture the list of repos
+	opts := &gitlab.ListProjectsOptions{
+		ListOptions:    gitlab.ListOptions{PerPage: 100}, // 100 is max
+		Archived:       gitlab.Bool(false),
+		Membership:     gitlab.Bool(true),
+		MinAccessLevel: gitlab.AccessLevel(gitlab.MaintainerPermissions),
+	}
+
+	// loop to capture *ALL* the repos
+	for {
+		// send API call to capture the user's repos
+		repos, resp, err := client.Projects.ListProjects(opts, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, fmt.Errorf("unable to list user repos: %w", err)
+		}
+
+		r = append(r, repos...)
+
+		// break the loop if there is no more results to page through
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opts.Page = resp.NextPage
+	}
+
+	// iterate through each repo for the user
+	for _, repo := range r {
+		// skip if the repo is void
+		if repo == nil {
+			continue
+		}
+
+		// skip if the repo is archived
+		if repo.Archived {
+			continue
+		}
+
+		f = append(f, toLibraryRepo(repo))
+	}
+
+	return f, nil
+}
+
+// toLibraryRepo does a partial conversion of a gitlab project to a library repo.
+func toLibraryRepo(gr *gitlab.Project) *library.Repo {
+	// setting the visbility to match the SCM visbility
+	visibility := constants.VisibilityPrivate
+	if gr.Visibility == gitlab.PublicVisibility {
+		visibility = constants.VisibilityPublic
+	}
+
+	org, name := splitPath(gr.PathWithNamespace)
+
+	r := new(library.Repo)
+	r.SetOrg(org)
+	r.SetName(name)
+	r.SetFullName(gr.PathWithNamespace)
+	r.SetLink(gr.WebURL)
+	r.SetClone(gr.HTTPURLToRepo)
+	r.SetBranch(gr.DefaultBranch)
+	r.SetTopics(gr.Topics)
+	r.SetPrivate(gr.Visibility != gitlab.PublicVisibility)
+	r.SetVisibility(visibility)
+
+	return r
+}
+
+// GetPullRequest defines a function that retrieves
+// a pull request for a repo.
+func (c *client) GetPullRequest(ctx context.Context, u *library.User, r *library.Repo, number int) (string, string, string, string, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("retrieving merge request %d for repo %s", number, r.GetFullName())
+
+	// create GitLab OAuth client with user's token
+	client := c.newClientToken(u.GetToken())
+
+	mr, _, err := client.MergeRequests.GetMergeRequest(projectID(r.GetOrg(), r.GetName()), number, nil, gitlab.WithContext(ctx))
+	if err != nil {
+		return "", "", "", "", err
+	}
+
+	commit := mr.SHA
+	branch := mr.TargetBranch
+	baseref := mr.TargetBranch
+	headref := mr.SourceBranch
+
+	return commit, branch, baseref, headref, nil
+}
+
+// GetHTMLURL retrieves the html_url from repository contents from the GitLab repo.
+func (c *client) GetHTMLURL(ctx context.Context, u *library.User, org, repo, name, ref string) (string, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  org,
+		"repo": repo,
+		"user": u.GetName(),
+	}).Tracef("capturing html_url for %s/%s/%s@%s", org, repo, name, ref)
+
+	// create GitLab OAuth client with user's token
+	client := c.newClientToken(u.GetToken())
+
+	// set the reference for the options to capture the repository contents
+	opts := &gitlab.GetFileMetaDataOptions{
+		Ref: gitlab.String(ref),
+	}
+
+	// send API call to verify the repository contents exist for org/repo/name at the ref provided
+	data, _, err := client.RepositoryFiles.GetFileMetaData(projectID(org, repo), name, opts, gitlab.WithContext(ctx))
+	if err != nil {
+		return "", err
+	}
+
+	// data is not nil if the file exists
+	if data != nil {
+		return fmt.Sprintf("%s/%s/%s/-/blob/%s/%s", c.config.Address, org, repo, ref, data.FilePath), nil
+	}
+
+	return "", fmt.Errorf("no valid repository contents found")
+}
+
+// GetBranch defines a function that retrieves a branch for a repo.
+func (c *client) GetBranch(ctx context.Context, u *library.User, r *library.Repo, branch string) (string, string, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("retrieving branch %s for repo %s", branch, r.GetFullName())
+
+	// create GitLab OAuth client with user's token
+	client := c.newClientToken(u.GetToken())
+
+	data, _, err := client.Branches.GetBranch(projectID(r.GetOrg(), r.GetName()), branch, gitlab.WithContext(ctx))
+	if err != nil {
+		return "", "", err
+	}
+
+	if data.Commit == nil {
+		return data.Name, "", nil
+	}
+
+	return data.Name, data.Commit.ID, nil
+}
+
+// GetTag defines a function that retrieves the commit for a tag in a repo.
+func (c *client) GetTag(ctx context.Context, u *library.User, r *library.Repo, tag string) (string, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("retrieving tag %s for repo %s", tag, r.GetFullName())
+
+	// create GitLab OAuth client with user's token
+	client := c.newClientToken(u.GetToken())
+
+	data, _, err := client.Tags.GetTag(projectID(r.GetOrg(), r.GetName()), tag, gitlab.WithContext(ctx))
+	if err != nil {
+		return "", err
+	}
+
+	if data.Commit == nil {
+		return "", fmt.Errorf("no commit found for tag %s in repo %s", tag, r.GetFullName())
+	}
+
+	return data.Commit.ID, nil
+}
+
+// CreateComment creates a note on a merge request for the GitLab repo.
+func (c *client) CreateComment(ctx context.Context, u *library.User, r *library.Repo, number int, body string) error {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("creating comment on merge request %d for repo %s", number, r.GetFullName())
+
+	// create GitLab OAuth client with user's token
+	client := c.newClientToken(u.GetToken())
+
+	opts := &gitlab.CreateMergeRequestNoteOptions{
+		Body: gitlab.String(body),
+	}
+
+	_, _, err := client.Notes.CreateMergeRequestNote(projectID(r.GetOrg(), r.GetName()), number, opts, gitlab.WithContext(ctx))
+
+	return err
+}
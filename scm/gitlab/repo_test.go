@@ -0,0 +1,915 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+)
+
+func TestGitlab_Config_YML(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	engine.UseRawPath = true
+
+	// setup mock server
+	engine.GET("/api/v4/projects/:project/repository/files/:file/raw", func(c *gin.Context) {
+		if c.Param("file") != ".vela.yml" {
+			c.Status(http.StatusNotFound)
+			return
+		}
+
+		c.Header("Content-Type", "text/plain")
+		c.Status(http.StatusOK)
+		c.File("testdata/pipeline.yml")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	r := new(library.Repo)
+	r.SetOrg("foo")
+	r.SetName("bar")
+
+	want, err := os.ReadFile("testdata/pipeline.yml")
+	if err != nil {
+		t.Errorf("Config reading file returned err: %v", err)
+	}
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got, err := client.Config(context.TODO(), u, r, "")
+
+	if err != nil {
+		t.Errorf("Config returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Config is %v, want %v", got, want)
+	}
+}
+
+func TestGitlab_Config_YAML(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	engine.UseRawPath = true
+
+	// setup mock server
+	engine.GET("/api/v4/projects/:project/repository/files/:file/raw", func(c *gin.Context) {
+		if c.Param("file") != ".vela.yaml" {
+			c.Status(http.StatusNotFound)
+			return
+		}
+
+		c.Header("Content-Type", "text/plain")
+		c.Status(http.StatusOK)
+		c.File("testdata/pipeline.yml")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	r := new(library.Repo)
+	r.SetOrg("foo")
+	r.SetName("bar")
+
+	want, err := os.ReadFile("testdata/pipeline.yml")
+	if err != nil {
+		t.Errorf("Config reading file returned err: %v", err)
+	}
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got, err := client.Config(context.TODO(), u, r, "")
+
+	if err != nil {
+		t.Errorf("Config returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Config is %v, want %v", got, want)
+	}
+}
+
+func TestGitlab_Config_NotFound(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	engine.UseRawPath = true
+
+	// setup mock server
+	engine.GET("/api/v4/projects/:project/repository/files/:file/raw", func(c *gin.Context) {
+		c.Status(http.StatusNotFound)
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	r := new(library.Repo)
+	r.SetOrg("foo")
+	r.SetName("bar")
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got, err := client.Config(context.TODO(), u, r, "")
+
+	if err == nil {
+		t.Errorf("Config should have returned err")
+	}
+
+	if got != nil {
+		t.Errorf("Config is %v, want nil", got)
+	}
+}
+
+func TestGitlab_Disable(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	engine.UseRawPath = true
+
+	deleted := []string{}
+
+	// setup mock server
+	engine.GET("/api/v4/projects/:project/hooks", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusOK)
+		c.File("testdata/hooks.json")
+	})
+	engine.DELETE("/api/v4/projects/:project/hooks/:hook_id", func(c *gin.Context) {
+		deleted = append(deleted, c.Param("hook_id"))
+
+		c.Status(http.StatusNoContent)
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	client, _ := New(
+		WithAddress(s.URL),
+		WithClientID("foo"),
+		WithClientSecret("bar"),
+		WithServerAddress("https://vela-server.example.com"),
+		WithServerWebhookAddress(""),
+		WithStatusContext("continuous-integration/vela"),
+		WithScopes([]string{"api", "read_user"}),
+	)
+
+	// run test
+	err := client.Disable(context.TODO(), u, "foo", "bar")
+
+	if err != nil {
+		t.Errorf("Disable returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(deleted, []string{"1"}) {
+		t.Errorf("Disable deleted hooks %v, want %v", deleted, []string{"1"})
+	}
+}
+
+func TestGitlab_Enable(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	engine.UseRawPath = true
+
+	body := make(map[string]interface{})
+
+	// setup mock server
+	engine.POST("/api/v4/projects/:project/hooks", func(c *gin.Context) {
+		_ = json.NewDecoder(c.Request.Body).Decode(&body)
+
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusCreated)
+		c.File("testdata/hook.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	r := new(library.Repo)
+	r.SetOrg("foo")
+	r.SetName("bar")
+	r.SetHash("secret")
+	r.SetAllowPush(true)
+	r.SetAllowPull(true)
+	r.SetAllowTag(true)
+
+	h := new(library.Hook)
+	h.SetNumber(1)
+
+	wantHook := new(library.Hook)
+	wantHook.SetWebhookID(1)
+	wantHook.SetSourceID("bar-initialize")
+	wantHook.SetCreated(1698840000)
+	wantHook.SetEvent("initialize")
+	wantHook.SetNumber(2)
+	wantHook.SetStatus(constants.StatusSuccess)
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got, url, err := client.Enable(context.TODO(), u, r, h)
+
+	if err != nil {
+		t.Errorf("Enable returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, wantHook) {
+		t.Errorf("Enable returned hook %v, want %v", got, wantHook)
+	}
+
+	if url != fmt.Sprintf("%s/foo/bar", s.URL) {
+		t.Errorf("Enable returned url %v, want %v", url, fmt.Sprintf("%s/foo/bar", s.URL))
+	}
+
+	if body["token"] != "secret" {
+		t.Errorf("Enable hook token is %v, want %v", body["token"], "secret")
+	}
+
+	if body["merge_requests_events"] != true || body["note_events"] != false {
+		t.Errorf("Enable hook events are %v", body)
+	}
+}
+
+func TestGitlab_Update(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	engine.UseRawPath = true
+
+	// setup mock server
+	engine.PUT("/api/v4/projects/:project/hooks/:hook_id", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusOK)
+		c.File("testdata/hook.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	r := new(library.Repo)
+	r.SetOrg("foo")
+	r.SetName("bar")
+	r.SetHash("secret")
+	r.SetAllowPush(true)
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got, err := client.Update(context.TODO(), u, r, 1)
+
+	if err != nil {
+		t.Errorf("Update returned err: %v", err)
+	}
+
+	if !got {
+		t.Errorf("Update is %v, want true", got)
+	}
+}
+
+func TestGitlab_Update_HookDeleted(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	engine.UseRawPath = true
+
+	// setup mock server
+	engine.PUT("/api/v4/projects/:project/hooks/:hook_id", func(c *gin.Context) {
+		c.JSON(http.StatusNotFound, gin.H{"message": "404 Not found"})
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	r := new(library.Repo)
+	r.SetOrg("foo")
+	r.SetName("bar")
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got, err := client.Update(context.TODO(), u, r, 1)
+
+	if err == nil {
+		t.Errorf("Update should have returned err")
+	}
+
+	if got {
+		t.Errorf("Update is %v, want false", got)
+	}
+}
+
+func TestGitlab_Status(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	engine.UseRawPath = true
+
+	body := make(map[string]interface{})
+
+	// setup mock server
+	engine.POST("/api/v4/projects/:project/statuses/:sha", func(c *gin.Context) {
+		_ = json.NewDecoder(c.Request.Body).Decode(&body)
+
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusCreated)
+		c.File("testdata/status.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	b := new(library.Build)
+	b.SetNumber(1)
+	b.SetEvent(constants.EventPush)
+	b.SetStatus(constants.StatusSuccess)
+	b.SetCommit("a76aded1ad1c5a5d5a8b8e9b7b5e3d1c7f2a4b6c")
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	err := client.Status(context.TODO(), u, b, "foo", "bar")
+
+	if err != nil {
+		t.Errorf("Status returned err: %v", err)
+	}
+
+	want := map[string]interface{}{
+		"name":        "continuous-integration/vela/push",
+		"description": "the build was successful",
+		"state":       "success",
+		"target_url":  fmt.Sprintf("%s/foo/bar/1", s.URL),
+	}
+
+	if !reflect.DeepEqual(body, want) {
+		t.Errorf("Status is %v, want %v", body, want)
+	}
+}
+
+func TestGitlab_Status_Deployment(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	engine.UseRawPath = true
+
+	body := make(map[string]interface{})
+
+	// setup mock server
+	engine.PUT("/api/v4/projects/:project/deployments/:deployment", func(c *gin.Context) {
+		_ = json.NewDecoder(c.Request.Body).Decode(&body)
+
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusOK)
+		c.File("testdata/deployment.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	b := new(library.Build)
+	b.SetNumber(1)
+	b.SetEvent(constants.EventDeploy)
+	b.SetStatus(constants.StatusFailure)
+	b.SetSource(fmt.Sprintf("%s/api/v4/projects/foo%%2Fbar/deployments/1", s.URL))
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	err := client.Status(context.TODO(), u, b, "foo", "bar")
+
+	if err != nil {
+		t.Errorf("Status returned err: %v", err)
+	}
+
+	if body["status"] != "failed" {
+		t.Errorf("Status is %v, want %v", body["status"], "failed")
+	}
+}
+
+func TestGitlab_GetRepo(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	engine.UseRawPath = true
+
+	// setup mock server
+	engine.GET("/api/v4/projects/:project", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusOK)
+		c.File("testdata/project.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	r := new(library.Repo)
+	r.SetOrg("octocat")
+	r.SetName("Hello-World")
+
+	want := new(library.Repo)
+	want.SetOrg("octocat")
+	want.SetName("Hello-World")
+	want.SetFullName("octocat/Hello-World")
+	want.SetLink("https://gitlab.com/octocat/Hello-World")
+	want.SetClone("https://gitlab.com/octocat/Hello-World.git")
+	want.SetBranch("main")
+	want.SetTopics([]string{"octocat", "api"})
+	want.SetPrivate(false)
+	want.SetVisibility("public")
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got, err := client.GetRepo(context.TODO(), u, r)
+
+	if err != nil {
+		t.Errorf("GetRepo returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("GetRepo is %v, want %v", got, want)
+	}
+}
+
+func TestGitlab_GetOrgAndRepoName(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	engine.UseRawPath = true
+
+	// setup mock server
+	engine.GET("/api/v4/projects/:project", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusOK)
+		c.File("testdata/project.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	org, name, err := client.GetOrgAndRepoName(context.TODO(), u, "Octocat", "hello-world")
+
+	if err != nil {
+		t.Errorf("GetOrgAndRepoName returned err: %v", err)
+	}
+
+	if org != "octocat" || name != "Hello-World" {
+		t.Errorf("GetOrgAndRepoName is %s/%s, want %s", org, name, "octocat/Hello-World")
+	}
+}
+
+func TestGitlab_ListUserRepos(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/api/v4/projects", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusOK)
+		c.File("testdata/projects.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	r := new(library.Repo)
+	r.SetOrg("octocat")
+	r.SetName("Hello-World")
+	r.SetFullName("octocat/Hello-World")
+	r.SetLink("https://gitlab.com/octocat/Hello-World")
+	r.SetClone("https://gitlab.com/octocat/Hello-World.git")
+	r.SetBranch("main")
+	r.SetTopics([]string{})
+	r.SetPrivate(false)
+	r.SetVisibility("public")
+
+	want := []*library.Repo{r}
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got, err := client.ListUserRepos(context.TODO(), u)
+
+	if err != nil {
+		t.Errorf("ListUserRepos returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ListUserRepos is %v, want %v", got, want)
+	}
+}
+
+func TestGitlab_GetPullRequest(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	engine.UseRawPath = true
+
+	// setup mock server
+	engine.GET("/api/v4/projects/:project/merge_requests/:number", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusOK)
+		c.File("testdata/merge_request.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	r := new(library.Repo)
+	r.SetOrg("octocat")
+	r.SetName("Hello-World")
+
+	wantCommit := "a76aded1ad1c5a5d5a8b8e9b7b5e3d1c7f2a4b6c"
+	wantBranch := "main"
+	wantBaseRef := "main"
+	wantHeadRef := "changes"
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	gotCommit, gotBranch, gotBaseRef, gotHeadRef, err := client.GetPullRequest(context.TODO(), u, r, 1)
+
+	if err != nil {
+		t.Errorf("GetPullRequest returned err: %v", err)
+	}
+
+	if gotCommit != wantCommit {
+		t.Errorf("Commit is %v, want %v", gotCommit, wantCommit)
+	}
+
+	if gotBranch != wantBranch {
+		t.Errorf("Branch is %v, want %v", gotBranch, wantBranch)
+	}
+
+	if gotBaseRef != wantBaseRef {
+		t.Errorf("BaseRef is %v, want %v", gotBaseRef, wantBaseRef)
+	}
+
+	if gotHeadRef != wantHeadRef {
+		t.Errorf("HeadRef is %v, want %v", gotHeadRef, wantHeadRef)
+	}
+}
+
+func TestGitlab_GetHTMLURL(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	engine.UseRawPath = true
+
+	// setup mock server
+	engine.HEAD("/api/v4/projects/:project/repository/files/:file", func(c *gin.Context) {
+		c.Header("X-Gitlab-File-Name", ".vela.yml")
+		c.Header("X-Gitlab-File-Path", ".vela.yml")
+		c.Header("X-Gitlab-Ref", "main")
+		c.Header("X-Gitlab-Size", "123")
+		c.Status(http.StatusOK)
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	want := fmt.Sprintf("%s/octocat/Hello-World/-/blob/main/.vela.yml", s.URL)
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got, err := client.GetHTMLURL(context.TODO(), u, "octocat", "Hello-World", ".vela.yml", "main")
+
+	if err != nil {
+		t.Errorf("GetHTMLURL returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("GetHTMLURL is %v, want %v", got, want)
+	}
+}
+
+func TestGitlab_GetBranch(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	engine.UseRawPath = true
+
+	// setup mock server
+	engine.GET("/api/v4/projects/:project/repository/branches/:branch", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusOK)
+		c.File("testdata/branch.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	r := new(library.Repo)
+	r.SetOrg("octocat")
+	r.SetName("Hello-World")
+
+	wantBranch := "main"
+	wantCommit := "a76aded1ad1c5a5d5a8b8e9b7b5e3d1c7f2a4b6c"
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	gotBranch, gotCommit, err := client.GetBranch(context.TODO(), u, r, "main")
+
+	if err != nil {
+		t.Errorf("GetBranch returned err: %v", err)
+	}
+
+	if gotBranch != wantBranch {
+		t.Errorf("Branch is %v, want %v", gotBranch, wantBranch)
+	}
+
+	if gotCommit != wantCommit {
+		t.Errorf("Commit is %v, want %v", gotCommit, wantCommit)
+	}
+}
+
+func TestGitlab_GetTag(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	engine.UseRawPath = true
+
+	// setup mock server
+	engine.GET("/api/v4/projects/:project/repository/tags/:tag", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusOK)
+		c.File("testdata/tag.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	r := new(library.Repo)
+	r.SetOrg("octocat")
+	r.SetName("Hello-World")
+
+	want := "a76aded1ad1c5a5d5a8b8e9b7b5e3d1c7f2a4b6c"
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got, err := client.GetTag(context.TODO(), u, r, "v0.1.0")
+
+	if err != nil {
+		t.Errorf("GetTag returned err: %v", err)
+	}
+
+	if got != want {
+		t.Errorf("GetTag is %v, want %v", got, want)
+	}
+}
+
+func TestGitlab_CheckRun(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	engine.UseRawPath = true
+
+	created := false
+
+	// setup mock server
+	engine.POST("/api/v4/projects/:project/statuses/:sha", func(c *gin.Context) {
+		created = true
+
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusCreated)
+		c.File("testdata/status.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	b := new(library.Build)
+	b.SetNumber(1)
+	b.SetEvent(constants.EventPush)
+	b.SetStatus(constants.StatusSuccess)
+	b.SetCommit("abcd1234")
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	err := client.CheckRun(context.TODO(), u, b, nil, "foo", "bar")
+
+	if err != nil {
+		t.Errorf("CheckRun returned err: %v", err)
+	}
+
+	// the build is reported with the commit status instead
+	if !created {
+		t.Errorf("CheckRun did not create commit status")
+	}
+}
+
+func TestGitlab_CreateComment(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	engine.UseRawPath = true
+
+	// setup mock server
+	engine.POST("/api/v4/projects/:project/merge_requests/:iid/notes", func(c *gin.Context) {
+		body := struct {
+			Body string `json:"body"`
+		}{}
+
+		err := c.BindJSON(&body)
+		if err != nil || c.Param("iid") != "1" || body.Body != "Me too" {
+			c.Status(http.StatusBadRequest)
+
+			return
+		}
+
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusCreated)
+		c.File("testdata/comment.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	r := new(library.Repo)
+	r.SetOrg("octocat")
+	r.SetName("Hello-World")
+	r.SetFullName("octocat/Hello-World")
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	err := client.CreateComment(context.TODO(), u, r, 1, "Me too")
+
+	if err != nil {
+		t.Errorf("CreateComment returned err: %v", err)
+	}
+
+	err = client.CreateComment(context.TODO(), u, r, 2, "Me too")
+
+	if err == nil {
+		t.Errorf("CreateComment should have returned err")
+	}
+}
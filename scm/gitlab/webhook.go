@@ -0,0 +1,516 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package gitlab
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/go-vela/types"
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+	"github.com/xanzy/go-gitlab"
+)
+
+// ProcessWebhook parses the webhook from a repo.
+//
+//nolint:nilerr // ignore webhook returning nil
+func (c *client) ProcessWebhook(ctx context.Context, request *http.Request) (*types.Webhook, error) {
+	c.Logger.Tracef("processing GitLab webhook")
+
+	// create our own record of the hook and populate its fields
+	h := new(library.Hook)
+	h.SetNumber(1)
+	h.SetSourceID(request.Header.Get("X-Gitlab-Event-UUID"))
+	h.SetCreated(time.Now().UTC().Unix())
+	h.SetHost(hostname(c.config.Address))
+	h.SetEvent(request.Header.Get("X-Gitlab-Event"))
+	h.SetStatus(constants.StatusSuccess)
+
+	if len(request.Header.Get("X-Gitlab-Instance")) > 0 {
+		h.SetHost(hostname(request.Header.Get("X-Gitlab-Instance")))
+	}
+
+	payload, err := io.ReadAll(request.Body)
+	if err != nil {
+		return &types.Webhook{Hook: h}, nil
+	}
+
+	// parse the payload from the webhook
+	event, err := gitlab.ParseWebhook(gitlab.HookEventType(request), payload)
+	if err != nil {
+		return &types.Webhook{Hook: h}, nil
+	}
+
+	// process the event from the webhook
+	switch event := event.(type) {
+	case *gitlab.PushEvent:
+		return c.processPushEvent(h, event)
+	case *gitlab.TagEvent:
+		return c.processTagEvent(h, event)
+	case *gitlab.MergeEvent:
+		return c.processMergeEvent(h, event)
+	case *gitlab.MergeCommentEvent:
+		return c.processMergeCommentEvent(h, event)
+	case *gitlab.IssueCommentEvent:
+		return c.processIssueCommentEvent(h, event)
+	case *gitlab.DeploymentEvent:
+		return c.processDeploymentEvent(h, event)
+	}
+
+	return &types.Webhook{Hook: h}, nil
+}
+
+// VerifyWebhook verifies the webhook from a repo.
+//
+// GitLab does not sign the payload for webhooks so the secret
+// token provided when creating the webhook is compared instead.
+func (c *client) VerifyWebhook(ctx context.Context, request *http.Request, r *library.Repo) error {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+	}).Tracef("verifying GitLab webhook for %s", r.GetFullName())
+
+	token := request.Header.Get("X-Gitlab-Token")
+	if len(token) == 0 {
+		return errors.New("no X-Gitlab-Token header provided")
+	}
+
+	if subtle.ConstantTimeCompare([]byte(token), []byte(r.GetHash())) != 1 {
+		return errors.New("invalid X-Gitlab-Token header provided")
+	}
+
+	return nil
+}
+
+// RedeliverWebhook redelivers webhooks from GitLab.
+func (c *client) RedeliverWebhook(ctx context.Context, u *library.User, r *library.Repo, h *library.Hook) error {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("redelivering GitLab webhook %s for %s", h.GetSourceID(), r.GetFullName())
+
+	return fmt.Errorf("redelivering webhooks is not supported for %s", c.Driver())
+}
+
+// processPushEvent is a helper function to process the push event.
+func (c *client) processPushEvent(h *library.Hook, payload *gitlab.PushEvent) (*types.Webhook, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"repo": payload.Project.PathWithNamespace,
+	}).Tracef("processing push GitLab webhook for %s", payload.Project.PathWithNamespace)
+
+	project := payload.Project
+
+	// convert payload to library repo
+	r := toWebhookRepo(
+		project.PathWithNamespace,
+		project.WebURL,
+		project.GitHTTPURL,
+		project.DefaultBranch,
+		project.Visibility != gitlab.PublicVisibility,
+	)
+
+	// convert payload to library build
+	b := new(library.Build)
+	b.SetEvent(constants.EventPush)
+	b.SetClone(project.GitHTTPURL)
+	b.SetTitle(fmt.Sprintf("%s received from %s", constants.EventPush, project.WebURL))
+	b.SetCommit(payload.CheckoutSHA)
+	b.SetSender(payload.UserUsername)
+	b.SetAuthor(payload.UserUsername)
+	b.SetEmail(payload.UserEmail)
+	b.SetBranch(strings.TrimPrefix(payload.Ref, "refs/heads/"))
+	b.SetRef(payload.Ref)
+
+	// capture the head commit from the list of commits
+	for _, commit := range payload.Commits {
+		if commit == nil || commit.ID != payload.CheckoutSHA {
+			continue
+		}
+
+		b.SetSource(commit.URL)
+		b.SetMessage(commit.Message)
+
+		// ensure the build email is set
+		if len(b.GetEmail()) == 0 {
+			b.SetEmail(commit.Author.Email)
+		}
+	}
+
+	// update the hook object
+	h.SetBranch(b.GetBranch())
+	h.SetEvent(constants.EventPush)
+	h.SetLink(hookLink(c.config.Address, r.GetFullName()))
+
+	// handle when push event is a tag
+	if strings.HasPrefix(b.GetRef(), "refs/tags/") {
+		// set the proper event for the hook
+		h.SetEvent(constants.EventTag)
+		// set the proper event for the build
+		b.SetEvent(constants.EventTag)
+	}
+
+	return &types.Webhook{
+		Comment: "",
+		Hook:    h,
+		Repo:    r,
+		Build:   b,
+	}, nil
+}
+
+// processTagEvent is a helper function to process the tag push event.
+func (c *client) processTagEvent(h *library.Hook, payload *gitlab.TagEvent) (*types.Webhook, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"repo": payload.Project.PathWithNamespace,
+	}).Tracef("processing tag GitLab webhook for %s", payload.Project.PathWithNamespace)
+
+	project := payload.Project
+
+	// convert payload to library repo
+	r := toWebhookRepo(
+		project.PathWithNamespace,
+		project.WebURL,
+		project.GitHTTPURL,
+		project.DefaultBranch,
+		project.Visibility != gitlab.PublicVisibility,
+	)
+
+	// update the hook object
+	h.SetBranch(strings.TrimPrefix(payload.Ref, "refs/tags/"))
+	h.SetEvent(constants.EventTag)
+	h.SetLink(hookLink(c.config.Address, r.GetFullName()))
+
+	// skip if the tag was deleted
+	if len(strings.Trim(payload.CheckoutSHA, "0")) == 0 {
+		return &types.Webhook{Hook: h}, nil
+	}
+
+	// convert payload to library build
+	b := new(library.Build)
+	b.SetEvent(constants.EventTag)
+	b.SetClone(project.GitHTTPURL)
+	b.SetTitle(fmt.Sprintf("%s received from %s", constants.EventTag, project.WebURL))
+	b.SetMessage(payload.Message)
+	b.SetCommit(payload.CheckoutSHA)
+	b.SetSender(payload.UserUsername)
+	b.SetAuthor(payload.UserUsername)
+	b.SetEmail(payload.UserEmail)
+	b.SetBranch(strings.TrimPrefix(payload.Ref, "refs/tags/"))
+	b.SetRef(payload.Ref)
+
+	// capture the tagged commit from the list of commits
+	for _, commit := range payload.Commits {
+		if commit == nil || commit.ID != payload.CheckoutSHA {
+			continue
+		}
+
+		b.SetSource(commit.URL)
+
+		// ensure the build message is set
+		if len(b.GetMessage()) == 0 {
+			b.SetMessage(commit.Message)
+		}
+	}
+
+	return &types.Webhook{
+		Comment: "",
+		Hook:    h,
+		Repo:    r,
+		Build:   b,
+	}, nil
+}
+
+// processMergeEvent is a helper function to process the merge request event.
+func (c *client) processMergeEvent(h *library.Hook, payload *gitlab.MergeEvent) (*types.Webhook, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"repo": payload.Project.PathWithNamespace,
+	}).Tracef("processing merge request GitLab webhook for %s", payload.Project.PathWithNamespace)
+
+	project := payload.Project
+	mr := payload.ObjectAttributes
+
+	// update the hook object
+	h.SetBranch(mr.TargetBranch)
+	h.SetEvent(constants.EventPull)
+	h.SetLink(hookLink(c.config.Address, project.PathWithNamespace))
+
+	// if the merge request state isn't open we ignore it
+	if mr.State != stateOpened {
+		return &types.Webhook{Hook: h}, nil
+	}
+
+	// convert the merge request action to the matching pull request action
+	var action string
+
+	switch mr.Action {
+	case actionOpen:
+		action = constants.ActionOpened
+	case actionReopen:
+		action = constants.ActionReopened
+	case actionUpdate:
+		// skip updates that did not push new commits
+		if len(mr.OldRev) == 0 {
+			return &types.Webhook{Hook: h}, nil
+		}
+
+		action = constants.ActionSynchronize
+	default:
+		return &types.Webhook{Hook: h}, nil
+	}
+
+	// convert payload to library repo
+	r := toWebhookRepo(
+		project.PathWithNamespace,
+		project.WebURL,
+		project.GitHTTPURL,
+		project.DefaultBranch,
+		project.Visibility != gitlab.PublicVisibility,
+	)
+
+	// convert payload to library build
+	b := new(library.Build)
+	b.SetEvent(constants.EventPull)
+	b.SetEventAction(action)
+	b.SetClone(project.GitHTTPURL)
+	b.SetSource(mr.URL)
+	b.SetTitle(fmt.Sprintf("%s received from %s", constants.EventPull, project.WebURL))
+	b.SetMessage(mr.Title)
+	b.SetCommit(mr.LastCommit.ID)
+	b.SetEmail(mr.LastCommit.Author.Email)
+	b.SetBranch(mr.TargetBranch)
+	b.SetRef(fmt.Sprintf("refs/merge-requests/%d/head", mr.IID))
+	b.SetBaseRef(mr.TargetBranch)
+	b.SetHeadRef(mr.SourceBranch)
+
+	if payload.User != nil {
+		b.SetSender(payload.User.Username)
+		b.SetAuthor(payload.User.Username)
+	}
+
+	// ensure the build author is set
+	if len(b.GetAuthor()) == 0 {
+		b.SetAuthor(mr.LastCommit.Author.Name)
+	}
+
+	return &types.Webhook{
+		Comment:  "",
+		PRNumber: mr.IID,
+		Hook:     h,
+		Repo:     r,
+		Build:    b,
+	}, nil
+}
+
+// processMergeCommentEvent is a helper function to process the comment on a merge request event.
+func (c *client) processMergeCommentEvent(h *library.Hook, payload *gitlab.MergeCommentEvent) (*types.Webhook, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"repo": payload.Project.PathWithNamespace,
+	}).Tracef("processing merge request comment GitLab webhook for %s", payload.Project.PathWithNamespace)
+
+	project := payload.Project
+	mr := payload.MergeRequest
+
+	// update the hook object
+	h.SetEvent(constants.EventComment)
+	h.SetLink(hookLink(c.config.Address, project.PathWithNamespace))
+
+	// convert payload to library repo
+	r := toWebhookRepo(
+		project.PathWithNamespace,
+		project.WebURL,
+		project.GitHTTPURL,
+		project.DefaultBranch,
+		project.Visibility != gitlab.PublicVisibility,
+	)
+
+	// convert payload to library build
+	b := new(library.Build)
+	b.SetEvent(constants.EventComment)
+	b.SetEventAction(constants.ActionCreated)
+	b.SetClone(project.GitHTTPURL)
+	b.SetSource(payload.ObjectAttributes.URL)
+	b.SetTitle(fmt.Sprintf("%s received from %s", constants.EventComment, project.WebURL))
+	b.SetMessage(mr.Title)
+	b.SetEmail(mr.LastCommit.Author.Email)
+	b.SetRef(fmt.Sprintf("refs/merge-requests/%d/head", mr.IID))
+
+	if payload.User != nil {
+		b.SetSender(payload.User.Username)
+		b.SetAuthor(payload.User.Username)
+	}
+
+	return &types.Webhook{
+		Comment:  payload.ObjectAttributes.Note,
+		PRNumber: mr.IID,
+		Hook:     h,
+		Repo:     r,
+		Build:    b,
+	}, nil
+}
+
+// processIssueCommentEvent is a helper function to process the comment on an issue event.
+func (c *client) processIssueCommentEvent(h *library.Hook, payload *gitlab.IssueCommentEvent) (*types.Webhook, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"repo": payload.Project.PathWithNamespace,
+	}).Tracef("processing issue comment GitLab webhook for %s", payload.Project.PathWithNamespace)
+
+	project := payload.Project
+
+	// update the hook object
+	h.SetEvent(constants.EventComment)
+	h.SetLink(hookLink(c.config.Address, project.PathWithNamespace))
+
+	// convert payload to library repo
+	r := toWebhookRepo(
+		project.PathWithNamespace,
+		project.WebURL,
+		project.GitHTTPURL,
+		project.DefaultBranch,
+		project.Visibility != gitlab.PublicVisibility,
+	)
+
+	// convert payload to library build
+	b := new(library.Build)
+	b.SetEvent(constants.EventComment)
+	b.SetEventAction(constants.ActionCreated)
+	b.SetClone(project.GitHTTPURL)
+	b.SetSource(payload.ObjectAttributes.URL)
+	b.SetTitle(fmt.Sprintf("%s received from %s", constants.EventComment, project.WebURL))
+	b.SetMessage(payload.Issue.Title)
+	// comments on issues are treated as a comment
+	// on the default branch for the repo
+	b.SetRef(fmt.Sprintf("refs/heads/%s", r.GetBranch()))
+
+	if payload.User != nil {
+		b.SetSender(payload.User.Username)
+		b.SetAuthor(payload.User.Username)
+		b.SetEmail(payload.User.Email)
+	}
+
+	return &types.Webhook{
+		Comment: payload.ObjectAttributes.Note,
+		Hook:    h,
+		Repo:    r,
+		Build:   b,
+	}, nil
+}
+
+// processDeploymentEvent is a helper function to process the deployment event.
+func (c *client) processDeploymentEvent(h *library.Hook, payload *gitlab.DeploymentEvent) (*types.Webhook, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"repo": payload.Project.PathWithNamespace,
+	}).Tracef("processing deployment GitLab webhook for %s", payload.Project.PathWithNamespace)
+
+	project := payload.Project
+
+	// update the hook object
+	h.SetEvent(constants.EventDeploy)
+	h.SetLink(hookLink(c.config.Address, project.PathWithNamespace))
+
+	// GitLab sends a webhook for every status change of a deployment
+	// so only the creation of the deployment triggers a build
+	if !strings.EqualFold(payload.Status, string(gitlab.DeploymentStatusRunning)) {
+		return &types.Webhook{Hook: h}, nil
+	}
+
+	// convert payload to library repo
+	//
+	// a visibility level of 20 is public in GitLab
+	r := toWebhookRepo(
+		project.PathWithNamespace,
+		project.WebURL,
+		project.GitHTTPURL,
+		project.DefaultBranch,
+		project.VisibilityLevel != 20,
+	)
+
+	// capture the commit from the commit URL in the payload
+	//
+	// pattern: <address>/<org>/<repo>/-/commit/<sha>
+	commit := payload.CommitURL[strings.LastIndex(payload.CommitURL, "/")+1:]
+
+	// convert payload to library build
+	b := new(library.Build)
+	b.SetEvent(constants.EventDeploy)
+	b.SetClone(project.GitHTTPURL)
+	b.SetDeploy(payload.Environment)
+	b.SetSource(c.deploymentURL(r.GetOrg(), r.GetName(), payload.DeploymentID))
+	b.SetTitle(fmt.Sprintf("%s received from %s", constants.EventDeploy, project.WebURL))
+	b.SetMessage(payload.CommitTitle)
+	b.SetCommit(commit)
+	b.SetBranch(payload.Ref)
+	b.SetRef(payload.Ref)
+
+	if payload.User != nil {
+		b.SetSender(payload.User.Username)
+		b.SetAuthor(payload.User.Username)
+		b.SetEmail(payload.User.Email)
+	}
+
+	// handle when the ref is a sha or short sha
+	if strings.HasPrefix(b.GetCommit(), b.GetRef()) || b.GetCommit() == b.GetRef() {
+		// set the proper branch for the build
+		b.SetBranch(r.GetBranch())
+		// set the proper ref for the build
+		b.SetRef(fmt.Sprintf("refs/heads/%s", b.GetBranch()))
+	}
+
+	// handle when the ref is a branch
+	if !strings.HasPrefix(b.GetRef(), "refs/") {
+		// set the proper ref for the build
+		b.SetRef(fmt.Sprintf("refs/heads/%s", b.GetBranch()))
+	}
+
+	h.SetBranch(b.GetBranch())
+
+	return &types.Webhook{
+		Comment: "",
+		Hook:    h,
+		Repo:    r,
+		Build:   b,
+	}, nil
+}
+
+// toWebhookRepo is a helper function to convert
+// the project from a webhook to a library repo.
+func toWebhookRepo(fullName, link, clone, branch string, private bool) *library.Repo {
+	org, name := splitPath(fullName)
+
+	r := new(library.Repo)
+	r.SetOrg(org)
+	r.SetName(name)
+	r.SetFullName(fullName)
+	r.SetLink(link)
+	r.SetClone(clone)
+	r.SetBranch(branch)
+	r.SetPrivate(private)
+
+	return r
+}
+
+// hookLink is a helper function to create the
+// link to the webhook settings for the GitLab repo.
+func hookLink(address, fullName string) string {
+	return fmt.Sprintf("%s/%s/-/hooks", address, fullName)
+}
+
+// hostname is a helper function to capture
+// the host from the provided GitLab address.
+func hostname(address string) string {
+	u, err := url.Parse(address)
+	if err != nil || len(u.Host) == 0 {
+		return address
+	}
+
+	return u.Host
+}
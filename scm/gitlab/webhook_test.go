@@ -0,0 +1,708 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-vela/types"
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+)
+
+func TestGitlab_ProcessWebhook_Push(t *testing.T) {
+	// setup router
+	s := httptest.NewServer(http.NotFoundHandler())
+	defer s.Close()
+
+	// setup request
+	body, err := os.Open("testdata/hooks/push.json")
+	if err != nil {
+		t.Errorf("unable to open file: %v", err)
+	}
+
+	defer body.Close()
+
+	request, _ := http.NewRequestWithContext(context.Background(), http.MethodPost, "/test", body)
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("X-Gitlab-Event", "Push Hook")
+	request.Header.Set("X-Gitlab-Event-UUID", "7bd477e4-4415-11e9-9359-0d41fdf9567e")
+	request.Header.Set("X-Gitlab-Instance", "https://gitlab.com")
+
+	// setup client
+	client, _ := NewTest(s.URL)
+
+	// run test
+	wantHook := new(library.Hook)
+	wantHook.SetNumber(1)
+	wantHook.SetSourceID("7bd477e4-4415-11e9-9359-0d41fdf9567e")
+	wantHook.SetCreated(time.Now().UTC().Unix())
+	wantHook.SetHost("gitlab.com")
+	wantHook.SetEvent("push")
+	wantHook.SetBranch("main")
+	wantHook.SetStatus(constants.StatusSuccess)
+	wantHook.SetLink(fmt.Sprintf("%s/octocat/Hello-World/-/hooks", s.URL))
+
+	wantRepo := new(library.Repo)
+	wantRepo.SetOrg("octocat")
+	wantRepo.SetName("Hello-World")
+	wantRepo.SetFullName("octocat/Hello-World")
+	wantRepo.SetLink("https://gitlab.com/octocat/Hello-World")
+	wantRepo.SetClone("https://gitlab.com/octocat/Hello-World.git")
+	wantRepo.SetBranch("main")
+	wantRepo.SetPrivate(false)
+
+	wantBuild := new(library.Build)
+	wantBuild.SetEvent("push")
+	wantBuild.SetClone("https://gitlab.com/octocat/Hello-World.git")
+	wantBuild.SetSource("https://gitlab.com/octocat/Hello-World/-/commit/a76aded1ad1c5a5d5a8b8e9b7b5e3d1c7f2a4b6c")
+	wantBuild.SetTitle("push received from https://gitlab.com/octocat/Hello-World")
+	wantBuild.SetMessage("Update README.md")
+	wantBuild.SetCommit("a76aded1ad1c5a5d5a8b8e9b7b5e3d1c7f2a4b6c")
+	wantBuild.SetSender("octocat")
+	wantBuild.SetAuthor("octocat")
+	wantBuild.SetEmail("octocat@github.com")
+	wantBuild.SetBranch("main")
+	wantBuild.SetRef("refs/heads/main")
+
+	want := &types.Webhook{
+		Comment: "",
+		Hook:    wantHook,
+		Repo:    wantRepo,
+		Build:   wantBuild,
+	}
+
+	got, err := client.ProcessWebhook(context.TODO(), request)
+
+	if err != nil {
+		t.Errorf("ProcessWebhook returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ProcessWebhook is %v, want %v", got, want)
+	}
+}
+
+func TestGitlab_ProcessWebhook_Tag(t *testing.T) {
+	// setup router
+	s := httptest.NewServer(http.NotFoundHandler())
+	defer s.Close()
+
+	// setup request
+	body, err := os.Open("testdata/hooks/tag.json")
+	if err != nil {
+		t.Errorf("unable to open file: %v", err)
+	}
+
+	defer body.Close()
+
+	request, _ := http.NewRequestWithContext(context.Background(), http.MethodPost, "/test", body)
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("X-Gitlab-Event", "Tag Push Hook")
+	request.Header.Set("X-Gitlab-Event-UUID", "7bd477e4-4415-11e9-9359-0d41fdf9567e")
+
+	// setup client
+	client, _ := NewTest(s.URL)
+
+	// run test
+	wantHook := new(library.Hook)
+	wantHook.SetNumber(1)
+	wantHook.SetSourceID("7bd477e4-4415-11e9-9359-0d41fdf9567e")
+	wantHook.SetCreated(time.Now().UTC().Unix())
+	wantHook.SetHost(strings.TrimPrefix(s.URL, "http://"))
+	wantHook.SetEvent("tag")
+	wantHook.SetBranch("v0.1.0")
+	wantHook.SetStatus(constants.StatusSuccess)
+	wantHook.SetLink(fmt.Sprintf("%s/octocat/Hello-World/-/hooks", s.URL))
+
+	wantRepo := new(library.Repo)
+	wantRepo.SetOrg("octocat")
+	wantRepo.SetName("Hello-World")
+	wantRepo.SetFullName("octocat/Hello-World")
+	wantRepo.SetLink("https://gitlab.com/octocat/Hello-World")
+	wantRepo.SetClone("https://gitlab.com/octocat/Hello-World.git")
+	wantRepo.SetBranch("main")
+	wantRepo.SetPrivate(true)
+
+	wantBuild := new(library.Build)
+	wantBuild.SetEvent("tag")
+	wantBuild.SetClone("https://gitlab.com/octocat/Hello-World.git")
+	wantBuild.SetSource("https://gitlab.com/octocat/Hello-World/-/commit/a76aded1ad1c5a5d5a8b8e9b7b5e3d1c7f2a4b6c")
+	wantBuild.SetTitle("tag received from https://gitlab.com/octocat/Hello-World")
+	wantBuild.SetMessage("Release v0.1.0")
+	wantBuild.SetCommit("a76aded1ad1c5a5d5a8b8e9b7b5e3d1c7f2a4b6c")
+	wantBuild.SetSender("octocat")
+	wantBuild.SetAuthor("octocat")
+	wantBuild.SetEmail("octocat@github.com")
+	wantBuild.SetBranch("v0.1.0")
+	wantBuild.SetRef("refs/tags/v0.1.0")
+
+	want := &types.Webhook{
+		Comment: "",
+		Hook:    wantHook,
+		Repo:    wantRepo,
+		Build:   wantBuild,
+	}
+
+	got, err := client.ProcessWebhook(context.TODO(), request)
+
+	if err != nil {
+		t.Errorf("ProcessWebhook returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ProcessWebhook is %v, want %v", got, want)
+	}
+}
+
+func TestGitlab_ProcessWebhook_MergeRequest(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name   string
+		file   string
+		action string
+	}{
+		{
+			name:   "opened",
+			file:   "testdata/hooks/merge_request.json",
+			action: "opened",
+		},
+		{
+			name:   "synchronize",
+			file:   "testdata/hooks/merge_request_synchronize.json",
+			action: "synchronize",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// setup router
+			s := httptest.NewServer(http.NotFoundHandler())
+			defer s.Close()
+
+			// setup request
+			body, err := os.Open(test.file)
+			if err != nil {
+				t.Errorf("unable to open file: %v", err)
+			}
+
+			defer body.Close()
+
+			request, _ := http.NewRequestWithContext(context.Background(), http.MethodPost, "/test", body)
+			request.Header.Set("Content-Type", "application/json")
+			request.Header.Set("X-Gitlab-Event", "Merge Request Hook")
+			request.Header.Set("X-Gitlab-Event-UUID", "7bd477e4-4415-11e9-9359-0d41fdf9567e")
+			request.Header.Set("X-Gitlab-Instance", "https://gitlab.com")
+
+			// setup client
+			client, _ := NewTest(s.URL)
+
+			// run test
+			wantHook := new(library.Hook)
+			wantHook.SetNumber(1)
+			wantHook.SetSourceID("7bd477e4-4415-11e9-9359-0d41fdf9567e")
+			wantHook.SetCreated(time.Now().UTC().Unix())
+			wantHook.SetHost("gitlab.com")
+			wantHook.SetEvent("pull_request")
+			wantHook.SetBranch("main")
+			wantHook.SetStatus(constants.StatusSuccess)
+			wantHook.SetLink(fmt.Sprintf("%s/octocat/Hello-World/-/hooks", s.URL))
+
+			wantRepo := new(library.Repo)
+			wantRepo.SetOrg("octocat")
+			wantRepo.SetName("Hello-World")
+			wantRepo.SetFullName("octocat/Hello-World")
+			wantRepo.SetLink("https://gitlab.com/octocat/Hello-World")
+			wantRepo.SetClone("https://gitlab.com/octocat/Hello-World.git")
+			wantRepo.SetBranch("main")
+			wantRepo.SetPrivate(false)
+
+			wantBuild := new(library.Build)
+			wantBuild.SetEvent("pull_request")
+			wantBuild.SetEventAction(test.action)
+			wantBuild.SetClone("https://gitlab.com/octocat/Hello-World.git")
+			wantBuild.SetSource("https://gitlab.com/octocat/Hello-World/-/merge_requests/1")
+			wantBuild.SetTitle("pull_request received from https://gitlab.com/octocat/Hello-World")
+			wantBuild.SetMessage("Update README.md")
+			wantBuild.SetCommit("a76aded1ad1c5a5d5a8b8e9b7b5e3d1c7f2a4b6c")
+			wantBuild.SetSender("octocat")
+			wantBuild.SetAuthor("octocat")
+			wantBuild.SetEmail("octocat@github.com")
+			wantBuild.SetBranch("main")
+			wantBuild.SetRef("refs/merge-requests/1/head")
+			wantBuild.SetBaseRef("main")
+			wantBuild.SetHeadRef("changes")
+
+			want := &types.Webhook{
+				Comment:  "",
+				PRNumber: 1,
+				Hook:     wantHook,
+				Repo:     wantRepo,
+				Build:    wantBuild,
+			}
+
+			got, err := client.ProcessWebhook(context.TODO(), request)
+
+			if err != nil {
+				t.Errorf("ProcessWebhook returned err: %v", err)
+			}
+
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("ProcessWebhook is %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestGitlab_ProcessWebhook_MergeRequest_Skipped(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name string
+		file string
+	}{
+		{
+			name: "closed",
+			file: "testdata/hooks/merge_request_closed.json",
+		},
+		{
+			name: "update without commits",
+			file: "testdata/hooks/merge_request_update.json",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// setup router
+			s := httptest.NewServer(http.NotFoundHandler())
+			defer s.Close()
+
+			// setup request
+			body, err := os.Open(test.file)
+			if err != nil {
+				t.Errorf("unable to open file: %v", err)
+			}
+
+			defer body.Close()
+
+			request, _ := http.NewRequestWithContext(context.Background(), http.MethodPost, "/test", body)
+			request.Header.Set("Content-Type", "application/json")
+			request.Header.Set("X-Gitlab-Event", "Merge Request Hook")
+			request.Header.Set("X-Gitlab-Event-UUID", "7bd477e4-4415-11e9-9359-0d41fdf9567e")
+			request.Header.Set("X-Gitlab-Instance", "https://gitlab.com")
+
+			// setup client
+			client, _ := NewTest(s.URL)
+
+			// run test
+			wantHook := new(library.Hook)
+			wantHook.SetNumber(1)
+			wantHook.SetSourceID("7bd477e4-4415-11e9-9359-0d41fdf9567e")
+			wantHook.SetCreated(time.Now().UTC().Unix())
+			wantHook.SetHost("gitlab.com")
+			wantHook.SetEvent("pull_request")
+			wantHook.SetBranch("main")
+			wantHook.SetStatus(constants.StatusSuccess)
+			wantHook.SetLink(fmt.Sprintf("%s/octocat/Hello-World/-/hooks", s.URL))
+
+			want := &types.Webhook{Hook: wantHook}
+
+			got, err := client.ProcessWebhook(context.TODO(), request)
+
+			if err != nil {
+				t.Errorf("ProcessWebhook returned err: %v", err)
+			}
+
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("ProcessWebhook is %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestGitlab_ProcessWebhook_Note_MergeRequest(t *testing.T) {
+	// setup router
+	s := httptest.NewServer(http.NotFoundHandler())
+	defer s.Close()
+
+	// setup request
+	body, err := os.Open("testdata/hooks/note_merge_request.json")
+	if err != nil {
+		t.Errorf("unable to open file: %v", err)
+	}
+
+	defer body.Close()
+
+	request, _ := http.NewRequestWithContext(context.Background(), http.MethodPost, "/test", body)
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("X-Gitlab-Event", "Note Hook")
+	request.Header.Set("X-Gitlab-Event-UUID", "7bd477e4-4415-11e9-9359-0d41fdf9567e")
+	request.Header.Set("X-Gitlab-Instance", "https://gitlab.com")
+
+	// setup client
+	client, _ := NewTest(s.URL)
+
+	// run test
+	wantHook := new(library.Hook)
+	wantHook.SetNumber(1)
+	wantHook.SetSourceID("7bd477e4-4415-11e9-9359-0d41fdf9567e")
+	wantHook.SetCreated(time.Now().UTC().Unix())
+	wantHook.SetHost("gitlab.com")
+	wantHook.SetEvent("comment")
+	wantHook.SetStatus(constants.StatusSuccess)
+	wantHook.SetLink(fmt.Sprintf("%s/octocat/Hello-World/-/hooks", s.URL))
+
+	wantRepo := new(library.Repo)
+	wantRepo.SetOrg("octocat")
+	wantRepo.SetName("Hello-World")
+	wantRepo.SetFullName("octocat/Hello-World")
+	wantRepo.SetLink("https://gitlab.com/octocat/Hello-World")
+	wantRepo.SetClone("https://gitlab.com/octocat/Hello-World.git")
+	wantRepo.SetBranch("main")
+	wantRepo.SetPrivate(false)
+
+	wantBuild := new(library.Build)
+	wantBuild.SetEvent("comment")
+	wantBuild.SetEventAction("created")
+	wantBuild.SetClone("https://gitlab.com/octocat/Hello-World.git")
+	wantBuild.SetSource("https://gitlab.com/octocat/Hello-World/-/merge_requests/1#note_1244")
+	wantBuild.SetTitle("comment received from https://gitlab.com/octocat/Hello-World")
+	wantBuild.SetMessage("Update README.md")
+	wantBuild.SetSender("octocat")
+	wantBuild.SetAuthor("octocat")
+	wantBuild.SetEmail("octocat@github.com")
+	wantBuild.SetRef("refs/merge-requests/1/head")
+
+	want := &types.Webhook{
+		Comment:  "/vela restart",
+		PRNumber: 1,
+		Hook:     wantHook,
+		Repo:     wantRepo,
+		Build:    wantBuild,
+	}
+
+	got, err := client.ProcessWebhook(context.TODO(), request)
+
+	if err != nil {
+		t.Errorf("ProcessWebhook returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ProcessWebhook is %v, want %v", got, want)
+	}
+}
+
+func TestGitlab_ProcessWebhook_Note_Issue(t *testing.T) {
+	// setup router
+	s := httptest.NewServer(http.NotFoundHandler())
+	defer s.Close()
+
+	// setup request
+	body, err := os.Open("testdata/hooks/note_issue.json")
+	if err != nil {
+		t.Errorf("unable to open file: %v", err)
+	}
+
+	defer body.Close()
+
+	request, _ := http.NewRequestWithContext(context.Background(), http.MethodPost, "/test", body)
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("X-Gitlab-Event", "Note Hook")
+	request.Header.Set("X-Gitlab-Event-UUID", "7bd477e4-4415-11e9-9359-0d41fdf9567e")
+	request.Header.Set("X-Gitlab-Instance", "https://gitlab.com")
+
+	// setup client
+	client, _ := NewTest(s.URL)
+
+	// run test
+	wantHook := new(library.Hook)
+	wantHook.SetNumber(1)
+	wantHook.SetSourceID("7bd477e4-4415-11e9-9359-0d41fdf9567e")
+	wantHook.SetCreated(time.Now().UTC().Unix())
+	wantHook.SetHost("gitlab.com")
+	wantHook.SetEvent("comment")
+	wantHook.SetStatus(constants.StatusSuccess)
+	wantHook.SetLink(fmt.Sprintf("%s/octocat/Hello-World/-/hooks", s.URL))
+
+	wantRepo := new(library.Repo)
+	wantRepo.SetOrg("octocat")
+	wantRepo.SetName("Hello-World")
+	wantRepo.SetFullName("octocat/Hello-World")
+	wantRepo.SetLink("https://gitlab.com/octocat/Hello-World")
+	wantRepo.SetClone("https://gitlab.com/octocat/Hello-World.git")
+	wantRepo.SetBranch("main")
+	wantRepo.SetPrivate(false)
+
+	wantBuild := new(library.Build)
+	wantBuild.SetEvent("comment")
+	wantBuild.SetEventAction("created")
+	wantBuild.SetClone("https://gitlab.com/octocat/Hello-World.git")
+	wantBuild.SetSource("https://gitlab.com/octocat/Hello-World/-/issues/17#note_1241")
+	wantBuild.SetTitle("comment received from https://gitlab.com/octocat/Hello-World")
+	wantBuild.SetMessage("Spelling error in the README file")
+	wantBuild.SetSender("octocat")
+	wantBuild.SetAuthor("octocat")
+	wantBuild.SetEmail("octocat@github.com")
+	wantBuild.SetRef("refs/heads/main")
+
+	want := &types.Webhook{
+		Comment: "ok to test",
+		Hook:    wantHook,
+		Repo:    wantRepo,
+		Build:   wantBuild,
+	}
+
+	got, err := client.ProcessWebhook(context.TODO(), request)
+
+	if err != nil {
+		t.Errorf("ProcessWebhook returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ProcessWebhook is %v, want %v", got, want)
+	}
+}
+
+func TestGitlab_ProcessWebhook_Deployment(t *testing.T) {
+	// setup router
+	s := httptest.NewServer(http.NotFoundHandler())
+	defer s.Close()
+
+	// setup request
+	body, err := os.Open("testdata/hooks/deployment.json")
+	if err != nil {
+		t.Errorf("unable to open file: %v", err)
+	}
+
+	defer body.Close()
+
+	request, _ := http.NewRequestWithContext(context.Background(), http.MethodPost, "/test", body)
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("X-Gitlab-Event", "Deployment Hook")
+	request.Header.Set("X-Gitlab-Event-UUID", "7bd477e4-4415-11e9-9359-0d41fdf9567e")
+	request.Header.Set("X-Gitlab-Instance", "https://gitlab.com")
+
+	// setup client
+	client, _ := NewTest(s.URL)
+
+	// run test
+	wantHook := new(library.Hook)
+	wantHook.SetNumber(1)
+	wantHook.SetSourceID("7bd477e4-4415-11e9-9359-0d41fdf9567e")
+	wantHook.SetCreated(time.Now().UTC().Unix())
+	wantHook.SetHost("gitlab.com")
+	wantHook.SetEvent("deployment")
+	wantHook.SetBranch("main")
+	wantHook.SetStatus(constants.StatusSuccess)
+	wantHook.SetLink(fmt.Sprintf("%s/octocat/Hello-World/-/hooks", s.URL))
+
+	wantRepo := new(library.Repo)
+	wantRepo.SetOrg("octocat")
+	wantRepo.SetName("Hello-World")
+	wantRepo.SetFullName("octocat/Hello-World")
+	wantRepo.SetLink("https://gitlab.com/octocat/Hello-World")
+	wantRepo.SetClone("https://gitlab.com/octocat/Hello-World.git")
+	wantRepo.SetBranch("main")
+	wantRepo.SetPrivate(false)
+
+	wantBuild := new(library.Build)
+	wantBuild.SetEvent("deployment")
+	wantBuild.SetClone("https://gitlab.com/octocat/Hello-World.git")
+	wantBuild.SetDeploy("production")
+	wantBuild.SetSource(fmt.Sprintf("%s/api/v4/projects/octocat%%2FHello-World/deployments/15", s.URL))
+	wantBuild.SetTitle("deployment received from https://gitlab.com/octocat/Hello-World")
+	wantBuild.SetMessage("Update README.md")
+	wantBuild.SetCommit("a76aded1ad1c5a5d5a8b8e9b7b5e3d1c7f2a4b6c")
+	wantBuild.SetSender("octocat")
+	wantBuild.SetAuthor("octocat")
+	wantBuild.SetEmail("octocat@github.com")
+	wantBuild.SetBranch("main")
+	wantBuild.SetRef("refs/heads/main")
+
+	want := &types.Webhook{
+		Comment: "",
+		Hook:    wantHook,
+		Repo:    wantRepo,
+		Build:   wantBuild,
+	}
+
+	got, err := client.ProcessWebhook(context.TODO(), request)
+
+	if err != nil {
+		t.Errorf("ProcessWebhook returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ProcessWebhook is %v, want %v", got, want)
+	}
+}
+
+func TestGitlab_ProcessWebhook_Deployment_StatusChange(t *testing.T) {
+	// setup router
+	s := httptest.NewServer(http.NotFoundHandler())
+	defer s.Close()
+
+	// setup request
+	body, err := os.Open("testdata/hooks/deployment_success.json")
+	if err != nil {
+		t.Errorf("unable to open file: %v", err)
+	}
+
+	defer body.Close()
+
+	request, _ := http.NewRequestWithContext(context.Background(), http.MethodPost, "/test", body)
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("X-Gitlab-Event", "Deployment Hook")
+	request.Header.Set("X-Gitlab-Event-UUID", "7bd477e4-4415-11e9-9359-0d41fdf9567e")
+	request.Header.Set("X-Gitlab-Instance", "https://gitlab.com")
+
+	// setup client
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got, err := client.ProcessWebhook(context.TODO(), request)
+
+	if err != nil {
+		t.Errorf("ProcessWebhook returned err: %v", err)
+	}
+
+	if got.Build != nil {
+		t.Errorf("ProcessWebhook Build is %v, want nil", got.Build)
+	}
+
+	if got.Hook.GetEvent() != constants.EventDeploy {
+		t.Errorf("ProcessWebhook Hook event is %v, want %v", got.Hook.GetEvent(), constants.EventDeploy)
+	}
+}
+
+func TestGitlab_ProcessWebhook_BadGitlabEvent(t *testing.T) {
+	// setup router
+	s := httptest.NewServer(http.NotFoundHandler())
+	defer s.Close()
+
+	// setup request
+	body, err := os.Open("testdata/hooks/push.json")
+	if err != nil {
+		t.Errorf("unable to open file: %v", err)
+	}
+
+	defer body.Close()
+
+	request, _ := http.NewRequestWithContext(context.Background(), http.MethodPost, "/test", body)
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("X-Gitlab-Event", "Foo Hook")
+	request.Header.Set("X-Gitlab-Event-UUID", "7bd477e4-4415-11e9-9359-0d41fdf9567e")
+	request.Header.Set("X-Gitlab-Instance", "https://gitlab.com")
+
+	// setup client
+	client, _ := NewTest(s.URL)
+
+	// run test
+	wantHook := new(library.Hook)
+	wantHook.SetNumber(1)
+	wantHook.SetSourceID("7bd477e4-4415-11e9-9359-0d41fdf9567e")
+	wantHook.SetCreated(time.Now().UTC().Unix())
+	wantHook.SetHost("gitlab.com")
+	wantHook.SetEvent("Foo Hook")
+	wantHook.SetStatus(constants.StatusSuccess)
+
+	want := &types.Webhook{Hook: wantHook}
+
+	got, err := client.ProcessWebhook(context.TODO(), request)
+
+	if err != nil {
+		t.Errorf("ProcessWebhook returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ProcessWebhook is %v, want %v", got, want)
+	}
+}
+
+func TestGitlab_VerifyWebhook(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name    string
+		failure bool
+		token   string
+	}{
+		{
+			name:    "valid",
+			failure: false,
+			token:   "secret",
+		},
+		{
+			name:    "invalid",
+			failure: true,
+			token:   "foobar",
+		},
+		{
+			name:    "missing",
+			failure: true,
+			token:   "",
+		},
+	}
+
+	// setup types
+	r := new(library.Repo)
+	r.SetOrg("octocat")
+	r.SetName("Hello-World")
+	r.SetFullName("octocat/Hello-World")
+	r.SetHash("secret")
+
+	client, _ := NewTest("https://gitlab.example.com")
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			request, _ := http.NewRequestWithContext(context.Background(), http.MethodPost, "/test", nil)
+			request.Header.Set("X-Gitlab-Token", test.token)
+
+			err := client.VerifyWebhook(context.TODO(), request, r)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("VerifyWebhook should have returned err")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("VerifyWebhook returned err: %v", err)
+			}
+		})
+	}
+}
+
+func TestGitlab_RedeliverWebhook(t *testing.T) {
+	// setup types
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	r := new(library.Repo)
+	r.SetOrg("octocat")
+	r.SetName("Hello-World")
+
+	h := new(library.Hook)
+	h.SetSourceID("7bd477e4-4415-11e9-9359-0d41fdf9567e")
+
+	client, _ := NewTest("https://gitlab.example.com")
+
+	// run test
+	err := client.RedeliverWebhook(context.TODO(), u, r, h)
+
+	if err == nil {
+		t.Errorf("RedeliverWebhook should have returned err")
+	}
+}
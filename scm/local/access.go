@@ -0,0 +1,174 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package local
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/go-vela/types/library"
+)
+
+// OrgAccess captures the user's access level for an org.
+func (c *client) OrgAccess(ctx context.Context, u *library.User, org string) (string, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  org,
+		"user": u.GetName(),
+	}).Tracef("capturing %s access level to org %s", u.GetName(), org)
+
+	// check if user is accessing personal org
+	if strings.EqualFold(org, u.GetName()) {
+		c.Logger.WithFields(logrus.Fields{
+			"org":  org,
+			"user": u.GetName(),
+		}).Debugf("skipping access level check for user %s with org %s", u.GetName(), org)
+
+		//nolint:goconst // ignore making constant
+		return "admin", nil
+	}
+
+	user := c.userForName(u.GetName())
+	if user == nil {
+		return "", fmt.Errorf("user %s not found", u.GetName())
+	}
+
+	if user.Admin {
+		return "admin", nil
+	}
+
+	return user.Orgs[org], nil
+}
+
+// RepoAccess captures the user's access level for a repo.
+func (c *client) RepoAccess(ctx context.Context, u *library.User, token, org, repo string) (string, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  org,
+		"repo": repo,
+		"user": u.GetName(),
+	}).Tracef("capturing %s access level to repo %s/%s", u.GetName(), org, repo)
+
+	// check if user is accessing personal repo
+	if strings.EqualFold(org, u.GetName()) {
+		c.Logger.WithFields(logrus.Fields{
+			"org":  org,
+			"repo": repo,
+			"user": u.GetName(),
+		}).Debugf("skipping access level check for user %s with repo %s/%s", u.GetName(), org, repo)
+
+		return "admin", nil
+	}
+
+	// capture the user for the token provided
+	user := c.userForToken(token)
+	if user == nil {
+		return "", fmt.Errorf("unable to capture access level to repo %s/%s: invalid token", org, repo)
+	}
+
+	return repoPermission(user, org, repo), nil
+}
+
+// RepoInstalled checks if the scm acts on a repo
+// with an app installation instead of a user's token.
+func (c *client) RepoInstalled(ctx context.Context, org, repo string) bool {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  org,
+		"repo": repo,
+	}).Tracef("checking app installation for repo %s/%s", org, repo)
+
+	return false
+}
+
+// TeamAccess captures the user's access level for a team.
+func (c *client) TeamAccess(ctx context.Context, u *library.User, org, team string) (string, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  org,
+		"team": team,
+		"user": u.GetName(),
+	}).Tracef("capturing %s access level to team %s/%s", u.GetName(), org, team)
+
+	// check if user is accessing team in personal org
+	if strings.EqualFold(org, u.GetName()) {
+		c.Logger.WithFields(logrus.Fields{
+			"org":  org,
+			"team": team,
+			"user": u.GetName(),
+		}).Debugf("skipping access level check for user %s with team %s/%s", u.GetName(), org, team)
+
+		return "admin", nil
+	}
+
+	// iterate through each member of the team
+	for _, member := range c.config.Static.Teams[fmt.Sprintf("%s/%s", org, team)] {
+		// return admin access if the user is a part of that team
+		if strings.EqualFold(member, u.GetName()) {
+			return "admin", nil
+		}
+	}
+
+	return "", nil
+}
+
+// ListUsersTeamsForOrg captures the user's teams for an org.
+func (c *client) ListUsersTeamsForOrg(ctx context.Context, u *library.User, org string) ([]string, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  org,
+		"user": u.GetName(),
+	}).Tracef("capturing %s team membership for org %s", u.GetName(), org)
+
+	var userTeams []string
+
+	// iterate through each team in the static configuration
+	for key, members := range c.config.Static.Teams {
+		teamOrg, team, found := strings.Cut(key, "/")
+
+		// skip the team if it does not belong to the org we are checking
+		if !found || !strings.EqualFold(org, teamOrg) {
+			continue
+		}
+
+		for _, member := range members {
+			if strings.EqualFold(member, u.GetName()) {
+				userTeams = append(userTeams, team)
+
+				break
+			}
+		}
+	}
+
+	// sort the teams since they are captured from a map
+	sort.Strings(userTeams)
+
+	return userTeams, nil
+}
+
+// repoPermission is a helper function to capture
+// the access level of the static user for a repo.
+func repoPermission(u *StaticUser, org, repo string) string {
+	if u.Admin || strings.EqualFold(org, u.Name) {
+		return "admin"
+	}
+
+	// check for access to the repo
+	if perm, ok := u.Repos[fmt.Sprintf("%s/%s", org, repo)]; ok {
+		return perm
+	}
+
+	// check for access to every repo in the org
+	if perm, ok := u.Repos[fmt.Sprintf("%s/*", org)]; ok {
+		return perm
+	}
+
+	// fall back to access from the org membership
+	switch u.Orgs[org] {
+	case "admin":
+		return "admin"
+	case "member":
+		return "read"
+	default:
+		return "none"
+	}
+}
@@ -0,0 +1,159 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package local
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/go-vela/types/library"
+)
+
+func TestLocal_OrgAccess(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		user    string
+		org     string
+		want    string
+	}{
+		{failure: false, user: "octocat", org: "octocat", want: "admin"},
+		{failure: false, user: "octocat", org: "github", want: "member"},
+		{failure: false, user: "octocat", org: "go-vela", want: ""},
+		{failure: false, user: "vela", org: "github", want: "admin"},
+		{failure: true, user: "foo", org: "github", want: ""},
+	}
+
+	client, _ := NewTest(t.TempDir(), "testdata/static.yml")
+
+	// run tests
+	for _, test := range tests {
+		u := new(library.User)
+		u.SetName(test.user)
+
+		got, err := client.OrgAccess(context.TODO(), u, test.org)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("OrgAccess for %s should have returned err", test.user)
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("OrgAccess for %s returned err: %v", test.user, err)
+		}
+
+		if got != test.want {
+			t.Errorf("OrgAccess for %s in %s is %v, want %v", test.user, test.org, got, test.want)
+		}
+	}
+}
+
+func TestLocal_RepoAccess(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		user    string
+		token   string
+		org     string
+		repo    string
+		want    string
+	}{
+		{failure: false, user: "octocat", token: "foo", org: "octocat", repo: "hello-world", want: "admin"},
+		{failure: false, user: "octocat", token: "foo", org: "github", repo: "octocat", want: "admin"},
+		{failure: false, user: "octocat", token: "foo", org: "github", repo: "hello-world", want: "read"},
+		{failure: false, user: "octocat", token: "foo", org: "go-vela", repo: "server", want: "none"},
+		{failure: false, user: "vela", token: "bar", org: "go-vela", repo: "server", want: "admin"},
+		{failure: true, user: "octocat", token: "baz", org: "github", repo: "octocat", want: ""},
+	}
+
+	client, _ := NewTest(t.TempDir(), "testdata/static.yml")
+
+	// run tests
+	for _, test := range tests {
+		u := new(library.User)
+		u.SetName(test.user)
+
+		got, err := client.RepoAccess(context.TODO(), u, test.token, test.org, test.repo)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("RepoAccess for %s/%s should have returned err", test.org, test.repo)
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("RepoAccess for %s/%s returned err: %v", test.org, test.repo, err)
+		}
+
+		if got != test.want {
+			t.Errorf("RepoAccess for %s/%s is %v, want %v", test.org, test.repo, got, test.want)
+		}
+	}
+}
+
+func TestLocal_RepoInstalled(t *testing.T) {
+	client, _ := NewTest(t.TempDir(), "testdata/static.yml")
+
+	// run test
+	got := client.RepoInstalled(context.TODO(), "github", "octocat")
+
+	if got {
+		t.Errorf("RepoInstalled is %v, want false", got)
+	}
+}
+
+func TestLocal_TeamAccess(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		user string
+		team string
+		want string
+	}{
+		{user: "octocat", team: "octokitties", want: "admin"},
+		{user: "octocat", team: "admins", want: ""},
+		{user: "vela", team: "octokitties", want: ""},
+	}
+
+	client, _ := NewTest(t.TempDir(), "testdata/static.yml")
+
+	// run tests
+	for _, test := range tests {
+		u := new(library.User)
+		u.SetName(test.user)
+
+		got, err := client.TeamAccess(context.TODO(), u, "github", test.team)
+		if err != nil {
+			t.Errorf("TeamAccess returned err: %v", err)
+		}
+
+		if got != test.want {
+			t.Errorf("TeamAccess for %s in %s is %v, want %v", test.user, test.team, got, test.want)
+		}
+	}
+}
+
+func TestLocal_ListUsersTeamsForOrg(t *testing.T) {
+	// setup types
+	u := new(library.User)
+	u.SetName("octocat")
+
+	want := []string{"octokitties"}
+
+	client, _ := NewTest(t.TempDir(), "testdata/static.yml")
+
+	// run test
+	got, err := client.ListUsersTeamsForOrg(context.TODO(), u, "github")
+	if err != nil {
+		t.Errorf("ListUsersTeamsForOrg returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ListUsersTeamsForOrg is %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,92 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package local
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/go-vela/types/library"
+)
+
+// Authorize uses the given access token to authorize the user.
+func (c *client) Authorize(ctx context.Context, token string) (string, error) {
+	c.Logger.Trace("authorizing user with token")
+
+	// capture the user for the token from the static configuration
+	u := c.userForToken(token)
+	if u == nil {
+		return "", errors.New("invalid token provided")
+	}
+
+	return u.Name, nil
+}
+
+// Login begins the authentication workflow for the session.
+//
+// The local repositories have no OAuth provider so users
+// complete the workflow by providing their token as the
+// code when authenticating, e.g. /authenticate?code=<token>.
+func (c *client) Login(ctx context.Context, w http.ResponseWriter, r *http.Request) (string, error) {
+	c.Logger.Trace("processing login request")
+
+	return "", fmt.Errorf("OAuth login is not supported by the %s scm driver: provide a token as the code to authenticate", c.Driver())
+}
+
+// Authenticate completes the authentication workflow for the session
+// and returns the remote user details.
+//
+// The code provided is expected to be the user's token
+// from the static configuration file.
+func (c *client) Authenticate(ctx context.Context, w http.ResponseWriter, r *http.Request, oAuthState string) (*library.User, error) {
+	c.Logger.Trace("authenticating user")
+
+	// get the token provided as the code
+	token := r.FormValue("code")
+	if len(token) == 0 {
+		return nil, nil
+	}
+
+	// authorize the user for the token
+	u, err := c.Authorize(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	return &library.User{
+		Name:  &u,
+		Token: &token,
+	}, nil
+}
+
+// AuthenticateToken completes the authentication workflow
+// for the session and returns the remote user details.
+func (c *client) AuthenticateToken(ctx context.Context, r *http.Request) (*library.User, error) {
+	c.Logger.Trace("authenticating user via token")
+
+	token := r.Header.Get("Token")
+	if len(token) == 0 {
+		return nil, errors.New("no token provided")
+	}
+
+	u, err := c.Authorize(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	return &library.User{
+		Name:  &u,
+		Token: &token,
+	}, nil
+}
+
+// ValidateOAuthToken takes a user oauth integration token and
+// validates that it was created by the Vela OAuth application.
+//
+// The local repositories have no OAuth provider so
+// tokens are never reported as created by Vela.
+func (c *client) ValidateOAuthToken(ctx context.Context, token string) (bool, error) {
+	return false, nil
+}
@@ -0,0 +1,125 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package local
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestLocal_Authorize(t *testing.T) {
+	client, _ := NewTest(t.TempDir(), "testdata/static.yml")
+
+	// run tests
+	got, err := client.Authorize(context.TODO(), "foo")
+	if err != nil {
+		t.Errorf("Authorize returned err: %v", err)
+	}
+
+	if got != "octocat" {
+		t.Errorf("Authorize is %v, want %v", got, "octocat")
+	}
+
+	_, err = client.Authorize(context.TODO(), "baz")
+	if err == nil {
+		t.Errorf("Authorize should have returned err")
+	}
+}
+
+func TestLocal_Login(t *testing.T) {
+	// setup request
+	r := httptest.NewRequest(http.MethodGet, "/login", nil)
+	w := httptest.NewRecorder()
+
+	client, _ := NewTest(t.TempDir(), "testdata/static.yml")
+
+	// run test
+	_, err := client.Login(context.TODO(), w, r)
+	if err == nil {
+		t.Errorf("Login should have returned err")
+	}
+}
+
+func TestLocal_Authenticate(t *testing.T) {
+	client, _ := NewTest(t.TempDir(), "testdata/static.yml")
+
+	// setup tests
+	tests := []struct {
+		failure bool
+		code    string
+		want    string
+	}{
+		{failure: false, code: "foo", want: "octocat"},
+		{failure: false, code: "", want: ""},
+		{failure: true, code: "baz", want: ""},
+	}
+
+	// run tests
+	for _, test := range tests {
+		r := httptest.NewRequest(http.MethodGet, "/authenticate?state=bar&code="+test.code, nil)
+		w := httptest.NewRecorder()
+
+		got, err := client.Authenticate(context.TODO(), w, r, "bar")
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("Authenticate for %s should have returned err", test.code)
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("Authenticate for %s returned err: %v", test.code, err)
+		}
+
+		if got.GetName() != test.want {
+			t.Errorf("Authenticate for %s is %v, want %v", test.code, got.GetName(), test.want)
+		}
+
+		if len(test.want) > 0 && got.GetToken() != test.code {
+			t.Errorf("Authenticate token for %s is %v, want %v", test.code, got.GetToken(), test.code)
+		}
+	}
+}
+
+func TestLocal_AuthenticateToken(t *testing.T) {
+	client, _ := NewTest(t.TempDir(), "testdata/static.yml")
+
+	// setup tests
+	tests := []struct {
+		failure bool
+		token   string
+		want    string
+	}{
+		{failure: false, token: "bar", want: "vela"},
+		{failure: true, token: "", want: ""},
+		{failure: true, token: "baz", want: ""},
+	}
+
+	// run tests
+	for _, test := range tests {
+		r := httptest.NewRequest(http.MethodPost, "/authenticate/token", nil)
+		r.Header.Set("Token", test.token)
+
+		got, err := client.AuthenticateToken(context.TODO(), r)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("AuthenticateToken for %s should have returned err", test.token)
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("AuthenticateToken for %s returned err: %v", test.token, err)
+		}
+
+		if got.GetName() != test.want {
+			t.Errorf("AuthenticateToken for %s is %v, want %v", test.token, got.GetName(), test.want)
+		}
+	}
+}
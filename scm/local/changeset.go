@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package local
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/go-vela/types/library"
+)
+
+// Changeset captures the list of files changed for a commit.
+func (c *client) Changeset(ctx context.Context, u *library.User, r *library.Repo, sha string) ([]string, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("capturing commit changeset for %s/commit/%s", r.GetFullName(), sha)
+
+	err := validRef(sha)
+	if err != nil {
+		return nil, err
+	}
+
+	// capture the files changed by the commit including the root commit
+	out, err := c.git(ctx, r.GetOrg(), r.GetName(), "diff-tree", "--no-commit-id", "--name-only", "-r", "--root", sha)
+	if err != nil {
+		return nil, fmt.Errorf("unable to capture changeset for %s: %w", sha, err)
+	}
+
+	return lines(out), nil
+}
+
+// ChangesetPR captures the list of files changed for a pull request.
+//
+// Pull requests are read from refs/pull/<number>/head and compared
+// against the point they diverged from the default branch of the repo.
+func (c *client) ChangesetPR(ctx context.Context, u *library.User, r *library.Repo, number int) ([]string, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("capturing pull request changeset for %s/pulls/%d", r.GetFullName(), number)
+
+	branch, err := c.defaultBranch(ctx, r.GetOrg(), r.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	// capture the files changed since the pull request diverged from the default branch
+	out, err := c.git(ctx, r.GetOrg(), r.GetName(), "diff", "--name-only", fmt.Sprintf("refs/heads/%s...%s", branch, pullRef(number)))
+	if err != nil {
+		return nil, fmt.Errorf("unable to capture changeset for pull request %d: %w", number, err)
+	}
+
+	return lines(out), nil
+}
+
+// CommitMessage captures the message for a commit.
+func (c *client) CommitMessage(ctx context.Context, u *library.User, r *library.Repo, sha string) (string, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("capturing commit message for %s/commit/%s", r.GetFullName(), sha)
+
+	err := validRef(sha)
+	if err != nil {
+		return "", err
+	}
+
+	// capture the full message for the commit
+	out, err := c.git(ctx, r.GetOrg(), r.GetName(), "log", "-1", "--format=%B", sha)
+	if err != nil {
+		return "", fmt.Errorf("unable to capture message for %s: %w", sha, err)
+	}
+
+	return strings.TrimSpace(out), nil
+}
@@ -0,0 +1,135 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package local
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/go-vela/types/library"
+)
+
+func TestLocal_Changeset(t *testing.T) {
+	// setup types
+	root, commits := testRepos(t)
+
+	u := new(library.User)
+	u.SetName("octocat")
+
+	r := new(library.Repo)
+	r.SetOrg("github")
+	r.SetName("octocat")
+
+	client, _ := NewTest(root, "testdata/static.yml")
+
+	// setup tests
+	tests := []struct {
+		failure bool
+		sha     string
+		want    []string
+	}{
+		{failure: false, sha: commits["initial"], want: []string{".vela.yml", "README.md"}},
+		{failure: false, sha: commits["main"], want: []string{"README.md"}},
+		{failure: true, sha: "--output=foo", want: nil},
+		{failure: true, sha: "0000000000000000000000000000000000000001", want: nil},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got, err := client.Changeset(context.TODO(), u, r, test.sha)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("Changeset for %s should have returned err", test.sha)
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("Changeset for %s returned err: %v", test.sha, err)
+		}
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("Changeset for %s is %v, want %v", test.sha, got, test.want)
+		}
+	}
+}
+
+func TestLocal_ChangesetPR(t *testing.T) {
+	// setup types
+	root, _ := testRepos(t)
+
+	u := new(library.User)
+	u.SetName("octocat")
+
+	r := new(library.Repo)
+	r.SetOrg("github")
+	r.SetName("octocat")
+
+	want := []string{"foo.txt"}
+
+	client, _ := NewTest(root, "testdata/static.yml")
+
+	// run test
+	got, err := client.ChangesetPR(context.TODO(), u, r, 1)
+	if err != nil {
+		t.Errorf("ChangesetPR returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ChangesetPR is %v, want %v", got, want)
+	}
+
+	_, err = client.ChangesetPR(context.TODO(), u, r, 2)
+	if err == nil {
+		t.Errorf("ChangesetPR should have returned err")
+	}
+}
+
+func TestLocal_CommitMessage(t *testing.T) {
+	// setup types
+	root, commits := testRepos(t)
+
+	u := new(library.User)
+	u.SetName("octocat")
+
+	r := new(library.Repo)
+	r.SetOrg("github")
+	r.SetName("octocat")
+
+	client, _ := NewTest(root, "testdata/static.yml")
+
+	// setup tests
+	tests := []struct {
+		failure bool
+		sha     string
+		want    string
+	}{
+		{failure: false, sha: commits["main"], want: "update readme"},
+		{failure: false, sha: commits["pull"], want: "add foo"},
+		{failure: true, sha: "--output=foo", want: ""},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got, err := client.CommitMessage(context.TODO(), u, r, test.sha)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("CommitMessage for %s should have returned err", test.sha)
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("CommitMessage for %s returned err: %v", test.sha, err)
+		}
+
+		if got != test.want {
+			t.Errorf("CommitMessage for %s is %v, want %v", test.sha, got, test.want)
+		}
+	}
+}
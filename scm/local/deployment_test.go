@@ -0,0 +1,44 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package local
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-vela/types/library"
+)
+
+func TestLocal_Deployments(t *testing.T) {
+	// setup types
+	u := new(library.User)
+	u.SetName("octocat")
+
+	r := new(library.Repo)
+	r.SetOrg("github")
+	r.SetName("octocat")
+
+	client, _ := NewTest(t.TempDir(), "testdata/static.yml")
+
+	// run tests
+	_, err := client.GetDeployment(context.TODO(), u, r, 1)
+	if !errors.Is(err, errDeploymentsNotSupported) {
+		t.Errorf("GetDeployment returned %v, want %v", err, errDeploymentsNotSupported)
+	}
+
+	_, err = client.GetDeploymentCount(context.TODO(), u, r)
+	if !errors.Is(err, errDeploymentsNotSupported) {
+		t.Errorf("GetDeploymentCount returned %v, want %v", err, errDeploymentsNotSupported)
+	}
+
+	_, err = client.GetDeploymentList(context.TODO(), u, r, 1, 10)
+	if !errors.Is(err, errDeploymentsNotSupported) {
+		t.Errorf("GetDeploymentList returned %v, want %v", err, errDeploymentsNotSupported)
+	}
+
+	err = client.CreateDeployment(context.TODO(), u, r, new(library.Deployment))
+	if !errors.Is(err, errDeploymentsNotSupported) {
+		t.Errorf("CreateDeployment returned %v, want %v", err, errDeploymentsNotSupported)
+	}
+}
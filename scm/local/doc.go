@@ -0,0 +1,10 @@
+// SPDX-License-Identifier: Apache-2.0
+
+// Package local provides the ability for Vela to integrate
+// with bare git repositories on the local filesystem as a
+// scm provider for air-gapped and test setups.
+//
+// Usage:
+//
+//	import "github.com/go-vela/server/scm/local"
+package local
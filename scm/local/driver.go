@@ -0,0 +1,12 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package local
+
+// DriverLocal defines the driver type when integrating
+// with bare git repositories on the local filesystem.
+const DriverLocal = "local"
+
+// Driver outputs the configured scm driver.
+func (c *client) Driver() string {
+	return DriverLocal
+}
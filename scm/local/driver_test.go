@@ -0,0 +1,24 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package local
+
+import (
+	"testing"
+)
+
+func TestLocal_Driver(t *testing.T) {
+	// setup types
+	want := "local"
+
+	_service, err := NewTest(t.TempDir(), "testdata/static.yml")
+	if err != nil {
+		t.Errorf("unable to create new local client: %v", err)
+	}
+
+	// run test
+	got := _service.Driver()
+
+	if got != want {
+		t.Errorf("Driver is %v, want %v", got, want)
+	}
+}
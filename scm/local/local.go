@@ -0,0 +1,243 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package local
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/buildkite/yaml"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// events for repo webhooks.
+	eventPush       = "push"
+	eventInitialize = "initialize"
+)
+
+type config struct {
+	// specifies the name of the scm provider for the local client
+	Name string
+	// specifies the address of the directory holding the bare git repositories
+	Address string
+	// specifies the directory holding the bare git repositories
+	Root string
+	// specifies the users, teams and webhook secret for the local client
+	Static *Static
+}
+
+// Static represents the static configuration file that
+// provides the users and permissions for the local client.
+type Static struct {
+	// secret used to sign and verify synthesized webhooks
+	WebhookSecret string `yaml:"webhook_secret"`
+	// users that can authenticate with the local client
+	Users []*StaticUser `yaml:"users"`
+	// team memberships indexed by <org>/<team>
+	Teams map[string][]string `yaml:"teams"`
+}
+
+// StaticUser represents a user from the static
+// configuration file for the local client.
+type StaticUser struct {
+	// name of the user
+	Name string `yaml:"name"`
+	// personal access token used by the user to authenticate
+	Token string `yaml:"token"`
+	// email address of the user
+	Email string `yaml:"email"`
+	// grants the user admin access to every org and repo
+	Admin bool `yaml:"admin"`
+	// access level (admin or member) for orgs indexed by org
+	Orgs map[string]string `yaml:"orgs"`
+	// access level (admin, write or read) for repos indexed
+	// by <org>/<repo> with <org>/* matching every repo in an org
+	Repos map[string]string `yaml:"repos"`
+}
+
+type client struct {
+	config *config
+	// https://pkg.go.dev/github.com/sirupsen/logrus#Entry
+	Logger *logrus.Entry
+}
+
+// New returns a SCM implementation that integrates with
+// bare git repositories on the local filesystem.
+//
+//nolint:revive // ignore returning unexported client
+func New(opts ...ClientOpt) (*client, error) {
+	// create new local client
+	c := new(client)
+
+	// create new fields
+	c.config = new(config)
+	c.config.Static = new(Static)
+
+	// create new logger for the client
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#StandardLogger
+	logger := logrus.StandardLogger()
+
+	// create new logger for the client
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#NewEntry
+	c.Logger = logrus.NewEntry(logger).WithField("scm", c.Driver())
+
+	// apply all provided configuration options
+	for _, opt := range opts {
+		err := opt(c)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return c, nil
+}
+
+// NewTest returns a SCM implementation that integrates with the bare
+// git repositories in the provided directory. Only the directory and
+// the path to the static configuration file are required.
+//
+// This function is intended for running tests only.
+//
+//nolint:revive // ignore returning unexported client
+func NewTest(root, static string) (*client, error) {
+	return New(
+		WithAddress(fmt.Sprintf("file://%s", root)),
+		WithStaticFile(static),
+	)
+}
+
+// readStatic is a helper function to read the
+// static configuration file for the local client.
+func readStatic(path string) (*Static, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read static configuration file: %w", err)
+	}
+
+	s := new(Static)
+
+	err = yaml.Unmarshal(data, s)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse static configuration file: %w", err)
+	}
+
+	return s, nil
+}
+
+// userForName is a helper function to capture
+// the static user with the provided name.
+func (c *client) userForName(name string) *StaticUser {
+	for _, u := range c.config.Static.Users {
+		if strings.EqualFold(u.Name, name) {
+			return u
+		}
+	}
+
+	return nil
+}
+
+// userForToken is a helper function to capture
+// the static user with the provided token.
+func (c *client) userForToken(token string) *StaticUser {
+	if len(token) == 0 {
+		return nil
+	}
+
+	for _, u := range c.config.Static.Users {
+		if u.Token == token {
+			return u
+		}
+	}
+
+	return nil
+}
+
+// repoPath is a helper function to create the path
+// to the bare git repository for the org and repo.
+func (c *client) repoPath(org, name string) string {
+	return filepath.Join(c.config.Root, org, fmt.Sprintf("%s.git", name))
+}
+
+// repoLink is a helper function to create the
+// link to the bare git repository for the org and repo.
+func (c *client) repoLink(org, name string) string {
+	return fmt.Sprintf("file://%s", c.repoPath(org, name))
+}
+
+// exists is a helper function to check if the
+// bare git repository for the org and repo exists.
+func (c *client) exists(org, name string) bool {
+	// prevent escaping the directory holding the repositories
+	if strings.ContainsAny(org+name, `/\`) || org == ".." || name == ".." {
+		return false
+	}
+
+	info, err := os.Stat(c.repoPath(org, name))
+	if err != nil {
+		return false
+	}
+
+	return info.IsDir()
+}
+
+// git is a helper function to run a git command
+// against the bare git repository for the org and repo.
+func (c *client) git(ctx context.Context, org, name string, args ...string) (string, error) {
+	if !c.exists(org, name) {
+		return "", fmt.Errorf("repo %s/%s not found", org, name)
+	}
+
+	var stdout, stderr bytes.Buffer
+
+	//nolint:gosec // ignore running git with arguments from the server
+	cmd := exec.CommandContext(ctx, "git", append([]string{"--git-dir", c.repoPath(org, name)}, args...)...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	err := cmd.Run()
+	if err != nil {
+		return "", fmt.Errorf("git %s failed for %s/%s: %w: %s", args[0], org, name, err, strings.TrimSpace(stderr.String()))
+	}
+
+	return stdout.String(), nil
+}
+
+// lines is a helper function to split the
+// output from a git command into lines.
+func lines(output string) []string {
+	s := []string{}
+
+	for _, line := range strings.Split(output, "\n") {
+		if len(strings.TrimSpace(line)) == 0 {
+			continue
+		}
+
+		s = append(s, line)
+	}
+
+	return s
+}
+
+// validRef is a helper function to verify a ref or commit
+// can be safely provided as an argument to a git command.
+func validRef(ref string) error {
+	if len(ref) == 0 || strings.HasPrefix(ref, "-") || strings.ContainsAny(ref, " \t\r\n") {
+		return fmt.Errorf("invalid ref provided: %q", ref)
+	}
+
+	return nil
+}
+
+// pullRef is a helper function to create the ref
+// holding the head commit for a pull request.
+func pullRef(number int) string {
+	return fmt.Sprintf("refs/pull/%d/head", number)
+}
@@ -0,0 +1,195 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package local
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLocal_New(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		address string
+		static  string
+	}{
+		{
+			failure: false,
+			address: "file:///var/lib/git",
+			static:  "testdata/static.yml",
+		},
+		{
+			failure: true,
+			address: "https://github.com",
+			static:  "testdata/static.yml",
+		},
+		{
+			failure: true,
+			address: "file:///var/lib/git",
+			static:  "",
+		},
+		{
+			failure: true,
+			address: "file:///var/lib/git",
+			static:  "testdata/missing.yml",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		_, err := New(
+			WithAddress(test.address),
+			WithStaticFile(test.static),
+		)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("New should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("New returned err: %v", err)
+		}
+	}
+}
+
+func TestLocal_exists(t *testing.T) {
+	// setup types
+	root, _ := testRepos(t)
+
+	client, _ := NewTest(root, "testdata/static.yml")
+
+	// setup tests
+	tests := []struct {
+		org  string
+		repo string
+		want bool
+	}{
+		{org: "github", repo: "octocat", want: true},
+		{org: "github", repo: "hello-world", want: false},
+		{org: "..", repo: "octocat", want: false},
+		{org: "github", repo: "../github/octocat", want: false},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got := client.exists(test.org, test.repo)
+
+		if got != test.want {
+			t.Errorf("exists for %s/%s is %v, want %v", test.org, test.repo, got, test.want)
+		}
+	}
+}
+
+func TestLocal_validRef(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		ref     string
+	}{
+		{failure: false, ref: "refs/heads/main"},
+		{failure: false, ref: "7fd1a60b01f91b314f59955a4e4d4e80d8edf11d"},
+		{failure: true, ref: ""},
+		{failure: true, ref: "--output=/tmp/foo"},
+		{failure: true, ref: "main foo"},
+	}
+
+	// run tests
+	for _, test := range tests {
+		err := validRef(test.ref)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("validRef for %s should have returned err", test.ref)
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("validRef for %s returned err: %v", test.ref, err)
+		}
+	}
+}
+
+// testRepos is a helper function to create the bare git
+// repository github/octocat in a temporary directory.
+//
+// The repository has two commits on the main branch, the tag v0.1.0
+// on the last commit and the pull request 1 adding a file to main.
+func testRepos(t *testing.T) (string, map[string]string) {
+	t.Helper()
+
+	root := t.TempDir()
+	work := t.TempDir()
+	bare := filepath.Join(root, "github", "octocat.git")
+
+	run := func(dir string, args ...string) string {
+		t.Helper()
+
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		cmd.Env = append(os.Environ(),
+			"GIT_AUTHOR_NAME=Octocat",
+			"GIT_AUTHOR_EMAIL=octocat@github.com",
+			"GIT_COMMITTER_NAME=Octocat",
+			"GIT_COMMITTER_EMAIL=octocat@github.com",
+			"GIT_CONFIG_NOSYSTEM=1",
+			"HOME="+work,
+		)
+
+		out, err := cmd.CombinedOutput()
+		if err != nil {
+			t.Fatalf("git %s failed: %v: %s", strings.Join(args, " "), err, out)
+		}
+
+		return strings.TrimSpace(string(out))
+	}
+
+	write := func(name, content string) {
+		t.Helper()
+
+		err := os.WriteFile(filepath.Join(work, name), []byte(content), 0600)
+		if err != nil {
+			t.Fatalf("unable to write %s: %v", name, err)
+		}
+	}
+
+	err := os.MkdirAll(bare, 0700)
+	if err != nil {
+		t.Fatalf("unable to create %s: %v", bare, err)
+	}
+
+	run(bare, "init", "--bare", "--initial-branch", "main")
+	run(work, "init", "--initial-branch", "main")
+
+	write(".vela.yml", "version: \"1\"\n")
+	write("README.md", "# octocat\n")
+	run(work, "add", ".")
+	run(work, "commit", "-m", "initial commit")
+
+	write("README.md", "# octocat\n\nHello World!\n")
+	run(work, "commit", "-am", "update readme")
+
+	run(work, "tag", "v0.1.0")
+	run(work, "checkout", "-b", "feature")
+
+	write("foo.txt", "foo\n")
+	run(work, "add", ".")
+	run(work, "commit", "-m", "add foo")
+
+	run(work, "push", bare, "main", "v0.1.0", "feature:refs/pull/1/head")
+
+	return root, map[string]string{
+		"initial": run(work, "rev-parse", "main~1"),
+		"main":    run(work, "rev-parse", "main"),
+		"pull":    run(work, "rev-parse", "feature"),
+	}
+}
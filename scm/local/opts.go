@@ -0,0 +1,73 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package local
+
+import (
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"strings"
+)
+
+// ClientOpt represents a configuration option to initialize the scm client for local repositories.
+type ClientOpt func(*client) error
+
+// WithAddress sets the address of the directory holding
+// the bare git repositories in the scm client for local repositories.
+func WithAddress(address string) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring address in local scm client")
+
+		// check if the address provided is empty
+		if len(address) == 0 {
+			return fmt.Errorf("no local repositories address provided")
+		}
+
+		// check if the address is a valid file url
+		u, err := url.Parse(address)
+		if err != nil || u.Scheme != "file" || len(u.Path) == 0 {
+			return fmt.Errorf("invalid local repositories address provided: %s must be a file:// url", address)
+		}
+
+		// set the address and directory for the client
+		c.config.Address = strings.TrimSuffix(address, "/")
+		c.config.Root = filepath.Clean(u.Path)
+
+		return nil
+	}
+}
+
+// WithStaticFile sets the static configuration providing the
+// users and permissions in the scm client for local repositories.
+func WithStaticFile(path string) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring static configuration file in local scm client")
+
+		// check if the static configuration file provided is empty
+		if len(path) == 0 {
+			return fmt.Errorf("no local static configuration file provided")
+		}
+
+		s, err := readStatic(path)
+		if err != nil {
+			return err
+		}
+
+		// set the static configuration in the local client
+		c.config.Static = s
+
+		return nil
+	}
+}
+
+// WithName sets the name of the scm provider in the scm client for local repositories.
+func WithName(name string) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring scm provider name in local scm client")
+
+		// set the scm provider name in the local client
+		c.config.Name = name
+
+		return nil
+	}
+}
@@ -0,0 +1,139 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package local
+
+import (
+	"testing"
+)
+
+func TestLocal_ClientOpt_WithAddress(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure  bool
+		address  string
+		wantRoot string
+	}{
+		{
+			failure:  false,
+			address:  "file:///var/lib/git/",
+			wantRoot: "/var/lib/git",
+		},
+		{
+			failure: true,
+			address: "",
+		},
+		{
+			failure: true,
+			address: "https://github.com",
+		},
+		{
+			failure: true,
+			address: "/var/lib/git",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		_service, err := New(
+			WithAddress(test.address),
+		)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("WithAddress should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("WithAddress returned err: %v", err)
+		}
+
+		if _service.config.Root != test.wantRoot {
+			t.Errorf("WithAddress root is %v, want %v", _service.config.Root, test.wantRoot)
+		}
+	}
+}
+
+func TestLocal_ClientOpt_WithStaticFile(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure   bool
+		path      string
+		wantUsers int
+	}{
+		{
+			failure:   false,
+			path:      "testdata/static.yml",
+			wantUsers: 2,
+		},
+		{
+			failure: true,
+			path:    "",
+		},
+		{
+			failure: true,
+			path:    "testdata/missing.yml",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		_service, err := New(
+			WithStaticFile(test.path),
+		)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("WithStaticFile should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("WithStaticFile returned err: %v", err)
+		}
+
+		if len(_service.config.Static.Users) != test.wantUsers {
+			t.Errorf("WithStaticFile users is %v, want %v", len(_service.config.Static.Users), test.wantUsers)
+		}
+
+		if _service.config.Static.WebhookSecret != "superSecretWebhookSecret" {
+			t.Errorf("WithStaticFile webhook secret is %v, want %v", _service.config.Static.WebhookSecret, "superSecretWebhookSecret")
+		}
+	}
+}
+
+func TestLocal_ClientOpt_WithName(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name string
+		want string
+	}{
+		{
+			name: "git",
+			want: "git",
+		},
+		{
+			name: "",
+			want: "",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		_service, err := New(
+			WithName(test.name),
+		)
+
+		if err != nil {
+			t.Errorf("WithName returned err: %v", err)
+		}
+
+		if _service.config.Name != test.want {
+			t.Errorf("WithName is %v, want %v", _service.config.Name, test.want)
+		}
+	}
+}
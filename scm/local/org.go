@@ -0,0 +1,35 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package local
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/go-vela/types/library"
+)
+
+// GetOrgName gets org name from the local repositories.
+func (c *client) GetOrgName(ctx context.Context, u *library.User, o string) (string, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  o,
+		"user": u.GetName(),
+	}).Tracef("retrieving org information for %s", o)
+
+	// prevent escaping the directory holding the repositories
+	if len(o) == 0 || strings.ContainsAny(o, `/\`) || o == ".." {
+		return "", fmt.Errorf("org %s not found", o)
+	}
+
+	info, err := os.Stat(filepath.Join(c.config.Root, o))
+	if err != nil || !info.IsDir() {
+		return "", fmt.Errorf("org %s not found", o)
+	}
+
+	return o, nil
+}
@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package local
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-vela/types/library"
+)
+
+func TestLocal_GetOrgName(t *testing.T) {
+	// setup types
+	root, _ := testRepos(t)
+
+	u := new(library.User)
+	u.SetName("octocat")
+
+	client, _ := NewTest(root, "testdata/static.yml")
+
+	// setup tests
+	tests := []struct {
+		failure bool
+		org     string
+	}{
+		{failure: false, org: "github"},
+		{failure: true, org: "go-vela"},
+		{failure: true, org: ".."},
+		{failure: true, org: ""},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got, err := client.GetOrgName(context.TODO(), u, test.org)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("GetOrgName for %s should have returned err", test.org)
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("GetOrgName for %s returned err: %v", test.org, err)
+		}
+
+		if got != test.org {
+			t.Errorf("GetOrgName is %v, want %v", got, test.org)
+		}
+	}
+}
@@ -0,0 +1,366 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package local
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+)
+
+// ConfigBackoff is a wrapper for Config that will retry five times if the function
+// fails to retrieve the yaml/yml file.
+func (c *client) ConfigBackoff(ctx context.Context, u *library.User, r *library.Repo, ref string) (data []byte, err error) {
+	// number of times to retry
+	retryLimit := 5
+
+	for i := 0; i < retryLimit; i++ {
+		logrus.Debugf("Fetching config file - Attempt %d", i+1)
+		// attempt to fetch the config
+		data, err = c.Config(ctx, u, r, ref)
+
+		// return err if the last attempt returns error
+		if err != nil && i == retryLimit-1 {
+			return
+		}
+
+		// if data is valid break the retry loop
+		if data != nil {
+			break
+		}
+
+		// sleep in between retries
+		sleep := time.Duration(i+1) * time.Second
+		time.Sleep(sleep)
+	}
+
+	return
+}
+
+// Config gets the pipeline configuration from the local repo.
+func (c *client) Config(ctx context.Context, u *library.User, r *library.Repo, ref string) ([]byte, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("capturing configuration file for %s/commit/%s", r.GetFullName(), ref)
+
+	err := validRef(ref)
+	if err != nil {
+		return nil, err
+	}
+
+	files := []string{".vela.yml", ".vela.yaml"}
+
+	if strings.EqualFold(r.GetPipelineType(), constants.PipelineTypeStarlark) {
+		files = append(files, ".vela.star", ".vela.py")
+	}
+
+	for _, file := range files {
+		object := fmt.Sprintf("%s:%s", ref, file)
+
+		// check if the pipeline configuration exists at the ref
+		_, err = c.git(ctx, r.GetOrg(), r.GetName(), "cat-file", "-e", object)
+		if err != nil {
+			continue
+		}
+
+		// capture the pipeline configuration at the ref
+		data, err := c.git(ctx, r.GetOrg(), r.GetName(), "cat-file", "blob", object)
+		if err != nil {
+			return nil, err
+		}
+
+		return []byte(data), nil
+	}
+
+	return nil, fmt.Errorf("no valid pipeline configuration file (%s) found", strings.Join(files, ","))
+}
+
+// Disable deactivates a repo by deleting the webhook.
+//
+// The local repositories have no webhooks to delete since
+// webhooks are synthesized from the git hooks of the repo.
+func (c *client) Disable(ctx context.Context, u *library.User, org, name string) error {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  org,
+		"repo": name,
+		"user": u.GetName(),
+	}).Tracef("deleting repository webhooks for %s/%s", org, name)
+
+	return nil
+}
+
+// Enable activates a repo by creating the webhook.
+//
+// The local repositories have no webhooks to create since
+// webhooks are synthesized from the git hooks of the repo.
+func (c *client) Enable(ctx context.Context, u *library.User, r *library.Repo, h *library.Hook) (*library.Hook, string, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("creating repository webhook for %s/%s", r.GetOrg(), r.GetName())
+
+	if !c.exists(r.GetOrg(), r.GetName()) {
+		return nil, "", fmt.Errorf("repo not found")
+	}
+
+	// create the first hook for the repo
+	webhook := new(library.Hook)
+	webhook.SetSourceID(r.GetName() + "-" + eventInitialize)
+	webhook.SetCreated(time.Now().UTC().Unix())
+	webhook.SetEvent(eventInitialize)
+	webhook.SetNumber(h.GetNumber() + 1)
+	webhook.SetStatus(constants.StatusSuccess)
+
+	return webhook, c.repoLink(r.GetOrg(), r.GetName()), nil
+}
+
+// Update edits a repo webhook.
+//
+// The local repositories have no webhooks so the webhook is
+// reported as existing as long as the repository exists.
+func (c *client) Update(ctx context.Context, u *library.User, r *library.Repo, hookID int64) (bool, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("updating repository webhook for %s/%s", r.GetOrg(), r.GetName())
+
+	if !c.exists(r.GetOrg(), r.GetName()) {
+		return false, fmt.Errorf("repo %s not found", r.GetFullName())
+	}
+
+	return true, nil
+}
+
+// Status sends the commit status for the given SHA from the local repo.
+//
+// The local repositories have no commit statuses so the status is only logged.
+func (c *client) Status(ctx context.Context, u *library.User, b *library.Build, org, name string) error {
+	c.Logger.WithFields(logrus.Fields{
+		"build": b.GetNumber(),
+		"org":   org,
+		"repo":  name,
+		"user":  u.GetName(),
+	}).Tracef("skipping commit status %s for %s/%s/%d @ %s", b.GetStatus(), org, name, b.GetNumber(), b.GetCommit())
+
+	return nil
+}
+
+// CheckRun sets the check run with per-step detail for the build from the local repo.
+//
+// The local repositories have no check runs so the build is reported with the commit status.
+func (c *client) CheckRun(ctx context.Context, u *library.User, b *library.Build, steps []*library.Step, org, name string) error {
+	return c.Status(ctx, u, b, org, name)
+}
+
+// GetRepo gets repo information from the local repositories.
+func (c *client) GetRepo(ctx context.Context, u *library.User, r *library.Repo) (*library.Repo, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("retrieving repository information for %s", r.GetFullName())
+
+	return c.toLibraryRepo(ctx, r.GetOrg(), r.GetName())
+}
+
+// GetOrgAndRepoName returns the name of the org and the repository in the SCM.
+func (c *client) GetOrgAndRepoName(ctx context.Context, u *library.User, o string, r string) (string, string, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  o,
+		"repo": r,
+		"user": u.GetName(),
+	}).Tracef("retrieving repository information for %s/%s", o, r)
+
+	if !c.exists(o, r) {
+		return "", "", fmt.Errorf("repo %s/%s not found", o, r)
+	}
+
+	return o, r, nil
+}
+
+// ListUserRepos returns a list of all repos the user has admin access to.
+func (c *client) ListUserRepos(ctx context.Context, u *library.User) ([]*library.Repo, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"user": u.GetName(),
+	}).Tracef("listing source repositories for %s", u.GetName())
+
+	user := c.userForName(u.GetName())
+	if user == nil {
+		return nil, fmt.Errorf("unable to list user repos: user %s not found", u.GetName())
+	}
+
+	// capture the bare git repositories for every org
+	paths, err := filepath.Glob(filepath.Join(c.config.Root, "*", "*.git"))
+	if err != nil {
+		return nil, fmt.Errorf("unable to list user repos: %w", err)
+	}
+
+	f := []*library.Repo{}
+
+	// iterate through each repo
+	for _, path := range paths {
+		org := filepath.Base(filepath.Dir(path))
+		name := strings.TrimSuffix(filepath.Base(path), ".git")
+
+		// skip if the user is not an admin for the repo
+		if repoPermission(user, org, name) != "admin" {
+			continue
+		}
+
+		r, err := c.toLibraryRepo(ctx, org, name)
+		if err != nil {
+			return nil, fmt.Errorf("unable to list user repos: %w", err)
+		}
+
+		f = append(f, r)
+	}
+
+	return f, nil
+}
+
+// GetPullRequest defines a function that retrieves
+// a pull request for a repo.
+//
+// Pull requests are read from refs/pull/<number>/head
+// and target the default branch of the repo.
+func (c *client) GetPullRequest(ctx context.Context, u *library.User, r *library.Repo, number int) (string, string, string, string, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("retrieving pull request %d for repo %s", number, r.GetFullName())
+
+	commit, err := c.revParse(ctx, r.GetOrg(), r.GetName(), pullRef(number))
+	if err != nil {
+		return "", "", "", "", err
+	}
+
+	branch, err := c.defaultBranch(ctx, r.GetOrg(), r.GetName())
+	if err != nil {
+		return "", "", "", "", err
+	}
+
+	return commit, branch, branch, pullRef(number), nil
+}
+
+// GetHTMLURL retrieves the link to a file in the local repo.
+func (c *client) GetHTMLURL(ctx context.Context, u *library.User, org, repo, name, ref string) (string, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  org,
+		"repo": repo,
+		"user": u.GetName(),
+	}).Tracef("capturing html_url for %s/%s/%s@%s", org, repo, name, ref)
+
+	err := validRef(ref)
+	if err != nil {
+		return "", err
+	}
+
+	// check if the file exists at the ref
+	_, err = c.git(ctx, org, repo, "cat-file", "-e", fmt.Sprintf("%s:%s", ref, name))
+	if err != nil {
+		return "", fmt.Errorf("no valid repository contents found")
+	}
+
+	return fmt.Sprintf("%s/%s@%s", c.repoLink(org, repo), name, ref), nil
+}
+
+// GetBranch defines a function that retrieves a branch for a repo.
+func (c *client) GetBranch(ctx context.Context, u *library.User, r *library.Repo, branch string) (string, string, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("retrieving branch %s for repo %s", branch, r.GetFullName())
+
+	commit, err := c.revParse(ctx, r.GetOrg(), r.GetName(), fmt.Sprintf("refs/heads/%s", branch))
+	if err != nil {
+		return "", "", err
+	}
+
+	return branch, commit, nil
+}
+
+// GetTag defines a function that retrieves the commit for a tag in a repo.
+func (c *client) GetTag(ctx context.Context, u *library.User, r *library.Repo, tag string) (string, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("retrieving tag %s for repo %s", tag, r.GetFullName())
+
+	return c.revParse(ctx, r.GetOrg(), r.GetName(), fmt.Sprintf("refs/tags/%s", tag))
+}
+
+// CreateComment creates a comment on a pull request for the local repo.
+//
+// The local repositories have no pull requests so the comment is only logged.
+func (c *client) CreateComment(ctx context.Context, u *library.User, r *library.Repo, number int, body string) error {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("skipping comment on pull request %d for repo %s: %s", number, r.GetFullName(), body)
+
+	return nil
+}
+
+// revParse is a helper function to resolve
+// a ref to a commit for the local repo.
+func (c *client) revParse(ctx context.Context, org, name, ref string) (string, error) {
+	err := validRef(ref)
+	if err != nil {
+		return "", err
+	}
+
+	out, err := c.git(ctx, org, name, "rev-parse", "--verify", "--quiet", fmt.Sprintf("%s^{commit}", ref))
+	if err != nil {
+		return "", fmt.Errorf("unable to resolve %s for %s/%s", ref, org, name)
+	}
+
+	return strings.TrimSpace(out), nil
+}
+
+// defaultBranch is a helper function to capture
+// the default branch for the local repo.
+func (c *client) defaultBranch(ctx context.Context, org, name string) (string, error) {
+	out, err := c.git(ctx, org, name, "symbolic-ref", "--short", "HEAD")
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(out), nil
+}
+
+// toLibraryRepo is a helper function to convert
+// a local repo to a library repo.
+func (c *client) toLibraryRepo(ctx context.Context, org, name string) (*library.Repo, error) {
+	branch, err := c.defaultBranch(ctx, org, name)
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(library.Repo)
+	r.SetOrg(org)
+	r.SetName(name)
+	r.SetFullName(fmt.Sprintf("%s/%s", org, name))
+	r.SetLink(c.repoLink(org, name))
+	r.SetClone(c.repoLink(org, name))
+	r.SetBranch(branch)
+	r.SetTopics([]string{})
+	r.SetPrivate(true)
+	r.SetVisibility(constants.VisibilityPrivate)
+
+	return r, nil
+}
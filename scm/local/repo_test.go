@@ -0,0 +1,322 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package local
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+)
+
+func TestLocal_Config(t *testing.T) {
+	// setup types
+	root, commits := testRepos(t)
+
+	u := new(library.User)
+	u.SetName("octocat")
+
+	r := new(library.Repo)
+	r.SetOrg("github")
+	r.SetName("octocat")
+	r.SetFullName("github/octocat")
+
+	want := []byte("version: \"1\"\n")
+
+	client, _ := NewTest(root, "testdata/static.yml")
+
+	// setup tests
+	tests := []struct {
+		failure bool
+		ref     string
+	}{
+		{failure: false, ref: commits["main"]},
+		{failure: false, ref: "refs/heads/main"},
+		{failure: false, ref: "v0.1.0"},
+		{failure: true, ref: "refs/heads/foo"},
+		{failure: true, ref: "-h"},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got, err := client.Config(context.TODO(), u, r, test.ref)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("Config for %s should have returned err", test.ref)
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("Config for %s returned err: %v", test.ref, err)
+		}
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Config for %s is %s, want %s", test.ref, got, want)
+		}
+	}
+}
+
+func TestLocal_Enable(t *testing.T) {
+	// setup types
+	root, _ := testRepos(t)
+
+	u := new(library.User)
+	u.SetName("octocat")
+
+	r := new(library.Repo)
+	r.SetOrg("github")
+	r.SetName("octocat")
+
+	client, _ := NewTest(root, "testdata/static.yml")
+
+	// run test
+	got, url, err := client.Enable(context.TODO(), u, r, new(library.Hook))
+	if err != nil {
+		t.Errorf("Enable returned err: %v", err)
+	}
+
+	if got.GetEvent() != "initialize" || got.GetNumber() != 1 || got.GetStatus() != constants.StatusSuccess {
+		t.Errorf("Enable hook is %v, want initialize hook", got)
+	}
+
+	wantURL := fmt.Sprintf("file://%s", filepath.Join(root, "github", "octocat.git"))
+
+	if url != wantURL {
+		t.Errorf("Enable url is %v, want %v", url, wantURL)
+	}
+
+	r.SetName("hello-world")
+
+	_, _, err = client.Enable(context.TODO(), u, r, new(library.Hook))
+	if err == nil || err.Error() != "repo not found" {
+		t.Errorf("Enable returned %v, want repo not found", err)
+	}
+}
+
+func TestLocal_GetRepo(t *testing.T) {
+	// setup types
+	root, _ := testRepos(t)
+
+	u := new(library.User)
+	u.SetName("octocat")
+
+	r := new(library.Repo)
+	r.SetOrg("github")
+	r.SetName("octocat")
+
+	link := fmt.Sprintf("file://%s", filepath.Join(root, "github", "octocat.git"))
+
+	want := new(library.Repo)
+	want.SetOrg("github")
+	want.SetName("octocat")
+	want.SetFullName("github/octocat")
+	want.SetLink(link)
+	want.SetClone(link)
+	want.SetBranch("main")
+	want.SetTopics([]string{})
+	want.SetPrivate(true)
+	want.SetVisibility(constants.VisibilityPrivate)
+
+	client, _ := NewTest(root, "testdata/static.yml")
+
+	// run test
+	got, err := client.GetRepo(context.TODO(), u, r)
+	if err != nil {
+		t.Errorf("GetRepo returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("GetRepo is %v, want %v", got, want)
+	}
+}
+
+func TestLocal_ListUserRepos(t *testing.T) {
+	// setup types
+	root, _ := testRepos(t)
+
+	client, _ := NewTest(root, "testdata/static.yml")
+
+	// setup tests
+	tests := []struct {
+		failure bool
+		user    string
+		want    int
+	}{
+		{failure: false, user: "octocat", want: 1},
+		{failure: false, user: "vela", want: 1},
+		{failure: true, user: "foo", want: 0},
+	}
+
+	// run tests
+	for _, test := range tests {
+		u := new(library.User)
+		u.SetName(test.user)
+
+		got, err := client.ListUserRepos(context.TODO(), u)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("ListUserRepos for %s should have returned err", test.user)
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("ListUserRepos for %s returned err: %v", test.user, err)
+		}
+
+		if len(got) != test.want {
+			t.Errorf("ListUserRepos for %s is %v, want %d repos", test.user, got, test.want)
+		}
+	}
+}
+
+func TestLocal_GetBranch(t *testing.T) {
+	// setup types
+	root, commits := testRepos(t)
+
+	u := new(library.User)
+	u.SetName("octocat")
+
+	r := new(library.Repo)
+	r.SetOrg("github")
+	r.SetName("octocat")
+
+	client, _ := NewTest(root, "testdata/static.yml")
+
+	// run test
+	branch, commit, err := client.GetBranch(context.TODO(), u, r, "main")
+	if err != nil {
+		t.Errorf("GetBranch returned err: %v", err)
+	}
+
+	if branch != "main" {
+		t.Errorf("GetBranch branch is %v, want %v", branch, "main")
+	}
+
+	if commit != commits["main"] {
+		t.Errorf("GetBranch commit is %v, want %v", commit, commits["main"])
+	}
+
+	_, _, err = client.GetBranch(context.TODO(), u, r, "foo")
+	if err == nil {
+		t.Errorf("GetBranch should have returned err")
+	}
+}
+
+func TestLocal_GetTag(t *testing.T) {
+	// setup types
+	root, commits := testRepos(t)
+
+	u := new(library.User)
+	u.SetName("octocat")
+
+	r := new(library.Repo)
+	r.SetOrg("github")
+	r.SetName("octocat")
+
+	client, _ := NewTest(root, "testdata/static.yml")
+
+	// run test
+	commit, err := client.GetTag(context.TODO(), u, r, "v0.1.0")
+	if err != nil {
+		t.Errorf("GetTag returned err: %v", err)
+	}
+
+	if commit != commits["main"] {
+		t.Errorf("GetTag is %v, want %v", commit, commits["main"])
+	}
+
+	_, err = client.GetTag(context.TODO(), u, r, "v1.0.0")
+	if err == nil {
+		t.Errorf("GetTag should have returned err")
+	}
+}
+
+func TestLocal_GetPullRequest(t *testing.T) {
+	// setup types
+	root, commits := testRepos(t)
+
+	u := new(library.User)
+	u.SetName("octocat")
+
+	r := new(library.Repo)
+	r.SetOrg("github")
+	r.SetName("octocat")
+
+	client, _ := NewTest(root, "testdata/static.yml")
+
+	// run test
+	commit, branch, baseref, headref, err := client.GetPullRequest(context.TODO(), u, r, 1)
+	if err != nil {
+		t.Errorf("GetPullRequest returned err: %v", err)
+	}
+
+	if commit != commits["pull"] {
+		t.Errorf("GetPullRequest commit is %v, want %v", commit, commits["pull"])
+	}
+
+	if branch != "main" || baseref != "main" {
+		t.Errorf("GetPullRequest branch is %v and baseref is %v, want main", branch, baseref)
+	}
+
+	if headref != "refs/pull/1/head" {
+		t.Errorf("GetPullRequest headref is %v, want %v", headref, "refs/pull/1/head")
+	}
+}
+
+func TestLocal_GetHTMLURL(t *testing.T) {
+	// setup types
+	root, _ := testRepos(t)
+
+	u := new(library.User)
+	u.SetName("octocat")
+
+	want := fmt.Sprintf("file://%s/README.md@v0.1.0", filepath.Join(root, "github", "octocat.git"))
+
+	client, _ := NewTest(root, "testdata/static.yml")
+
+	// run test
+	got, err := client.GetHTMLURL(context.TODO(), u, "github", "octocat", "README.md", "v0.1.0")
+	if err != nil {
+		t.Errorf("GetHTMLURL returned err: %v", err)
+	}
+
+	if got != want {
+		t.Errorf("GetHTMLURL is %v, want %v", got, want)
+	}
+
+	_, err = client.GetHTMLURL(context.TODO(), u, "github", "octocat", "foo.txt", "v0.1.0")
+	if err == nil {
+		t.Errorf("GetHTMLURL should have returned err")
+	}
+}
+
+func TestLocal_CreateComment(t *testing.T) {
+	// setup types
+	root, _ := testRepos(t)
+
+	u := new(library.User)
+	u.SetName("octocat")
+
+	r := new(library.Repo)
+	r.SetOrg("github")
+	r.SetName("octocat")
+	r.SetFullName("github/octocat")
+
+	client, _ := NewTest(root, "testdata/static.yml")
+
+	// run test
+	err := client.CreateComment(context.TODO(), u, r, 1, "Me too")
+	if err != nil {
+		t.Errorf("CreateComment returned err: %v", err)
+	}
+}
@@ -0,0 +1,215 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package local
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/go-vela/types"
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+)
+
+const (
+	// HeaderEvent is the header providing the event for a synthesized webhook.
+	HeaderEvent = "X-Vela-Event"
+	// HeaderDelivery is the header providing the unique ID for a synthesized webhook.
+	HeaderDelivery = "X-Vela-Delivery"
+	// HeaderSignature is the header providing the HMAC-SHA256
+	// signature of the payload for a synthesized webhook.
+	HeaderSignature = "X-Vela-Signature"
+)
+
+// Push represents the payload synthesized from
+// a push to a bare git repository on disk.
+type Push struct {
+	// full ref that was pushed (i.e. refs/heads/main)
+	Ref string `json:"ref"`
+	// commit the ref pointed to before the push
+	Before string `json:"before"`
+	// commit the ref points to after the push
+	After string `json:"after"`
+	// org holding the repository that was pushed to
+	Org string `json:"org"`
+	// name of the repository that was pushed to
+	Repo string `json:"repo"`
+	// name of the user that pushed to the repository
+	Sender string `json:"sender"`
+}
+
+// Sign returns the value for the signature header of
+// a synthesized webhook using the provided secret.
+func Sign(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+
+	return fmt.Sprintf("sha256=%s", hex.EncodeToString(mac.Sum(nil)))
+}
+
+// ProcessWebhook parses the webhook from a repo.
+//
+//nolint:nilerr // ignore webhook returning nil
+func (c *client) ProcessWebhook(ctx context.Context, request *http.Request) (*types.Webhook, error) {
+	c.Logger.Tracef("processing local webhook")
+
+	// create our own record of the hook and populate its fields
+	h := new(library.Hook)
+	h.SetNumber(1)
+	h.SetSourceID(request.Header.Get(HeaderDelivery))
+	h.SetCreated(time.Now().UTC().Unix())
+	h.SetHost(c.config.Root)
+	h.SetEvent(request.Header.Get(HeaderEvent))
+	h.SetStatus(constants.StatusSuccess)
+
+	payload, err := io.ReadAll(request.Body)
+	if err != nil {
+		return &types.Webhook{Hook: h}, nil
+	}
+
+	// process the event from the webhook
+	switch request.Header.Get(HeaderEvent) {
+	case eventPush:
+		event := new(Push)
+
+		err = json.Unmarshal(payload, event)
+		if err != nil || !c.exists(event.Org, event.Repo) {
+			return &types.Webhook{Hook: h}, nil
+		}
+
+		return c.processPushEvent(ctx, h, event)
+	}
+
+	return &types.Webhook{Hook: h}, nil
+}
+
+// VerifyWebhook verifies the webhook from a repo.
+//
+// The synthesized webhooks are signed with an HMAC-SHA256 digest
+// using the webhook secret from the static configuration file.
+func (c *client) VerifyWebhook(ctx context.Context, request *http.Request, r *library.Repo) error {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+	}).Tracef("verifying local webhook for %s", r.GetFullName())
+
+	if len(c.config.Static.WebhookSecret) == 0 {
+		return errors.New("no webhook secret provided in local static configuration file")
+	}
+
+	signature := request.Header.Get(HeaderSignature)
+	if len(signature) == 0 {
+		return fmt.Errorf("no %s header provided", HeaderSignature)
+	}
+
+	payload, err := io.ReadAll(request.Body)
+	if err != nil {
+		return err
+	}
+
+	if !hmac.Equal([]byte(signature), []byte(Sign(c.config.Static.WebhookSecret, payload))) {
+		return fmt.Errorf("invalid %s header provided", HeaderSignature)
+	}
+
+	return nil
+}
+
+// RedeliverWebhook redelivers webhooks for the local repositories.
+func (c *client) RedeliverWebhook(ctx context.Context, u *library.User, r *library.Repo, h *library.Hook) error {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("redelivering local webhook %s for %s", h.GetSourceID(), r.GetFullName())
+
+	return fmt.Errorf("redelivering webhooks is not supported for %s", c.Driver())
+}
+
+// processPushEvent is a helper function to process the push event.
+func (c *client) processPushEvent(ctx context.Context, h *library.Hook, payload *Push) (*types.Webhook, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  payload.Org,
+		"repo": payload.Repo,
+	}).Tracef("processing push local webhook for %s/%s", payload.Org, payload.Repo)
+
+	// capture the repo from the local repositories
+	r, err := c.toLibraryRepo(ctx, payload.Org, payload.Repo)
+	if err != nil {
+		return nil, err
+	}
+
+	// update the hook object
+	h.SetBranch(strings.TrimPrefix(payload.Ref, "refs/heads/"))
+	h.SetEvent(constants.EventPush)
+	h.SetLink(r.GetLink())
+
+	// skip if the branch or tag was deleted
+	if len(strings.Trim(payload.After, "0")) == 0 {
+		return &types.Webhook{Hook: h}, nil
+	}
+
+	err = validRef(payload.After)
+	if err != nil {
+		return nil, err
+	}
+
+	// capture the author, email and message for the commit
+	out, err := c.git(ctx, payload.Org, payload.Repo, "log", "-1", "--format=%an%x00%ae%x00%B", payload.After)
+	if err != nil {
+		return nil, err
+	}
+
+	commit := strings.SplitN(out, "\x00", 3)
+	if len(commit) != 3 {
+		return nil, fmt.Errorf("unable to parse commit %s for %s", payload.After, r.GetFullName())
+	}
+
+	// convert payload to library build
+	b := new(library.Build)
+	b.SetEvent(constants.EventPush)
+	b.SetClone(r.GetClone())
+	b.SetSource(fmt.Sprintf("%s@%s", r.GetLink(), payload.After))
+	b.SetTitle(fmt.Sprintf("%s received from %s", constants.EventPush, r.GetLink()))
+	b.SetMessage(strings.TrimSpace(commit[2]))
+	b.SetCommit(payload.After)
+	b.SetBranch(strings.TrimPrefix(payload.Ref, "refs/heads/"))
+	b.SetRef(payload.Ref)
+	b.SetSender(payload.Sender)
+	b.SetAuthor(commit[0])
+	b.SetEmail(commit[1])
+
+	// fall back to the commit author for the sender
+	if len(b.GetSender()) == 0 {
+		b.SetSender(commit[0])
+	}
+
+	// handle when push event is a tag
+	if strings.HasPrefix(b.GetRef(), "refs/tags/") {
+		// set the proper event for the hook
+		h.SetEvent(constants.EventTag)
+		// set the proper event for the build
+		b.SetEvent(constants.EventTag)
+		// set the proper branch for the build
+		b.SetBranch(strings.TrimPrefix(b.GetRef(), "refs/tags/"))
+		// set the proper branch for the hook
+		h.SetBranch(b.GetBranch())
+	}
+
+	return &types.Webhook{
+		Comment: "",
+		Hook:    h,
+		Repo:    r,
+		Build:   b,
+	}, nil
+}
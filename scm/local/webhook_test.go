@@ -0,0 +1,185 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package local
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+)
+
+func TestLocal_ProcessWebhook_Push(t *testing.T) {
+	// setup types
+	root, commits := testRepos(t)
+
+	client, _ := NewTest(root, "testdata/static.yml")
+
+	// setup tests
+	tests := []struct {
+		name       string
+		push       *Push
+		wantEvent  string
+		wantBranch string
+		wantBuild  bool
+	}{
+		{
+			name:       "branch",
+			push:       &Push{Ref: "refs/heads/main", Before: commits["initial"], After: commits["main"], Org: "github", Repo: "octocat", Sender: "vela"},
+			wantEvent:  constants.EventPush,
+			wantBranch: "main",
+			wantBuild:  true,
+		},
+		{
+			name:       "tag",
+			push:       &Push{Ref: "refs/tags/v0.1.0", Before: "0000000000000000000000000000000000000000", After: commits["main"], Org: "github", Repo: "octocat"},
+			wantEvent:  constants.EventTag,
+			wantBranch: "v0.1.0",
+			wantBuild:  true,
+		},
+		{
+			name:       "delete",
+			push:       &Push{Ref: "refs/heads/feature", Before: commits["pull"], After: "0000000000000000000000000000000000000000", Org: "github", Repo: "octocat"},
+			wantEvent:  constants.EventPush,
+			wantBranch: "feature",
+			wantBuild:  false,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			payload, _ := json.Marshal(test.push)
+
+			request := httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewReader(payload))
+			request.Header.Set(HeaderEvent, "push")
+			request.Header.Set(HeaderDelivery, "7bd477e4-4415-11e9-9359-0d41fdf9567e")
+
+			got, err := client.ProcessWebhook(context.TODO(), request)
+			if err != nil {
+				t.Errorf("ProcessWebhook returned err: %v", err)
+			}
+
+			if got.Hook.GetEvent() != test.wantEvent {
+				t.Errorf("ProcessWebhook hook event is %v, want %v", got.Hook.GetEvent(), test.wantEvent)
+			}
+
+			if got.Hook.GetBranch() != test.wantBranch {
+				t.Errorf("ProcessWebhook hook branch is %v, want %v", got.Hook.GetBranch(), test.wantBranch)
+			}
+
+			if !test.wantBuild {
+				if got.Build != nil {
+					t.Errorf("ProcessWebhook build is %v, want nil", got.Build)
+				}
+
+				return
+			}
+
+			if got.Repo.GetFullName() != "github/octocat" {
+				t.Errorf("ProcessWebhook repo is %v, want %v", got.Repo.GetFullName(), "github/octocat")
+			}
+
+			b := got.Build
+
+			if b.GetEvent() != test.wantEvent || b.GetBranch() != test.wantBranch || b.GetCommit() != commits["main"] {
+				t.Errorf("ProcessWebhook build is %v, want %s build for %s", b, test.wantEvent, test.wantBranch)
+			}
+
+			if b.GetAuthor() != "Octocat" || b.GetEmail() != "octocat@github.com" || b.GetMessage() != "update readme" {
+				t.Errorf("ProcessWebhook build commit details are %s <%s> %s", b.GetAuthor(), b.GetEmail(), b.GetMessage())
+			}
+
+			wantSender := test.push.Sender
+			if len(wantSender) == 0 {
+				wantSender = "Octocat"
+			}
+
+			if b.GetSender() != wantSender {
+				t.Errorf("ProcessWebhook build sender is %v, want %v", b.GetSender(), wantSender)
+			}
+		})
+	}
+}
+
+func TestLocal_ProcessWebhook_UnknownRepo(t *testing.T) {
+	// setup types
+	root, commits := testRepos(t)
+
+	client, _ := NewTest(root, "testdata/static.yml")
+
+	payload, _ := json.Marshal(&Push{Ref: "refs/heads/main", After: commits["main"], Org: "..", Repo: "octocat"})
+
+	request := httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewReader(payload))
+	request.Header.Set(HeaderEvent, "push")
+
+	// run test
+	got, err := client.ProcessWebhook(context.TODO(), request)
+	if err != nil {
+		t.Errorf("ProcessWebhook returned err: %v", err)
+	}
+
+	if got.Build != nil || got.Repo != nil {
+		t.Errorf("ProcessWebhook is %v, want hook only", got)
+	}
+}
+
+func TestLocal_VerifyWebhook(t *testing.T) {
+	// setup types
+	r := new(library.Repo)
+	r.SetOrg("github")
+	r.SetName("octocat")
+
+	payload := []byte(`{"ref":"refs/heads/main"}`)
+
+	client, _ := NewTest(t.TempDir(), "testdata/static.yml")
+
+	// setup tests
+	tests := []struct {
+		failure   bool
+		signature string
+	}{
+		{failure: false, signature: Sign("superSecretWebhookSecret", payload)},
+		{failure: true, signature: Sign("foo", payload)},
+		{failure: true, signature: ""},
+	}
+
+	// run tests
+	for _, test := range tests {
+		request := httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewReader(payload))
+		request.Header.Set(HeaderSignature, test.signature)
+
+		err := client.VerifyWebhook(context.TODO(), request, r)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("VerifyWebhook for %s should have returned err", test.signature)
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("VerifyWebhook for %s returned err: %v", test.signature, err)
+		}
+	}
+}
+
+func TestLocal_RedeliverWebhook(t *testing.T) {
+	// setup types
+	u := new(library.User)
+	u.SetName("octocat")
+
+	client, _ := NewTest(t.TempDir(), "testdata/static.yml")
+
+	// run test
+	err := client.RedeliverWebhook(context.TODO(), u, new(library.Repo), new(library.Hook))
+	if err == nil {
+		t.Errorf("RedeliverWebhook should have returned err")
+	}
+}
@@ -0,0 +1,185 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package scm
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// providersKey defines the key type for storing
+// the scm Providers in the context.
+const providersKey = "scm-providers"
+
+// selectedKey defines the key type for storing the name
+// of the selected scm provider in the context.
+const selectedKey = "scm-selected"
+
+// Providers represents the set of named scm services
+// that the Vela server is configured to integrate with.
+//
+// The default provider is stored with an empty name for
+// repos and users so that deployments with a single scm
+// provider continue to work without any changes.
+type Providers struct {
+	// name of the default scm provider
+	name string
+	// default scm provider
+	service Service
+	// additional scm providers indexed by name
+	named map[string]Service
+}
+
+// NewProviders returns a set of scm providers with the
+// provided service as the default scm provider.
+func NewProviders(name string, s Service) *Providers {
+	return &Providers{
+		name:    name,
+		service: s,
+		named:   make(map[string]Service),
+	}
+}
+
+// Add registers an additional named scm provider.
+func (p *Providers) Add(name string, s Service) error {
+	// verify a name was provided for the scm provider
+	if len(name) == 0 {
+		return fmt.Errorf("no name provided for scm provider")
+	}
+
+	// verify the name can be used in the webhook path and OAuth state
+	if strings.ContainsAny(name, "./?#") {
+		return fmt.Errorf("invalid name provided for scm provider: %s", name)
+	}
+
+	// verify the name is not already in use
+	if _, ok := p.named[name]; ok || strings.EqualFold(name, p.name) {
+		return fmt.Errorf("scm provider %s already exists", name)
+	}
+
+	p.named[name] = s
+
+	return nil
+}
+
+// Default returns the default scm provider.
+func (p *Providers) Default() Service {
+	return p.service
+}
+
+// Get returns the scm provider for the provided name
+// along with the name the provider is stored under.
+//
+// An empty name or the name of the default scm
+// provider returns the default scm provider.
+func (p *Providers) Get(name string) (string, Service, error) {
+	if len(name) == 0 || strings.EqualFold(name, p.name) {
+		return "", p.service, nil
+	}
+
+	s, ok := p.named[name]
+	if !ok {
+		return "", nil, fmt.Errorf("unknown scm provider: %s", name)
+	}
+
+	return name, s, nil
+}
+
+// Names returns the names of all the scm providers
+// with the default scm provider listed first.
+func (p *Providers) Names() []string {
+	names := make([]string, 0, len(p.named))
+
+	for name := range p.named {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return append([]string{p.name}, names...)
+}
+
+// ProviderFromState returns the name of the scm provider
+// embedded in an OAuth state created by a named provider.
+func ProviderFromState(state string) string {
+	name, _, found := strings.Cut(state, ".")
+	if !found {
+		return ""
+	}
+
+	return name
+}
+
+// ProvidersFromContext returns the scm Providers
+// associated with this context.
+func ProvidersFromContext(c context.Context) *Providers {
+	// get scm providers value from context
+	v := c.Value(providersKey)
+	if v == nil {
+		return nil
+	}
+
+	// cast scm providers value to expected Providers type
+	p, ok := v.(*Providers)
+	if !ok {
+		return nil
+	}
+
+	return p
+}
+
+// ProvidersToContext adds the scm Providers to this
+// context if it supports the Setter interface.
+func ProvidersToContext(c Setter, p *Providers) {
+	c.Set(providersKey, p)
+}
+
+// SelectedFromContext returns the name the scm provider
+// selected for this context is stored under.
+//
+// An empty name is returned for the default scm provider
+// or when no scm provider has been selected.
+func SelectedFromContext(c context.Context) string {
+	// get selected scm provider value from context
+	v := c.Value(selectedKey)
+	if v == nil {
+		return ""
+	}
+
+	// cast selected scm provider value to expected string type
+	name, ok := v.(string)
+	if !ok {
+		return ""
+	}
+
+	return name
+}
+
+// Select replaces the scm Service in this context with
+// the scm provider for the provided name and returns
+// the name the provider is stored under.
+//
+// The context is left unchanged if no scm Providers
+// are associated with the context.
+func Select(c SetterContext, name string) (string, error) {
+	p := ProvidersFromContext(c)
+	if p == nil {
+		if len(name) > 0 {
+			return "", fmt.Errorf("unknown scm provider: %s", name)
+		}
+
+		return "", nil
+	}
+
+	stored, s, err := p.Get(name)
+	if err != nil {
+		return "", err
+	}
+
+	ToContext(c, s)
+	c.Set(selectedKey, stored)
+
+	return stored, nil
+}
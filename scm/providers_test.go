@@ -0,0 +1,276 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package scm
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/go-vela/server/scm/github"
+	"github.com/go-vela/server/scm/gitlab"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestSCM_Providers_Get(t *testing.T) {
+	// setup types
+	s := httptest.NewServer(http.NotFoundHandler())
+	defer s.Close()
+
+	_github, _ := github.NewTest(s.URL)
+	_gitlab, _ := gitlab.NewTest(s.URL)
+
+	p := NewProviders("github", _github)
+
+	err := p.Add("gitlab", _gitlab)
+	if err != nil {
+		t.Errorf("Add returned err: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		provider string
+		want     Service
+		wantName string
+	}{
+		{
+			failure:  false,
+			name:     "empty",
+			provider: "",
+			want:     _github,
+			wantName: "",
+		},
+		{
+			failure:  false,
+			name:     "default",
+			provider: "github",
+			want:     _github,
+			wantName: "",
+		},
+		{
+			failure:  false,
+			name:     "named",
+			provider: "gitlab",
+			want:     _gitlab,
+			wantName: "gitlab",
+		},
+		{
+			failure:  true,
+			name:     "unknown",
+			provider: "gitea",
+			want:     nil,
+			wantName: "",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			gotName, got, err := p.Get(test.provider)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("Get for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("Get for %s returned err: %v", test.name, err)
+			}
+
+			if got != test.want {
+				t.Errorf("Get for %s is %v, want %v", test.name, got, test.want)
+			}
+
+			if gotName != test.wantName {
+				t.Errorf("Get name for %s is %v, want %v", test.name, gotName, test.wantName)
+			}
+		})
+	}
+}
+
+func TestSCM_Providers_Add(t *testing.T) {
+	// setup types
+	s := httptest.NewServer(http.NotFoundHandler())
+	defer s.Close()
+
+	_github, _ := github.NewTest(s.URL)
+
+	// setup tests
+	tests := []struct {
+		failure bool
+		name    string
+	}{
+		{
+			failure: false,
+			name:    "enterprise",
+		},
+		{
+			failure: true,
+			name:    "",
+		},
+		{
+			failure: true,
+			name:    "github",
+		},
+		{
+			failure: true,
+			name:    "enterprise",
+		},
+		{
+			failure: true,
+			name:    "foo.bar",
+		},
+	}
+
+	p := NewProviders("github", _github)
+
+	// run tests
+	for _, test := range tests {
+		err := p.Add(test.name, _github)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("Add for %s should have returned err", test.name)
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("Add for %s returned err: %v", test.name, err)
+		}
+	}
+
+	want := []string{"github", "enterprise"}
+
+	if !reflect.DeepEqual(p.Names(), want) {
+		t.Errorf("Names is %v, want %v", p.Names(), want)
+	}
+}
+
+func TestSCM_ProviderFromState(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		state string
+		want  string
+	}{
+		{
+			state: "enterprise.Zm9vYmFy",
+			want:  "enterprise",
+		},
+		{
+			state: "Zm9vYmFy",
+			want:  "",
+		},
+		{
+			state: "",
+			want:  "",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got := ProviderFromState(test.state)
+
+		if got != test.want {
+			t.Errorf("ProviderFromState for %s is %v, want %v", test.state, got, test.want)
+		}
+	}
+}
+
+func TestSCM_Select(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	context, _ := gin.CreateTestContext(nil)
+
+	// setup types
+	s := httptest.NewServer(http.NotFoundHandler())
+	defer s.Close()
+
+	_github, _ := github.NewTest(s.URL)
+	_gitlab, _ := gitlab.NewTest(s.URL)
+
+	p := NewProviders("github", _github)
+
+	err := p.Add("gitlab", _gitlab)
+	if err != nil {
+		t.Errorf("Add returned err: %v", err)
+	}
+
+	ProvidersToContext(context, p)
+	ToContext(context, p.Default())
+
+	// run test
+	name, err := Select(context, "gitlab")
+	if err != nil {
+		t.Errorf("Select returned err: %v", err)
+	}
+
+	if name != "gitlab" {
+		t.Errorf("Select is %v, want %v", name, "gitlab")
+	}
+
+	if FromContext(context) != _gitlab {
+		t.Errorf("Select set %v, want %v", FromContext(context), _gitlab)
+	}
+
+	if SelectedFromContext(context) != "gitlab" {
+		t.Errorf("SelectedFromContext is %v, want %v", SelectedFromContext(context), "gitlab")
+	}
+
+	_, err = Select(context, "github")
+	if err != nil {
+		t.Errorf("Select returned err: %v", err)
+	}
+
+	if SelectedFromContext(context) != "" {
+		t.Errorf("SelectedFromContext is %v, want default provider", SelectedFromContext(context))
+	}
+
+	_, err = Select(context, "gitea")
+	if err == nil {
+		t.Errorf("Select should have returned err")
+	}
+}
+
+func TestSCM_Select_NoProviders(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	context, _ := gin.CreateTestContext(nil)
+
+	// setup types
+	s := httptest.NewServer(http.NotFoundHandler())
+	defer s.Close()
+
+	want, _ := github.NewTest(s.URL)
+
+	ToContext(context, want)
+
+	// run test
+	name, err := Select(context, "")
+	if err != nil {
+		t.Errorf("Select returned err: %v", err)
+	}
+
+	if name != "" {
+		t.Errorf("Select is %v, want empty provider", name)
+	}
+
+	if FromContext(context) != want {
+		t.Errorf("Select set %v, want %v", FromContext(context), want)
+	}
+
+	_, err = Select(context, "gitlab")
+	if err == nil {
+		t.Errorf("Select should have returned err")
+	}
+}
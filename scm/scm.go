@@ -5,6 +5,8 @@ package scm
 import (
 	"fmt"
 
+	"github.com/go-vela/server/scm/gitea"
+	"github.com/go-vela/server/scm/local"
 	"github.com/go-vela/types/constants"
 
 	"github.com/sirupsen/logrus"
@@ -16,6 +18,9 @@ import (
 // Currently the following scm providers are supported:
 //
 // * Github
+// * Gitlab
+// * Gitea (including Forgejo)
+// * Local (bare git repositories on disk)
 // .
 func New(s *Setup) (Service, error) {
 	// validate the setup being provided
@@ -39,6 +44,16 @@ func New(s *Setup) (Service, error) {
 		//
 		// https://pkg.go.dev/github.com/go-vela/server/scm?tab=doc#Setup.Gitlab
 		return s.Gitlab()
+	case gitea.DriverGitea:
+		// handle the Gitea scm driver being provided
+		//
+		// https://pkg.go.dev/github.com/go-vela/server/scm?tab=doc#Setup.Gitea
+		return s.Gitea()
+	case local.DriverLocal:
+		// handle the local scm driver being provided
+		//
+		// https://pkg.go.dev/github.com/go-vela/server/scm?tab=doc#Setup.Local
+		return s.Local()
 	default:
 		// handle an invalid scm driver being provided
 		return nil, fmt.Errorf("invalid scm driver provided: %s", s.Driver)
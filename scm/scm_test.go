@@ -27,7 +27,7 @@ func TestSCM_New(t *testing.T) {
 			},
 		},
 		{
-			failure: true,
+			failure: false,
 			setup: &Setup{
 				Driver:               "gitlab",
 				Address:              "https://gitlab.com",
@@ -37,7 +37,29 @@ func TestSCM_New(t *testing.T) {
 				ServerWebhookAddress: "",
 				StatusContext:        "continuous-integration/vela",
 				WebUIAddress:         "https://vela.example.com",
-				Scopes:               []string{"repo", "repo:status", "user:email", "read:user", "read:org"},
+				Scopes:               []string{"api", "read_user"},
+			},
+		},
+		{
+			failure: false,
+			setup: &Setup{
+				Driver:               "gitea",
+				Address:              "https://gitea.com",
+				ClientID:             "foo",
+				ClientSecret:         "bar",
+				ServerAddress:        "https://vela-server.example.com",
+				ServerWebhookAddress: "",
+				StatusContext:        "continuous-integration/vela",
+				WebUIAddress:         "https://vela.example.com",
+				Scopes:               []string{"read:user", "read:organization", "write:repository"},
+			},
+		},
+		{
+			failure: false,
+			setup: &Setup{
+				Driver:          "local",
+				Address:         "file:///var/lib/git",
+				LocalStaticFile: "local/testdata/static.yml",
 			},
 		},
 		{
@@ -48,6 +48,9 @@ type Service interface {
 	// RepoAccess defines a function that captures
 	// the user's access level for a repo.
 	RepoAccess(context.Context, *library.User, string, string, string) (string, error)
+	// RepoInstalled defines a function that checks if the scm acts
+	// on a repo with an app installation instead of a user's token.
+	RepoInstalled(context.Context, string, string) bool
 	// TeamAccess defines a function that captures
 	// the user's access level for a team.
 	TeamAccess(context.Context, *library.User, string, string) (string, error)
@@ -70,6 +73,9 @@ type Service interface {
 	//
 	// https://en.wikipedia.org/wiki/Changeset.
 	ChangesetPR(context.Context, *library.User, *library.Repo, int) ([]string, error)
+	// CommitMessage defines a function that captures
+	// the message for a commit.
+	CommitMessage(context.Context, *library.User, *library.Repo, string) (string, error)
 
 	// Deployment SCM Interface Functions
 
@@ -107,15 +113,25 @@ type Service interface {
 	// Status defines a function that sends the
 	// commit status for the given SHA from a repo.
 	Status(context.Context, *library.User, *library.Build, string, string) error
+	// CheckRun defines a function that sets the check run with
+	// per-step detail for a build from a repo, or sends the commit
+	// status when the provider does not report check runs.
+	CheckRun(context.Context, *library.User, *library.Build, []*library.Step, string, string) error
 	// ListUserRepos defines a function that retrieves
 	// all repos with admin rights for the user.
 	ListUserRepos(context.Context, *library.User) ([]*library.Repo, error)
 	// GetBranch defines a function that retrieves
 	// a branch for a repo.
 	GetBranch(context.Context, *library.User, *library.Repo, string) (string, string, error)
+	// GetTag defines a function that retrieves
+	// the commit for a tag in a repo.
+	GetTag(context.Context, *library.User, *library.Repo, string) (string, error)
 	// GetPullRequest defines a function that retrieves
 	// a pull request for a repo.
 	GetPullRequest(context.Context, *library.User, *library.Repo, int) (string, string, string, string, error)
+	// CreateComment defines a function that creates
+	// a comment on a pull request for a repo.
+	CreateComment(context.Context, *library.User, *library.Repo, int, string) error
 	// GetRepo defines a function that retrieves
 	// details for a repo.
 	GetRepo(context.Context, *library.User, *library.Repo) (*library.Repo, error)
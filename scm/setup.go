@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/go-vela/server/scm/gitea"
 	"github.com/go-vela/server/scm/github"
-	"github.com/go-vela/types/constants"
+	"github.com/go-vela/server/scm/gitlab"
+	"github.com/go-vela/server/scm/local"
 
 	"github.com/sirupsen/logrus"
 )
@@ -20,6 +22,8 @@ type Setup struct {
 
 	// specifies the driver to use for the scm client
 	Driver string
+	// specifies the name of the scm provider for the scm client
+	Name string
 	// specifies the address to use for the scm client
 	Address string
 	// specifies the OAuth client ID from the scm system to use for the scm client
@@ -36,6 +40,16 @@ type Setup struct {
 	WebUIAddress string
 	// specifies the OAuth scopes to use for the scm client
 	Scopes []string
+	// specifies the GitHub App ID to use for the scm client
+	AppID int64
+	// specifies the PEM encoded GitHub App private key to use for the scm client
+	AppPrivateKey string
+	// specifies the GitHub App webhook secret to use for the scm client
+	AppWebhookSecret string
+	// specifies whether to report builds as GitHub check runs for the scm client
+	Checks bool
+	// specifies the path to the static configuration file providing users and permissions for the local scm client
+	LocalStaticFile string
 }
 
 // Github creates and returns a Vela service capable of
@@ -55,6 +69,11 @@ func (s *Setup) Github() (Service, error) {
 		github.WithStatusContext(s.StatusContext),
 		github.WithWebUIAddress(s.WebUIAddress),
 		github.WithScopes(s.Scopes),
+		github.WithName(s.Name),
+		github.WithGithubAppID(s.AppID),
+		github.WithGithubPrivateKey(s.AppPrivateKey),
+		github.WithGithubAppWebhookSecret(s.AppWebhookSecret),
+		github.WithChecks(s.Checks),
 	)
 }
 
@@ -63,7 +82,56 @@ func (s *Setup) Github() (Service, error) {
 func (s *Setup) Gitlab() (Service, error) {
 	logrus.Trace("creating gitlab scm client from setup")
 
-	return nil, fmt.Errorf("unsupported scm driver: %s", constants.DriverGitlab)
+	// create new Gitlab scm service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/scm/gitlab?tab=doc#New
+	return gitlab.New(
+		gitlab.WithAddress(s.Address),
+		gitlab.WithClientID(s.ClientID),
+		gitlab.WithClientSecret(s.ClientSecret),
+		gitlab.WithServerAddress(s.ServerAddress),
+		gitlab.WithServerWebhookAddress(s.ServerWebhookAddress),
+		gitlab.WithStatusContext(s.StatusContext),
+		gitlab.WithWebUIAddress(s.WebUIAddress),
+		gitlab.WithScopes(s.Scopes),
+		gitlab.WithName(s.Name),
+	)
+}
+
+// Gitea creates and returns a Vela service capable of
+// integrating with a Gitea or Forgejo scm system.
+func (s *Setup) Gitea() (Service, error) {
+	logrus.Trace("creating gitea scm client from setup")
+
+	// create new Gitea scm service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/scm/gitea?tab=doc#New
+	return gitea.New(
+		gitea.WithAddress(s.Address),
+		gitea.WithClientID(s.ClientID),
+		gitea.WithClientSecret(s.ClientSecret),
+		gitea.WithServerAddress(s.ServerAddress),
+		gitea.WithServerWebhookAddress(s.ServerWebhookAddress),
+		gitea.WithStatusContext(s.StatusContext),
+		gitea.WithWebUIAddress(s.WebUIAddress),
+		gitea.WithScopes(s.Scopes),
+		gitea.WithName(s.Name),
+	)
+}
+
+// Local creates and returns a Vela service capable of
+// integrating with bare git repositories on the local filesystem.
+func (s *Setup) Local() (Service, error) {
+	logrus.Trace("creating local scm client from setup")
+
+	// create new local scm service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/scm/local?tab=doc#New
+	return local.New(
+		local.WithAddress(s.Address),
+		local.WithStaticFile(s.LocalStaticFile),
+		local.WithName(s.Name),
+	)
 }
 
 // Validate verifies the necessary fields for the
@@ -91,6 +159,17 @@ func (s *Setup) Validate() error {
 		return fmt.Errorf("scm address must not have trailing slash")
 	}
 
+	// the local scm driver authenticates users from the static configuration file
+	if s.Driver == local.DriverLocal {
+		// verify a static configuration file was provided
+		if len(s.LocalStaticFile) == 0 {
+			return fmt.Errorf("no scm local static configuration file provided")
+		}
+
+		// setup is valid
+		return nil
+	}
+
 	// verify a scm OAuth client ID was provided
 	if len(s.ClientID) == 0 {
 		return fmt.Errorf("no scm client id provided")
@@ -110,6 +189,16 @@ func (s *Setup) Validate() error {
 		return fmt.Errorf("no scm scopes provided")
 	}
 
+	// verify the GitHub App ID and private key are provided together
+	if (s.AppID > 0) != (len(s.AppPrivateKey) > 0) {
+		return fmt.Errorf("scm app id and app private key must be provided together")
+	}
+
+	// verify a GitHub App is provided when reporting builds as check runs
+	if s.Checks && s.AppID == 0 {
+		return fmt.Errorf("scm app id and app private key must be provided to report builds as check runs")
+	}
+
 	// setup is valid
 	return nil
 }
@@ -77,16 +77,163 @@ func TestSCM_Setup_Gitlab(t *testing.T) {
 		ServerWebhookAddress: "",
 		StatusContext:        "continuous-integration/vela",
 		WebUIAddress:         "https://vela.example.com",
+		Scopes:               []string{"api", "read_user"},
 	}
 
-	// run test
-	got, err := _setup.Gitlab()
-	if err == nil {
-		t.Errorf("Gitlab should have returned err")
+	_gitlab, err := _setup.Gitlab()
+	if err != nil {
+		t.Errorf("unable to setup scm: %v", err)
 	}
 
-	if got != nil {
-		t.Errorf("Gitlab is %v, want nil", got)
+	// setup tests
+	tests := []struct {
+		failure bool
+		setup   *Setup
+		want    Service
+	}{
+		{
+			failure: false,
+			setup:   _setup,
+			want:    _gitlab,
+		},
+		{
+			failure: true,
+			setup:   &Setup{Driver: "gitlab"},
+			want:    nil,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got, err := test.setup.Gitlab()
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("Gitlab should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("Gitlab returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("Gitlab is %v, want %v", got, test.want)
+		}
+	}
+}
+
+func TestSCM_Setup_Gitea(t *testing.T) {
+	// setup types
+	_setup := &Setup{
+		Driver:               "gitea",
+		Address:              "https://gitea.com",
+		ClientID:             "foo",
+		ClientSecret:         "bar",
+		ServerAddress:        "https://vela-server.example.com",
+		ServerWebhookAddress: "",
+		StatusContext:        "continuous-integration/vela",
+		WebUIAddress:         "https://vela.example.com",
+		Scopes:               []string{"read:user", "read:organization", "write:repository"},
+	}
+
+	_gitea, err := _setup.Gitea()
+	if err != nil {
+		t.Errorf("unable to setup scm: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure bool
+		setup   *Setup
+		want    Service
+	}{
+		{
+			failure: false,
+			setup:   _setup,
+			want:    _gitea,
+		},
+		{
+			failure: true,
+			setup:   &Setup{Driver: "gitea"},
+			want:    nil,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got, err := test.setup.Gitea()
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("Gitea should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("Gitea returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("Gitea is %v, want %v", got, test.want)
+		}
+	}
+}
+
+func TestSCM_Setup_Local(t *testing.T) {
+	// setup types
+	_setup := &Setup{
+		Driver:          "local",
+		Address:         "file:///var/lib/git",
+		LocalStaticFile: "local/testdata/static.yml",
+	}
+
+	_local, err := _setup.Local()
+	if err != nil {
+		t.Errorf("unable to setup scm: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure bool
+		setup   *Setup
+		want    Service
+	}{
+		{
+			failure: false,
+			setup:   _setup,
+			want:    _local,
+		},
+		{
+			failure: true,
+			setup:   &Setup{Driver: "local"},
+			want:    nil,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got, err := test.setup.Local()
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("Local should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("Local returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("Local is %v, want %v", got, test.want)
+		}
 	}
 }
 
@@ -124,6 +271,51 @@ func TestSCM_Setup_Validate(t *testing.T) {
 				Scopes:               []string{"repo", "repo:status", "user:email", "read:user", "read:org"},
 			},
 		},
+		{
+			failure: false,
+			setup: &Setup{
+				Driver:          "local",
+				Address:         "file:///var/lib/git",
+				LocalStaticFile: "local/testdata/static.yml",
+			},
+		},
+		{
+			failure: true,
+			setup: &Setup{
+				Driver:  "local",
+				Address: "file:///var/lib/git",
+			},
+		},
+		{
+			failure: true,
+			setup: &Setup{
+				Driver:               "github",
+				Address:              "https://github.com",
+				ClientID:             "foo",
+				ClientSecret:         "bar",
+				ServerAddress:        "https://vela-server.example.com",
+				ServerWebhookAddress: "",
+				StatusContext:        "continuous-integration/vela",
+				WebUIAddress:         "https://vela.example.com",
+				Scopes:               []string{"repo", "repo:status", "user:email", "read:user", "read:org"},
+				AppID:                1,
+			},
+		},
+		{
+			failure: true,
+			setup: &Setup{
+				Driver:               "github",
+				Address:              "https://github.com",
+				ClientID:             "foo",
+				ClientSecret:         "bar",
+				ServerAddress:        "https://vela-server.example.com",
+				ServerWebhookAddress: "",
+				StatusContext:        "continuous-integration/vela",
+				WebUIAddress:         "https://vela.example.com",
+				Scopes:               []string{"repo", "repo:status", "user:email", "read:user", "read:org"},
+				Checks:               true,
+			},
+		},
 		{
 			failure: true,
 			setup: &Setup{
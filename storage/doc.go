@@ -0,0 +1,9 @@
+// SPDX-License-Identifier: Apache-2.0
+
+// Package storage provides the ability for Vela to integrate
+// with different supported backends for storing log data.
+//
+// Usage:
+//
+//	import "github.com/go-vela/server/storage"
+package storage
@@ -0,0 +1,12 @@
+// SPDX-License-Identifier: Apache-2.0
+
+// Package filesystem provides the ability for Vela to store
+// log data in a local directory.
+//
+// The directory can be a mounted volume shared between
+// multiple servers.
+//
+// Usage:
+//
+//	import "github.com/go-vela/server/storage/filesystem"
+package filesystem
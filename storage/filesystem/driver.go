@@ -0,0 +1,10 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package filesystem
+
+import "github.com/go-vela/server/constants"
+
+// Driver outputs the configured log storage driver.
+func (c *client) Driver() string {
+	return constants.DriverFilesystem
+}
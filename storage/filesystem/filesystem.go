@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package filesystem
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/sirupsen/logrus"
+)
+
+type config struct {
+	// specifies the directory to store objects in for the Filesystem client
+	Directory string
+}
+
+type client struct {
+	config *config
+	// https://pkg.go.dev/github.com/sirupsen/logrus#Entry
+	Logger *logrus.Entry
+}
+
+// New returns a Storage implementation that
+// integrates with a local directory.
+//
+//nolint:revive // ignore returning unexported client
+func New(opts ...ClientOpt) (*client, error) {
+	// create new Filesystem client
+	c := new(client)
+
+	// create new fields
+	c.config = new(config)
+
+	// create new logger for the client
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#StandardLogger
+	logger := logrus.StandardLogger()
+
+	// create new logger for the client
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#NewEntry
+	c.Logger = logrus.NewEntry(logger).WithField("storage", c.Driver())
+
+	// apply all provided configuration options
+	for _, opt := range opts {
+		err := opt(c)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// check if a directory was provided
+	if len(c.config.Directory) == 0 {
+		return nil, fmt.Errorf("no Filesystem storage directory provided")
+	}
+
+	return c, nil
+}
+
+// path is a helper function to capture the path
+// of an object in the directory from the key.
+func (c *client) path(key string) (string, error) {
+	// prevent keys from escaping the directory
+	if !filepath.IsLocal(key) {
+		return "", fmt.Errorf("invalid Filesystem storage key provided: %s", key)
+	}
+
+	return filepath.Join(c.config.Directory, filepath.FromSlash(key)), nil
+}
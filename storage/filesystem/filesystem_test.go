@@ -0,0 +1,54 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package filesystem
+
+import (
+	"testing"
+)
+
+func TestFilesystem_New(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure   bool
+		directory string
+	}{
+		{
+			failure:   false,
+			directory: t.TempDir(),
+		},
+		{
+			failure:   true,
+			directory: "",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		_, err := New(
+			WithDirectory(test.directory),
+		)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("New should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("New returned err: %v", err)
+		}
+	}
+}
+
+// testClient is a helper function to create
+// a Filesystem client in a temporary directory.
+func testClient(t *testing.T) *client {
+	_service, err := New(WithDirectory(t.TempDir()))
+	if err != nil {
+		t.Fatalf("unable to create filesystem storage service: %v", err)
+	}
+
+	return _service
+}
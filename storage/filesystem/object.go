@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package filesystem
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// Get reads the data of an object from the directory.
+func (c *client) Get(ctx context.Context, key string) ([]byte, error) {
+	c.Logger.Tracef("reading object %s from the directory", key)
+
+	path, err := c.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return os.ReadFile(path)
+}
+
+// Put writes the data of an object to the directory.
+func (c *client) Put(ctx context.Context, key string, data []byte) error {
+	c.Logger.Tracef("writing object %s to the directory", key)
+
+	path, err := c.path(key)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(path), 0o750)
+	if err != nil {
+		return err
+	}
+
+	// write to a temporary file in the same directory first
+	// so readers never observe a partially written object
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".tmp-*")
+	if err != nil {
+		return err
+	}
+
+	defer os.Remove(tmp.Name())
+
+	_, err = tmp.Write(data)
+	if err != nil {
+		tmp.Close()
+
+		return err
+	}
+
+	err = tmp.Close()
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
+
+// Delete removes an object from the directory.
+func (c *client) Delete(ctx context.Context, key string) error {
+	c.Logger.Tracef("removing object %s from the directory", key)
+
+	path, err := c.path(key)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(path)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
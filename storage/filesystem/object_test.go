@@ -0,0 +1,84 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package filesystem
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestFilesystem_Object(t *testing.T) {
+	// setup types
+	_service := testClient(t)
+
+	key := "1/2/step/3"
+	want := []byte("foo")
+
+	// run test
+	err := _service.Put(context.TODO(), key, want)
+	if err != nil {
+		t.Errorf("Put returned err: %v", err)
+	}
+
+	// ensure writing an object again replaces it
+	err = _service.Put(context.TODO(), key, want)
+	if err != nil {
+		t.Errorf("Put returned err: %v", err)
+	}
+
+	got, err := _service.Get(context.TODO(), key)
+	if err != nil {
+		t.Errorf("Get returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Get is %v, want %v", got, want)
+	}
+
+	err = _service.Delete(context.TODO(), key)
+	if err != nil {
+		t.Errorf("Delete returned err: %v", err)
+	}
+
+	_, err = _service.Get(context.TODO(), key)
+	if err == nil {
+		t.Errorf("Get for deleted object should have returned err")
+	}
+
+	// ensure removing a missing object is not an error
+	err = _service.Delete(context.TODO(), key)
+	if err != nil {
+		t.Errorf("Delete for missing object returned err: %v", err)
+	}
+}
+
+func TestFilesystem_Object_InvalidKey(t *testing.T) {
+	// setup types
+	_service := testClient(t)
+
+	// setup tests
+	tests := []string{
+		"../foo",
+		"/foo",
+		"",
+	}
+
+	// run tests
+	for _, key := range tests {
+		err := _service.Put(context.TODO(), key, []byte("foo"))
+		if err == nil {
+			t.Errorf("Put for %q should have returned err", key)
+		}
+
+		_, err = _service.Get(context.TODO(), key)
+		if err == nil {
+			t.Errorf("Get for %q should have returned err", key)
+		}
+
+		err = _service.Delete(context.TODO(), key)
+		if err == nil {
+			t.Errorf("Delete for %q should have returned err", key)
+		}
+	}
+}
@@ -0,0 +1,34 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package filesystem
+
+import (
+	"fmt"
+	"os"
+)
+
+// ClientOpt represents a configuration option to initialize the storage client for Filesystem.
+type ClientOpt func(*client) error
+
+// WithDirectory sets the directory in the storage client for Filesystem.
+func WithDirectory(directory string) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring directory in filesystem storage client")
+
+		// check if the directory provided is empty
+		if len(directory) == 0 {
+			return fmt.Errorf("no Filesystem storage directory provided")
+		}
+
+		// create the directory if it does not exist
+		err := os.MkdirAll(directory, 0o750)
+		if err != nil {
+			return fmt.Errorf("unable to create Filesystem storage directory %s: %w", directory, err)
+		}
+
+		// set the storage directory in the filesystem client
+		c.config.Directory = directory
+
+		return nil
+	}
+}
@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package filesystem
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+)
+
+func TestFilesystem_ClientOpt_WithDirectory(t *testing.T) {
+	// setup types
+	nested := filepath.Join(t.TempDir(), "foo", "bar")
+
+	// setup tests
+	tests := []struct {
+		failure   bool
+		directory string
+		want      string
+	}{
+		{
+			failure:   false,
+			directory: nested,
+			want:      nested,
+		},
+		{
+			failure:   true,
+			directory: "",
+			want:      "",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		_service := &client{config: new(config), Logger: logrus.NewEntry(logrus.StandardLogger())}
+
+		err := WithDirectory(test.directory)(_service)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("WithDirectory should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("WithDirectory returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(_service.config.Directory, test.want) {
+			t.Errorf("WithDirectory is %v, want %v", _service.config.Directory, test.want)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"time"
+
+	"github.com/go-vela/server/constants"
+	"github.com/urfave/cli/v2"
+)
+
+// Flags represents all supported command line
+// interface (CLI) flags for the log storage.
+//
+// https://pkg.go.dev/github.com/urfave/cli?tab=doc#Flag
+var Flags = []cli.Flag{
+	// Storage Flags
+
+	&cli.StringFlag{
+		EnvVars:  []string{"VELA_STORAGE_DRIVER", "STORAGE_DRIVER"},
+		FilePath: "/vela/storage/driver",
+		Name:     "storage.driver",
+		Usage:    "driver to be used for storing log data (database, filesystem or s3)",
+		Value:    constants.DriverDatabase,
+	},
+	&cli.StringFlag{
+		EnvVars:  []string{"VELA_STORAGE_DIR", "STORAGE_DIR"},
+		FilePath: "/vela/storage/dir",
+		Name:     "storage.dir",
+		Usage:    "local directory for storing log data with the filesystem driver",
+	},
+	&cli.StringFlag{
+		EnvVars:  []string{"VELA_STORAGE_ADDR", "STORAGE_ADDR"},
+		FilePath: "/vela/storage/addr",
+		Name:     "storage.addr",
+		Usage:    "fully qualified url (<scheme>://<host>) for an S3-compatible object store (defaults to AWS)",
+	},
+	&cli.StringFlag{
+		EnvVars:  []string{"VELA_STORAGE_BUCKET", "STORAGE_BUCKET"},
+		FilePath: "/vela/storage/bucket",
+		Name:     "storage.bucket",
+		Usage:    "bucket for storing log data with the s3 driver",
+	},
+	&cli.StringFlag{
+		EnvVars:  []string{"VELA_STORAGE_REGION", "STORAGE_REGION"},
+		FilePath: "/vela/storage/region",
+		Name:     "storage.region",
+		Usage:    "region of the bucket for storing log data with the s3 driver",
+		Value:    "us-east-1",
+	},
+	&cli.StringFlag{
+		EnvVars:  []string{"VELA_STORAGE_ACCESS_KEY", "STORAGE_ACCESS_KEY"},
+		FilePath: "/vela/storage/access_key",
+		Name:     "storage.access-key",
+		Usage:    "access key for the s3 driver (defaults to the AWS credential chain when empty)",
+	},
+	&cli.StringFlag{
+		EnvVars:  []string{"VELA_STORAGE_SECRET_KEY", "STORAGE_SECRET_KEY"},
+		FilePath: "/vela/storage/secret_key",
+		Name:     "storage.secret-key",
+		Usage:    "secret key for the s3 driver (defaults to the AWS credential chain when empty)",
+	},
+	&cli.StringFlag{
+		EnvVars:  []string{"VELA_STORAGE_PREFIX", "STORAGE_PREFIX"},
+		FilePath: "/vela/storage/prefix",
+		Name:     "storage.prefix",
+		Usage:    "prefix prepended to the keys of objects with the s3 driver",
+	},
+	&cli.BoolFlag{
+		EnvVars:  []string{"VELA_STORAGE_PATH_STYLE", "STORAGE_PATH_STYLE"},
+		FilePath: "/vela/storage/path_style",
+		Name:     "storage.path-style",
+		Usage:    "enables addressing buckets by path with the s3 driver (required by most S3-compatible stores)",
+	},
+	&cli.DurationFlag{
+		EnvVars:  []string{"VELA_STORAGE_MIGRATE_INTERVAL", "STORAGE_MIGRATE_INTERVAL"},
+		FilePath: "/vela/storage/migrate/interval",
+		Name:     "storage.migrate.interval",
+		Usage:    "interval for moving log data from the database to the log storage (disabled when zero)",
+		Value:    5 * time.Minute,
+	},
+	&cli.IntFlag{
+		EnvVars:  []string{"VELA_STORAGE_MIGRATE_BATCH", "STORAGE_MIGRATE_BATCH"},
+		FilePath: "/vela/storage/migrate/batch",
+		Name:     "storage.migrate.batch",
+		Usage:    "maximum number of logs moved from the database to the log storage per interval",
+		Value:    100,
+	},
+}
@@ -0,0 +1,12 @@
+// SPDX-License-Identifier: Apache-2.0
+
+// Package s3 provides the ability for Vela to store
+// log data in an S3-compatible object store.
+//
+// Besides AWS, any store implementing the S3 API
+// (i.e. MinIO) can be used by providing its address.
+//
+// Usage:
+//
+//	import "github.com/go-vela/server/storage/s3"
+package s3
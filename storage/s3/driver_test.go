@@ -0,0 +1,24 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package s3
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/go-vela/server/constants"
+)
+
+func TestS3_Driver(t *testing.T) {
+	// setup types
+	want := constants.DriverS3
+
+	_service := testClient(t, "http://localhost:9000", "")
+
+	// run test
+	got := _service.Driver()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Driver is %v, want %v", got, want)
+	}
+}
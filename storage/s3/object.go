@@ -0,0 +1,65 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package s3
+
+import (
+	"bytes"
+	"context"
+	"io"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+// Get reads the data of an object from the bucket.
+func (c *client) Get(ctx context.Context, key string) ([]byte, error) {
+	c.Logger.Tracef("reading object %s from the bucket", key)
+
+	// send API call to capture the object
+	//
+	// https://pkg.go.dev/github.com/aws/aws-sdk-go/service/s3#S3.GetObjectWithContext
+	output, err := c.S3.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(c.config.Bucket),
+		Key:    aws.String(c.key(key)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	defer output.Body.Close()
+
+	return io.ReadAll(output.Body)
+}
+
+// Put writes the data of an object to the bucket.
+func (c *client) Put(ctx context.Context, key string, data []byte) error {
+	c.Logger.Tracef("writing object %s to the bucket", key)
+
+	// send API call to create the object
+	//
+	// https://pkg.go.dev/github.com/aws/aws-sdk-go/service/s3#S3.PutObjectWithContext
+	_, err := c.S3.PutObjectWithContext(ctx, &s3.PutObjectInput{
+		Bucket: aws.String(c.config.Bucket),
+		Key:    aws.String(c.key(key)),
+		Body:   bytes.NewReader(data),
+	})
+
+	return err
+}
+
+// Delete removes an object from the bucket.
+func (c *client) Delete(ctx context.Context, key string) error {
+	c.Logger.Tracef("removing object %s from the bucket", key)
+
+	// send API call to remove the object
+	//
+	// S3 does not return an error for a missing object.
+	//
+	// https://pkg.go.dev/github.com/aws/aws-sdk-go/service/s3#S3.DeleteObjectWithContext
+	_, err := c.S3.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(c.config.Bucket),
+		Key:    aws.String(c.key(key)),
+	})
+
+	return err
+}
@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package s3
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestS3_Object(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name   string
+		prefix string
+		want   string
+	}{
+		{
+			name:   "without prefix",
+			prefix: "",
+			want:   "1/2/step/3",
+		},
+		{
+			name:   "with prefix",
+			prefix: "/logs/",
+			want:   "logs/1/2/step/3",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s, objects := testServer(t, "vela")
+
+			_service := testClient(t, s.URL, test.prefix)
+
+			data := []byte("foo")
+
+			err := _service.Put(context.TODO(), "1/2/step/3", data)
+			if err != nil {
+				t.Errorf("Put for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(objects[test.want], data) {
+				t.Errorf("Put for %s stored %v at %s, want %v", test.name, objects[test.want], test.want, data)
+			}
+
+			got, err := _service.Get(context.TODO(), "1/2/step/3")
+			if err != nil {
+				t.Errorf("Get for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, data) {
+				t.Errorf("Get for %s is %v, want %v", test.name, got, data)
+			}
+
+			err = _service.Delete(context.TODO(), "1/2/step/3")
+			if err != nil {
+				t.Errorf("Delete for %s returned err: %v", test.name, err)
+			}
+
+			if _, ok := objects[test.want]; ok {
+				t.Errorf("Delete for %s did not remove %s", test.name, test.want)
+			}
+
+			_, err = _service.Get(context.TODO(), "1/2/step/3")
+			if err == nil {
+				t.Errorf("Get for %s deleted object should have returned err", test.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package s3
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ClientOpt represents a configuration option to initialize the storage client for S3.
+type ClientOpt func(*client) error
+
+// WithAddress sets the address in the storage client for S3.
+func WithAddress(address string) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring address in s3 storage client")
+
+		// set the storage address in the s3 client
+		c.config.Address = address
+
+		return nil
+	}
+}
+
+// WithBucket sets the bucket in the storage client for S3.
+func WithBucket(bucket string) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring bucket in s3 storage client")
+
+		// check if the bucket provided is empty
+		if len(bucket) == 0 {
+			return fmt.Errorf("no S3 storage bucket provided")
+		}
+
+		// set the storage bucket in the s3 client
+		c.config.Bucket = bucket
+
+		return nil
+	}
+}
+
+// WithRegion sets the region in the storage client for S3.
+func WithRegion(region string) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring region in s3 storage client")
+
+		// set the storage region in the s3 client
+		c.config.Region = region
+
+		return nil
+	}
+}
+
+// WithCredentials sets the access and secret keys in the storage client for S3.
+func WithCredentials(accessKey, secretKey string) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring credentials in s3 storage client")
+
+		// check if only one of the keys provided is empty
+		if (len(accessKey) == 0) != (len(secretKey) == 0) {
+			return fmt.Errorf("S3 storage access key and secret key must be provided together")
+		}
+
+		// set the storage credentials in the s3 client
+		c.config.AccessKey = accessKey
+		c.config.SecretKey = secretKey
+
+		return nil
+	}
+}
+
+// WithPrefix sets the prefix for keys in the storage client for S3.
+func WithPrefix(prefix string) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring prefix in s3 storage client")
+
+		// set the storage prefix in the s3 client
+		c.config.Prefix = strings.Trim(prefix, "/")
+
+		return nil
+	}
+}
+
+// WithPathStyle sets the path style addressing in the storage client for S3.
+func WithPathStyle(pathStyle bool) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring path style in s3 storage client")
+
+		// set the storage path style in the s3 client
+		c.config.PathStyle = pathStyle
+
+		return nil
+	}
+}
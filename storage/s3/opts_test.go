@@ -0,0 +1,190 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package s3
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+)
+
+func TestS3_ClientOpt_WithAddress(t *testing.T) {
+	// setup types
+	_service := &client{config: new(config), Logger: logrus.NewEntry(logrus.StandardLogger())}
+
+	want := "http://localhost:9000"
+
+	// run test
+	err := WithAddress(want)(_service)
+	if err != nil {
+		t.Errorf("WithAddress returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(_service.config.Address, want) {
+		t.Errorf("WithAddress is %v, want %v", _service.config.Address, want)
+	}
+}
+
+func TestS3_ClientOpt_WithBucket(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		bucket  string
+		want    string
+	}{
+		{
+			failure: false,
+			bucket:  "vela",
+			want:    "vela",
+		},
+		{
+			failure: true,
+			bucket:  "",
+			want:    "",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		_service := &client{config: new(config), Logger: logrus.NewEntry(logrus.StandardLogger())}
+
+		err := WithBucket(test.bucket)(_service)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("WithBucket should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("WithBucket returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(_service.config.Bucket, test.want) {
+			t.Errorf("WithBucket is %v, want %v", _service.config.Bucket, test.want)
+		}
+	}
+}
+
+func TestS3_ClientOpt_WithRegion(t *testing.T) {
+	// setup types
+	_service := &client{config: new(config), Logger: logrus.NewEntry(logrus.StandardLogger())}
+
+	want := "us-east-1"
+
+	// run test
+	err := WithRegion(want)(_service)
+	if err != nil {
+		t.Errorf("WithRegion returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(_service.config.Region, want) {
+		t.Errorf("WithRegion is %v, want %v", _service.config.Region, want)
+	}
+}
+
+func TestS3_ClientOpt_WithCredentials(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure   bool
+		accessKey string
+		secretKey string
+	}{
+		{
+			failure:   false,
+			accessKey: "foo",
+			secretKey: "bar",
+		},
+		{
+			failure:   false,
+			accessKey: "",
+			secretKey: "",
+		},
+		{
+			failure:   true,
+			accessKey: "foo",
+			secretKey: "",
+		},
+		{
+			failure:   true,
+			accessKey: "",
+			secretKey: "bar",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		_service := &client{config: new(config), Logger: logrus.NewEntry(logrus.StandardLogger())}
+
+		err := WithCredentials(test.accessKey, test.secretKey)(_service)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("WithCredentials should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("WithCredentials returned err: %v", err)
+		}
+
+		if _service.config.AccessKey != test.accessKey || _service.config.SecretKey != test.secretKey {
+			t.Errorf("WithCredentials is %s/%s, want %s/%s", _service.config.AccessKey, _service.config.SecretKey, test.accessKey, test.secretKey)
+		}
+	}
+}
+
+func TestS3_ClientOpt_WithPrefix(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		prefix string
+		want   string
+	}{
+		{
+			prefix: "logs",
+			want:   "logs",
+		},
+		{
+			prefix: "/logs/",
+			want:   "logs",
+		},
+		{
+			prefix: "",
+			want:   "",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		_service := &client{config: new(config), Logger: logrus.NewEntry(logrus.StandardLogger())}
+
+		err := WithPrefix(test.prefix)(_service)
+		if err != nil {
+			t.Errorf("WithPrefix returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(_service.config.Prefix, test.want) {
+			t.Errorf("WithPrefix is %v, want %v", _service.config.Prefix, test.want)
+		}
+	}
+}
+
+func TestS3_ClientOpt_WithPathStyle(t *testing.T) {
+	// setup types
+	_service := &client{config: new(config), Logger: logrus.NewEntry(logrus.StandardLogger())}
+
+	// run test
+	err := WithPathStyle(true)(_service)
+	if err != nil {
+		t.Errorf("WithPathStyle returned err: %v", err)
+	}
+
+	if !_service.config.PathStyle {
+		t.Errorf("WithPathStyle is %v, want %v", _service.config.PathStyle, true)
+	}
+}
@@ -0,0 +1,118 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package s3
+
+import (
+	"fmt"
+	"path"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/sirupsen/logrus"
+)
+
+type config struct {
+	// specifies the endpoint of the object store for the S3 client
+	Address string
+	// specifies the bucket to store objects in for the S3 client
+	Bucket string
+	// specifies the region of the bucket for the S3 client
+	Region string
+	// specifies the access key for the S3 client
+	AccessKey string
+	// specifies the secret key for the S3 client
+	SecretKey string
+	// specifies the prefix prepended to the keys of objects for the S3 client
+	Prefix string
+	// enables the S3 client to address buckets by path instead of subdomain
+	PathStyle bool
+}
+
+type client struct {
+	config *config
+	// https://pkg.go.dev/github.com/aws/aws-sdk-go/service/s3/s3iface#S3API
+	S3 s3iface.S3API
+	// https://pkg.go.dev/github.com/sirupsen/logrus#Entry
+	Logger *logrus.Entry
+}
+
+// New returns a Storage implementation that
+// integrates with an S3-compatible object store.
+//
+//nolint:revive // ignore returning unexported client
+func New(opts ...ClientOpt) (*client, error) {
+	// create new S3 client
+	c := new(client)
+
+	// create new fields
+	c.config = new(config)
+
+	// create new logger for the client
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#StandardLogger
+	logger := logrus.StandardLogger()
+
+	// create new logger for the client
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#NewEntry
+	c.Logger = logrus.NewEntry(logger).WithField("storage", c.Driver())
+
+	// apply all provided configuration options
+	for _, opt := range opts {
+		err := opt(c)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// check if a bucket was provided
+	if len(c.config.Bucket) == 0 {
+		return nil, fmt.Errorf("no S3 storage bucket provided")
+	}
+
+	cfg := aws.Config{
+		Region:           aws.String(c.config.Region),
+		S3ForcePathStyle: aws.Bool(c.config.PathStyle),
+	}
+
+	// use the provided endpoint instead of AWS
+	if len(c.config.Address) > 0 {
+		cfg.Endpoint = aws.String(c.config.Address)
+	}
+
+	// use the provided keys instead of the AWS credential chain
+	if len(c.config.AccessKey) > 0 {
+		cfg.Credentials = credentials.NewStaticCredentials(c.config.AccessKey, c.config.SecretKey, "")
+	}
+
+	// create session for the object store
+	//
+	// https://pkg.go.dev/github.com/aws/aws-sdk-go/aws/session#NewSessionWithOptions
+	sess, err := session.NewSessionWithOptions(session.Options{
+		Config:            cfg,
+		SharedConfigState: session.SharedConfigEnable,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to create S3 storage session: %w", err)
+	}
+
+	// create the client for the object store
+	//
+	// https://pkg.go.dev/github.com/aws/aws-sdk-go/service/s3#New
+	c.S3 = s3.New(sess)
+
+	return c, nil
+}
+
+// key is a helper function to capture the key
+// of an object in the bucket with the prefix.
+func (c *client) key(key string) string {
+	if len(c.config.Prefix) == 0 {
+		return key
+	}
+
+	return path.Join(c.config.Prefix, key)
+}
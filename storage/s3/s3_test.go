@@ -0,0 +1,141 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package s3
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestS3_New(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		opts    []ClientOpt
+	}{
+		{
+			failure: false,
+			opts: []ClientOpt{
+				WithAddress("http://localhost:9000"),
+				WithBucket("vela"),
+				WithRegion("us-east-1"),
+				WithCredentials("foo", "bar"),
+				WithPathStyle(true),
+			},
+		},
+		{
+			failure: false,
+			opts: []ClientOpt{
+				WithBucket("vela"),
+				WithRegion("us-east-1"),
+			},
+		},
+		{
+			failure: true,
+			opts: []ClientOpt{
+				WithRegion("us-east-1"),
+			},
+		},
+		{
+			failure: true,
+			opts: []ClientOpt{
+				WithBucket("vela"),
+				WithCredentials("foo", ""),
+			},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		_, err := New(test.opts...)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("New should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("New returned err: %v", err)
+		}
+	}
+}
+
+// testServer is a helper function to create a mock
+// S3-compatible object store holding objects in memory.
+func testServer(t *testing.T, bucket string) (*httptest.Server, map[string][]byte) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	objects := make(map[string][]byte)
+	mutex := sync.Mutex{}
+
+	// setup mock server
+	engine.PUT("/"+bucket+"/*key", func(c *gin.Context) {
+		data, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			c.Status(http.StatusInternalServerError)
+			return
+		}
+
+		mutex.Lock()
+		objects[c.Param("key")[1:]] = data
+		mutex.Unlock()
+
+		c.Status(http.StatusOK)
+	})
+
+	engine.GET("/"+bucket+"/*key", func(c *gin.Context) {
+		mutex.Lock()
+		data, ok := objects[c.Param("key")[1:]]
+		mutex.Unlock()
+
+		if !ok {
+			c.Data(http.StatusNotFound, "application/xml", []byte("<Error><Code>NoSuchKey</Code></Error>"))
+			return
+		}
+
+		c.Data(http.StatusOK, "application/octet-stream", data)
+	})
+
+	engine.DELETE("/"+bucket+"/*key", func(c *gin.Context) {
+		mutex.Lock()
+		delete(objects, c.Param("key")[1:])
+		mutex.Unlock()
+
+		c.Status(http.StatusNoContent)
+	})
+
+	s := httptest.NewServer(engine)
+	t.Cleanup(s.Close)
+
+	return s, objects
+}
+
+// testClient is a helper function to create
+// an S3 client for the mock object store.
+func testClient(t *testing.T, address, prefix string) *client {
+	_service, err := New(
+		WithAddress(address),
+		WithBucket("vela"),
+		WithRegion("us-east-1"),
+		WithCredentials("foo", "bar"),
+		WithPrefix(prefix),
+		WithPathStyle(true),
+	)
+	if err != nil {
+		t.Fatalf("unable to create s3 storage service: %v", err)
+	}
+
+	return _service
+}
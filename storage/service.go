@@ -0,0 +1,27 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import "context"
+
+// Service represents the interface for Vela integrating
+// with the different supported log storage backends.
+type Service interface {
+	// Service Interface Functions
+
+	// Driver defines a function that outputs
+	// the configured log storage driver.
+	Driver() string
+
+	// Get defines a function that reads
+	// the data of an object by key.
+	Get(context.Context, string) ([]byte, error)
+
+	// Put defines a function that writes
+	// the data of an object by key.
+	Put(context.Context, string, []byte) error
+
+	// Delete defines a function that removes an object
+	// by key, without an error if it does not exist.
+	Delete(context.Context, string) error
+}
@@ -0,0 +1,112 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-vela/server/constants"
+	"github.com/go-vela/server/storage/filesystem"
+	"github.com/go-vela/server/storage/s3"
+	"github.com/sirupsen/logrus"
+)
+
+// Setup represents the configuration necessary for
+// creating a Vela service capable of integrating
+// with a configured log storage environment.
+type Setup struct {
+	// Storage Configuration
+
+	// specifies the driver to use for the log storage client
+	Driver string
+	// specifies the local directory to use for the filesystem log storage client
+	Directory string
+	// specifies the endpoint to use for the s3 log storage client
+	Address string
+	// specifies the bucket to use for the s3 log storage client
+	Bucket string
+	// specifies the region to use for the s3 log storage client
+	Region string
+	// specifies the access key to use for the s3 log storage client
+	AccessKey string
+	// specifies the secret key to use for the s3 log storage client
+	SecretKey string
+	// specifies the prefix prepended to the keys of objects in the s3 log storage client
+	Prefix string
+	// enables the s3 log storage client to address buckets by path instead of subdomain
+	PathStyle bool
+}
+
+// Filesystem creates and returns a Vela service capable
+// of integrating with a local directory for log storage.
+func (s *Setup) Filesystem() (Service, error) {
+	logrus.Trace("creating filesystem log storage client from setup")
+
+	// create new Filesystem log storage service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/storage/filesystem?tab=doc#New
+	return filesystem.New(
+		filesystem.WithDirectory(s.Directory),
+	)
+}
+
+// S3 creates and returns a Vela service capable
+// of integrating with an S3-compatible object store.
+func (s *Setup) S3() (Service, error) {
+	logrus.Trace("creating s3 log storage client from setup")
+
+	// create new S3 log storage service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/storage/s3?tab=doc#New
+	return s3.New(
+		s3.WithAddress(s.Address),
+		s3.WithBucket(s.Bucket),
+		s3.WithRegion(s.Region),
+		s3.WithCredentials(s.AccessKey, s.SecretKey),
+		s3.WithPrefix(s.Prefix),
+		s3.WithPathStyle(s.PathStyle),
+	)
+}
+
+// Validate verifies the necessary fields for the
+// provided configuration are populated correctly.
+func (s *Setup) Validate() error {
+	logrus.Trace("validating log storage setup for client")
+
+	// verify a log storage driver was provided
+	if len(s.Driver) == 0 {
+		return fmt.Errorf("no log storage driver provided")
+	}
+
+	switch s.Driver {
+	case constants.DriverFilesystem:
+		// verify a log storage directory was provided
+		if len(s.Directory) == 0 {
+			return fmt.Errorf("no log storage directory provided")
+		}
+	case constants.DriverS3:
+		// verify a log storage bucket was provided
+		if len(s.Bucket) == 0 {
+			return fmt.Errorf("no log storage bucket provided")
+		}
+
+		// check if the log storage address has a scheme
+		if len(s.Address) > 0 && !strings.Contains(s.Address, "://") {
+			return fmt.Errorf("log storage address must be fully qualified (<scheme>://<host>)")
+		}
+
+		// check if the log storage address has a trailing slash
+		if strings.HasSuffix(s.Address, "/") {
+			return fmt.Errorf("log storage address must not have trailing slash")
+		}
+
+		// verify both or neither of the log storage keys were provided
+		if (len(s.AccessKey) == 0) != (len(s.SecretKey) == 0) {
+			return fmt.Errorf("log storage access key and secret key must be provided together")
+		}
+	}
+
+	// setup is valid
+	return nil
+}
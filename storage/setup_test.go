@@ -0,0 +1,137 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"testing"
+)
+
+func TestStorage_Setup_Filesystem(t *testing.T) {
+	// setup types
+	_setup := &Setup{
+		Driver:    "filesystem",
+		Directory: t.TempDir(),
+	}
+
+	_, err := _setup.Filesystem()
+	if err != nil {
+		t.Errorf("Filesystem returned err: %v", err)
+	}
+}
+
+func TestStorage_Setup_S3(t *testing.T) {
+	// setup types
+	_setup := &Setup{
+		Driver:    "s3",
+		Address:   "http://localhost:9000",
+		Bucket:    "vela",
+		Region:    "us-east-1",
+		AccessKey: "foo",
+		SecretKey: "bar",
+		PathStyle: true,
+	}
+
+	_, err := _setup.S3()
+	if err != nil {
+		t.Errorf("S3 returned err: %v", err)
+	}
+}
+
+func TestStorage_Setup_Validate(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		setup   *Setup
+	}{
+		{
+			failure: false,
+			setup: &Setup{
+				Driver: "database",
+			},
+		},
+		{
+			failure: false,
+			setup: &Setup{
+				Driver:    "filesystem",
+				Directory: "/vela/logs",
+			},
+		},
+		{
+			failure: false,
+			setup: &Setup{
+				Driver:    "s3",
+				Address:   "http://localhost:9000",
+				Bucket:    "vela",
+				AccessKey: "foo",
+				SecretKey: "bar",
+			},
+		},
+		{
+			failure: false,
+			setup: &Setup{
+				Driver: "s3",
+				Bucket: "vela",
+			},
+		},
+		{
+			failure: true,
+			setup: &Setup{
+				Driver: "",
+			},
+		},
+		{
+			failure: true,
+			setup: &Setup{
+				Driver: "filesystem",
+			},
+		},
+		{
+			failure: true,
+			setup: &Setup{
+				Driver:  "s3",
+				Address: "http://localhost:9000",
+			},
+		},
+		{
+			failure: true,
+			setup: &Setup{
+				Driver:  "s3",
+				Address: "localhost:9000",
+				Bucket:  "vela",
+			},
+		},
+		{
+			failure: true,
+			setup: &Setup{
+				Driver:  "s3",
+				Address: "http://localhost:9000/",
+				Bucket:  "vela",
+			},
+		},
+		{
+			failure: true,
+			setup: &Setup{
+				Driver:    "s3",
+				Bucket:    "vela",
+				AccessKey: "foo",
+			},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		err := test.setup.Validate()
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("Validate should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("Validate returned err: %v", err)
+		}
+	}
+}
@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"fmt"
+
+	"github.com/go-vela/server/constants"
+	"github.com/sirupsen/logrus"
+)
+
+// New creates and returns a Vela service capable of
+// integrating with the configured log storage.
+// Currently, the following log storages are supported:
+//
+// * database
+// * filesystem
+// * s3
+// .
+//
+// The database driver returns no service since
+// the log data is kept in the logs table.
+func New(s *Setup) (Service, error) {
+	// validate the setup being provided
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/storage?tab=doc#Setup.Validate
+	err := s.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	logrus.Debug("creating log storage client from setup")
+	// process the log storage driver being provided
+	switch s.Driver {
+	case constants.DriverDatabase:
+		// handle the Database log storage driver being provided
+		return nil, nil
+	case constants.DriverFilesystem:
+		// handle the Filesystem log storage driver being provided
+		//
+		// https://pkg.go.dev/github.com/go-vela/server/storage?tab=doc#Setup.Filesystem
+		return s.Filesystem()
+	case constants.DriverS3:
+		// handle the S3 log storage driver being provided
+		//
+		// https://pkg.go.dev/github.com/go-vela/server/storage?tab=doc#Setup.S3
+		return s.S3()
+	default:
+		// handle an invalid log storage driver being provided
+		return nil, fmt.Errorf("invalid log storage driver provided: %s", s.Driver)
+	}
+}
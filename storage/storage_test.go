@@ -0,0 +1,84 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"testing"
+)
+
+func TestStorage_New(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		setup   *Setup
+		want    string
+	}{
+		{
+			failure: false,
+			setup: &Setup{
+				Driver: "database",
+			},
+			want: "",
+		},
+		{
+			failure: false,
+			setup: &Setup{
+				Driver:    "filesystem",
+				Directory: t.TempDir(),
+			},
+			want: "filesystem",
+		},
+		{
+			failure: false,
+			setup: &Setup{
+				Driver:  "s3",
+				Address: "http://localhost:9000",
+				Bucket:  "vela",
+				Region:  "us-east-1",
+			},
+			want: "s3",
+		},
+		{
+			failure: true,
+			setup: &Setup{
+				Driver: "gcs",
+			},
+		},
+		{
+			failure: true,
+			setup: &Setup{
+				Driver: "",
+			},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got, err := New(test.setup)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("New for %s should have returned err", test.setup.Driver)
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("New for %s returned err: %v", test.setup.Driver, err)
+		}
+
+		// the database driver keeps logs in the database
+		if len(test.want) == 0 {
+			if got != nil {
+				t.Errorf("New for %s is %v, want nil", test.setup.Driver, got)
+			}
+
+			continue
+		}
+
+		if got.Driver() != test.want {
+			t.Errorf("New for %s is %s, want %s", test.setup.Driver, got.Driver(), test.want)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package stream
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+)
+
+// key defines the key type for storing
+// the stream Service in the context.
+const key = "stream"
+
+// FromContext retrieves the stream Service from the context.Context.
+func FromContext(c context.Context) Service {
+	// get stream value from context.Context
+	v := c.Value(key)
+	if v == nil {
+		return nil
+	}
+
+	// cast stream value to expected Service type
+	s, ok := v.(Service)
+	if !ok {
+		return nil
+	}
+
+	return s
+}
+
+// FromGinContext retrieves the stream Service from the gin.Context.
+func FromGinContext(c *gin.Context) Service {
+	// get stream value from gin.Context
+	//
+	// https://pkg.go.dev/github.com/gin-gonic/gin?tab=doc#Context.Get
+	v, ok := c.Get(key)
+	if !ok {
+		return nil
+	}
+
+	// cast stream value to expected Service type
+	s, ok := v.(Service)
+	if !ok {
+		return nil
+	}
+
+	return s
+}
+
+// WithContext inserts the stream Service into the context.Context.
+func WithContext(c context.Context, s Service) context.Context {
+	// set the stream Service in the context.Context
+	//
+	// https://pkg.go.dev/context?tab=doc#WithValue
+	//
+	//nolint:staticcheck,revive // ignore using string with context value
+	return context.WithValue(c, key, s)
+}
+
+// WithGinContext inserts the stream Service into the gin.Context.
+func WithGinContext(c *gin.Context, s Service) {
+	// set the stream Service in the gin.Context
+	//
+	// https://pkg.go.dev/github.com/gin-gonic/gin?tab=doc#Context.Set
+	c.Set(key, s)
+}
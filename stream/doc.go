@@ -0,0 +1,10 @@
+// SPDX-License-Identifier: Apache-2.0
+
+// Package stream provides the ability for Vela to integrate
+// with different supported backends for publishing live
+// log and status events to the clients following a build.
+//
+// Usage:
+//
+//	import "github.com/go-vela/server/stream"
+package stream
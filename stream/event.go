@@ -0,0 +1,110 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package stream
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+)
+
+const (
+	// EventBuild defines the event type for the status of a build.
+	EventBuild = "build"
+
+	// EventService defines the event type for the status of a service.
+	EventService = "service"
+
+	// EventStep defines the event type for the status of a step.
+	EventStep = "step"
+
+	// EventLog defines the event type for data written to a log.
+	EventLog = "log"
+
+	// EventEnd defines the event type sent when a stream is complete.
+	EventEnd = "end"
+)
+
+// Event represents an event published to a topic of the stream.
+//
+// Log events only notify the subscribers data was written
+// to a log so the data is read from the database, which
+// allows clients to resume the stream from any offset.
+type Event struct {
+	Type    string           `json:"type"`
+	Build   *library.Build   `json:"build,omitempty"`
+	Service *library.Service `json:"service,omitempty"`
+	Step    *library.Step    `json:"step,omitempty"`
+}
+
+// BuildTopic returns the topic of the stream
+// for the status events of a build.
+func BuildTopic(id int64) string {
+	return fmt.Sprintf("builds/%d", id)
+}
+
+// LogTopic returns the topic of the stream for the
+// events of the log for a service or step.
+func LogTopic(l *library.Log) string {
+	if l.GetServiceID() > 0 {
+		return fmt.Sprintf("services/%d/logs", l.GetServiceID())
+	}
+
+	return fmt.Sprintf("steps/%d/logs", l.GetStepID())
+}
+
+// Final returns true when the status is final and
+// no more events are published for the resource.
+func Final(status string) bool {
+	return status != constants.StatusPending && status != constants.StatusRunning
+}
+
+// Decode parses an event received from the stream.
+func Decode(data []byte) (*Event, error) {
+	e := new(Event)
+
+	err := json.Unmarshal(data, e)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode stream event: %w", err)
+	}
+
+	return e, nil
+}
+
+// PublishBuild publishes the status of the build to the stream.
+func PublishBuild(ctx context.Context, s Service, b *library.Build) error {
+	return publish(ctx, s, BuildTopic(b.GetID()), &Event{Type: EventBuild, Build: b})
+}
+
+// PublishService publishes the status of the service to the stream.
+func PublishService(ctx context.Context, s Service, svc *library.Service) error {
+	return publish(ctx, s, BuildTopic(svc.GetBuildID()), &Event{Type: EventService, Service: svc})
+}
+
+// PublishStep publishes the status of the step to the stream.
+func PublishStep(ctx context.Context, s Service, step *library.Step) error {
+	return publish(ctx, s, BuildTopic(step.GetBuildID()), &Event{Type: EventStep, Step: step})
+}
+
+// PublishLog publishes that data was written to the log to the stream.
+func PublishLog(ctx context.Context, s Service, l *library.Log) error {
+	return publish(ctx, s, LogTopic(l), &Event{Type: EventLog})
+}
+
+// publish is a helper function to encode and publish
+// the event to the topic when a stream is configured.
+func publish(ctx context.Context, s Service, topic string, e *Event) error {
+	if s == nil {
+		return nil
+	}
+
+	data, err := json.Marshal(e)
+	if err != nil {
+		return fmt.Errorf("unable to encode stream event: %w", err)
+	}
+
+	return s.Publish(ctx, topic, data)
+}
@@ -0,0 +1,167 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package stream
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+)
+
+func TestStream_LogTopic(t *testing.T) {
+	// setup types
+	_service := new(library.Log)
+	_service.SetServiceID(1)
+
+	_step := new(library.Log)
+	_step.SetStepID(2)
+
+	// setup tests
+	tests := []struct {
+		log  *library.Log
+		want string
+	}{
+		{
+			log:  _service,
+			want: "services/1/logs",
+		},
+		{
+			log:  _step,
+			want: "steps/2/logs",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got := LogTopic(test.log)
+
+		if got != test.want {
+			t.Errorf("LogTopic is %v, want %v", got, test.want)
+		}
+	}
+
+	if got := BuildTopic(1); got != "builds/1" {
+		t.Errorf("BuildTopic is %v, want %v", got, "builds/1")
+	}
+}
+
+func TestStream_Final(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		status string
+		want   bool
+	}{
+		{
+			status: constants.StatusPending,
+			want:   false,
+		},
+		{
+			status: constants.StatusRunning,
+			want:   false,
+		},
+		{
+			status: constants.StatusSuccess,
+			want:   true,
+		},
+		{
+			status: constants.StatusKilled,
+			want:   true,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got := Final(test.status)
+
+		if got != test.want {
+			t.Errorf("Final for %s is %v, want %v", test.status, got, test.want)
+		}
+	}
+}
+
+func TestStream_Publish(t *testing.T) {
+	// setup types
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	_build := new(library.Build)
+	_build.SetID(1)
+	_build.SetStatus(constants.StatusRunning)
+
+	_service := new(library.Service)
+	_service.SetID(1)
+	_service.SetBuildID(1)
+	_service.SetStatus(constants.StatusSuccess)
+
+	_step := new(library.Step)
+	_step.SetID(1)
+	_step.SetBuildID(1)
+	_step.SetStatus(constants.StatusFailure)
+
+	_log := new(library.Log)
+	_log.SetStepID(1)
+
+	_stream, err := New(&Setup{Driver: "memory", Buffer: 10})
+	if err != nil {
+		t.Errorf("unable to create stream service: %v", err)
+	}
+
+	events, err := _stream.Subscribe(ctx, BuildTopic(1), LogTopic(_log))
+	if err != nil {
+		t.Errorf("unable to subscribe to stream: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		publish func() error
+		want    *Event
+	}{
+		{
+			publish: func() error { return PublishBuild(ctx, _stream, _build) },
+			want:    &Event{Type: EventBuild, Build: _build},
+		},
+		{
+			publish: func() error { return PublishService(ctx, _stream, _service) },
+			want:    &Event{Type: EventService, Service: _service},
+		},
+		{
+			publish: func() error { return PublishStep(ctx, _stream, _step) },
+			want:    &Event{Type: EventStep, Step: _step},
+		},
+		{
+			publish: func() error { return PublishLog(ctx, _stream, _log) },
+			want:    &Event{Type: EventLog},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		err := test.publish()
+		if err != nil {
+			t.Errorf("publishing %s event returned err: %v", test.want.Type, err)
+		}
+
+		got, err := Decode(<-events)
+		if err != nil {
+			t.Errorf("Decode for %s event returned err: %v", test.want.Type, err)
+		}
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("published event is %v, want %v", got, test.want)
+		}
+	}
+
+	// ensure publishing without a stream is a no-op
+	err = PublishBuild(ctx, nil, _build)
+	if err != nil {
+		t.Errorf("PublishBuild without stream returned err: %v", err)
+	}
+
+	_, err = Decode([]byte("foo"))
+	if err == nil {
+		t.Errorf("Decode should have returned err")
+	}
+}
@@ -0,0 +1,37 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package stream
+
+import (
+	"github.com/go-vela/server/constants"
+	"github.com/urfave/cli/v2"
+)
+
+// Flags represents all supported command line
+// interface (CLI) flags for the stream.
+//
+// https://pkg.go.dev/github.com/urfave/cli?tab=doc#Flag
+var Flags = []cli.Flag{
+	// Stream Flags
+
+	&cli.StringFlag{
+		EnvVars:  []string{"VELA_STREAM_DRIVER", "STREAM_DRIVER"},
+		FilePath: "/vela/stream/driver",
+		Name:     "stream.driver",
+		Usage:    "driver to be used for publishing live log and status events (memory or redis)",
+		Value:    constants.DriverMemory,
+	},
+	&cli.StringFlag{
+		EnvVars:  []string{"VELA_STREAM_ADDR", "STREAM_ADDR"},
+		FilePath: "/vela/stream/addr",
+		Name:     "stream.addr",
+		Usage:    "fully qualified url (<scheme>://<host>) for the redis stream shared between servers",
+	},
+	&cli.IntFlag{
+		EnvVars:  []string{"VELA_STREAM_BUFFER", "STREAM_BUFFER"},
+		FilePath: "/vela/stream/buffer",
+		Name:     "stream.buffer",
+		Usage:    "number of events buffered for each client following a build before events are dropped",
+		Value:    100,
+	},
+}
@@ -0,0 +1,11 @@
+// SPDX-License-Identifier: Apache-2.0
+
+// Package memory provides the ability for Vela to publish
+// events to the subscribers within the same server process.
+//
+// The events are not shared between multiple servers.
+//
+// Usage:
+//
+//	import "github.com/go-vela/server/stream/memory"
+package memory
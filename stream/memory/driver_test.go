@@ -0,0 +1,24 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package memory
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/go-vela/server/constants"
+)
+
+func TestMemory_Driver(t *testing.T) {
+	// setup types
+	want := constants.DriverMemory
+
+	_service := testClient(t)
+
+	// run test
+	got := _service.Driver()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Driver is %v, want %v", got, want)
+	}
+}
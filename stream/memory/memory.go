@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package memory
+
+import (
+	"sync"
+
+	"github.com/sirupsen/logrus"
+)
+
+type config struct {
+	// specifies the number of events buffered for each subscriber of the Memory client
+	Buffer int
+}
+
+type client struct {
+	config *config
+	// channels of the subscribers for each topic
+	topics map[string]map[chan []byte]struct{}
+	mutex  sync.RWMutex
+	// https://pkg.go.dev/github.com/sirupsen/logrus#Entry
+	Logger *logrus.Entry
+}
+
+// New returns a Stream implementation that
+// integrates with an in-process stream.
+//
+//nolint:revive // ignore returning unexported client
+func New(opts ...ClientOpt) (*client, error) {
+	// create new Memory client
+	c := new(client)
+
+	// create new fields
+	c.config = new(config)
+	c.topics = make(map[string]map[chan []byte]struct{})
+
+	// create new logger for the client
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#StandardLogger
+	logger := logrus.StandardLogger()
+
+	// create new logger for the client
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#NewEntry
+	c.Logger = logrus.NewEntry(logger).WithField("stream", c.Driver())
+
+	// apply all provided configuration options
+	for _, opt := range opts {
+		err := opt(c)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return c, nil
+}
@@ -0,0 +1,55 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package memory
+
+import (
+	"testing"
+)
+
+func TestMemory_New(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		buffer  int
+	}{
+		{
+			failure: false,
+			buffer:  10,
+		},
+		{
+			failure: true,
+			buffer:  0,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		_, err := New(
+			WithBuffer(test.buffer),
+		)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("New should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("New returned err: %v", err)
+		}
+	}
+}
+
+// testClient is a helper function to create a Memory stream client for testing.
+func testClient(t *testing.T) *client {
+	t.Helper()
+
+	_service, err := New(WithBuffer(1))
+	if err != nil {
+		t.Fatalf("unable to create memory stream client: %v", err)
+	}
+
+	return _service
+}
@@ -0,0 +1,25 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package memory
+
+import "fmt"
+
+// ClientOpt represents a configuration option to initialize the stream client for Memory.
+type ClientOpt func(*client) error
+
+// WithBuffer sets the number of events buffered for each subscriber in the stream client for Memory.
+func WithBuffer(buffer int) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring buffer in memory stream client")
+
+		// check if the buffer provided is valid
+		if buffer <= 0 {
+			return fmt.Errorf("invalid Memory stream buffer provided: %d", buffer)
+		}
+
+		// set the stream buffer in the memory client
+		c.config.Buffer = buffer
+
+		return nil
+	}
+}
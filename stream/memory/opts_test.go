@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package memory
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+)
+
+func TestMemory_ClientOpt_WithBuffer(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		buffer  int
+		want    int
+	}{
+		{
+			failure: false,
+			buffer:  100,
+			want:    100,
+		},
+		{
+			failure: true,
+			buffer:  0,
+			want:    0,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		_service := &client{config: new(config), Logger: logrus.NewEntry(logrus.StandardLogger())}
+
+		err := WithBuffer(test.buffer)(_service)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("WithBuffer should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("WithBuffer returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(_service.config.Buffer, test.want) {
+			t.Errorf("WithBuffer is %v, want %v", _service.config.Buffer, test.want)
+		}
+	}
+}
@@ -0,0 +1,26 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package memory
+
+import "context"
+
+// Publish sends an event to the subscribers of the topic.
+//
+// The event is dropped for subscribers with a full
+// buffer so slow clients do not block the publisher.
+func (c *client) Publish(_ context.Context, topic string, data []byte) error {
+	c.Logger.Tracef("publishing event to topic %s", topic)
+
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	for subscriber := range c.topics[topic] {
+		select {
+		case subscriber <- data:
+		default:
+			c.Logger.Warnf("dropping event for slow subscriber to topic %s", topic)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package memory
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestMemory_Publish(t *testing.T) {
+	// setup types
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	_service := testClient(t)
+
+	events, err := _service.Subscribe(ctx, "builds/1")
+	if err != nil {
+		t.Fatalf("unable to subscribe to memory stream: %v", err)
+	}
+
+	// run tests
+	err = _service.Publish(ctx, "builds/1", []byte("foo"))
+	if err != nil {
+		t.Errorf("Publish returned err: %v", err)
+	}
+
+	// the buffer of the subscriber is full
+	err = _service.Publish(ctx, "builds/1", []byte("bar"))
+	if err != nil {
+		t.Errorf("Publish for full subscriber returned err: %v", err)
+	}
+
+	// no subscribers for the topic
+	err = _service.Publish(ctx, "builds/2", []byte("baz"))
+	if err != nil {
+		t.Errorf("Publish without subscribers returned err: %v", err)
+	}
+
+	got := <-events
+
+	if !reflect.DeepEqual(got, []byte("foo")) {
+		t.Errorf("Publish is %s, want %s", got, "foo")
+	}
+
+	select {
+	case got = <-events:
+		t.Errorf("Publish sent %s to full subscriber", got)
+	default:
+	}
+}
@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package memory
+
+import "context"
+
+// Subscribe receives the events published to the
+// topics until the context is canceled.
+func (c *client) Subscribe(ctx context.Context, topics ...string) (<-chan []byte, error) {
+	c.Logger.Tracef("subscribing to topics %v", topics)
+
+	subscriber := make(chan []byte, c.config.Buffer)
+
+	c.mutex.Lock()
+
+	for _, topic := range topics {
+		if c.topics[topic] == nil {
+			c.topics[topic] = make(map[chan []byte]struct{})
+		}
+
+		c.topics[topic][subscriber] = struct{}{}
+	}
+
+	c.mutex.Unlock()
+
+	// remove the subscriber once the context is canceled
+	go func() {
+		<-ctx.Done()
+
+		c.mutex.Lock()
+		defer c.mutex.Unlock()
+
+		for _, topic := range topics {
+			delete(c.topics[topic], subscriber)
+
+			if len(c.topics[topic]) == 0 {
+				delete(c.topics, topic)
+			}
+		}
+
+		close(subscriber)
+	}()
+
+	return subscriber, nil
+}
@@ -0,0 +1,49 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package memory
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestMemory_Subscribe(t *testing.T) {
+	// setup types
+	ctx, cancel := context.WithCancel(context.Background())
+
+	_service := testClient(t)
+
+	// run test
+	events, err := _service.Subscribe(ctx, "builds/1", "steps/1/logs")
+	if err != nil {
+		t.Errorf("Subscribe returned err: %v", err)
+	}
+
+	for _, topic := range []string{"builds/1", "steps/1/logs"} {
+		err = _service.Publish(ctx, topic, []byte(topic))
+		if err != nil {
+			t.Errorf("unable to publish to memory stream: %v", err)
+		}
+
+		got := <-events
+
+		if !reflect.DeepEqual(got, []byte(topic)) {
+			t.Errorf("Subscribe is %s, want %s", got, topic)
+		}
+	}
+
+	// ensure the subscriber is removed once the context is canceled
+	cancel()
+
+	if _, ok := <-events; ok {
+		t.Errorf("Subscribe should have closed the channel")
+	}
+
+	_service.mutex.RLock()
+	defer _service.mutex.RUnlock()
+
+	if len(_service.topics) > 0 {
+		t.Errorf("Subscribe left topics %v", _service.topics)
+	}
+}
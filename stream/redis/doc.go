@@ -0,0 +1,10 @@
+// SPDX-License-Identifier: Apache-2.0
+
+// Package redis provides the ability for Vela to publish
+// events to the subscribers on every server through Redis
+// pub/sub.
+//
+// Usage:
+//
+//	import "github.com/go-vela/server/stream/redis"
+package redis
@@ -0,0 +1,27 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/go-vela/types/constants"
+)
+
+func TestRedis_Driver(t *testing.T) {
+	// setup types
+	want := constants.DriverRedis
+
+	_service, err := NewTest()
+	if err != nil {
+		t.Errorf("unable to create stream service: %v", err)
+	}
+
+	// run test
+	got := _service.Driver()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Driver is %v, want %v", got, want)
+	}
+}
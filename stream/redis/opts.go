@@ -0,0 +1,42 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import "fmt"
+
+// ClientOpt represents a configuration option to initialize the stream client for Redis.
+type ClientOpt func(*client) error
+
+// WithAddress sets the address in the stream client for Redis.
+func WithAddress(address string) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring address in redis stream client")
+
+		// check if the address provided is empty
+		if len(address) == 0 {
+			return fmt.Errorf("no Redis stream address provided")
+		}
+
+		// set the stream address in the redis client
+		c.config.Address = address
+
+		return nil
+	}
+}
+
+// WithBuffer sets the number of events buffered for each subscriber in the stream client for Redis.
+func WithBuffer(buffer int) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring buffer in redis stream client")
+
+		// check if the buffer provided is valid
+		if buffer <= 0 {
+			return fmt.Errorf("invalid Redis stream buffer provided: %d", buffer)
+		}
+
+		// set the stream buffer in the redis client
+		c.config.Buffer = buffer
+
+		return nil
+	}
+}
@@ -0,0 +1,15 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import "context"
+
+// Publish sends an event to the subscribers of the topic on every server.
+func (c *client) Publish(ctx context.Context, topic string, data []byte) error {
+	c.Logger.Tracef("publishing event to topic %s", topic)
+
+	// send event to the topic
+	//
+	// https://pkg.go.dev/github.com/redis/go-redis/v9#Client.Publish
+	return c.Redis.Publish(ctx, topic, data).Err()
+}
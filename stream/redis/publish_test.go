@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestRedis_Publish(t *testing.T) {
+	// setup types
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	_service, err := NewTest()
+	if err != nil {
+		t.Errorf("unable to create stream service: %v", err)
+	}
+
+	events, err := _service.Subscribe(ctx, "builds/1")
+	if err != nil {
+		t.Fatalf("unable to subscribe to redis stream: %v", err)
+	}
+
+	// run tests
+	err = _service.Publish(ctx, "builds/1", []byte("foo"))
+	if err != nil {
+		t.Errorf("Publish returned err: %v", err)
+	}
+
+	// no subscribers for the topic
+	err = _service.Publish(ctx, "builds/2", []byte("bar"))
+	if err != nil {
+		t.Errorf("Publish without subscribers returned err: %v", err)
+	}
+
+	select {
+	case got := <-events:
+		if !reflect.DeepEqual(got, []byte("foo")) {
+			t.Errorf("Publish is %s, want %s", got, "foo")
+		}
+	case <-time.After(5 * time.Second):
+		t.Errorf("Publish did not send the event")
+	}
+}
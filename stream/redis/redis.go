@@ -0,0 +1,119 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/sirupsen/logrus"
+)
+
+type config struct {
+	// specifies the address to use for the Redis client
+	Address string
+	// specifies the number of events buffered for each subscriber of the Redis client
+	Buffer int
+}
+
+type client struct {
+	config *config
+	Redis  *redis.Client
+	// https://pkg.go.dev/github.com/sirupsen/logrus#Entry
+	Logger *logrus.Entry
+}
+
+// New returns a Stream implementation that
+// integrates with Redis pub/sub.
+//
+//nolint:revive // ignore returning unexported client
+func New(opts ...ClientOpt) (*client, error) {
+	// create new Redis client
+	c := new(client)
+
+	// create new fields
+	c.config = new(config)
+
+	// create new logger for the client
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#StandardLogger
+	logger := logrus.StandardLogger()
+
+	// create new logger for the client
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#NewEntry
+	c.Logger = logrus.NewEntry(logger).WithField("stream", c.Driver())
+
+	// apply all provided configuration options
+	for _, opt := range opts {
+		err := opt(c)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// parse the url provided
+	options, err := redis.ParseURL(c.config.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	// create the Redis client from the parsed url
+	c.Redis = redis.NewClient(options)
+
+	// ping the stream
+	err = pingStream(c)
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// pingStream is a helper function to send a "ping"
+// request with backoff to the stream.
+//
+// This will ensure we have properly established a
+// connection to the Redis instance before we try
+// to subscribe to it.
+func pingStream(c *client) error {
+	// attempt 10 times
+	for i := 0; i < 10; i++ {
+		// send ping request to client
+		err := c.Redis.Ping(context.Background()).Err()
+		if err != nil {
+			c.Logger.Debugf("unable to ping Redis stream. Retrying in %v", time.Duration(i)*time.Second)
+			time.Sleep(1 * time.Second)
+
+			continue
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("unable to establish connection to Redis stream")
+}
+
+// NewTest returns a Stream implementation that
+// integrates with a local Redis instance.
+//
+// This function is intended for running tests only.
+//
+//nolint:revive // ignore returning unexported client
+func NewTest() (*client, error) {
+	// create a local fake redis instance
+	//
+	// https://pkg.go.dev/github.com/alicebob/miniredis/v2#Run
+	_redis, err := miniredis.Run()
+	if err != nil {
+		return nil, err
+	}
+
+	return New(
+		WithAddress(fmt.Sprintf("redis://%s", _redis.Addr())),
+		WithBuffer(1),
+	)
+}
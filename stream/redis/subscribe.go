@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Subscribe receives the events published to the
+// topics until the context is canceled.
+//
+// The events are dropped for subscribers with a full
+// buffer so slow clients do not block the others.
+func (c *client) Subscribe(ctx context.Context, topics ...string) (<-chan []byte, error) {
+	c.Logger.Tracef("subscribing to topics %v", topics)
+
+	// subscribe to the topics
+	//
+	// https://pkg.go.dev/github.com/redis/go-redis/v9#Client.Subscribe
+	pubsub := c.Redis.Subscribe(ctx, topics...)
+
+	// wait for the subscription to be confirmed so
+	// the events published afterwards are received
+	_, err := pubsub.Receive(ctx)
+	if err != nil {
+		pubsub.Close()
+
+		return nil, fmt.Errorf("unable to subscribe to topics %v: %w", topics, err)
+	}
+
+	subscriber := make(chan []byte, c.config.Buffer)
+	messages := pubsub.Channel(redis.WithChannelSize(c.config.Buffer))
+
+	go func() {
+		defer close(subscriber)
+		defer pubsub.Close()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case message, ok := <-messages:
+				if !ok {
+					return
+				}
+
+				select {
+				case subscriber <- []byte(message.Payload):
+				default:
+					c.Logger.Warnf("dropping event for slow subscriber to topic %s", message.Channel)
+				}
+			}
+		}
+	}()
+
+	return subscriber, nil
+}
@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestRedis_Subscribe(t *testing.T) {
+	// setup types
+	ctx, cancel := context.WithCancel(context.Background())
+
+	_service, err := NewTest()
+	if err != nil {
+		t.Errorf("unable to create stream service: %v", err)
+	}
+
+	// run test
+	events, err := _service.Subscribe(ctx, "builds/1", "steps/1/logs")
+	if err != nil {
+		t.Errorf("Subscribe returned err: %v", err)
+	}
+
+	for _, topic := range []string{"builds/1", "steps/1/logs"} {
+		err = _service.Publish(ctx, topic, []byte(topic))
+		if err != nil {
+			t.Errorf("unable to publish to redis stream: %v", err)
+		}
+
+		select {
+		case got := <-events:
+			if !reflect.DeepEqual(got, []byte(topic)) {
+				t.Errorf("Subscribe is %s, want %s", got, topic)
+			}
+		case <-time.After(5 * time.Second):
+			t.Errorf("Subscribe did not receive the event for %s", topic)
+		}
+	}
+
+	// ensure the channel is closed once the context is canceled
+	cancel()
+
+	select {
+	case _, ok := <-events:
+		if ok {
+			t.Errorf("Subscribe should have closed the channel")
+		}
+	case <-time.After(5 * time.Second):
+		t.Errorf("Subscribe did not close the channel")
+	}
+
+	// ensure the subscription fails with a canceled context
+	_, err = _service.Subscribe(ctx, "builds/1")
+	if err == nil {
+		t.Errorf("Subscribe should have returned err")
+	}
+}
@@ -0,0 +1,24 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package stream
+
+import "context"
+
+// Service represents the interface for Vela integrating
+// with the different supported Stream backends.
+type Service interface {
+	// Service Interface Functions
+
+	// Driver defines a function that outputs
+	// the configured stream driver.
+	Driver() string
+
+	// Publish defines a function that publishes
+	// an event to a topic of the stream.
+	Publish(context.Context, string, []byte) error
+
+	// Subscribe defines a function that receives the events
+	// published to the topics of the stream until the
+	// context is canceled, closing the channel returned.
+	Subscribe(context.Context, ...string) (<-chan []byte, error)
+}
@@ -0,0 +1,88 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package stream
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-vela/server/stream/memory"
+	"github.com/go-vela/server/stream/redis"
+	"github.com/go-vela/types/constants"
+	"github.com/sirupsen/logrus"
+)
+
+// Setup represents the configuration necessary for
+// creating a Vela service capable of integrating
+// with a configured stream environment.
+type Setup struct {
+	// Stream Configuration
+
+	// specifies the driver to use for the stream client
+	Driver string
+	// specifies the address to use for the stream client
+	Address string
+	// specifies the number of events buffered for each subscriber of the stream client
+	Buffer int
+}
+
+// Memory creates and returns a Vela service capable
+// of integrating with an in-process stream.
+func (s *Setup) Memory() (Service, error) {
+	logrus.Trace("creating memory stream client from setup")
+
+	// create new Memory stream service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/stream/memory?tab=doc#New
+	return memory.New(
+		memory.WithBuffer(s.Buffer),
+	)
+}
+
+// Redis creates and returns a Vela service capable
+// of integrating with Redis pub/sub.
+func (s *Setup) Redis() (Service, error) {
+	logrus.Trace("creating redis stream client from setup")
+
+	// create new Redis stream service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/stream/redis?tab=doc#New
+	return redis.New(
+		redis.WithAddress(s.Address),
+		redis.WithBuffer(s.Buffer),
+	)
+}
+
+// Validate verifies the necessary fields for the
+// provided configuration are populated correctly.
+func (s *Setup) Validate() error {
+	logrus.Trace("validating stream setup for client")
+
+	// verify a stream driver was provided
+	if len(s.Driver) == 0 {
+		return fmt.Errorf("no stream driver provided")
+	}
+
+	// verify a stream address was provided for redis
+	if len(s.Address) == 0 && s.Driver == constants.DriverRedis {
+		return fmt.Errorf("no stream address provided")
+	}
+
+	// check if the stream address has a scheme
+	if len(s.Address) > 0 && !strings.Contains(s.Address, "://") {
+		return fmt.Errorf("stream address must be fully qualified (<scheme>://<host>)")
+	}
+
+	// check if the stream address has a trailing slash
+	if strings.HasSuffix(s.Address, "/") {
+		return fmt.Errorf("stream address must not have trailing slash")
+	}
+
+	// verify a stream buffer was provided
+	if s.Buffer <= 0 {
+		return fmt.Errorf("stream buffer must be greater than 0")
+	}
+
+	// setup is valid
+	return nil
+}
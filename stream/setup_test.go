@@ -0,0 +1,124 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package stream
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+)
+
+func TestStream_Setup_Memory(t *testing.T) {
+	// setup types
+	_setup := &Setup{
+		Driver: "memory",
+		Buffer: 100,
+	}
+
+	_, err := _setup.Memory()
+	if err != nil {
+		t.Errorf("Memory returned err: %v", err)
+	}
+}
+
+func TestStream_Setup_Redis(t *testing.T) {
+	// setup types
+	// create a local fake redis instance
+	//
+	// https://pkg.go.dev/github.com/alicebob/miniredis/v2#Run
+	_redis, err := miniredis.Run()
+	if err != nil {
+		t.Errorf("unable to create miniredis instance: %v", err)
+	}
+	defer _redis.Close()
+
+	_setup := &Setup{
+		Driver:  "redis",
+		Address: fmt.Sprintf("redis://%s", _redis.Addr()),
+		Buffer:  100,
+	}
+
+	_, err = _setup.Redis()
+	if err != nil {
+		t.Errorf("Redis returned err: %v", err)
+	}
+}
+
+func TestStream_Setup_Validate(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		setup   *Setup
+	}{
+		{
+			failure: false,
+			setup: &Setup{
+				Driver: "memory",
+				Buffer: 100,
+			},
+		},
+		{
+			failure: false,
+			setup: &Setup{
+				Driver:  "redis",
+				Address: "redis://redis.example.com",
+				Buffer:  100,
+			},
+		},
+		{
+			failure: true,
+			setup: &Setup{
+				Driver: "",
+				Buffer: 100,
+			},
+		},
+		{
+			failure: true,
+			setup: &Setup{
+				Driver: "redis",
+				Buffer: 100,
+			},
+		},
+		{
+			failure: true,
+			setup: &Setup{
+				Driver:  "redis",
+				Address: "redis.example.com",
+				Buffer:  100,
+			},
+		},
+		{
+			failure: true,
+			setup: &Setup{
+				Driver:  "redis",
+				Address: "redis://redis.example.com/",
+				Buffer:  100,
+			},
+		},
+		{
+			failure: true,
+			setup: &Setup{
+				Driver: "memory",
+				Buffer: 0,
+			},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		err := test.setup.Validate()
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("Validate should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("Validate returned err: %v", err)
+		}
+	}
+}
@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package stream
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-contrib/sse"
+	"github.com/gin-gonic/gin"
+)
+
+// Heartbeat defines the interval for sending comments
+// to the clients of a stream to keep the connection open.
+const Heartbeat = 15 * time.Second
+
+// Open is a helper function to start sending the
+// response to the client as server-sent events.
+func Open(c *gin.Context) {
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	// disable buffering the response in nginx
+	c.Header("X-Accel-Buffering", "no")
+
+	c.Status(http.StatusOK)
+	c.Writer.Flush()
+}
+
+// Write is a helper function to send the data
+// to the client as a server-sent event.
+//
+// https://html.spec.whatwg.org/multipage/server-sent-events.html
+func Write(c *gin.Context, id, event string, data interface{}) {
+	c.Render(-1, sse.Event{
+		Id:    id,
+		Event: event,
+		Data:  data,
+	})
+
+	c.Writer.Flush()
+}
+
+// Ping is a helper function to send a comment
+// to the client to keep the connection open.
+func Ping(c *gin.Context) {
+	_, _ = c.Writer.WriteString(": ping\n\n")
+
+	c.Writer.Flush()
+}
@@ -0,0 +1,44 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package stream
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestStream_SSE(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	context, engine := gin.CreateTestContext(resp)
+	context.Request, _ = http.NewRequest(http.MethodGet, "/stream", nil)
+
+	// setup mock server
+	engine.GET("/stream", func(c *gin.Context) {
+		Open(c)
+		Write(c, "4", EventLog, map[string]string{"foo": "bar"})
+		Ping(c)
+	})
+
+	// run test
+	engine.ServeHTTP(context.Writer, context.Request)
+
+	if resp.Code != http.StatusOK {
+		t.Errorf("SSE returned %v, want %v", resp.Code, http.StatusOK)
+	}
+
+	if got := resp.Header().Get("Content-Type"); got != "text/event-stream" {
+		t.Errorf("SSE Content-Type is %v, want %v", got, "text/event-stream")
+	}
+
+	want := "id:4\nevent:log\ndata:{\"foo\":\"bar\"}\n\n: ping\n\n"
+
+	if got := resp.Body.String(); got != want {
+		t.Errorf("SSE is %q, want %q", got, want)
+	}
+}
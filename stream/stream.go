@@ -0,0 +1,46 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package stream
+
+import (
+	"fmt"
+
+	serverconstants "github.com/go-vela/server/constants"
+	"github.com/go-vela/types/constants"
+	"github.com/sirupsen/logrus"
+)
+
+// New creates and returns a Vela service capable of
+// integrating with the configured stream environment.
+// Currently, the following streams are supported:
+//
+// * memory
+// * redis
+// .
+func New(s *Setup) (Service, error) {
+	// validate the setup being provided
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/stream?tab=doc#Setup.Validate
+	err := s.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	logrus.Debug("creating stream client from setup")
+	// process the stream driver being provided
+	switch s.Driver {
+	case serverconstants.DriverMemory:
+		// handle the Memory stream driver being provided
+		//
+		// https://pkg.go.dev/github.com/go-vela/server/stream?tab=doc#Setup.Memory
+		return s.Memory()
+	case constants.DriverRedis:
+		// handle the Redis stream driver being provided
+		//
+		// https://pkg.go.dev/github.com/go-vela/server/stream?tab=doc#Setup.Redis
+		return s.Redis()
+	default:
+		// handle an invalid stream driver being provided
+		return nil, fmt.Errorf("invalid stream driver provided: %s", s.Driver)
+	}
+}
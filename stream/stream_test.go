@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package stream
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+)
+
+func TestStream_New(t *testing.T) {
+	// setup types
+	// create a local fake redis instance
+	//
+	// https://pkg.go.dev/github.com/alicebob/miniredis/v2#Run
+	_redis, err := miniredis.Run()
+	if err != nil {
+		t.Errorf("unable to create miniredis instance: %v", err)
+	}
+	defer _redis.Close()
+
+	// setup tests
+	tests := []struct {
+		failure bool
+		setup   *Setup
+		want    string
+	}{
+		{
+			failure: false,
+			setup: &Setup{
+				Driver: "memory",
+				Buffer: 100,
+			},
+			want: "memory",
+		},
+		{
+			failure: false,
+			setup: &Setup{
+				Driver:  "redis",
+				Address: fmt.Sprintf("redis://%s", _redis.Addr()),
+				Buffer:  100,
+			},
+			want: "redis",
+		},
+		{
+			failure: true,
+			setup: &Setup{
+				Driver: "kafka",
+				Buffer: 100,
+			},
+		},
+		{
+			failure: true,
+			setup: &Setup{
+				Driver: "",
+				Buffer: 100,
+			},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got, err := New(test.setup)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("New should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("New returned err: %v", err)
+		}
+
+		if got.Driver() != test.want {
+			t.Errorf("New is %v, want %v", got.Driver(), test.want)
+		}
+	}
+}